@@ -248,6 +248,10 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	// appSyncMap tracks which apps will be synced during this reconciliation.
 	appSyncMap := map[string]bool{}
+	// progressiveSyncRequeueAfter tracks when the rollout gates need to be evaluated again.
+	var progressiveSyncRequeueAfter time.Duration
+	// rolloutPromotionCompleted tracks whether the rollout of a promoted step has completed.
+	rolloutPromotionCompleted := false
 
 	if r.EnableProgressiveSyncs {
 		if !progressivesync.IsRollingSyncStrategy(&applicationSetInfo) && len(applicationSetInfo.Status.ApplicationStatus) > 0 {
@@ -274,7 +278,7 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 				)
 				return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
 			}
-			appSyncMap, err = r.ProgressiveSyncManager.PerformProgressiveSyncs(ctx, logCtx, applicationSetInfo, currentApplications, generatedApplications)
+			appSyncMap, progressiveSyncRequeueAfter, rolloutPromotionCompleted, err = r.ProgressiveSyncManager.PerformProgressiveSyncs(ctx, logCtx, applicationSetInfo, currentApplications, generatedApplications)
 			if err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to perform progressive sync reconciliation for application set: %w", err)
 			}
@@ -331,6 +335,7 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		// trigger appropriate application syncs if RollingSync strategy is enabled
 		if progressivesync.RollingSyncStrategyEnabled(&applicationSetInfo) {
 			validApps = r.ProgressiveSyncManager.SyncDesiredApplications(logCtx, &applicationSetInfo, appSyncMap, validApps)
+			validApps = r.ProgressiveSyncManager.RollbackDesiredApplications(logCtx, &applicationSetInfo, currentApplications, validApps)
 		}
 	}
 
@@ -422,7 +427,24 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
+	if rolloutPromotionCompleted {
+		// The promotion only applies to the rollout it was requested for
+		logCtx.Info("ApplicationSet rollout has completed, removing the promoted step")
+		updated := applicationSetInfo.DeepCopy()
+		delete(updated.Annotations, common.AnnotationApplicationSetRolloutPromotedStep)
+		err := r.Patch(ctx, updated, client.MergeFrom(&applicationSetInfo))
+		if err != nil {
+			logCtx.Warnf("error occurred while removing the promoted step of ApplicationSet: %v", err)
+			return ctrl.Result{}, err
+		}
+		// The ApplicationSet must have the updated annotations
+		updated.DeepCopyInto(&applicationSetInfo)
+	}
+
 	requeueAfter := r.getMinRequeueAfter(&applicationSetInfo)
+	if progressiveSyncRequeueAfter > 0 && (requeueAfter == 0 || progressiveSyncRequeueAfter < requeueAfter) {
+		requeueAfter = progressiveSyncRequeueAfter
+	}
 
 	if len(validateErrors) == 0 {
 		if err := r.setApplicationSetStatusCondition(ctx,
@@ -1160,8 +1182,9 @@ func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Contex
 			statusChanged := currentStatus.Status != appStatus.Status
 			stepChanged := currentStatus.Step != appStatus.Step
 			messageChanged := currentStatus.Message != appStatus.Message
+			gateChanged := !cmp.Equal(currentStatus.Gate, appStatus.Gate)
 
-			if statusChanged || stepChanged || messageChanged {
+			if statusChanged || stepChanged || messageChanged || gateChanged {
 				if statusChanged {
 					logCtx.WithFields(log.Fields{"application": appStatus.Application, "previous_status": currentStatus.Status, "new_status": appStatus.Status}).
 						Debug("application status changed")
@@ -1173,6 +1196,9 @@ func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Contex
 				if messageChanged {
 					logCtx.WithFields(log.Fields{"application": appStatus.Application}).Debug("application message changed")
 				}
+				if gateChanged {
+					logCtx.WithFields(log.Fields{"application": appStatus.Application}).Debug("application gate changed")
+				}
 				needToUpdateStatus = true
 				break
 			}
@@ -0,0 +1,366 @@
+package progressivesync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	defaultAnalysisInterval   = time.Minute
+	defaultWebAnalysisTimeout = 10 * time.Second
+	// maxWebAnalysisTimeout bounds the timeout of the web analysis requests, whatever the timeout of the analysis
+	maxWebAnalysisTimeout   = time.Minute
+	maxWebAnalysisRedirects = 10
+)
+
+type AnalysisResult string
+
+const (
+	// AnalysisPassed allows the next step to start
+	AnalysisPassed AnalysisResult = "Passed"
+	// AnalysisFailed halts the rollout at the analyzed step. The analysis is not run again until the gate is reset,
+	// when the Applications of the step stop being Healthy, or move to another step.
+	AnalysisFailed AnalysisResult = "Failed"
+	// AnalysisInconclusive retries the analysis after its interval
+	AnalysisInconclusive AnalysisResult = "Inconclusive"
+)
+
+// AnalysisRunner runs the analysis gating the RollingSync step of the ApplicationSet. It returns the result of the
+// analysis together with a human-readable message explaining it.
+type AnalysisRunner interface {
+	Run(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet, stepIndex int, analysis *argov1alpha1.ApplicationSetRolloutStepAnalysis) (AnalysisResult, string)
+}
+
+// defaultAnalysisRunner runs the analysis providers built into the ApplicationSet controller
+type defaultAnalysisRunner struct {
+	httpClient *http.Client
+	// allowedURLs are the URL prefixes the web analysis is allowed to request
+	allowedURLs []*url.URL
+}
+
+// newDefaultAnalysisRunner returns a runner whose web analysis only requests the URLs under one of the allowed URL
+// prefixes, or no URL at all when there is none. Redirects are only followed to allowed URLs.
+func newDefaultAnalysisRunner(allowedURLs []string) *defaultAnalysisRunner {
+	r := &defaultAnalysisRunner{}
+	for _, allowedURL := range allowedURLs {
+		u, err := url.Parse(allowedURL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			log.Errorf("Ignoring invalid allowed analysis URL %q", allowedURL)
+			continue
+		}
+		r.allowedURLs = append(r.allowedURLs, u)
+	}
+	r.httpClient = &http.Client{
+		Timeout: maxWebAnalysisTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxWebAnalysisRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxWebAnalysisRedirects)
+			}
+			if !r.urlAllowed(req.URL) {
+				return errors.New("redirected to a URL which is not allowed")
+			}
+			return nil
+		},
+	}
+	return r
+}
+
+// urlAllowed reports whether the URL has the scheme and the host of one of the allowed URL prefixes, and a path under
+// its path
+func (r *defaultAnalysisRunner) urlAllowed(u *url.URL) bool {
+	urlPath := path.Clean("/" + u.Path)
+	for _, allowed := range r.allowedURLs {
+		if !strings.EqualFold(u.Scheme, allowed.Scheme) || !strings.EqualFold(u.Host, allowed.Host) {
+			continue
+		}
+		prefix := strings.TrimSuffix(allowed.Path, "/")
+		if prefix == "" || urlPath == prefix || strings.HasPrefix(urlPath, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *defaultAnalysisRunner) Run(ctx context.Context, _ *argov1alpha1.ApplicationSet, _ int, analysis *argov1alpha1.ApplicationSetRolloutStepAnalysis) (AnalysisResult, string) {
+	if analysis.Web != nil {
+		return r.runWebAnalysis(ctx, analysis.Web)
+	}
+	return AnalysisInconclusive, "no analysis provider configured"
+}
+
+func (r *defaultAnalysisRunner) runWebAnalysis(ctx context.Context, web *argov1alpha1.ApplicationSetRolloutStepWebAnalysis) (AnalysisResult, string) {
+	timeout := defaultWebAnalysisTimeout
+	if web.TimeoutSeconds > 0 {
+		timeout = min(time.Duration(web.TimeoutSeconds)*time.Second, maxWebAnalysisTimeout)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, web.URL, http.NoBody)
+	if err != nil {
+		return AnalysisFailed, fmt.Sprintf("invalid analysis request: %v", err)
+	}
+	if !r.urlAllowed(req.URL) {
+		// Retried, so that the rollout proceeds once the URL is allowed by the controller
+		return AnalysisInconclusive, "analysis url is not allowed by the --allowed-analysis-urls setting of the applicationset-controller"
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			// Do not repeat the URL, which can contain credentials
+			err = urlErr.Err
+		}
+		return AnalysisInconclusive, fmt.Sprintf("analysis request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return AnalysisPassed, "analysis endpoint responded with " + resp.Status
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return AnalysisInconclusive, "analysis endpoint responded with " + resp.Status
+	default:
+		return AnalysisFailed, "analysis endpoint responded with " + resp.Status
+	}
+}
+
+// hasGate reports whether the next step needs to wait for the gate of the step, once its Applications are Healthy
+func hasGate(step argov1alpha1.ApplicationSetRolloutStep) bool {
+	return step.BakeTime != "" || step.Analysis != nil
+}
+
+// parseGate validates the gate of the step and returns its bake time and analysis interval
+func parseGate(step argov1alpha1.ApplicationSetRolloutStep) (bakeTime time.Duration, analysisInterval time.Duration, err error) {
+	if step.BakeTime != "" {
+		bakeTime, err = time.ParseDuration(step.BakeTime)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid bakeTime: %w", err)
+		}
+		if bakeTime < 0 {
+			return 0, 0, fmt.Errorf("invalid bakeTime: %s is negative", step.BakeTime)
+		}
+	}
+	if step.Analysis != nil {
+		if step.Analysis.Web == nil {
+			return 0, 0, errors.New("analysis has no provider configured")
+		}
+		if step.Analysis.Web.URL == "" {
+			return 0, 0, errors.New("web analysis has no url configured")
+		}
+		analysisInterval = defaultAnalysisInterval
+		if step.Analysis.Interval != "" {
+			analysisInterval, err = time.ParseDuration(step.Analysis.Interval)
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid analysis interval: %w", err)
+			}
+			if analysisInterval <= 0 {
+				return 0, 0, fmt.Errorf("invalid analysis interval: %s is not positive", step.Analysis.Interval)
+			}
+		}
+	}
+	return bakeTime, analysisInterval, nil
+}
+
+// isStepHealthy reports whether all the Applications of the step are created and Healthy
+func isStepHealthy(applicationSet *argov1alpha1.ApplicationSet, appNames []string, currentAppsMap map[string]bool) bool {
+	for _, appName := range appNames {
+		// Check if application is created and managed by this AppSet, if it is not created yet, we cannot progress
+		if _, ok := currentAppsMap[appName]; !ok {
+			return false
+		}
+
+		idx := utils.FindApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+		if idx == -1 {
+			// No Application status found, likely because the Application is being newly created
+			// This mean this wave is not yet completed
+			return false
+		}
+
+		if applicationSet.Status.ApplicationStatus[idx].Status != argov1alpha1.ProgressiveSyncHealthy {
+			// At least one application in this wave is not yet healthy
+			return false
+		}
+	}
+	return true
+}
+
+// isStepGatePhase reports whether the gate of all the Applications of the step is in the phase
+func isStepGatePhase(applicationSet *argov1alpha1.ApplicationSet, appNames []string, phase argov1alpha1.ApplicationSetStepGatePhase) bool {
+	for _, appName := range appNames {
+		idx := utils.FindApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+		if idx == -1 {
+			return false
+		}
+		gate := applicationSet.Status.ApplicationStatus[idx].Gate
+		if gate == nil || gate.Phase != phase {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateApplicationSetStepGates evaluates the gates of the Healthy steps, in order, until it reaches a step that is not
+// allowed to proceed yet. It runs the analysis when the bake time of the step elapses, and records the gate status of
+// the steps into the status of their Applications. It returns the time after which the gates need to be evaluated
+// again, or zero when the progress does not depend on time.
+func (m *Manager) UpdateApplicationSetStepGates(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, appDependencyList [][]string, applications []argov1alpha1.Application) (time.Duration, error) {
+	if !RollingSyncStrategyEnabled(applicationSet) {
+		return 0, nil
+	}
+
+	currentAppsMap := map[string]bool{}
+	for _, app := range applications {
+		currentAppsMap[app.Name] = true
+	}
+
+	now := metav1.Now().Rfc3339Copy()
+	var requeueAfter time.Duration
+	gates := map[string]*argov1alpha1.ApplicationSetStepGateStatus{}
+
+	promotedStep := getPromotedStep(applicationSet)
+	for stepIndex, appNames := range appDependencyList {
+		step := applicationSet.Spec.Strategy.RollingSync.Steps[stepIndex]
+		if stepIndex < promotedStep {
+			// The step was promoted, its gate does not matter anymore
+			continue
+		}
+		if !isStepHealthy(applicationSet, appNames, currentAppsMap) {
+			break
+		}
+		if !hasGate(step) || len(appNames) == 0 {
+			continue
+		}
+		if isStepGatePhase(applicationSet, appNames, argov1alpha1.ApplicationSetStepGatePassed) {
+			continue
+		}
+		if isStepGatePhase(applicationSet, appNames, argov1alpha1.ApplicationSetStepGateFailed) {
+			// The failed gate holds until it is reset by a change of the Applications, or the step is promoted
+			break
+		}
+
+		stepLogCtx := logCtx.WithField("step", stepIndex+1)
+		bakeTime, analysisInterval, err := parseGate(step)
+		if err != nil {
+			stepLogCtx.Warnf("AppSet has an invalid gate, not proceeding with the rollout: %v", err)
+			break
+		}
+
+		// The bake time starts when the last Application of the step becomes Healthy
+		var healthySince time.Time
+		var lastAnalysisTime *metav1.Time
+		for _, appName := range appNames {
+			appStatus := applicationSet.Status.ApplicationStatus[utils.FindApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)]
+			if appStatus.LastTransitionTime != nil && appStatus.LastTransitionTime.After(healthySince) {
+				healthySince = appStatus.LastTransitionTime.Time
+			}
+			if appStatus.Gate != nil && appStatus.Gate.LastAnalysisTime != nil && (lastAnalysisTime == nil || appStatus.Gate.LastAnalysisTime.After(lastAnalysisTime.Time)) {
+				lastAnalysisTime = appStatus.Gate.LastAnalysisTime
+			}
+		}
+		bakeUntil := metav1.NewTime(healthySince.Add(bakeTime))
+
+		gate := &argov1alpha1.ApplicationSetStepGateStatus{LastAnalysisTime: lastAnalysisTime}
+		if bakeTime > 0 {
+			gate.BakeUntil = &bakeUntil
+		}
+
+		passed := false
+		switch {
+		case now.Before(&bakeUntil):
+			gate.Phase = argov1alpha1.ApplicationSetStepGateBaking
+			gate.Message = fmt.Sprintf("Step %d is Healthy, waiting for the bake time to elapse at %s", stepIndex+1, bakeUntil.UTC().Format(time.RFC3339))
+			requeueAfter = bakeUntil.Sub(now.Time)
+		case step.Analysis == nil:
+			gate.Phase = argov1alpha1.ApplicationSetStepGatePassed
+			gate.Message = fmt.Sprintf("Step %d bake time has elapsed", stepIndex+1)
+			passed = true
+		case lastAnalysisTime != nil && lastAnalysisTime.After(bakeUntil.Time) && now.Sub(lastAnalysisTime.Time) < analysisInterval:
+			// An inconclusive analysis is retried after the interval
+			gate = existingStepGate(applicationSet, appNames)
+			requeueAfter = analysisInterval - now.Sub(lastAnalysisTime.Time)
+		default:
+			result, message := m.analysisRunner.Run(ctx, applicationSet, stepIndex, step.Analysis)
+			stepLogCtx.WithField("result", result).Infof("RollingSync step analysis performed: %s", message)
+			gate.LastAnalysisTime = &now
+			switch result {
+			case AnalysisPassed:
+				gate.Phase = argov1alpha1.ApplicationSetStepGatePassed
+				gate.Message = fmt.Sprintf("Step %d analysis passed: %s", stepIndex+1, message)
+				passed = true
+			case AnalysisFailed:
+				gate.Phase = argov1alpha1.ApplicationSetStepGateFailed
+				gate.Message = fmt.Sprintf("Step %d analysis failed: %s", stepIndex+1, message)
+			default:
+				gate.Phase = argov1alpha1.ApplicationSetStepGateAnalyzing
+				gate.Message = fmt.Sprintf("Step %d analysis was inconclusive, retrying in %s: %s", stepIndex+1, analysisInterval, message)
+				requeueAfter = analysisInterval
+			}
+		}
+
+		for _, appName := range appNames {
+			gates[appName] = gate
+		}
+		if !passed {
+			break
+		}
+	}
+
+	if len(gates) == 0 {
+		return requeueAfter, nil
+	}
+
+	appStatuses := make([]argov1alpha1.ApplicationSetApplicationStatus, 0, len(applicationSet.Status.ApplicationStatus))
+	for _, appStatus := range applicationSet.Status.ApplicationStatus {
+		if gate, ok := gates[appStatus.Application]; ok {
+			appStatus.Gate = gate.DeepCopy()
+			if gate.Phase != appStatusGatePhase(appStatus) {
+				logCtx.WithFields(log.Fields{
+					"app.name":           appStatus.Application,
+					"status.step":        appStatus.Step,
+					"new_gate.phase":     gate.Phase,
+					"new_gate.message":   gate.Message,
+					"new_gate.bakeUntil": gate.BakeUntil,
+				}).Info("Progressive sync application gate changed phase")
+			}
+		}
+		appStatuses = append(appStatuses, appStatus)
+	}
+
+	err := m.dependencies.SetAppSetApplicationStatus(ctx, logCtx, applicationSet, appStatuses)
+	if err != nil {
+		return 0, fmt.Errorf("failed to set AppSet application gate statuses: %w", err)
+	}
+	return requeueAfter, nil
+}
+
+// existingStepGate returns the gate status recorded for the step, i.e. the one of its Application analyzed most recently
+func existingStepGate(applicationSet *argov1alpha1.ApplicationSet, appNames []string) *argov1alpha1.ApplicationSetStepGateStatus {
+	var gate *argov1alpha1.ApplicationSetStepGateStatus
+	for _, appName := range appNames {
+		current := applicationSet.Status.ApplicationStatus[utils.FindApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)].Gate
+		if current != nil && current.LastAnalysisTime != nil && (gate == nil || current.LastAnalysisTime.After(gate.LastAnalysisTime.Time)) {
+			gate = current
+		}
+	}
+	return gate
+}
+
+func appStatusGatePhase(appStatus argov1alpha1.ApplicationSetApplicationStatus) argov1alpha1.ApplicationSetStepGatePhase {
+	if appStatus.Gate == nil {
+		return ""
+	}
+	return appStatus.Gate.Phase
+}
@@ -0,0 +1,361 @@
+package progressivesync
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeDependencies struct{}
+
+func (d *fakeDependencies) SetAppSetApplicationStatus(_ context.Context, _ *log.Entry, applicationSet *v1alpha1.ApplicationSet, applicationStatuses []v1alpha1.ApplicationSetApplicationStatus) error {
+	applicationSet.Status.ApplicationStatus = applicationStatuses
+	return nil
+}
+
+func (d *fakeDependencies) SetApplicationSetStatusCondition(_ context.Context, _ *v1alpha1.ApplicationSet, _ []v1alpha1.ApplicationSetCondition, _ bool) error {
+	return nil
+}
+
+type fakeAnalysisRunner struct {
+	result AnalysisResult
+	steps  []int
+}
+
+func (r *fakeAnalysisRunner) Run(_ context.Context, _ *v1alpha1.ApplicationSet, stepIndex int, _ *v1alpha1.ApplicationSetRolloutStepAnalysis) (AnalysisResult, string) {
+	r.steps = append(r.steps, stepIndex)
+	return r.result, "fake analysis"
+}
+
+func TestParseGate(t *testing.T) {
+	t.Parallel()
+	for _, cc := range []struct {
+		name             string
+		step             v1alpha1.ApplicationSetRolloutStep
+		expectedBakeTime time.Duration
+		expectedInterval time.Duration
+		expectedErr      string
+	}{
+		{
+			name: "no gate",
+			step: v1alpha1.ApplicationSetRolloutStep{},
+		},
+		{
+			name:             "bake time",
+			step:             v1alpha1.ApplicationSetRolloutStep{BakeTime: "30m"},
+			expectedBakeTime: 30 * time.Minute,
+		},
+		{
+			name: "analysis with default interval",
+			step: v1alpha1.ApplicationSetRolloutStep{
+				Analysis: &v1alpha1.ApplicationSetRolloutStepAnalysis{Web: &v1alpha1.ApplicationSetRolloutStepWebAnalysis{URL: "https://example.com"}},
+			},
+			expectedInterval: time.Minute,
+		},
+		{
+			name: "bake time and analysis",
+			step: v1alpha1.ApplicationSetRolloutStep{
+				BakeTime: "1h",
+				Analysis: &v1alpha1.ApplicationSetRolloutStepAnalysis{Web: &v1alpha1.ApplicationSetRolloutStepWebAnalysis{URL: "https://example.com"}, Interval: "5m"},
+			},
+			expectedBakeTime: time.Hour,
+			expectedInterval: 5 * time.Minute,
+		},
+		{
+			name:        "invalid bake time",
+			step:        v1alpha1.ApplicationSetRolloutStep{BakeTime: "soon"},
+			expectedErr: `invalid bakeTime: time: invalid duration "soon"`,
+		},
+		{
+			name:        "negative bake time",
+			step:        v1alpha1.ApplicationSetRolloutStep{BakeTime: "-5m"},
+			expectedErr: "invalid bakeTime: -5m is negative",
+		},
+		{
+			name:        "analysis without provider",
+			step:        v1alpha1.ApplicationSetRolloutStep{Analysis: &v1alpha1.ApplicationSetRolloutStepAnalysis{}},
+			expectedErr: "analysis has no provider configured",
+		},
+		{
+			name:        "web analysis without url",
+			step:        v1alpha1.ApplicationSetRolloutStep{Analysis: &v1alpha1.ApplicationSetRolloutStepAnalysis{Web: &v1alpha1.ApplicationSetRolloutStepWebAnalysis{}}},
+			expectedErr: "web analysis has no url configured",
+		},
+		{
+			name: "invalid analysis interval",
+			step: v1alpha1.ApplicationSetRolloutStep{
+				Analysis: &v1alpha1.ApplicationSetRolloutStepAnalysis{Web: &v1alpha1.ApplicationSetRolloutStepWebAnalysis{URL: "https://example.com"}, Interval: "0s"},
+			},
+			expectedErr: "invalid analysis interval: 0s is not positive",
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			t.Parallel()
+			bakeTime, interval, err := parseGate(cc.step)
+			if cc.expectedErr != "" {
+				require.EqualError(t, err, cc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, cc.expectedBakeTime, bakeTime)
+			assert.Equal(t, cc.expectedInterval, interval)
+		})
+	}
+}
+
+func TestWebAnalysis(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/unavailable":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/slow":
+			time.Sleep(2 * time.Second)
+		case "/redirect":
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	for _, cc := range []struct {
+		name            string
+		web             v1alpha1.ApplicationSetRolloutStepWebAnalysis
+		expectedResult  AnalysisResult
+		expectedMessage string
+	}{
+		{
+			name:            "passes on 200",
+			web:             v1alpha1.ApplicationSetRolloutStepWebAnalysis{URL: server.URL + "/ok"},
+			expectedResult:  AnalysisPassed,
+			expectedMessage: "analysis endpoint responded with 200 OK",
+		},
+		{
+			name:            "is inconclusive on 5xx",
+			web:             v1alpha1.ApplicationSetRolloutStepWebAnalysis{URL: server.URL + "/unavailable"},
+			expectedResult:  AnalysisInconclusive,
+			expectedMessage: "analysis endpoint responded with 503 Service Unavailable",
+		},
+		{
+			name:            "fails on other status codes",
+			web:             v1alpha1.ApplicationSetRolloutStepWebAnalysis{URL: server.URL + "/missing"},
+			expectedResult:  AnalysisFailed,
+			expectedMessage: "analysis endpoint responded with 404 Not Found",
+		},
+		{
+			name:            "is inconclusive on redirect to a URL which is not allowed",
+			web:             v1alpha1.ApplicationSetRolloutStepWebAnalysis{URL: server.URL + "/redirect"},
+			expectedResult:  AnalysisInconclusive,
+			expectedMessage: "analysis request failed: redirected to a URL which is not allowed",
+		},
+		{
+			name:            "is inconclusive on a URL which is not allowed",
+			web:             v1alpha1.ApplicationSetRolloutStepWebAnalysis{URL: "http://169.254.169.254/latest/meta-data"},
+			expectedResult:  AnalysisInconclusive,
+			expectedMessage: "analysis url is not allowed by the --allowed-analysis-urls setting of the applicationset-controller",
+		},
+		{
+			name:            "is inconclusive on timeout",
+			web:             v1alpha1.ApplicationSetRolloutStepWebAnalysis{URL: server.URL + "/slow", TimeoutSeconds: 1},
+			expectedResult:  AnalysisInconclusive,
+			expectedMessage: "analysis request failed: context deadline exceeded",
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			t.Parallel()
+			runner := newDefaultAnalysisRunner([]string{server.URL + "/"})
+			result, message := runner.Run(t.Context(), &v1alpha1.ApplicationSet{}, 0, &v1alpha1.ApplicationSetRolloutStepAnalysis{Web: &cc.web})
+			assert.Equal(t, cc.expectedResult, result)
+			assert.Equal(t, cc.expectedMessage, message)
+		})
+	}
+}
+
+func TestAnalysisURLAllowed(t *testing.T) {
+	t.Parallel()
+	runner := newDefaultAnalysisRunner([]string{"https://metrics.example.com/checks/", "http://prometheus:9090", "not a url"})
+	for rawURL, expected := range map[string]bool{
+		"https://metrics.example.com/checks/staging":    true,
+		"https://METRICS.example.com/checks/staging":    true,
+		"https://metrics.example.com/checks":            true,
+		"https://metrics.example.com/checks/../admin":   false,
+		"https://metrics.example.com/checksum":          false,
+		"http://metrics.example.com/checks/staging":     false,
+		"https://metrics.example.com.evil.com/checks/":  false,
+		"https://metrics.example.com:8443/checks/stage": false,
+		"http://prometheus:9090/api/v1/query":           true,
+		"http://prometheus:9091/api/v1/query":           false,
+	} {
+		u, err := url.Parse(rawURL)
+		require.NoError(t, err)
+		assert.Equal(t, expected, runner.urlAllowed(u), rawURL)
+	}
+
+	assert.False(t, newDefaultAnalysisRunner(nil).urlAllowed(&url.URL{Scheme: "https", Host: "metrics.example.com"}))
+}
+
+func TestUpdateApplicationSetStepGates(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	ago := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(now.Add(-d).Truncate(time.Second))
+		return &t
+	}
+	webAnalysis := &v1alpha1.ApplicationSetRolloutStepAnalysis{
+		Web:      &v1alpha1.ApplicationSetRolloutStepWebAnalysis{URL: "https://example.com"},
+		Interval: "5m",
+	}
+	healthy := func(name string, step string, since *metav1.Time, gate *v1alpha1.ApplicationSetStepGateStatus) v1alpha1.ApplicationSetApplicationStatus {
+		return v1alpha1.ApplicationSetApplicationStatus{Application: name, Status: v1alpha1.ProgressiveSyncHealthy, Step: step, LastTransitionTime: since, Gate: gate}
+	}
+
+	for _, cc := range []struct {
+		name                 string
+		steps                []v1alpha1.ApplicationSetRolloutStep
+		appStatuses          []v1alpha1.ApplicationSetApplicationStatus
+		analysisResult       AnalysisResult
+		expectedPhases       map[string]v1alpha1.ApplicationSetStepGatePhase
+		expectedAnalyzed     []int
+		expectedRequeueAfter time.Duration
+	}{
+		{
+			name:  "bakes the healthy step",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{BakeTime: "30m", Analysis: webAnalysis}, {}},
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				healthy("app1", "1", ago(20*time.Minute), nil),
+				healthy("app2", "1", ago(5*time.Minute), nil),
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+			},
+			expectedPhases:       map[string]v1alpha1.ApplicationSetStepGatePhase{"app1": v1alpha1.ApplicationSetStepGateBaking, "app2": v1alpha1.ApplicationSetStepGateBaking, "app3": ""},
+			expectedRequeueAfter: 25 * time.Minute,
+		},
+		{
+			name:  "passes the baked step without analysis",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{BakeTime: "30m"}, {}},
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				healthy("app1", "1", ago(40*time.Minute), &v1alpha1.ApplicationSetStepGateStatus{Phase: v1alpha1.ApplicationSetStepGateBaking}),
+				healthy("app2", "1", ago(35*time.Minute), &v1alpha1.ApplicationSetStepGateStatus{Phase: v1alpha1.ApplicationSetStepGateBaking}),
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+			},
+			expectedPhases: map[string]v1alpha1.ApplicationSetStepGatePhase{"app1": v1alpha1.ApplicationSetStepGatePassed, "app2": v1alpha1.ApplicationSetStepGatePassed, "app3": ""},
+		},
+		{
+			name:  "passes the baked step when analysis passes",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{BakeTime: "30m", Analysis: webAnalysis}, {}},
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				healthy("app1", "1", ago(40*time.Minute), nil),
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+			},
+			analysisResult:   AnalysisPassed,
+			expectedPhases:   map[string]v1alpha1.ApplicationSetStepGatePhase{"app1": v1alpha1.ApplicationSetStepGatePassed, "app3": ""},
+			expectedAnalyzed: []int{0},
+		},
+		{
+			name:  "fails the step when analysis fails",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{Analysis: webAnalysis}, {}},
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				healthy("app1", "1", ago(time.Minute), nil),
+				healthy("app3", "2", ago(time.Minute), nil),
+			},
+			analysisResult:   AnalysisFailed,
+			expectedPhases:   map[string]v1alpha1.ApplicationSetStepGatePhase{"app1": v1alpha1.ApplicationSetStepGateFailed, "app3": ""},
+			expectedAnalyzed: []int{0},
+		},
+		{
+			name:  "does not analyze the failed step again",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{Analysis: webAnalysis}, {}},
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				healthy("app1", "1", ago(time.Hour), &v1alpha1.ApplicationSetStepGateStatus{Phase: v1alpha1.ApplicationSetStepGateFailed, LastAnalysisTime: ago(time.Hour)}),
+			},
+			expectedPhases: map[string]v1alpha1.ApplicationSetStepGatePhase{"app1": v1alpha1.ApplicationSetStepGateFailed},
+		},
+		{
+			name:  "retries inconclusive analysis after the interval",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{Analysis: webAnalysis}, {}},
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				healthy("app1", "1", ago(time.Minute), nil),
+			},
+			analysisResult:       AnalysisInconclusive,
+			expectedPhases:       map[string]v1alpha1.ApplicationSetStepGatePhase{"app1": v1alpha1.ApplicationSetStepGateAnalyzing},
+			expectedAnalyzed:     []int{0},
+			expectedRequeueAfter: 5 * time.Minute,
+		},
+		{
+			name:  "waits for the interval of inconclusive analysis",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{Analysis: webAnalysis}, {}},
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				healthy("app1", "1", ago(10*time.Minute), &v1alpha1.ApplicationSetStepGateStatus{Phase: v1alpha1.ApplicationSetStepGateAnalyzing, LastAnalysisTime: ago(2 * time.Minute)}),
+			},
+			analysisResult:       AnalysisPassed,
+			expectedPhases:       map[string]v1alpha1.ApplicationSetStepGatePhase{"app1": v1alpha1.ApplicationSetStepGateAnalyzing},
+			expectedRequeueAfter: 3 * time.Minute,
+		},
+		{
+			name:  "evaluates the next step after the passed one",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{Analysis: webAnalysis}, {BakeTime: "10m"}},
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				healthy("app1", "1", ago(time.Hour), &v1alpha1.ApplicationSetStepGateStatus{Phase: v1alpha1.ApplicationSetStepGatePassed}),
+				healthy("app3", "2", ago(time.Minute), nil),
+			},
+			expectedPhases:       map[string]v1alpha1.ApplicationSetStepGatePhase{"app1": v1alpha1.ApplicationSetStepGatePassed, "app3": v1alpha1.ApplicationSetStepGateBaking},
+			expectedRequeueAfter: 9 * time.Minute,
+		},
+		{
+			name:  "does not evaluate the step that is not healthy",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{BakeTime: "10m", Analysis: webAnalysis}, {}},
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				healthy("app1", "1", ago(time.Hour), nil),
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncProgressing, Step: "1"},
+			},
+			expectedPhases: map[string]v1alpha1.ApplicationSetStepGatePhase{"app1": "", "app2": ""},
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			t.Parallel()
+			appSet := v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Strategy: &v1alpha1.ApplicationSetStrategy{
+						Type:        "RollingSync",
+						RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{Steps: cc.steps},
+					},
+				},
+				Status: v1alpha1.ApplicationSetStatus{ApplicationStatus: cc.appStatuses},
+			}
+			appDependencyList := make([][]string, len(cc.steps))
+			var apps []v1alpha1.Application
+			for _, appStatus := range cc.appStatuses {
+				stepIndex := int(appStatus.Step[0]-'0') - 1
+				appDependencyList[stepIndex] = append(appDependencyList[stepIndex], appStatus.Application)
+				apps = append(apps, v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: appStatus.Application}})
+			}
+
+			runner := &fakeAnalysisRunner{result: cc.analysisResult}
+			m := NewManager(nil, &fakeDependencies{}).WithAnalysisRunner(runner)
+			requeueAfter, err := m.UpdateApplicationSetStepGates(t.Context(), log.NewEntry(log.StandardLogger()), &appSet, appDependencyList, apps)
+			require.NoError(t, err)
+
+			phases := map[string]v1alpha1.ApplicationSetStepGatePhase{}
+			for _, appStatus := range appSet.Status.ApplicationStatus {
+				phases[appStatus.Application] = appStatusGatePhase(appStatus)
+			}
+			assert.Equal(t, cc.expectedPhases, phases)
+			assert.Equal(t, cc.expectedAnalyzed, runner.steps)
+			assert.InDelta(t, cc.expectedRequeueAfter.Seconds(), requeueAfter.Seconds(), 2)
+		})
+	}
+}
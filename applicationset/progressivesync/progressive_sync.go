@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"reflect"
 	"slices"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/common"
 	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,16 +30,13 @@ import (
 const (
 	ReverseDeletionOrder      = "Reverse"
 	AllAtOnceDeletionOrder    = "AllAtOnce"
+	HaltOnFailure             = "Halt"
+	RollbackOnFailure         = "Rollback"
 	revisionAndSpecChangedMsg = "Application has pending changes (revision and spec differ), setting status to Waiting"
 	revisionChangedMsg        = "Application has pending changes, setting status to Waiting"
 	specChangedMsg            = "Application has pending changes (spec differs), setting status to Waiting"
 )
 
-type deleteInOrder struct {
-	AppName string
-	Step    int
-}
-
 // Dependencies is the interface for dependencies of the Manager.
 // It serves two purposes: 1) it prevents progressive sync from having direct access
 // to the ApplicationSet controller, and 2) it allows for easy mocking in tests.
@@ -63,18 +61,37 @@ type Dependencies interface {
 type Manager struct {
 	Client           client.Client
 	dependencies     Dependencies
+	analysisRunner   AnalysisRunner
 	validationIssues *ValidationIssues // collected during progressive sync execution
 }
 
 // NewManager creates a new manager with dependencies
 func NewManager(client client.Client, dependencies Dependencies) *Manager {
 	return &Manager{
-		Client:       client,
-		dependencies: dependencies,
+		Client:         client,
+		dependencies:   dependencies,
+		analysisRunner: newDefaultAnalysisRunner(nil),
 	}
 }
 
-func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application) (map[string]bool, error) {
+// WithAllowedAnalysisURLs allows the web analysis gating the RollingSync steps to request the URLs under the given
+// URL prefixes
+func (m *Manager) WithAllowedAnalysisURLs(allowedURLs []string) *Manager {
+	m.analysisRunner = newDefaultAnalysisRunner(allowedURLs)
+	return m
+}
+
+// WithAnalysisRunner replaces the runner of the analysis gating the RollingSync steps
+func (m *Manager) WithAnalysisRunner(analysisRunner AnalysisRunner) *Manager {
+	m.analysisRunner = analysisRunner
+	return m
+}
+
+// PerformProgressiveSyncs updates the progressive sync status of the applications and returns the ones allowed to sync.
+// It also returns the time after which the ApplicationSet needs to be reconciled again for the rollout to progress, or
+// zero when the progress depends on the applications only, and whether the rollout of a promoted step has completed, in
+// which case the promotion must be removed.
+func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application) (map[string]bool, time.Duration, bool, error) {
 	// Initialize validation tracking
 	m.validationIssues = &ValidationIssues{}
 
@@ -87,7 +104,17 @@ func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry
 
 	_, err := m.UpdateApplicationSetApplicationStatus(ctx, logCtx, &appset, applications, desiredApplications, appStepMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update applicationset app status: %w", err)
+		return nil, 0, false, fmt.Errorf("failed to update applicationset app status: %w", err)
+	}
+
+	requeueAfter, err := m.UpdateApplicationSetStepGates(ctx, logCtx, &appset, appDependencyList, applications)
+	if err != nil {
+		return nil, 0, false, fmt.Errorf("failed to update applicationset step gates: %w", err)
+	}
+
+	err = m.UpdateApplicationSetRollback(ctx, logCtx, &appset, appDependencyList, applications)
+	if err != nil {
+		return nil, 0, false, fmt.Errorf("failed to update applicationset rollback: %w", err)
 	}
 
 	logCtx.Infof("ApplicationSet %v step list:", appset.Name)
@@ -96,24 +123,35 @@ func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry
 	}
 
 	appsToSync := getAppsToSync(appset, appDependencyList, applications)
+	if isRollbackStarted(&appset) {
+		// The failed rollout was rolled back, no Application is synced until they change again
+		appsToSync = map[string]bool{}
+	}
+	if IsRolloutPaused(&appset) {
+		// No Application is synced until the rollout is resumed
+		logCtx.Info("ApplicationSet rollout is paused")
+		appsToSync = map[string]bool{}
+	}
 	logCtx.Infof("Application allowed to sync before maxUpdate?: %+v", appsToSync)
 
 	_, err = m.UpdateApplicationSetApplicationStatusProgress(ctx, logCtx, &appset, appsToSync, appStepMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update applicationset application status progress: %w", err)
+		return nil, 0, false, fmt.Errorf("failed to update applicationset application status progress: %w", err)
 	}
 
-	progressingCondition := m.getProgressingCondition(&appset)
+	progressingCondition := m.getRollbackCondition(&appset)
+	if progressingCondition == nil {
+		progressingCondition = m.getProgressingCondition(&appset)
+	}
 	invalidConfigCondition := m.getInvalidRolloutConfig(&appset)
 	conditions := []*argov1alpha1.ApplicationSetCondition{invalidConfigCondition, progressingCondition}
 	_ = m.updateApplicationSetApplicationStatusConditions(ctx, &appset, conditions)
 
-	return appsToSync, nil
+	return appsToSync, requeueAfter, isRolloutPromotionCompleted(&appset, progressingCondition), nil
 }
 
 func (m *Manager) PerformReverseDeletion(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, currentApps []argov1alpha1.Application) (time.Duration, error) {
 	requeueTime := 10 * time.Second
-	stepLength := len(appset.Spec.Strategy.RollingSync.Steps)
 
 	// map applications by name using current applications
 	appMap := make(map[string]*argov1alpha1.Application)
@@ -123,30 +161,21 @@ func (m *Manager) PerformReverseDeletion(ctx context.Context, logCtx *log.Entry,
 
 	// Get Rolling Sync Step Maps
 	_, appStepMap, _ := buildAppDependencyList(logCtx, appset, currentApps)
-	// reverse the AppStepMap to perform deletion
-	var reverseDeleteAppSteps []deleteInOrder
-	for appName, appStep := range appStepMap {
-		reverseDeleteAppSteps = append(reverseDeleteAppSteps, deleteInOrder{appName, stepLength - appStep - 1})
-	}
-
-	sort.Slice(reverseDeleteAppSteps, func(i, j int) bool {
-		return reverseDeleteAppSteps[i].Step < reverseDeleteAppSteps[j].Step
-	})
 
-	for _, step := range reverseDeleteAppSteps {
-		logCtx.Infof("step %v : app %v", step.Step, step.AppName)
-		app := appMap[step.AppName]
+	for _, appName := range reverseStepOrder(appStepMap) {
+		logCtx.Infof("step %v : app %v", appStepMap[appName]+1, appName)
+		app := appMap[appName]
 		retrievedApp := argov1alpha1.Application{}
 		if err := m.Client.Get(ctx, types.NamespacedName{Name: app.Name, Namespace: app.Namespace}, &retrievedApp); err != nil {
 			if apierrors.IsNotFound(err) {
-				logCtx.Infof("application %s successfully deleted", step.AppName)
+				logCtx.Infof("application %s successfully deleted", appName)
 				continue
 			}
-			return 0, fmt.Errorf("error retrieving application %s: %w", step.AppName, err)
+			return 0, fmt.Errorf("error retrieving application %s: %w", appName, err)
 		}
 		// Check if the application is already being deleted
 		if retrievedApp.DeletionTimestamp != nil {
-			logCtx.Infof("application %s has been marked for deletion, but object not removed yet", step.AppName)
+			logCtx.Infof("application %s has been marked for deletion, but object not removed yet", appName)
 			if time.Since(retrievedApp.DeletionTimestamp.Time) > 2*time.Minute {
 				return 0, errors.New("application has not been deleted in over 2 minutes")
 			}
@@ -158,7 +187,7 @@ func (m *Manager) PerformReverseDeletion(ctx context.Context, logCtx *log.Entry,
 		// remove the ApplicationSet finalizer.
 		if err := m.Client.Delete(ctx, &retrievedApp); err != nil {
 			if apierrors.IsNotFound(err) {
-				logCtx.Infof("application %s already deleted", step.AppName)
+				logCtx.Infof("application %s already deleted", appName)
 				continue
 			}
 			return 0, err
@@ -169,6 +198,19 @@ func (m *Manager) PerformReverseDeletion(ctx context.Context, logCtx *log.Entry,
 	return 0, nil
 }
 
+// reverseStepOrder returns the names of the Applications ordered by step in reverse order, the Applications of the last
+// step first. This is the order in which the Applications are deleted, and rolled back when the rollout fails.
+func reverseStepOrder(appStepMap map[string]int) []string {
+	appNames := slices.Collect(maps.Keys(appStepMap))
+	slices.SortFunc(appNames, func(a, b string) int {
+		if appStepMap[a] != appStepMap[b] {
+			return appStepMap[b] - appStepMap[a]
+		}
+		return strings.Compare(a, b)
+	})
+	return appNames
+}
+
 // this list tracks which Applications belong to each RollingUpdate step
 func buildAppDependencyList(logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, applications []argov1alpha1.Application) ([][]string, map[string]int, *ValidationIssues) {
 	issues := &ValidationIssues{}
@@ -189,6 +231,19 @@ func buildAppDependencyList(logCtx *log.Entry, applicationSet argov1alpha1.Appli
 
 	appStepMap := map[string]int{}
 
+	if RollingSyncStrategyEnabled(&applicationSet) && !isValidOnFailure(applicationSet.Spec.Strategy.RollingSync.OnFailure) {
+		issues.InvalidOnFailure = applicationSet.Spec.Strategy.RollingSync.OnFailure
+	}
+
+	for i, step := range steps {
+		if _, _, err := parseGate(step); err != nil {
+			issues.InvalidGates = append(issues.InvalidGates, InvalidGate{
+				StepIndex: i,
+				Error:     err.Error(),
+			})
+		}
+	}
+
 	// use applicationLabelSelectors to filter generated Applications into steps and status by name
 	for _, app := range applications {
 		for i, step := range steps {
@@ -293,12 +348,13 @@ func (m *Manager) UpdateApplicationSetApplicationStatus(ctx context.Context, log
 		if idx == -1 {
 			// AppStatus not found, set default status of "Waiting"
 			currentAppStatus = argov1alpha1.ApplicationSetApplicationStatus{
-				Application:        app.Name,
-				TargetRevisions:    app.Status.GetRevisions(),
-				LastTransitionTime: &now,
-				Message:            "No Application status found, defaulting status to Waiting",
-				Status:             argov1alpha1.ProgressiveSyncWaiting,
-				Step:               strconv.Itoa(getAppStep(app.Name, appStepMap)),
+				Application:          app.Name,
+				TargetRevisions:      app.Status.GetRevisions(),
+				LastTransitionTime:   &now,
+				Message:              "No Application status found, defaulting status to Waiting",
+				Status:               argov1alpha1.ProgressiveSyncWaiting,
+				Step:                 strconv.Itoa(getAppStep(app.Name, appStepMap)),
+				PreviousDeploymentID: getLastDeploymentID(app),
 			}
 		} else {
 			// we have an existing AppStatus
@@ -330,6 +386,8 @@ func (m *Manager) UpdateApplicationSetApplicationStatus(ctx context.Context, log
 
 		if revisionsChanged || specChanged {
 			newAppStatus.TargetRevisions = app.Status.GetRevisions()
+			// The deployment to roll back to if the rollout of the new target fails
+			newAppStatus.PreviousDeploymentID = getLastDeploymentID(app)
 
 			switch {
 			case revisionsChanged && specChanged:
@@ -399,6 +457,11 @@ func (m *Manager) UpdateApplicationSetApplicationStatus(ctx context.Context, log
 			}
 		}
 
+		if newAppStatus.Status != argov1alpha1.ProgressiveSyncHealthy || newAppStatus.Step != currentAppStatus.Step {
+			// The gate of the step is evaluated again once the Application becomes Healthy
+			newAppStatus.Gate = nil
+		}
+
 		if newAppStatus.LastTransitionTime == &now {
 			statusLogCtx.WithFields(log.Fields{
 				"new_status.status":          newAppStatus.Status,
@@ -427,37 +490,25 @@ func getAppsToSync(applicationSet argov1alpha1.ApplicationSet, appDependencyList
 		currentAppsMap[app.Name] = true
 	}
 
+	promotedStep := getPromotedStep(&applicationSet)
 	for stepIndex := range appDependencyList {
 		// set the syncEnabled boolean for every Application in the current step
 		for _, appName := range appDependencyList[stepIndex] {
 			appSyncMap[appName] = true
 		}
 
-		// evaluate if we need to sync next waves
-		syncNextWave := true
-		for _, appName := range appDependencyList[stepIndex] {
-			// Check if application is created and managed by this AppSet, if it is not created yet, we cannot progress
-			if _, ok := currentAppsMap[appName]; !ok {
-				syncNextWave = false
-				break
-			}
-
-			idx := utils.FindApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
-			if idx == -1 {
-				// No Application status found, likely because the Application is being newly created
-				// This mean this wave is not yet completed
-				syncNextWave = false
-				break
-			}
+		if stepIndex < promotedStep {
+			// The wave was promoted, the next waves do not wait for it
+			continue
+		}
 
-			appStatus := applicationSet.Status.ApplicationStatus[idx]
-			if appStatus.Status != argov1alpha1.ProgressiveSyncHealthy {
-				// At least one application in this wave is not yet healthy. We cannot proceed to the next wave
-				syncNextWave = false
-				break
-			}
+		// evaluate if we need to sync next waves
+		if !isStepHealthy(&applicationSet, appDependencyList[stepIndex], currentAppsMap) {
+			// At least one application in this wave is not yet healthy. We cannot proceed to the next wave
+			break
 		}
-		if !syncNextWave {
+		if hasGate(applicationSet.Spec.Strategy.RollingSync.Steps[stepIndex]) && !isStepGatePhase(&applicationSet, appDependencyList[stepIndex], argov1alpha1.ApplicationSetStepGatePassed) {
+			// The bake time or the analysis of this wave did not pass yet
 			break
 		}
 	}
@@ -479,6 +530,34 @@ func RollingSyncStrategyEnabled(appset *argov1alpha1.ApplicationSet) bool {
 	return IsRollingSyncStrategy(appset) && len(appset.Spec.Strategy.RollingSync.Steps) > 0
 }
 
+func IsRolloutPaused(appset *argov1alpha1.ApplicationSet) bool {
+	// When progressive sync is enabled + the rollout paused annotation is set to true
+	return RollingSyncStrategyEnabled(appset) && appset.Annotations[common.AnnotationApplicationSetRolloutPaused] == "true"
+}
+
+// getPromotedStep returns the last step of the rollout that is promoted, or 0 if there is none
+func getPromotedStep(appset *argov1alpha1.ApplicationSet) int {
+	value, ok := appset.Annotations[common.AnnotationApplicationSetRolloutPromotedStep]
+	if !ok {
+		return 0
+	}
+	step, err := strconv.Atoi(value)
+	if err != nil || step < 0 {
+		log.WithField("applicationset", appset.Name).Warnf("ignoring invalid %s annotation value %q", common.AnnotationApplicationSetRolloutPromotedStep, value)
+		return 0
+	}
+	return step
+}
+
+// isRolloutPromotionCompleted reports whether the ApplicationSet has a promoted step, and its rollout has completed
+// according to the progressing condition computed from the current step statuses
+func isRolloutPromotionCompleted(appset *argov1alpha1.ApplicationSet, progressingCondition *argov1alpha1.ApplicationSetCondition) bool {
+	if _, ok := appset.Annotations[common.AnnotationApplicationSetRolloutPromotedStep]; !ok {
+		return false
+	}
+	return progressingCondition != nil && progressingCondition.Status == argov1alpha1.ApplicationSetConditionStatusFalse
+}
+
 func IsDeletionOrderReversed(appset *argov1alpha1.ApplicationSet) bool {
 	// When progressive sync is enabled + deletionOrder is set to Reverse (case-insensitive)
 	return RollingSyncStrategyEnabled(appset) && strings.EqualFold(appset.Spec.Strategy.DeletionOrder, ReverseDeletionOrder)
@@ -597,41 +676,70 @@ func (m *Manager) UpdateApplicationSetApplicationStatusProgress(ctx context.Cont
 	return appStatuses, nil
 }
 
-func (m *Manager) getProgressingCondition(applicationSet *argov1alpha1.ApplicationSet) *argov1alpha1.ApplicationSetCondition {
+// GetRolloutStep returns the step being rolled out, i.e. the first step with Applications that are not Healthy or whose
+// gate did not pass yet, or 0 when the rollout has completed. It relies on the status of the Applications of the
+// ApplicationSet only.
+func GetRolloutStep(applicationSet *argov1alpha1.ApplicationSet) int {
 	if !IsRollingSyncStrategy(applicationSet) {
-		return nil
+		return 0
 	}
+	steps := applicationSet.Spec.Strategy.RollingSync.Steps
 	completedWaves := map[string]bool{}
 	for _, appStatus := range applicationSet.Status.ApplicationStatus {
+		completed := appStatus.Status == argov1alpha1.ProgressiveSyncHealthy
+		if stepNumber, err := strconv.Atoi(appStatus.Step); err == nil && stepNumber > 0 && stepNumber <= len(steps) && hasGate(steps[stepNumber-1]) {
+			// The wave is completed once its gate passes
+			completed = completed && appStatus.Gate != nil && appStatus.Gate.Phase == argov1alpha1.ApplicationSetStepGatePassed
+		}
 		if v, ok := completedWaves[appStatus.Step]; !ok {
-			completedWaves[appStatus.Step] = appStatus.Status == argov1alpha1.ProgressiveSyncHealthy
+			completedWaves[appStatus.Step] = completed
 		} else {
-			completedWaves[appStatus.Step] = v && appStatus.Status == argov1alpha1.ProgressiveSyncHealthy
+			completedWaves[appStatus.Step] = v && completed
 		}
 	}
 
-	isProgressing := false
-	progressingStep := ""
-	for i := range applicationSet.Spec.Strategy.RollingSync.Steps {
-		step := strconv.Itoa(i + 1)
-		isCompleted, ok := completedWaves[step]
+	promotedStep := getPromotedStep(applicationSet)
+	for i := range steps {
+		if i < promotedStep {
+			// Step was promoted, so it is completed
+			continue
+		}
+		isCompleted, ok := completedWaves[strconv.Itoa(i+1)]
 		if !ok {
 			// Step has no applications, so it is completed
 			continue
 		}
 		if !isCompleted {
-			isProgressing = true
-			progressingStep = step
-			break
+			return i + 1
 		}
 	}
+	return 0
+}
 
-	if isProgressing {
+func (m *Manager) getProgressingCondition(applicationSet *argov1alpha1.ApplicationSet) *argov1alpha1.ApplicationSetCondition {
+	if !IsRollingSyncStrategy(applicationSet) {
+		return nil
+	}
+
+	if progressingStep := GetRolloutStep(applicationSet); progressingStep > 0 {
+		step := strconv.Itoa(progressingStep)
+		message := "ApplicationSet is performing rollout of step " + step
+		reason := argov1alpha1.ApplicationSetReasonApplicationSetModified
+		for _, appStatus := range applicationSet.Status.ApplicationStatus {
+			if appStatus.Step == step && appStatus.Status == argov1alpha1.ProgressiveSyncHealthy && appStatus.Gate != nil && appStatus.Gate.Phase != argov1alpha1.ApplicationSetStepGatePassed {
+				message = fmt.Sprintf("ApplicationSet is waiting for the gate of step %s: %s", step, appStatus.Gate.Message)
+				break
+			}
+		}
+		if IsRolloutPaused(applicationSet) {
+			message = "ApplicationSet rollout is paused at step " + step
+			reason = argov1alpha1.ApplicationSetReasonApplicationSetRolloutPaused
+		}
 		return &argov1alpha1.ApplicationSetCondition{
 			Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
 			Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
-			Message: "ApplicationSet is performing rollout of step " + progressingStep,
-			Reason:  argov1alpha1.ApplicationSetReasonApplicationSetModified,
+			Message: message,
+			Reason:  reason,
 		}
 	}
 
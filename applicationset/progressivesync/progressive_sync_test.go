@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -15,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -1329,6 +1331,170 @@ func TestGetAppsToSync(t *testing.T) {
 				"app2": true,
 			},
 		},
+		{
+			name: "waits for the gate of the healthy step",
+			appSet: v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "argocd",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Strategy: &v1alpha1.ApplicationSetStrategy{
+						Type: "RollingSync",
+						RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+							Steps: []v1alpha1.ApplicationSetRolloutStep{
+								{
+									MatchExpressions: []v1alpha1.ApplicationMatchExpression{},
+									BakeTime:         "30m",
+								},
+								{
+									MatchExpressions: []v1alpha1.ApplicationMatchExpression{},
+								},
+							},
+						},
+					},
+				},
+				Status: v1alpha1.ApplicationSetStatus{
+					ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+						{
+							Application: "app1",
+							Status:      v1alpha1.ProgressiveSyncHealthy,
+							Gate: &v1alpha1.ApplicationSetStepGateStatus{
+								Phase: v1alpha1.ApplicationSetStepGateBaking,
+							},
+						},
+						{
+							Application: "app2",
+							Status:      v1alpha1.ProgressiveSyncWaiting,
+						},
+					},
+				},
+			},
+			currentApps: []v1alpha1.Application{
+				{ObjectMeta: metav1.ObjectMeta{Name: "app1"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "app2"}},
+			},
+			appDependencyList: [][]string{
+				{"app1"},
+				{"app2"},
+			},
+			expectedMap: map[string]bool{
+				"app1": true,
+			},
+		},
+		{
+			name: "proceeds after the gate of the healthy step passed",
+			appSet: v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "argocd",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Strategy: &v1alpha1.ApplicationSetStrategy{
+						Type: "RollingSync",
+						RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+							Steps: []v1alpha1.ApplicationSetRolloutStep{
+								{
+									MatchExpressions: []v1alpha1.ApplicationMatchExpression{},
+									BakeTime:         "30m",
+								},
+								{
+									MatchExpressions: []v1alpha1.ApplicationMatchExpression{},
+								},
+							},
+						},
+					},
+				},
+				Status: v1alpha1.ApplicationSetStatus{
+					ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+						{
+							Application: "app1",
+							Status:      v1alpha1.ProgressiveSyncHealthy,
+							Gate: &v1alpha1.ApplicationSetStepGateStatus{
+								Phase: v1alpha1.ApplicationSetStepGatePassed,
+							},
+						},
+						{
+							Application: "app2",
+							Status:      v1alpha1.ProgressiveSyncWaiting,
+						},
+					},
+				},
+			},
+			currentApps: []v1alpha1.Application{
+				{ObjectMeta: metav1.ObjectMeta{Name: "app1"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "app2"}},
+			},
+			appDependencyList: [][]string{
+				{"app1"},
+				{"app2"},
+			},
+			expectedMap: map[string]bool{
+				"app1": true,
+				"app2": true,
+			},
+		},
+		{
+			name: "proceeds past a promoted step that is not healthy",
+			appSet: v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "argocd",
+					Annotations: map[string]string{
+						common.AnnotationApplicationSetRolloutPromotedStep: "1",
+					},
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Strategy: &v1alpha1.ApplicationSetStrategy{
+						Type: "RollingSync",
+						RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+							Steps: []v1alpha1.ApplicationSetRolloutStep{
+								{
+									MatchExpressions: []v1alpha1.ApplicationMatchExpression{},
+									BakeTime:         "30m",
+								},
+								{
+									MatchExpressions: []v1alpha1.ApplicationMatchExpression{},
+								},
+								{
+									MatchExpressions: []v1alpha1.ApplicationMatchExpression{},
+								},
+							},
+						},
+					},
+				},
+				Status: v1alpha1.ApplicationSetStatus{
+					ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+						{
+							Application: "app1",
+							Status:      v1alpha1.ProgressiveSyncProgressing,
+						},
+						{
+							Application: "app2",
+							Status:      v1alpha1.ProgressiveSyncWaiting,
+						},
+						{
+							Application: "app3",
+							Status:      v1alpha1.ProgressiveSyncWaiting,
+						},
+					},
+				},
+			},
+			currentApps: []v1alpha1.Application{
+				{ObjectMeta: metav1.ObjectMeta{Name: "app1"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "app2"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "app3"}},
+			},
+			appDependencyList: [][]string{
+				{"app1"},
+				{"app2"},
+				{"app3"},
+			},
+			expectedMap: map[string]bool{
+				"app1": true,
+				"app2": true,
+			},
+		},
 	} {
 		t.Run(cc.name, func(t *testing.T) {
 			t.Parallel()
@@ -1338,6 +1504,226 @@ func TestGetAppsToSync(t *testing.T) {
 	}
 }
 
+func TestGetRolloutStep(t *testing.T) {
+	t.Parallel()
+
+	newAppSet := func(annotations map[string]string, steps []v1alpha1.ApplicationSetRolloutStep, statuses ...v1alpha1.ApplicationSetApplicationStatus) *v1alpha1.ApplicationSet {
+		return &v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "name",
+				Namespace:   "argocd",
+				Annotations: annotations,
+			},
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type: "RollingSync",
+					RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+						Steps: steps,
+					},
+				},
+			},
+			Status: v1alpha1.ApplicationSetStatus{
+				ApplicationStatus: statuses,
+			},
+		}
+	}
+	twoSteps := []v1alpha1.ApplicationSetRolloutStep{{}, {}}
+
+	for _, cc := range []struct {
+		name         string
+		appSet       *v1alpha1.ApplicationSet
+		expectedStep int
+	}{
+		{
+			name:         "returns 0 without RollingSync strategy",
+			appSet:       &v1alpha1.ApplicationSet{},
+			expectedStep: 0,
+		},
+		{
+			name: "returns the first step that is not healthy",
+			appSet: newAppSet(nil, twoSteps,
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app2", Status: v1alpha1.ProgressiveSyncProgressing, Step: "2"},
+			),
+			expectedStep: 2,
+		},
+		{
+			name: "returns 0 when all the steps are healthy",
+			appSet: newAppSet(nil, twoSteps,
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app2", Status: v1alpha1.ProgressiveSyncHealthy, Step: "2"},
+			),
+			expectedStep: 0,
+		},
+		{
+			name: "returns the healthy step whose gate did not pass",
+			appSet: newAppSet(nil, []v1alpha1.ApplicationSetRolloutStep{{BakeTime: "30m"}, {}},
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1", Gate: &v1alpha1.ApplicationSetStepGateStatus{Phase: v1alpha1.ApplicationSetStepGateBaking}},
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app2", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+			),
+			expectedStep: 1,
+		},
+		{
+			name: "skips the promoted steps",
+			appSet: newAppSet(map[string]string{common.AnnotationApplicationSetRolloutPromotedStep: "1"}, twoSteps,
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncProgressing, Step: "1"},
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app2", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+			),
+			expectedStep: 2,
+		},
+		{
+			name: "ignores an invalid promoted step",
+			appSet: newAppSet(map[string]string{common.AnnotationApplicationSetRolloutPromotedStep: "first"}, twoSteps,
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncProgressing, Step: "1"},
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app2", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+			),
+			expectedStep: 1,
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, cc.expectedStep, GetRolloutStep(cc.appSet))
+		})
+	}
+}
+
+func TestPerformProgressiveSyncsPaused(t *testing.T) {
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+			Annotations: map[string]string{
+				common.AnnotationApplicationSetRolloutPaused: "true",
+			},
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Strategy: &v1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+					Steps: []v1alpha1.ApplicationSetRolloutStep{
+						{MatchExpressions: []v1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"dev"}}}},
+						{MatchExpressions: []v1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"prod"}}}},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.ApplicationSetStatus{
+			ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app-dev", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1", TargetRevisions: []string{"abc"}},
+				{Application: "app-prod", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2", TargetRevisions: []string{"abc"}},
+			},
+		},
+	}
+	apps := []v1alpha1.Application{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-dev", Labels: map[string]string{"env": "dev"}},
+			Status: v1alpha1.ApplicationStatus{
+				Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revisions: []string{"abc"}},
+				Health: v1alpha1.AppHealthStatus{Status: health.HealthStatusHealthy},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-prod", Labels: map[string]string{"env": "prod"}},
+			Status: v1alpha1.ApplicationStatus{
+				Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revisions: []string{"abc"}},
+				Health: v1alpha1.AppHealthStatus{Status: health.HealthStatusHealthy},
+			},
+		},
+	}
+
+	m := NewManager(nil, &fakeDependencies{})
+	appsToSync, _, _, err := m.PerformProgressiveSyncs(t.Context(), log.NewEntry(log.StandardLogger()), appSet, apps, apps)
+	require.NoError(t, err)
+	assert.Empty(t, appsToSync)
+
+	condition := m.getProgressingCondition(&appSet)
+	assert.Equal(t, "ApplicationSet rollout is paused at step 2", condition.Message)
+	assert.Equal(t, v1alpha1.ApplicationSetReasonApplicationSetRolloutPaused, condition.Reason)
+}
+
+func TestPerformProgressiveSyncsPromotionCompleted(t *testing.T) {
+	newAppSet := func() v1alpha1.ApplicationSet {
+		return v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "name",
+				Namespace: "argocd",
+				Annotations: map[string]string{
+					common.AnnotationApplicationSetRolloutPromotedStep: "1",
+				},
+			},
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type: "RollingSync",
+					RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+						Steps: []v1alpha1.ApplicationSetRolloutStep{
+							{MatchExpressions: []v1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"dev"}}}},
+							{MatchExpressions: []v1alpha1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"prod"}}}},
+						},
+					},
+				},
+			},
+			Status: v1alpha1.ApplicationSetStatus{
+				// The conditions are those of the previous reconciliation, when the rollout was still progressing
+				Conditions: []v1alpha1.ApplicationSetCondition{
+					{
+						Type:   v1alpha1.ApplicationSetConditionRolloutProgressing,
+						Status: v1alpha1.ApplicationSetConditionStatusTrue,
+						Reason: v1alpha1.ApplicationSetReasonApplicationSetModified,
+					},
+				},
+				ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+					{Application: "app-dev", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1", TargetRevisions: []string{"abc"}},
+					{Application: "app-prod", Status: v1alpha1.ProgressiveSyncProgressing, Step: "2", TargetRevisions: []string{"abc"}},
+				},
+			},
+		}
+	}
+	newApps := func(prodHealth health.HealthStatusCode) []v1alpha1.Application {
+		return []v1alpha1.Application{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "app-dev", Labels: map[string]string{"env": "dev"}},
+				Status: v1alpha1.ApplicationStatus{
+					Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revisions: []string{"abc"}},
+					Health: v1alpha1.AppHealthStatus{Status: health.HealthStatusHealthy},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "app-prod", Labels: map[string]string{"env": "prod"}},
+				Status: v1alpha1.ApplicationStatus{
+					Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revisions: []string{"abc"}},
+					Health: v1alpha1.AppHealthStatus{Status: prodHealth},
+				},
+			},
+		}
+	}
+
+	t.Run("completes once the last step is healthy", func(t *testing.T) {
+		apps := newApps(health.HealthStatusHealthy)
+		m := NewManager(nil, &fakeDependencies{})
+		_, _, promotionCompleted, err := m.PerformProgressiveSyncs(t.Context(), log.NewEntry(log.StandardLogger()), newAppSet(), apps, apps)
+		require.NoError(t, err)
+		assert.True(t, promotionCompleted)
+	})
+
+	t.Run("does not complete while the last step is progressing", func(t *testing.T) {
+		apps := newApps(health.HealthStatusProgressing)
+		m := NewManager(nil, &fakeDependencies{})
+		_, _, promotionCompleted, err := m.PerformProgressiveSyncs(t.Context(), log.NewEntry(log.StandardLogger()), newAppSet(), apps, apps)
+		require.NoError(t, err)
+		assert.False(t, promotionCompleted)
+	})
+
+	t.Run("does not complete without a promoted step", func(t *testing.T) {
+		appSet := newAppSet()
+		appSet.Annotations = nil
+		apps := newApps(health.HealthStatusHealthy)
+		m := NewManager(nil, &fakeDependencies{})
+		_, _, promotionCompleted, err := m.PerformProgressiveSyncs(t.Context(), log.NewEntry(log.StandardLogger()), appSet, apps, apps)
+		require.NoError(t, err)
+		assert.False(t, promotionCompleted)
+	})
+}
+
 func TestIsRollingSyncStrategy(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -1670,3 +2056,16 @@ func TestPerformReverseDeletionStaleCache(t *testing.T) {
 	assert.Equal(t, 1, deleteAttempts["appset-stage0"])
 	assert.Equal(t, 1, deleteAttempts["appset-stage1"])
 }
+
+func TestReverseStepOrder(t *testing.T) {
+	t.Parallel()
+	appStepMap := map[string]int{
+		"app-dev-b":  0,
+		"app-prod":   2,
+		"app-dev-a":  0,
+		"app-stage":  1,
+		"app-prod-2": 2,
+	}
+	assert.Equal(t, []string{"app-prod", "app-prod-2", "app-stage", "app-dev-a", "app-dev-b"}, reverseStepOrder(appStepMap))
+	assert.Empty(t, reverseStepOrder(map[string]int{}))
+}
@@ -0,0 +1,296 @@
+package progressivesync
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func IsRollbackOnFailure(appset *argov1alpha1.ApplicationSet) bool {
+	// When progressive sync is enabled + onFailure is set to Rollback (case-insensitive)
+	return RollingSyncStrategyEnabled(appset) && strings.EqualFold(appset.Spec.Strategy.RollingSync.OnFailure, RollbackOnFailure)
+}
+
+// isValidOnFailure reports whether onFailure is unset, which defaults to Halt, or one of the supported values
+func isValidOnFailure(onFailure string) bool {
+	return onFailure == "" || strings.EqualFold(onFailure, HaltOnFailure) || strings.EqualFold(onFailure, RollbackOnFailure)
+}
+
+// isRollbackStarted reports whether a failed rollout is being, or has been, rolled back. The rollout does not progress
+// until the Applications that were rolled back change again.
+func isRollbackStarted(applicationSet *argov1alpha1.ApplicationSet) bool {
+	for _, appStatus := range applicationSet.Status.ApplicationStatus {
+		if appStatus.Status == argov1alpha1.ProgressiveSyncRollingBack || appStatus.Status == argov1alpha1.ProgressiveSyncRolledBack {
+			return true
+		}
+	}
+	return false
+}
+
+// getRollbackStep returns the step being rolled back, i.e. the last step with Applications still rolling back, or -1 if
+// there is none. Steps are rolled back in reverse order.
+func getRollbackStep(applicationSet *argov1alpha1.ApplicationSet) int {
+	rollingBackSteps := map[string]int{}
+	for _, appStatus := range applicationSet.Status.ApplicationStatus {
+		if appStatus.Status != argov1alpha1.ProgressiveSyncRollingBack {
+			continue
+		}
+		if step, err := strconv.Atoi(appStatus.Step); err == nil {
+			rollingBackSteps[appStatus.Application] = step
+		}
+	}
+	appNames := reverseStepOrder(rollingBackSteps)
+	if len(appNames) == 0 {
+		return -1
+	}
+	return rollingBackSteps[appNames[0]]
+}
+
+// getLastDeploymentID returns the ID of the last deployment in the history of the Application, or nil if it was never deployed
+func getLastDeploymentID(app argov1alpha1.Application) *int64 {
+	if len(app.Status.History) == 0 {
+		return nil
+	}
+	id := app.Status.History.LastRevisionHistory().ID
+	return &id
+}
+
+func getDeployment(app argov1alpha1.Application, id int64) *argov1alpha1.RevisionHistory {
+	for i := range app.Status.History {
+		if app.Status.History[i].ID == id {
+			return &app.Status.History[i]
+		}
+	}
+	return nil
+}
+
+// isRollbackOperationStarted reports whether a sync of the Application started since it was selected to be rolled back
+func isRollbackOperationStarted(app argov1alpha1.Application, appStatus argov1alpha1.ApplicationSetApplicationStatus) bool {
+	return app.Status.OperationState != nil && appStatus.LastTransitionTime != nil && !app.Status.OperationState.StartedAt.Before(appStatus.LastTransitionTime)
+}
+
+// getFailedStep returns the index of the first step of the rollout with an Application that became Degraded after it was
+// synced, or whose analysis failed, along with the reason of the failure. It returns -1 when the rollout did not fail.
+// Only the steps up to the step being rolled out are evaluated, and a completed rollout is never rolled back.
+func getFailedStep(applicationSet *argov1alpha1.ApplicationSet, appDependencyList [][]string, appMap map[string]argov1alpha1.Application) (int, string) {
+	currentAppsMap := map[string]bool{}
+	for appName := range appMap {
+		currentAppsMap[appName] = true
+	}
+
+	promotedStep := getPromotedStep(applicationSet)
+	failedStep, reason := -1, ""
+	for stepIndex, appNames := range appDependencyList {
+		if stepIndex < promotedStep {
+			// The step was promoted despite its Applications, it does not fail the rollout
+			continue
+		}
+		for _, appName := range appNames {
+			if failedStep != -1 {
+				break
+			}
+			idx := utils.FindApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+			if idx == -1 {
+				continue
+			}
+			appStatus := applicationSet.Status.ApplicationStatus[idx]
+			if appStatus.Gate != nil && appStatus.Gate.Phase == argov1alpha1.ApplicationSetStepGateFailed {
+				failedStep, reason = stepIndex, appStatus.Gate.Message
+				continue
+			}
+			app, ok := appMap[appName]
+			if ok && (appStatus.Status == argov1alpha1.ProgressiveSyncProgressing || appStatus.Status == argov1alpha1.ProgressiveSyncHealthy) && app.Status.Health.Status == health.HealthStatusDegraded {
+				failedStep, reason = stepIndex, fmt.Sprintf("Application %s is Degraded", appName)
+			}
+		}
+
+		if !isStepHealthy(applicationSet, appNames, currentAppsMap) {
+			return failedStep, reason
+		}
+		if hasGate(applicationSet.Spec.Strategy.RollingSync.Steps[stepIndex]) && !isStepGatePhase(applicationSet, appNames, argov1alpha1.ApplicationSetStepGatePassed) {
+			return failedStep, reason
+		}
+	}
+	// The rollout has completed
+	return -1, ""
+}
+
+// UpdateApplicationSetRollback detects the failure of the rollout when the ApplicationSet rolls back on failure, and
+// selects the Applications already updated by the rollout to be rolled back to their previous deployment. It then
+// tracks the rollback of the steps, in reverse order, until all the Applications are rolled back.
+func (m *Manager) UpdateApplicationSetRollback(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, appDependencyList [][]string, applications []argov1alpha1.Application) error {
+	if !RollingSyncStrategyEnabled(applicationSet) {
+		return nil
+	}
+
+	appMap := map[string]argov1alpha1.Application{}
+	for _, app := range applications {
+		appMap[app.Name] = app
+	}
+
+	now := metav1.Now()
+	appStatuses := make([]argov1alpha1.ApplicationSetApplicationStatus, 0, len(applicationSet.Status.ApplicationStatus))
+	changed := false
+
+	if !isRollbackStarted(applicationSet) {
+		if !IsRollbackOnFailure(applicationSet) {
+			return nil
+		}
+		failedStep, reason := getFailedStep(applicationSet, appDependencyList, appMap)
+		if failedStep == -1 {
+			return nil
+		}
+		logCtx.WithField("step", failedStep+1).Warnf("RollingSync step failed, rolling back the rollout: %s", reason)
+
+		rollbackApps := map[string]bool{}
+		for _, appNames := range appDependencyList[:failedStep+1] {
+			for _, appName := range appNames {
+				rollbackApps[appName] = true
+			}
+		}
+
+		for _, appStatus := range applicationSet.Status.ApplicationStatus {
+			if rollbackApps[appStatus.Application] && (appStatus.Status == argov1alpha1.ProgressiveSyncPending || appStatus.Status == argov1alpha1.ProgressiveSyncProgressing || appStatus.Status == argov1alpha1.ProgressiveSyncHealthy) {
+				statusLogCtx := logCtx.WithFields(log.Fields{
+					"app.name":      appStatus.Application,
+					"status.status": appStatus.Status,
+					"status.step":   appStatus.Step,
+				})
+				if appStatus.PreviousDeploymentID == nil || getDeployment(appMap[appStatus.Application], *appStatus.PreviousDeploymentID) == nil {
+					statusLogCtx.Warn("Application has no previous deployment to roll back to, leaving it as is")
+				} else {
+					appStatus.LastTransitionTime = &now
+					appStatus.Status = argov1alpha1.ProgressiveSyncRollingBack
+					appStatus.Message = fmt.Sprintf("Step %d failed, rolling back the Application to deployment %d: %s", failedStep+1, *appStatus.PreviousDeploymentID, reason)
+					appStatus.Gate = nil
+					changed = true
+
+					statusLogCtx.WithFields(log.Fields{
+						"new_status.status":  appStatus.Status,
+						"new_status.message": appStatus.Message,
+					}).Info("Progressive sync application changed status")
+				}
+			}
+			appStatuses = append(appStatuses, appStatus)
+		}
+	} else {
+		rollbackStep := strconv.Itoa(getRollbackStep(applicationSet))
+		for _, appStatus := range applicationSet.Status.ApplicationStatus {
+			if appStatus.Status == argov1alpha1.ProgressiveSyncRollingBack && appStatus.Step == rollbackStep {
+				app, ok := appMap[appStatus.Application]
+				if ok && isRollbackOperationStarted(app, appStatus) && app.Status.OperationState.Phase.Completed() {
+					appStatus.LastTransitionTime = &now
+					appStatus.Status = argov1alpha1.ProgressiveSyncRolledBack
+					appStatus.Message = fmt.Sprintf("Application rollback completed with phase %s: %s", app.Status.OperationState.Phase, app.Status.OperationState.Message)
+					changed = true
+
+					logCtx.WithFields(log.Fields{
+						"app.name":           appStatus.Application,
+						"status.step":        appStatus.Step,
+						"new_status.status":  appStatus.Status,
+						"new_status.message": appStatus.Message,
+					}).Info("Progressive sync application changed status")
+				}
+			}
+			appStatuses = append(appStatuses, appStatus)
+		}
+	}
+
+	if !changed {
+		return nil
+	}
+
+	err := m.dependencies.SetAppSetApplicationStatus(ctx, logCtx, applicationSet, appStatuses)
+	if err != nil {
+		return fmt.Errorf("failed to set AppSet application rollback statuses: %w", err)
+	}
+	return nil
+}
+
+// RollbackDesiredApplications triggers the rollback of the Applications of the step being rolled back, by setting a sync
+// operation to their previous deployment on the desired Applications.
+func (m *Manager) RollbackDesiredApplications(logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, currentApplications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application) []argov1alpha1.Application {
+	rollbackStep := getRollbackStep(applicationSet)
+	if rollbackStep == -1 {
+		return desiredApplications
+	}
+
+	appMap := map[string]argov1alpha1.Application{}
+	for _, app := range currentApplications {
+		appMap[app.Name] = app
+	}
+
+	for i := range desiredApplications {
+		idx := utils.FindApplicationStatusIndex(applicationSet.Status.ApplicationStatus, desiredApplications[i].Name)
+		if idx == -1 {
+			continue
+		}
+		appStatus := applicationSet.Status.ApplicationStatus[idx]
+		if appStatus.Status != argov1alpha1.ProgressiveSyncRollingBack || appStatus.Step != strconv.Itoa(rollbackStep) || appStatus.PreviousDeploymentID == nil {
+			continue
+		}
+		app, ok := appMap[desiredApplications[i].Name]
+		if !ok || isRollbackOperationStarted(app, appStatus) {
+			continue
+		}
+		deployment := getDeployment(app, *appStatus.PreviousDeploymentID)
+		if deployment == nil {
+			logCtx.Warnf("application %v no longer has deployment %d in its history, it cannot be rolled back", app.Name, *appStatus.PreviousDeploymentID)
+			continue
+		}
+
+		prune := desiredApplications[i].Spec.SyncPolicy != nil && desiredApplications[i].Spec.SyncPolicy.Automated != nil && desiredApplications[i].Spec.SyncPolicy.Automated.GetPrune()
+		logCtx.Infof("triggering rollback for application: %v, deployment: %d, prune enabled: %v", app.Name, deployment.ID, prune)
+		desiredApplications[i] = rollbackApplication(desiredApplications[i], *deployment, prune, rollbackStep)
+	}
+	return desiredApplications
+}
+
+// used by the RollingSync Progressive Sync strategy to roll back a particular Application resource to a previous
+// deployment. Like the rollback of the API server, it is a sync to the revisions and sources of the deployment.
+func rollbackApplication(application argov1alpha1.Application, deployment argov1alpha1.RevisionHistory, prune bool, step int) argov1alpha1.Application {
+	application = syncApplication(application, prune)
+	application.Operation.Info = []*argov1alpha1.Info{
+		{
+			Name:  "Reason",
+			Value: fmt.Sprintf("ApplicationSet RollingSync rolled back this Application resource to deployment %d, while rolling back step %d", deployment.ID, step),
+		},
+	}
+	application.Operation.Sync.Revision = deployment.Revision
+	application.Operation.Sync.Revisions = deployment.Revisions
+	application.Operation.Sync.Source = &deployment.Source
+	application.Operation.Sync.Sources = deployment.Sources
+	application.Operation.Sync.SyncStrategy = &argov1alpha1.SyncStrategy{Apply: &argov1alpha1.SyncStrategyApply{}}
+	application.Operation.Sync.Prune = prune
+	return application
+}
+
+func (m *Manager) getRollbackCondition(applicationSet *argov1alpha1.ApplicationSet) *argov1alpha1.ApplicationSetCondition {
+	if !IsRollingSyncStrategy(applicationSet) || !isRollbackStarted(applicationSet) {
+		return nil
+	}
+
+	if rollbackStep := getRollbackStep(applicationSet); rollbackStep != -1 {
+		return &argov1alpha1.ApplicationSetCondition{
+			Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+			Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+			Message: fmt.Sprintf("ApplicationSet rollout failed, rolling back step %d", rollbackStep),
+			Reason:  argov1alpha1.ApplicationSetReasonApplicationSetRollingBack,
+		}
+	}
+
+	return &argov1alpha1.ApplicationSetCondition{
+		Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+		Status:  argov1alpha1.ApplicationSetConditionStatusFalse,
+		Message: "ApplicationSet rollout failed and has been rolled back, it resumes once the Applications change",
+		Reason:  argov1alpha1.ApplicationSetReasonApplicationSetRolledBack,
+	}
+}
@@ -0,0 +1,407 @@
+package progressivesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newRollbackTestAppSet(onFailure string, appStatuses ...v1alpha1.ApplicationSetApplicationStatus) v1alpha1.ApplicationSet {
+	return v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Strategy: &v1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+					Steps:     []v1alpha1.ApplicationSetRolloutStep{{}, {}, {}},
+					OnFailure: onFailure,
+				},
+			},
+		},
+		Status: v1alpha1.ApplicationSetStatus{ApplicationStatus: appStatuses},
+	}
+}
+
+func newRollbackTestApp(name string, healthStatus health.HealthStatusCode, deploymentIDs ...int64) v1alpha1.Application {
+	app := v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"}}
+	app.Status.Health.Status = healthStatus
+	for _, id := range deploymentIDs {
+		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
+			ID:       id,
+			Revision: "rev-" + name,
+			Source:   v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: name, TargetRevision: "v1"},
+		})
+	}
+	return app
+}
+
+func TestIsRollbackOnFailure(t *testing.T) {
+	t.Parallel()
+	for _, onFailure := range []string{"Rollback", "rollback"} {
+		appSet := newRollbackTestAppSet(onFailure)
+		assert.True(t, IsRollbackOnFailure(&appSet), onFailure)
+	}
+	for _, onFailure := range []string{"", "Halt"} {
+		appSet := newRollbackTestAppSet(onFailure)
+		assert.False(t, IsRollbackOnFailure(&appSet), onFailure)
+	}
+}
+
+func TestBuildAppDependencyListInvalidOnFailure(t *testing.T) {
+	t.Parallel()
+	for _, onFailure := range []string{"", "Halt", "rollback"} {
+		_, _, issues := buildAppDependencyList(log.NewEntry(log.StandardLogger()), newRollbackTestAppSet(onFailure), nil)
+		assert.Empty(t, issues.InvalidOnFailure, onFailure)
+	}
+	_, _, issues := buildAppDependencyList(log.NewEntry(log.StandardLogger()), newRollbackTestAppSet("Retry"), nil)
+	assert.Equal(t, "Retry", issues.InvalidOnFailure)
+	assert.True(t, issues.HasIssues())
+}
+
+func TestGetFailedStep(t *testing.T) {
+	t.Parallel()
+	appDependencyList := [][]string{{"app1"}, {"app2"}, {"app3"}}
+	for _, cc := range []struct {
+		name            string
+		appStatuses     []v1alpha1.ApplicationSetApplicationStatus
+		apps            []v1alpha1.Application
+		expectedStep    int
+		expectedMessage string
+	}{
+		{
+			name: "the rollout is healthy",
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncProgressing, Step: "2"},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting, Step: "3"},
+			},
+			apps: []v1alpha1.Application{
+				newRollbackTestApp("app1", health.HealthStatusHealthy),
+				newRollbackTestApp("app2", health.HealthStatusProgressing),
+				newRollbackTestApp("app3", health.HealthStatusHealthy),
+			},
+			expectedStep: -1,
+		},
+		{
+			name: "the application of the progressing step is degraded",
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncProgressing, Step: "2"},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting, Step: "3"},
+			},
+			apps: []v1alpha1.Application{
+				newRollbackTestApp("app1", health.HealthStatusHealthy),
+				newRollbackTestApp("app2", health.HealthStatusDegraded),
+				newRollbackTestApp("app3", health.HealthStatusHealthy),
+			},
+			expectedStep:    1,
+			expectedMessage: "Application app2 is Degraded",
+		},
+		{
+			name: "the application of a completed step is degraded",
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncProgressing, Step: "2"},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting, Step: "3"},
+			},
+			apps: []v1alpha1.Application{
+				newRollbackTestApp("app1", health.HealthStatusDegraded),
+				newRollbackTestApp("app2", health.HealthStatusDegraded),
+				newRollbackTestApp("app3", health.HealthStatusHealthy),
+			},
+			expectedStep:    0,
+			expectedMessage: "Application app1 is Degraded",
+		},
+		{
+			name: "the analysis of a step failed",
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1", Gate: &v1alpha1.ApplicationSetStepGateStatus{Phase: v1alpha1.ApplicationSetStepGateFailed, Message: "Step 1 analysis failed"}},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting, Step: "3"},
+			},
+			apps: []v1alpha1.Application{
+				newRollbackTestApp("app1", health.HealthStatusHealthy),
+				newRollbackTestApp("app2", health.HealthStatusHealthy),
+				newRollbackTestApp("app3", health.HealthStatusHealthy),
+			},
+			expectedStep:    0,
+			expectedMessage: "Step 1 analysis failed",
+		},
+		{
+			name: "the application that was not synced yet is degraded",
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncPending, Step: "2"},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncWaiting, Step: "3"},
+			},
+			apps: []v1alpha1.Application{
+				newRollbackTestApp("app1", health.HealthStatusHealthy),
+				newRollbackTestApp("app2", health.HealthStatusDegraded),
+				newRollbackTestApp("app3", health.HealthStatusDegraded),
+			},
+			expectedStep: -1,
+		},
+		{
+			name: "the rollout has completed",
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncHealthy, Step: "2"},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncHealthy, Step: "3"},
+			},
+			apps: []v1alpha1.Application{
+				newRollbackTestApp("app1", health.HealthStatusHealthy),
+				newRollbackTestApp("app2", health.HealthStatusDegraded),
+				newRollbackTestApp("app3", health.HealthStatusHealthy),
+			},
+			expectedStep: -1,
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			t.Parallel()
+			appSet := newRollbackTestAppSet(RollbackOnFailure, cc.appStatuses...)
+			appMap := map[string]v1alpha1.Application{}
+			for _, app := range cc.apps {
+				appMap[app.Name] = app
+			}
+			step, message := getFailedStep(&appSet, appDependencyList, appMap)
+			assert.Equal(t, cc.expectedStep, step)
+			assert.Equal(t, cc.expectedMessage, message)
+		})
+	}
+}
+
+func TestUpdateApplicationSetRollback(t *testing.T) {
+	t.Parallel()
+	appDependencyList := [][]string{{"app1", "app2"}, {"app3", "app4"}, {"app5"}}
+	before := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	after := metav1.NewTime(time.Now().Add(time.Minute).Truncate(time.Second))
+
+	for _, cc := range []struct {
+		name             string
+		onFailure        string
+		appStatuses      []v1alpha1.ApplicationSetApplicationStatus
+		apps             []v1alpha1.Application
+		expectedStatuses map[string]v1alpha1.ProgressiveSyncStatusCode
+	}{
+		{
+			name:      "halts the failed rollout by default",
+			onFailure: "",
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1", PreviousDeploymentID: new(int64(1))},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncProgressing, Step: "2", PreviousDeploymentID: new(int64(1))},
+			},
+			apps: []v1alpha1.Application{
+				newRollbackTestApp("app1", health.HealthStatusHealthy, 1, 2),
+				newRollbackTestApp("app3", health.HealthStatusDegraded, 1, 2),
+			},
+			expectedStatuses: map[string]v1alpha1.ProgressiveSyncStatusCode{
+				"app1": v1alpha1.ProgressiveSyncHealthy,
+				"app3": v1alpha1.ProgressiveSyncProgressing,
+			},
+		},
+		{
+			name:      "starts the rollback of the failed rollout",
+			onFailure: RollbackOnFailure,
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1", PreviousDeploymentID: new(int64(1))},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncProgressing, Step: "2", PreviousDeploymentID: new(int64(1))},
+				{Application: "app4", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2", PreviousDeploymentID: new(int64(1))},
+				{Application: "app5", Status: v1alpha1.ProgressiveSyncWaiting, Step: "3", PreviousDeploymentID: new(int64(1))},
+			},
+			apps: []v1alpha1.Application{
+				newRollbackTestApp("app1", health.HealthStatusHealthy, 1, 2),
+				newRollbackTestApp("app2", health.HealthStatusHealthy),
+				newRollbackTestApp("app3", health.HealthStatusDegraded, 1, 2),
+				newRollbackTestApp("app4", health.HealthStatusHealthy, 1),
+				newRollbackTestApp("app5", health.HealthStatusHealthy, 1),
+			},
+			expectedStatuses: map[string]v1alpha1.ProgressiveSyncStatusCode{
+				"app1": v1alpha1.ProgressiveSyncRollingBack,
+				"app2": v1alpha1.ProgressiveSyncHealthy,
+				"app3": v1alpha1.ProgressiveSyncRollingBack,
+				"app4": v1alpha1.ProgressiveSyncWaiting,
+				"app5": v1alpha1.ProgressiveSyncWaiting,
+			},
+		},
+		{
+			name:      "completes the rollback of the last step first",
+			onFailure: RollbackOnFailure,
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncRollingBack, Step: "1", LastTransitionTime: &before, PreviousDeploymentID: new(int64(1))},
+				{Application: "app3", Status: v1alpha1.ProgressiveSyncRollingBack, Step: "2", LastTransitionTime: &before, PreviousDeploymentID: new(int64(1))},
+				{Application: "app4", Status: v1alpha1.ProgressiveSyncRollingBack, Step: "2", LastTransitionTime: &before, PreviousDeploymentID: new(int64(1))},
+			},
+			apps: func() []v1alpha1.Application {
+				app1 := newRollbackTestApp("app1", health.HealthStatusHealthy, 1, 2)
+				app1.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationSucceeded, StartedAt: after}
+				app3 := newRollbackTestApp("app3", health.HealthStatusHealthy, 1, 2, 3)
+				app3.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationSucceeded, StartedAt: after}
+				app4 := newRollbackTestApp("app4", health.HealthStatusDegraded, 1, 2)
+				app4.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationRunning, StartedAt: after}
+				return []v1alpha1.Application{app1, app3, app4}
+			}(),
+			expectedStatuses: map[string]v1alpha1.ProgressiveSyncStatusCode{
+				"app1": v1alpha1.ProgressiveSyncRollingBack,
+				"app3": v1alpha1.ProgressiveSyncRolledBack,
+				"app4": v1alpha1.ProgressiveSyncRollingBack,
+			},
+		},
+		{
+			name:      "ignores the operation started before the rollback",
+			onFailure: RollbackOnFailure,
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncRollingBack, Step: "1", LastTransitionTime: &after, PreviousDeploymentID: new(int64(1))},
+			},
+			apps: func() []v1alpha1.Application {
+				app1 := newRollbackTestApp("app1", health.HealthStatusHealthy, 1, 2)
+				app1.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationSucceeded, StartedAt: before}
+				return []v1alpha1.Application{app1}
+			}(),
+			expectedStatuses: map[string]v1alpha1.ProgressiveSyncStatusCode{
+				"app1": v1alpha1.ProgressiveSyncRollingBack,
+			},
+		},
+		{
+			name:      "does not fail the rolled back rollout again",
+			onFailure: RollbackOnFailure,
+			appStatuses: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: v1alpha1.ProgressiveSyncRolledBack, Step: "1", PreviousDeploymentID: new(int64(1))},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+			},
+			apps: []v1alpha1.Application{
+				newRollbackTestApp("app1", health.HealthStatusHealthy, 1, 2, 3),
+				newRollbackTestApp("app2", health.HealthStatusDegraded),
+			},
+			expectedStatuses: map[string]v1alpha1.ProgressiveSyncStatusCode{
+				"app1": v1alpha1.ProgressiveSyncRolledBack,
+				"app2": v1alpha1.ProgressiveSyncHealthy,
+			},
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			t.Parallel()
+			appSet := newRollbackTestAppSet(cc.onFailure, cc.appStatuses...)
+			m := NewManager(nil, &fakeDependencies{})
+			err := m.UpdateApplicationSetRollback(t.Context(), log.NewEntry(log.StandardLogger()), &appSet, appDependencyList, cc.apps)
+			require.NoError(t, err)
+
+			statuses := map[string]v1alpha1.ProgressiveSyncStatusCode{}
+			for _, appStatus := range appSet.Status.ApplicationStatus {
+				statuses[appStatus.Application] = appStatus.Status
+			}
+			assert.Equal(t, cc.expectedStatuses, statuses)
+		})
+	}
+}
+
+func TestRollbackDesiredApplications(t *testing.T) {
+	t.Parallel()
+	transitioned := metav1.NewTime(time.Now().Truncate(time.Second))
+	started := metav1.NewTime(transitioned.Add(time.Second))
+
+	appSet := newRollbackTestAppSet(RollbackOnFailure,
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncRollingBack, Step: "1", LastTransitionTime: &transitioned, PreviousDeploymentID: new(int64(1))},
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app2", Status: v1alpha1.ProgressiveSyncRollingBack, Step: "2", LastTransitionTime: &transitioned, PreviousDeploymentID: new(int64(1))},
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app3", Status: v1alpha1.ProgressiveSyncRollingBack, Step: "2", LastTransitionTime: &transitioned, PreviousDeploymentID: new(int64(1))},
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app4", Status: v1alpha1.ProgressiveSyncWaiting, Step: "3"},
+	)
+	app3 := newRollbackTestApp("app3", health.HealthStatusDegraded, 1, 2)
+	app3.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationRunning, StartedAt: started}
+	currentApps := []v1alpha1.Application{
+		newRollbackTestApp("app1", health.HealthStatusHealthy, 1, 2),
+		newRollbackTestApp("app2", health.HealthStatusDegraded, 0, 1, 2),
+		app3,
+		newRollbackTestApp("app4", health.HealthStatusHealthy, 1),
+	}
+	desiredApps := []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app2"}, Spec: v1alpha1.ApplicationSpec{SyncPolicy: &v1alpha1.SyncPolicy{
+			Automated:   &v1alpha1.SyncPolicyAutomated{Prune: new(true), Enabled: new(false)},
+			SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true"},
+		}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app3"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app4"}},
+	}
+
+	m := NewManager(nil, &fakeDependencies{})
+	rolloutApps := m.RollbackDesiredApplications(log.NewEntry(log.StandardLogger()), &appSet, currentApps, desiredApps)
+	require.Len(t, rolloutApps, 4)
+
+	// app1 is rolled back once the step 2 is rolled back, app3 is already rolling back and app4 was not updated
+	assert.Nil(t, rolloutApps[0].Operation)
+	assert.Nil(t, rolloutApps[2].Operation)
+	assert.Nil(t, rolloutApps[3].Operation)
+
+	operation := rolloutApps[1].Operation
+	require.NotNil(t, operation)
+	assert.Equal(t, "applicationset-controller", operation.InitiatedBy.Username)
+	assert.True(t, operation.InitiatedBy.Automated)
+	assert.Equal(t, "ApplicationSet RollingSync rolled back this Application resource to deployment 1, while rolling back step 2", operation.Info[0].Value)
+	require.NotNil(t, operation.Sync)
+	assert.Equal(t, "rev-app2", operation.Sync.Revision)
+	assert.Equal(t, &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "app2", TargetRevision: "v1"}, operation.Sync.Source)
+	assert.Equal(t, &v1alpha1.SyncStrategy{Apply: &v1alpha1.SyncStrategyApply{}}, operation.Sync.SyncStrategy)
+	assert.Equal(t, v1alpha1.SyncOptions{"CreateNamespace=true"}, operation.Sync.SyncOptions)
+	assert.True(t, operation.Sync.Prune)
+}
+
+func TestGetRollbackCondition(t *testing.T) {
+	t.Parallel()
+	m := NewManager(nil, &fakeDependencies{})
+
+	appSet := newRollbackTestAppSet(RollbackOnFailure,
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+	)
+	assert.Nil(t, m.getRollbackCondition(&appSet))
+
+	appSet = newRollbackTestAppSet(RollbackOnFailure,
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncRollingBack, Step: "1"},
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app2", Status: v1alpha1.ProgressiveSyncRolledBack, Step: "2"},
+	)
+	condition := m.getRollbackCondition(&appSet)
+	require.NotNil(t, condition)
+	assert.Equal(t, v1alpha1.ApplicationSetConditionRolloutProgressing, condition.Type)
+	assert.Equal(t, v1alpha1.ApplicationSetConditionStatusTrue, condition.Status)
+	assert.Equal(t, v1alpha1.ApplicationSetReasonApplicationSetRollingBack, condition.Reason)
+	assert.Equal(t, "ApplicationSet rollout failed, rolling back step 1", condition.Message)
+
+	appSet = newRollbackTestAppSet(RollbackOnFailure,
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncRolledBack, Step: "1"},
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app2", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+	)
+	condition = m.getRollbackCondition(&appSet)
+	require.NotNil(t, condition)
+	assert.Equal(t, v1alpha1.ApplicationSetConditionStatusFalse, condition.Status)
+	assert.Equal(t, v1alpha1.ApplicationSetReasonApplicationSetRolledBack, condition.Reason)
+}
+
+func TestUpdateApplicationSetApplicationStatusRecordsPreviousDeployment(t *testing.T) {
+	t.Parallel()
+	appSet := newRollbackTestAppSet(RollbackOnFailure,
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1", TargetRevisions: []string{"old"}},
+	)
+	app1 := newRollbackTestApp("app1", health.HealthStatusHealthy, 4, 5)
+	app1.Status.Sync = v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: "new"}
+	app2 := newRollbackTestApp("app2", health.HealthStatusHealthy)
+	app2.Status.Sync = v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: "new"}
+
+	m := NewManager(nil, &fakeDependencies{})
+	appStatuses, err := m.UpdateApplicationSetApplicationStatus(t.Context(), log.NewEntry(log.StandardLogger()), &appSet, []v1alpha1.Application{app1, app2}, nil, map[string]int{"app1": 0, "app2": 0})
+	require.NoError(t, err)
+	require.Len(t, appStatuses, 2)
+
+	assert.Equal(t, v1alpha1.ProgressiveSyncWaiting, appStatuses[0].Status)
+	assert.Equal(t, new(int64(5)), appStatuses[0].PreviousDeploymentID)
+	// app2 was never deployed, so there is nothing to roll back to
+	assert.Equal(t, v1alpha1.ProgressiveSyncWaiting, appStatuses[1].Status)
+	assert.Nil(t, appStatuses[1].PreviousDeploymentID)
+}
@@ -16,6 +16,8 @@ type ValidationIssues struct {
 	DuplicateAppSelections  map[string][]int
 	EmptySteps              []int // step indices (0-based) with no matching apps
 	InvalidMaxUpdates       []InvalidMaxUpdate
+	InvalidGates            []InvalidGate
+	InvalidOnFailure        string // the unsupported onFailure value
 }
 
 // InvalidMatchExpression represents a step with an invalid matchExpression operator
@@ -31,12 +33,20 @@ type InvalidMaxUpdate struct {
 	Error     string
 }
 
+// InvalidGate represents a step with an invalid bakeTime or analysis
+type InvalidGate struct {
+	StepIndex int
+	Error     string
+}
+
 // HasIssues returns true if any validation issues exist
 func (v *ValidationIssues) HasIssues() bool {
-	return len(v.InvalidMatchExpressions) > 0 ||
+	return v.InvalidOnFailure != "" ||
+		len(v.InvalidMatchExpressions) > 0 ||
 		len(v.DuplicateAppSelections) > 0 ||
 		len(v.EmptySteps) > 0 ||
-		len(v.InvalidMaxUpdates) > 0
+		len(v.InvalidMaxUpdates) > 0 ||
+		len(v.InvalidGates) > 0
 }
 
 func (v *ValidationIssues) alreadyExists(stepIndex int, operator string) bool {
@@ -101,6 +111,26 @@ func (v *ValidationIssues) formatInvalidMaxUpdateMessage() string {
 	return fmt.Sprintf("Steps %v have invalid maxUpdate values: [%v]", strings.Join(stepNums, ", "), strings.Join(values, ", "))
 }
 
+// formatInvalidGateMessage formats error message for invalid gates
+func (v *ValidationIssues) formatInvalidGateMessage() string {
+	count := len(v.InvalidGates)
+	stepNums := make([]string, count)
+	errs := make([]string, count)
+	for i, invalidGate := range v.InvalidGates {
+		stepNums[i] = strconv.Itoa(invalidGate.StepIndex + 1)
+		errs[i] = invalidGate.Error
+	}
+	if len(stepNums) == 1 {
+		return fmt.Sprintf("Step %v has an invalid gate: %v", stepNums[0], errs[0])
+	}
+	return fmt.Sprintf("Steps %v have invalid gates: [%v]", strings.Join(stepNums, ", "), strings.Join(errs, ", "))
+}
+
+// formatInvalidOnFailureMessage formats error message for an invalid onFailure value
+func (v *ValidationIssues) formatInvalidOnFailureMessage() string {
+	return fmt.Sprintf("RollingSync has an invalid onFailure value: %s. Supported values are 'Halt' and 'Rollback'", v.InvalidOnFailure)
+}
+
 // formatEmptyStepsMessage formats warning message for empty steps
 func (v *ValidationIssues) formatEmptyStepsMessage() string {
 	count := len(v.EmptySteps)
@@ -115,12 +145,16 @@ func (v *ValidationIssues) formatEmptyStepsMessage() string {
 func (v *ValidationIssues) getConditionMessage() string {
 	var rolloutMessage string
 	switch {
+	case v.InvalidOnFailure != "":
+		rolloutMessage = v.formatInvalidOnFailureMessage()
 	case len(v.InvalidMatchExpressions) > 0:
 		rolloutMessage = v.formatInvalidMatchExpressionMessage()
 	case len(v.DuplicateAppSelections) > 0:
 		rolloutMessage = v.formatDuplicateAppSelectionMessage()
 	case len(v.InvalidMaxUpdates) > 0:
 		rolloutMessage = v.formatInvalidMaxUpdateMessage()
+	case len(v.InvalidGates) > 0:
+		rolloutMessage = v.formatInvalidGateMessage()
 	case len(v.EmptySteps) > 0:
 		rolloutMessage = v.formatEmptyStepsMessage()
 	}
@@ -165,3 +165,43 @@ func TestFormatEmptyStepsMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatInvalidGateMessage(t *testing.T) {
+	for _, tc := range []struct {
+		name                     string
+		issues                   ValidationIssues
+		expectedConditionMessage string
+	}{
+		{
+			name: "single step with invalid gate found for RollingSync",
+			issues: ValidationIssues{
+				InvalidGates: []InvalidGate{
+					{StepIndex: 0, Error: "invalid bakeTime: time: invalid duration \"soon\""},
+				},
+			},
+			expectedConditionMessage: "Step 1 has an invalid gate: invalid bakeTime: time: invalid duration \"soon\"",
+		},
+		{
+			name: "multiple steps with invalid gates found for RollingSync",
+			issues: ValidationIssues{
+				InvalidGates: []InvalidGate{
+					{StepIndex: 0, Error: "analysis has no provider configured"},
+					{StepIndex: 4, Error: "web analysis has no url configured"},
+				},
+			},
+			expectedConditionMessage: "Steps 1, 5 have invalid gates: [analysis has no provider configured, web analysis has no url configured]",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			progressingConditionMessage := tc.issues.formatInvalidGateMessage()
+			assert.NotEmpty(t, progressingConditionMessage)
+			assert.Equal(t, tc.expectedConditionMessage, progressingConditionMessage)
+		})
+	}
+}
+
+func TestFormatInvalidOnFailureMessage(t *testing.T) {
+	issues := ValidationIssues{InvalidOnFailure: "Retry"}
+	assert.Equal(t, "RollingSync has an invalid onFailure value: Retry. Supported values are 'Halt' and 'Rollback'", issues.formatInvalidOnFailureMessage())
+	assert.Equal(t, issues.formatInvalidOnFailureMessage(), issues.getConditionMessage())
+}
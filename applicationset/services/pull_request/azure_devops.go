@@ -2,6 +2,7 @@ package pull_request
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -42,6 +43,7 @@ type AzureDevOpsService struct {
 
 var (
 	_ PullRequestService       = (*AzureDevOpsService)(nil)
+	_ PullRequestPublisher     = (*AzureDevOpsService)(nil)
 	_ AzureDevOpsClientFactory = &devopsFactoryImpl{}
 )
 
@@ -122,6 +124,83 @@ func (a *AzureDevOpsService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+func (a *AzureDevOpsService) Publish(ctx context.Context, branch, targetBranch, title, description string) (*PullRequest, bool, error) {
+	client, err := a.clientFactory.GetClient(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get Azure DevOps client: %w", err)
+	}
+
+	sourceRefName := "refs/heads/" + branch
+	targetRefName := "refs/heads/" + targetBranch
+	azurePullRequests, err := client.GetPullRequests(ctx, git.GetPullRequestsArgs{
+		Project:      &a.project,
+		RepositoryId: &a.repo,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{
+			SourceRefName: &sourceRefName,
+			TargetRefName: &targetRefName,
+		},
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get pull requests: %w", err)
+	}
+
+	var pr *git.GitPullRequest
+	opened := true
+	if azurePullRequests != nil && len(*azurePullRequests) > 0 && (*azurePullRequests)[0].PullRequestId != nil {
+		opened = false
+		pullRequestID := (*azurePullRequests)[0].PullRequestId
+		pr, err = client.UpdatePullRequest(ctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: &git.GitPullRequest{
+				Title:       &title,
+				Description: &description,
+			},
+			RepositoryId:  &a.repo,
+			PullRequestId: pullRequestID,
+			Project:       &a.project,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to update pull request %d: %w", *pullRequestID, err)
+		}
+	} else {
+		pr, err = client.CreatePullRequest(ctx, git.CreatePullRequestArgs{
+			GitPullRequestToCreate: &git.GitPullRequest{
+				SourceRefName: &sourceRefName,
+				TargetRefName: &targetRefName,
+				Title:         &title,
+				Description:   &description,
+			},
+			RepositoryId: &a.repo,
+			Project:      &a.project,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to create pull request: %w", err)
+		}
+	}
+	if pr == nil || pr.PullRequestId == nil {
+		return nil, false, errors.New("no pull request returned by Azure DevOps")
+	}
+
+	pullRequest := &PullRequest{
+		Number:       int64(*pr.PullRequestId),
+		Branch:       branch,
+		TargetBranch: targetBranch,
+		Labels:       convertLabels(pr.Labels),
+	}
+	if pr.Title != nil {
+		pullRequest.Title = *pr.Title
+	}
+	if pr.LastMergeSourceCommit != nil && pr.LastMergeSourceCommit.CommitId != nil {
+		pullRequest.HeadSHA = *pr.LastMergeSourceCommit.CommitId
+	}
+	if pr.CreatedBy != nil && pr.CreatedBy.UniqueName != nil {
+		pullRequest.Author = strings.Split(*pr.CreatedBy.UniqueName, "@")[0]
+	}
+	if pr.Repository != nil && pr.Repository.WebUrl != nil {
+		pullRequest.URL = fmt.Sprintf("%s/pullrequest/%d", *pr.Repository.WebUrl, *pr.PullRequestId)
+	}
+	return pullRequest, opened, nil
+}
+
 // convertLabels converts WebApiTagDefinitions to strings
 func convertLabels(tags *[]core.WebApiTagDefinition) []string {
 	if tags == nil {
@@ -234,3 +234,67 @@ func TestAzureDevOpsListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestAzureDevOpsPublish(t *testing.T) {
+	t.Parallel()
+	pullRequest := git.GitPullRequest{
+		PullRequestId: new(7),
+		Title:         new("Hydrate"),
+		SourceRefName: new("refs/heads/hydrator/main"),
+		TargetRefName: new("refs/heads/main"),
+		Repository: &git.GitRepository{
+			WebUrl: new("https://dev.azure.com/myorg/myproject/_git/myrepo"),
+		},
+		CreatedBy: &webapi.IdentityRef{
+			UniqueName: new("argocd@example.com"),
+		},
+	}
+	searchArgs := git.GetPullRequestsArgs{
+		Project:      new("myproject"),
+		RepositoryId: new("myrepo"),
+		SearchCriteria: &git.GitPullRequestSearchCriteria{
+			SourceRefName: new("refs/heads/hydrator/main"),
+			TargetRefName: new("refs/heads/main"),
+		},
+	}
+
+	t.Run("opens a pull request", func(t *testing.T) {
+		t.Parallel()
+		gitClientMock := azureMock.NewClient(t)
+		clientFactoryMock := mocks.NewAzureDevOpsClientFactory(t)
+		clientFactoryMock.EXPECT().GetClient(mock.Anything).Return(gitClientMock, nil)
+		gitClientMock.EXPECT().GetPullRequests(mock.Anything, searchArgs).Return(&[]git.GitPullRequest{}, nil)
+		gitClientMock.EXPECT().CreatePullRequest(mock.Anything, mock.MatchedBy(func(args git.CreatePullRequestArgs) bool {
+			return *args.GitPullRequestToCreate.SourceRefName == "refs/heads/hydrator/main" &&
+				*args.GitPullRequestToCreate.TargetRefName == "refs/heads/main" &&
+				*args.GitPullRequestToCreate.Title == "Hydrate"
+		})).Return(&pullRequest, nil)
+
+		provider := AzureDevOpsService{clientFactory: clientFactoryMock, project: "myproject", repo: "myrepo"}
+		pr, opened, err := provider.Publish(t.Context(), "hydrator/main", "main", "Hydrate", "description")
+		require.NoError(t, err)
+		assert.True(t, opened)
+		assert.Equal(t, int64(7), pr.Number)
+		assert.Equal(t, "argocd", pr.Author)
+		assert.Equal(t, "https://dev.azure.com/myorg/myproject/_git/myrepo/pullrequest/7", pr.URL)
+	})
+
+	t.Run("updates the open pull request", func(t *testing.T) {
+		t.Parallel()
+		gitClientMock := azureMock.NewClient(t)
+		clientFactoryMock := mocks.NewAzureDevOpsClientFactory(t)
+		clientFactoryMock.EXPECT().GetClient(mock.Anything).Return(gitClientMock, nil)
+		gitClientMock.EXPECT().GetPullRequests(mock.Anything, searchArgs).Return(&[]git.GitPullRequest{pullRequest}, nil)
+		gitClientMock.EXPECT().UpdatePullRequest(mock.Anything, mock.MatchedBy(func(args git.UpdatePullRequestArgs) bool {
+			return *args.PullRequestId == 7 && *args.GitPullRequestToUpdate.Description == "description"
+		})).Return(&pullRequest, nil)
+
+		provider := AzureDevOpsService{clientFactory: clientFactoryMock, project: "myproject", repo: "myrepo"}
+		pr, opened, err := provider.Publish(t.Context(), "hydrator/main", "main", "Hydrate", "description")
+		require.NoError(t, err)
+		assert.False(t, opened)
+		assert.Equal(t, int64(7), pr.Number)
+		assert.Equal(t, "hydrator/main", pr.Branch)
+		assert.Equal(t, "main", pr.TargetBranch)
+	})
+}
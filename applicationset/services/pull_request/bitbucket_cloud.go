@@ -23,6 +23,15 @@ type BitbucketCloudPullRequest struct {
 	Source      BitbucketCloudPullRequestSource      `json:"source"`
 	Author      BitbucketCloudPullRequestAuthor      `json:"author"`
 	Destination BitbucketCloudPullRequestDestination `json:"destination"`
+	Links       BitbucketCloudPullRequestLinks       `json:"links"`
+}
+
+type BitbucketCloudPullRequestLinks struct {
+	HTML BitbucketCloudPullRequestLink `json:"html"`
+}
+
+type BitbucketCloudPullRequestLink struct {
+	Href string `json:"href"`
 }
 
 type BitbucketCloudPullRequestDestination struct {
@@ -60,7 +69,10 @@ type PullRequestResponse struct {
 	Items    []PullRequest `json:"values"`
 }
 
-var _ PullRequestService = (*BitbucketCloudService)(nil)
+var (
+	_ PullRequestService   = (*BitbucketCloudService)(nil)
+	_ PullRequestPublisher = (*BitbucketCloudService)(nil)
+)
 
 func parseURL(uri string) (*url.URL, error) {
 	if uri == "" {
@@ -167,3 +179,61 @@ func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error)
 
 	return pullRequests, nil
 }
+
+func (b *BitbucketCloudService) Publish(ctx context.Context, branch, targetBranch, title, description string) (*PullRequest, bool, error) {
+	opts := &bitbucket.PullRequestsOptions{
+		Owner:    b.owner,
+		RepoSlug: b.repositorySlug,
+		States:   []string{"OPEN"},
+		Query:    fmt.Sprintf("source.branch.name = %q AND destination.branch.name = %q", branch, targetBranch),
+	}
+	response, err := b.client.Repositories.PullRequests.Gets(opts.WithContext(ctx))
+	if err != nil {
+		return nil, false, fmt.Errorf("error listing pull requests for %s/%s: %w", b.owner, b.repositorySlug, err)
+	}
+	resp, ok := response.(map[string]any)
+	if !ok {
+		return nil, false, errors.New("unknown type returned from bitbucket pull requests")
+	}
+	pulls, ok := resp["values"].([]any)
+	if !ok {
+		return nil, false, errors.New("unknown type returned from response values")
+	}
+
+	var pull any
+	if len(pulls) > 0 {
+		// Updating a pull request replaces its reviewers, so the pull request is left as is. Pushing to the branch
+		// already updated its changes.
+		pull = pulls[0]
+	} else {
+		pull, err = b.client.Repositories.PullRequests.Create((&bitbucket.PullRequestsOptions{
+			Owner:             b.owner,
+			RepoSlug:          b.repositorySlug,
+			Title:             title,
+			Description:       description,
+			SourceBranch:      branch,
+			DestinationBranch: targetBranch,
+		}).WithContext(ctx))
+		if err != nil {
+			return nil, false, fmt.Errorf("error creating pull request for %s/%s: %w", b.owner, b.repositorySlug, err)
+		}
+	}
+
+	jsonStr, err := json.Marshal(pull)
+	if err != nil {
+		return nil, false, fmt.Errorf("error marshalling response body to json: %w", err)
+	}
+	var bitbucketPull BitbucketCloudPullRequest
+	if err := json.Unmarshal(jsonStr, &bitbucketPull); err != nil {
+		return nil, false, fmt.Errorf("error unmarshalling json to type 'BitbucketCloudPullRequest': %w", err)
+	}
+	return &PullRequest{
+		Number:       int64(bitbucketPull.ID),
+		Title:        bitbucketPull.Title,
+		Branch:       bitbucketPull.Source.Branch.Name,
+		TargetBranch: bitbucketPull.Destination.Branch.Name,
+		HeadSHA:      bitbucketPull.Source.Commit.Hash,
+		Author:       bitbucketPull.Author.Nickname,
+		URL:          bitbucketPull.Links.HTML.Href,
+	}, len(pulls) == 0, nil
+}
@@ -532,3 +532,54 @@ func TestBitbucketCloudListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestBitbucketCloudPublish(t *testing.T) {
+	t.Parallel()
+	const pullJSON = `{"id": 7, "title": "Hydrate",
+		"source": {"branch": {"name": "hydrator/main"}, "commit": {"hash": "abc"}},
+		"destination": {"branch": {"name": "main"}},
+		"author": {"nickname": "argocd"},
+		"links": {"html": {"href": "https://bitbucket.org/OWNER/REPO/pull-requests/7"}}}`
+
+	cases := []struct {
+		name           string
+		existing       string
+		expectedMethod []string
+	}{
+		{name: "opens a pull request", existing: `[]`, expectedMethod: []string{http.MethodGet, http.MethodPost}},
+		{name: "keeps the open pull request", existing: "[" + pullJSON + "]", expectedMethod: []string{http.MethodGet}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			var methods []string
+			mux := http.NewServeMux()
+			mux.HandleFunc("/repositories/OWNER/REPO/pullrequests/", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				methods = append(methods, r.Method)
+				if r.Method == http.MethodGet {
+					assert.Equal(t, `source.branch.name = "hydrator/main" AND destination.branch.name = "main"`, r.URL.Query().Get("q"))
+					assert.Equal(t, "OPEN", r.URL.Query().Get("state"))
+					_, _ = w.Write([]byte(`{"size": 1, "pagelen": 10, "page": 1, "values": ` + c.existing + `}`))
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(pullJSON))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			svc, err := NewBitbucketCloudServiceBearerToken(server.URL, "token", "OWNER", "REPO")
+			require.NoError(t, err)
+			pr, opened, err := svc.(PullRequestPublisher).Publish(t.Context(), "hydrator/main", "main", "Hydrate", "description")
+			require.NoError(t, err)
+
+			assert.Equal(t, c.expectedMethod, methods)
+			assert.Equal(t, len(c.expectedMethod) == 2, opened)
+			assert.Equal(t, int64(7), pr.Number)
+			assert.Equal(t, "hydrator/main", pr.Branch)
+			assert.Equal(t, "main", pr.TargetBranch)
+			assert.Equal(t, "https://bitbucket.org/OWNER/REPO/pull-requests/7", pr.URL)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	bitbucketv1 "github.com/gfleury/go-bitbucket-v1"
 	log "github.com/sirupsen/logrus"
@@ -19,7 +20,10 @@ type BitbucketService struct {
 	// labels         []string
 }
 
-var _ PullRequestService = (*BitbucketService)(nil)
+var (
+	_ PullRequestService   = (*BitbucketService)(nil)
+	_ PullRequestPublisher = (*BitbucketService)(nil)
+)
 
 func NewBitbucketServiceBasicAuth(ctx context.Context, username, password, url, projectKey, repositorySlug string, scmRootCAPath string, insecure bool, caCerts []byte, proxyURL, noProxy string) (PullRequestService, error) {
 	bitbucketConfig := bitbucketv1.NewConfiguration(url)
@@ -100,3 +104,85 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+func (b *BitbucketService) Publish(_ context.Context, branch, targetBranch, title, description string) (*PullRequest, bool, error) {
+	fromRef := bitbucketv1.PullRequestRef{
+		ID: "refs/heads/" + branch,
+		Repository: bitbucketv1.Repository{
+			Slug:    b.repositorySlug,
+			Project: &bitbucketv1.Project{Key: b.projectKey},
+		},
+	}
+	toRef := bitbucketv1.PullRequestRef{
+		ID:         "refs/heads/" + targetBranch,
+		Repository: fromRef.Repository,
+	}
+
+	response, err := b.client.DefaultApi.GetPullRequestsPage(b.projectKey, b.repositorySlug, map[string]any{
+		"at":        toRef.ID,
+		"direction": "INCOMING",
+		"state":     "OPEN",
+		"limit":     100,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("error listing pull requests for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	pulls, err := bitbucketv1.GetPullRequestsResponse(response)
+	if err != nil {
+		return nil, false, fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+
+	response = nil
+	opened := true
+	for _, pull := range pulls {
+		if pull.FromRef.ID != fromRef.ID {
+			continue
+		}
+		response, err = b.client.DefaultApi.UpdatePullRequest(b.projectKey, b.repositorySlug, &bitbucketv1.EditPullRequestOptions{
+			ID:              int64(pull.ID),
+			Version:         strconv.Itoa(int(pull.Version)),
+			Title:           title,
+			Description:     description,
+			TargetBranchRef: pull.ToRef,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("error updating pull request #%d for %s/%s: %w", pull.ID, b.projectKey, b.repositorySlug, err)
+		}
+		opened = false
+		break
+	}
+	if response == nil {
+		response, err = b.client.DefaultApi.CreatePullRequest(b.projectKey, b.repositorySlug, bitbucketv1.PullRequest{
+			Title:       title,
+			Description: description,
+			State:       "OPEN",
+			Open:        true,
+			FromRef:     fromRef,
+			ToRef:       toRef,
+			Reviewers:   []bitbucketv1.UserWithMetadata{},
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("error creating pull request for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+		}
+	}
+
+	pull, err := bitbucketv1.GetPullRequestResponse(response)
+	if err != nil {
+		return nil, false, fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	pullRequest := &PullRequest{
+		Number:       int64(pull.ID),
+		Title:        pull.Title,
+		Branch:       pull.FromRef.DisplayID,
+		TargetBranch: pull.ToRef.DisplayID,
+		HeadSHA:      pull.FromRef.LatestCommit,
+		Labels:       []string{},
+	}
+	if pull.Author != nil {
+		pullRequest.Author = pull.Author.User.Name
+	}
+	if len(pull.Links.Self) > 0 {
+		pullRequest.URL = pull.Links.Self[0].Href
+	}
+	return pullRequest, opened, nil
+}
@@ -546,3 +546,62 @@ func TestBitbucketServerListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestBitbucketServerPublish(t *testing.T) {
+	t.Parallel()
+	const pullJSON = `{"id": 7, "version": 3, "title": "Hydrate",
+		"fromRef": {"id": "refs/heads/hydrator/main", "displayId": "hydrator/main", "latestCommit": "abc"},
+		"toRef": {"id": "refs/heads/main", "displayId": "main"},
+		"author": {"user": {"name": "argocd"}},
+		"links": {"self": [{"href": "https://bitbucket.example.com/projects/PROJECT/repos/REPO/pull-requests/7"}]}}`
+
+	cases := []struct {
+		name         string
+		existing     string
+		expectMethod string
+	}{
+		{name: "opens a pull request", existing: `[]`, expectMethod: http.MethodPost},
+		{name: "updates the open pull request", existing: "[" + pullJSON + "]", expectMethod: http.MethodPut},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			var methods []string
+			mux := http.NewServeMux()
+			mux.HandleFunc("/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				methods = append(methods, r.Method)
+				if r.Method == http.MethodGet {
+					assert.Equal(t, "refs/heads/main", r.URL.Query().Get("at"))
+					assert.Equal(t, "INCOMING", r.URL.Query().Get("direction"))
+					_, _ = w.Write([]byte(`{"size": 1, "limit": 100, "isLastPage": true, "start": 0, "values": ` + c.existing + `}`))
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(pullJSON))
+			})
+			mux.HandleFunc("/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests/7", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				methods = append(methods, r.Method)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Contains(t, string(body), `"version":"3"`)
+				_, _ = w.Write([]byte(pullJSON))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			svc, err := NewBitbucketServiceBearerToken(t.Context(), "token", server.URL+"/rest", "PROJECT", "REPO", "", false, nil, "", "")
+			require.NoError(t, err)
+			pr, opened, err := svc.(PullRequestPublisher).Publish(t.Context(), "hydrator/main", "main", "Hydrate", "description")
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{http.MethodGet, c.expectMethod}, methods)
+			assert.Equal(t, c.expectMethod == http.MethodPost, opened)
+			assert.Equal(t, int64(7), pr.Number)
+			assert.Equal(t, "hydrator/main", pr.Branch)
+			assert.Equal(t, "main", pr.TargetBranch)
+			assert.Equal(t, "https://bitbucket.example.com/projects/PROJECT/repos/REPO/pull-requests/7", pr.URL)
+		})
+	}
+}
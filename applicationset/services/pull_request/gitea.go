@@ -3,6 +3,7 @@ package pull_request
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/http/cookiejar"
 	"os"
@@ -19,7 +20,10 @@ type GiteaService struct {
 	labels []string
 }
 
-var _ PullRequestService = (*GiteaService)(nil)
+var (
+	_ PullRequestService   = (*GiteaService)(nil)
+	_ PullRequestPublisher = (*GiteaService)(nil)
+)
 
 func NewGiteaService(token, url, owner, repo string, labels []string, insecure bool, proxyURL, noProxy string) (PullRequestService, error) {
 	if token == "" {
@@ -82,6 +86,63 @@ func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 	return list, nil
 }
 
+func (g *GiteaService) Publish(ctx context.Context, branch, targetBranch, title, description string) (*PullRequest, bool, error) {
+	g.client.SetContext(ctx)
+	prs, _, err := g.client.ListRepoPullRequests(g.owner, g.repo, gitea.ListPullRequestsOptions{
+		State: gitea.StateOpen,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("error listing pull requests for %s/%s: %w", g.owner, g.repo, err)
+	}
+	var pr *gitea.PullRequest
+	opened := true
+	for _, p := range prs {
+		if p.Head != nil && p.Head.Ref == branch && p.Base != nil && p.Base.Ref == targetBranch {
+			pr = p
+			break
+		}
+	}
+
+	if pr != nil {
+		opened = false
+		index := pr.Index
+		pr, _, err = g.client.EditPullRequest(g.owner, g.repo, index, gitea.EditPullRequestOption{
+			Title: title,
+			Body:  &description,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("error updating pull request #%d for %s/%s: %w", index, g.owner, g.repo, err)
+		}
+	} else {
+		pr, _, err = g.client.CreatePullRequest(g.owner, g.repo, gitea.CreatePullRequestOption{
+			Head:  branch,
+			Base:  targetBranch,
+			Title: title,
+			Body:  description,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("error creating pull request for %s/%s: %w", g.owner, g.repo, err)
+		}
+	}
+	pullRequest := &PullRequest{
+		Number: pr.Index,
+		Title:  pr.Title,
+		Labels: getGiteaPRLabelNames(pr.Labels),
+		URL:    pr.HTMLURL,
+	}
+	if pr.Head != nil {
+		pullRequest.Branch = pr.Head.Ref
+		pullRequest.HeadSHA = pr.Head.Sha
+	}
+	if pr.Base != nil {
+		pullRequest.TargetBranch = pr.Base.Ref
+	}
+	if pr.Poster != nil {
+		pullRequest.Author = pr.Poster.UserName
+	}
+	return pullRequest, opened, nil
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func giteaContainLabels(expectedLabels []string, gotLabels []*gitea.Label) bool {
 	gotLabelNamesMap := make(map[string]bool)
@@ -18,7 +18,10 @@ type GithubService struct {
 	labels []string
 }
 
-var _ PullRequestService = (*GithubService)(nil)
+var (
+	_ PullRequestService   = (*GithubService)(nil)
+	_ PullRequestPublisher = (*GithubService)(nil)
+)
 
 func NewGithubService(token, url, owner, repo string, labels []string, optionalHTTPClient ...*http.Client) (PullRequestService, error) {
 	// Undocumented environment variable to set a default token, to be used in testing to dodge anonymous rate limits.
@@ -93,6 +96,49 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+func (g *GithubService) Publish(ctx context.Context, branch, targetBranch, title, description string) (*PullRequest, bool, error) {
+	opts := &github.PullRequestListOptions{
+		State: "open",
+		Head:  g.owner + ":" + branch,
+		Base:  targetBranch,
+	}
+	pulls, _, err := g.client.PullRequests.List(ctx, g.owner, g.repo, opts)
+	if err != nil {
+		return nil, false, fmt.Errorf("error listing pull requests for %s/%s: %w", g.owner, g.repo, err)
+	}
+
+	var pull *github.PullRequest
+	if len(pulls) > 0 {
+		pull, _, err = g.client.PullRequests.Edit(ctx, g.owner, g.repo, pulls[0].GetNumber(), &github.PullRequest{
+			Title: &title,
+			Body:  &description,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("error updating pull request #%d for %s/%s: %w", pulls[0].GetNumber(), g.owner, g.repo, err)
+		}
+	} else {
+		pull, _, err = g.client.PullRequests.Create(ctx, g.owner, g.repo, &github.NewPullRequest{
+			Title: &title,
+			Head:  &branch,
+			Base:  &targetBranch,
+			Body:  &description,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("error creating pull request for %s/%s: %w", g.owner, g.repo, err)
+		}
+	}
+	return &PullRequest{
+		Number:       int64(pull.GetNumber()),
+		Title:        pull.GetTitle(),
+		Branch:       pull.GetHead().GetRef(),
+		TargetBranch: pull.GetBase().GetRef(),
+		HeadSHA:      pull.GetHead().GetSHA(),
+		Labels:       getGithubPRLabelNames(pull.Labels),
+		Author:       pull.GetUser().GetLogin(),
+		URL:          pull.GetHTMLURL(),
+	}, len(pulls) == 0, nil
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func containLabels(expectedLabels []string, gotLabels []*github.Label) bool {
 	for _, expected := range expectedLabels {
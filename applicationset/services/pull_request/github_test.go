@@ -116,3 +116,55 @@ func TestGitHubListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitHubPublish(t *testing.T) {
+	t.Parallel()
+	const pullJSON = `{"number": 7, "title": "Hydrate", "html_url": "https://github.example.com/owner/repo/pull/7",
+		"head": {"ref": "hydrator/main", "sha": "abc"}, "base": {"ref": "main"}, "user": {"login": "argocd"}}`
+
+	cases := []struct {
+		name         string
+		existing     string
+		expectMethod string
+	}{
+		{name: "opens a pull request", existing: `[]`, expectMethod: http.MethodPost},
+		{name: "updates the open pull request", existing: "[" + pullJSON + "]", expectMethod: http.MethodPatch},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			var methods []string
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				if r.Method == http.MethodGet {
+					assert.Equal(t, "owner:hydrator/main", r.URL.Query().Get("head"))
+					assert.Equal(t, "main", r.URL.Query().Get("base"))
+					assert.Equal(t, "open", r.URL.Query().Get("state"))
+					_, _ = w.Write([]byte(c.existing))
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(pullJSON))
+			})
+			mux.HandleFunc("/api/v3/repos/owner/repo/pulls/7", func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				_, _ = w.Write([]byte(pullJSON))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			svc, err := NewGithubService("token", server.URL, "owner", "repo", nil)
+			require.NoError(t, err)
+			pr, opened, err := svc.(PullRequestPublisher).Publish(t.Context(), "hydrator/main", "main", "Hydrate", "description")
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{http.MethodGet, c.expectMethod}, methods)
+			assert.Equal(t, c.expectMethod == http.MethodPost, opened)
+			assert.Equal(t, int64(7), pr.Number)
+			assert.Equal(t, "hydrator/main", pr.Branch)
+			assert.Equal(t, "main", pr.TargetBranch)
+			assert.Equal(t, "https://github.example.com/owner/repo/pull/7", pr.URL)
+		})
+	}
+}
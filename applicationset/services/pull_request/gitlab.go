@@ -20,7 +20,10 @@ type GitLabService struct {
 	pullRequestState string
 }
 
-var _ PullRequestService = (*GitLabService)(nil)
+var (
+	_ PullRequestService   = (*GitLabService)(nil)
+	_ PullRequestPublisher = (*GitLabService)(nil)
+)
 
 func NewGitLabService(token, url, project string, labels []string, pullRequestState string, scmRootCAPath string, insecure bool, caCerts []byte, proxyURL, noProxy string) (PullRequestService, error) {
 	var clientOptionFns []gitlab.ClientOptionFunc
@@ -107,3 +110,49 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+func (g *GitLabService) Publish(ctx context.Context, branch, targetBranch, title, description string) (*PullRequest, bool, error) {
+	opts := &gitlab.ListProjectMergeRequestsOptions{
+		State:        gitlab.Ptr("opened"),
+		SourceBranch: &branch,
+		TargetBranch: &targetBranch,
+	}
+	mrs, _, err := g.client.MergeRequests.ListProjectMergeRequests(g.project, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, false, fmt.Errorf("error listing merge requests for project '%s': %w", g.project, err)
+	}
+
+	var mr *gitlab.MergeRequest
+	if len(mrs) > 0 {
+		mr, _, err = g.client.MergeRequests.UpdateMergeRequest(g.project, mrs[0].IID, &gitlab.UpdateMergeRequestOptions{
+			Title:       &title,
+			Description: &description,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, false, fmt.Errorf("error updating merge request !%d for project '%s': %w", mrs[0].IID, g.project, err)
+		}
+	} else {
+		mr, _, err = g.client.MergeRequests.CreateMergeRequest(g.project, &gitlab.CreateMergeRequestOptions{
+			Title:        &title,
+			Description:  &description,
+			SourceBranch: &branch,
+			TargetBranch: &targetBranch,
+		}, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, false, fmt.Errorf("error creating merge request for project '%s': %w", g.project, err)
+		}
+	}
+	pullRequest := &PullRequest{
+		Number:       mr.IID,
+		Title:        mr.Title,
+		Branch:       mr.SourceBranch,
+		TargetBranch: mr.TargetBranch,
+		HeadSHA:      mr.SHA,
+		Labels:       mr.Labels,
+		URL:          mr.WebURL,
+	}
+	if mr.Author != nil {
+		pullRequest.Author = mr.Author.Username
+	}
+	return pullRequest, len(mrs) == 0, nil
+}
@@ -224,3 +224,55 @@ func TestGitLabListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitLabPublish(t *testing.T) {
+	t.Parallel()
+	const mrJSON = `{"iid": 7, "title": "Hydrate", "web_url": "https://gitlab.example.com/group/project/-/merge_requests/7",
+		"source_branch": "hydrator/main", "target_branch": "main", "sha": "abc", "author": {"username": "argocd"}}`
+
+	cases := []struct {
+		name         string
+		existing     string
+		expectMethod string
+	}{
+		{name: "opens a merge request", existing: `[]`, expectMethod: http.MethodPost},
+		{name: "updates the open merge request", existing: "[" + mrJSON + "]", expectMethod: http.MethodPut},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			var methods []string
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				if r.Method == http.MethodGet {
+					assert.Equal(t, "hydrator/main", r.URL.Query().Get("source_branch"))
+					assert.Equal(t, "main", r.URL.Query().Get("target_branch"))
+					assert.Equal(t, "opened", r.URL.Query().Get("state"))
+					_, _ = w.Write([]byte(c.existing))
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(mrJSON))
+			})
+			mux.HandleFunc("/api/v4/projects/278964/merge_requests/7", func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				_, _ = w.Write([]byte(mrJSON))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			svc, err := NewGitLabService("token", server.URL, "278964", nil, "", "", false, nil, "", "")
+			require.NoError(t, err)
+			pr, opened, err := svc.(PullRequestPublisher).Publish(t.Context(), "hydrator/main", "main", "Hydrate", "description")
+			require.NoError(t, err)
+
+			assert.Equal(t, []string{http.MethodGet, c.expectMethod}, methods)
+			assert.Equal(t, c.expectMethod == http.MethodPost, opened)
+			assert.Equal(t, int64(7), pr.Number)
+			assert.Equal(t, "hydrator/main", pr.Branch)
+			assert.Equal(t, "argocd", pr.Author)
+			assert.Equal(t, "https://gitlab.example.com/group/project/-/merge_requests/7", pr.URL)
+		})
+	}
+}
@@ -21,6 +21,8 @@ type PullRequest struct {
 	Labels []string
 	// Author is the author of the pull request.
 	Author string
+	// URL is the web URL of the pull request. It is only set for published pull requests.
+	URL string
 }
 
 type PullRequestService interface {
@@ -28,6 +30,14 @@ type PullRequestService interface {
 	List(ctx context.Context) ([]*PullRequest, error)
 }
 
+// PullRequestPublisher is implemented by the pull request services which can open pull requests.
+type PullRequestPublisher interface {
+	// Publish opens a pull request from the branch against the target branch, or updates the title and the
+	// description of the pull request already open between these branches. It returns the published pull request, and
+	// whether it was opened rather than updated.
+	Publish(ctx context.Context, branch, targetBranch, title, description string) (*PullRequest, bool, error)
+}
+
 type Filter struct {
 	BranchMatch       *regexp.Regexp
 	TargetBranchMatch *regexp.Regexp
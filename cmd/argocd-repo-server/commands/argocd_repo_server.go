@@ -82,6 +82,10 @@ func NewCommand() *cobra.Command {
 		cmpUseManifestGeneratePaths        bool
 		ociMediaTypes                      []string
 		enableBuiltinGitConfig             bool
+		diskCacheDir                       string
+		diskCacheMaxSize                   string
+		sparseCheckout                     bool
+		helmDependenciesOffline            bool
 		clientCAPath                       string
 		disableTLS                         bool
 	)
@@ -138,6 +142,9 @@ func NewCommand() *cobra.Command {
 			helmRegistryMaxIndexSizeQuantity, err := resource.ParseQuantity(helmRegistryMaxIndexSize)
 			errors.CheckError(err)
 
+			diskCacheMaxSizeQuantity, err := resource.ParseQuantity(diskCacheMaxSize)
+			errors.CheckError(err)
+
 			askPassServer := askpass.NewServer(askpass.SocketPath)
 			metricsServer := metrics.NewMetricsServer()
 			cacheutil.CollectMetrics(redisClient, metricsServer, nil)
@@ -166,6 +173,10 @@ func NewCommand() *cobra.Command {
 				EnableBuiltinGitConfig:                       enableBuiltinGitConfig,
 				HelmUserAgent:                                helmUserAgent,
 				HelmChartCacheExpiration:                     repoCacheExpiration,
+				DiskCacheDir:                                 diskCacheDir,
+				DiskCacheMaxSize:                             diskCacheMaxSizeQuantity.ToDec().Value(),
+				SparseCheckout:                               sparseCheckout,
+				HelmDependenciesOffline:                      helmDependenciesOffline,
 			}, askPassServer, clientCAPath, disableTLS)
 			errors.CheckError(err)
 
@@ -279,6 +290,10 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&cmpUseManifestGeneratePaths, "plugin-use-manifest-generate-paths", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_PLUGIN_USE_MANIFEST_GENERATE_PATHS", false), "Pass the resources described in argocd.argoproj.io/manifest-generate-paths value to the cmpserver to generate the application manifests.")
 	command.Flags().StringSliceVar(&ociMediaTypes, "oci-layer-media-types", env.StringsFromEnv("ARGOCD_REPO_SERVER_OCI_LAYER_MEDIA_TYPES", []string{"application/vnd.oci.image.layer.v1.tar", "application/vnd.oci.image.layer.v1.tar+gzip", "application/vnd.cncf.helm.chart.content.v1.tar+gzip"}, ","), "Comma separated list of allowed media types for OCI media types. This only accounts for media types within layers.")
 	command.Flags().BoolVar(&enableBuiltinGitConfig, "enable-builtin-git-config", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_ENABLE_BUILTIN_GIT_CONFIG", true), "Enable builtin git configuration options that are required for correct argocd-repo-server operation.")
+	command.Flags().StringVar(&diskCacheDir, "disk-cache-dir", env.StringFromEnv("ARGOCD_REPO_SERVER_DISK_CACHE_DIR", ""), "Directory of the disk cache of git worktrees, Helm charts and OCI images, which survives restarts if the directory is on a persistent volume. The disk cache is disabled if empty.")
+	command.Flags().StringVar(&diskCacheMaxSize, "disk-cache-max-size", env.StringFromEnv("ARGOCD_REPO_SERVER_DISK_CACHE_MAX_SIZE", "10G"), "Maximum size of the disk cache, above which the least recently used entries are evicted")
+	command.Flags().BoolVar(&sparseCheckout, "sparse-checkout", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_SPARSE_CHECKOUT", false), "Use partial clones of git repositories and only check out the directories needed to generate the manifests of the applications, i.e. their path and the paths of their argocd.argoproj.io/manifest-generate-paths annotation.")
+	command.Flags().BoolVar(&helmDependenciesOffline, "helm-dependencies-offline", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_HELM_DEPENDENCIES_OFFLINE", false), "Refuse to download the dependencies of Helm charts which are neither vendored in the charts directory nor cached, e.g. in air-gapped environments.")
 	command.Flags().BoolVar(&disableTLS, "disable-tls", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_TLS", false), "Disable TLS for the repo-server gRPC endpoint")
 	command.Flags().StringVar(&clientCAPath, "client-ca-path", env.StringFromEnv("ARGOCD_REPO_SERVER_CLIENT_CA_PATH", "/app/config/reposerver/mtls/client-ca.crt"), "Path to the client CA certificate file for mTLS. Defaults to the auto-mounted Secret path; mTLS is skipped if the file does not exist.")
 
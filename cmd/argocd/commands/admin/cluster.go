@@ -104,9 +104,9 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 			shardingAlgorithm = common.DefaultShardingAlgorithm
 		}
 	}
-	clusterShardingCache := sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm)
+	// the shards of the weighted sharding algorithm are read from the assignment shared by the controllers
+	clusterShardingCache := sharding.ShareWeightedDistribution(sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm), kubeClient, namespace, true)
 	clusterShardingCache.Init(clustersList, appItems)
-	clusterShards := clusterShardingCache.GetDistribution()
 
 	var cache *appstatecache.Cache
 	if portForwardRedis {
@@ -136,6 +136,15 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 		}
 	}
 
+	clusterShardingCache.UpdateClustersCacheInfo(func(server string) (*v1alpha1.ClusterCacheInfo, error) {
+		var info v1alpha1.ClusterInfo
+		if err := cache.GetClusterInfo(server, &info); err != nil {
+			return nil, err
+		}
+		return &info.CacheInfo, nil
+	})
+	clusterShards := clusterShardingCache.GetDistribution()
+
 	namespacesByServer := map[string]map[string]bool{}
 	for _, app := range appItems.Items {
 		destCluster, resolveErr := argo.GetDestinationCluster(ctx, app.Spec.Destination, argoDB)
@@ -233,7 +242,7 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", "", "Sharding method. Defaults to what is set for sharding algorithm in argocd-cmd-params (legacy if not provided). Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", "", "Sharding method. Defaults to what is set for sharding algorithm in argocd-cmd-params (legacy if not provided). Supported sharding methods are : [legacy, round-robin, consistent-hashing, weighted] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
@@ -528,7 +537,7 @@ argocd admin cluster stats target-cluster`,
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", "", "Sharding method. Defaults to what is set for sharding algorithm in argocd-cmd-params (legacy if not provided). Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", "", "Sharding method. Defaults to what is set for sharding algorithm in argocd-cmd-params (legacy if not provided). Supported sharding methods are : [legacy, round-robin, consistent-hashing, weighted] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 
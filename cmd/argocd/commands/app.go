@@ -295,7 +295,7 @@ func parentChildDetails(ctx context.Context, appIf application.ApplicationServic
 	return mapUIDToNode, mapParentToChild, parentNode
 }
 
-func printHeader(ctx context.Context, acdClient argocdclient.Client, app *argoappv1.Application, windows *argoappv1.SyncWindows, showOperation bool, showParams bool, sourcePosition int) {
+func printHeader(ctx context.Context, acdClient argocdclient.Client, app *argoappv1.Application, windows *application.ApplicationSyncWindowsResponse, showOperation bool, showParams bool, sourcePosition int) {
 	appURL := getAppURL(ctx, acdClient, app.Name)
 	printAppSummaryTable(app, appURL, windows)
 
@@ -461,12 +461,15 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 				}
 			}
 
-			pConn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
-			defer utilio.Close(pConn)
-			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: app.Spec.Project})
-			errors.CheckError(err)
-
-			windows := proj.Spec.SyncWindows.Matches(app)
+			// the server resolves the calendars the sync windows refer to
+			windows, err := appIf.GetApplicationSyncWindows(ctx, &application.ApplicationSyncWindowsQuery{
+				Name:         &app.Name,
+				AppNamespace: &app.Namespace,
+				Project:      &app.Spec.Project,
+			})
+			if err != nil {
+				log.Warnf("Failed to get the sync windows of the application: %v", err)
+			}
 
 			switch output {
 			case "yaml", "json":
@@ -648,7 +651,7 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	return command
 }
 
-func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *argoappv1.SyncWindows) {
+func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *application.ApplicationSyncWindowsResponse) {
 	fmt.Printf(printOpFmtStr, "Name:", app.QualifiedName())
 	fmt.Printf(printOpFmtStr, "Project:", app.Spec.GetProject())
 	fmt.Printf(printOpFmtStr, "Server:", getServer(app))
@@ -665,25 +668,26 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 	var wds []string
 	var status string
 	var allow, deny, inactiveAllows bool
-	if windows.HasWindows() {
-		active, err := windows.Active()
-		if err == nil && active.HasWindows() {
-			for _, w := range *active {
-				if w.Kind == "deny" {
-					deny = true
-				} else {
-					allow = true
-				}
+	if len(windows.GetAssignedWindows()) > 0 {
+		// the active windows are a subset of the assigned windows
+		var allows, activeAllows int
+		for _, w := range windows.GetActiveWindows() {
+			if w.GetKind() == "deny" {
+				deny = true
+			} else {
+				allow = true
+				activeAllows++
 			}
 		}
-		inactiveAllowWindows, err := windows.InactiveAllows()
-		if err == nil && inactiveAllowWindows.HasWindows() {
-			inactiveAllows = true
+		for _, w := range windows.GetAssignedWindows() {
+			if w.GetKind() == "allow" {
+				allows++
+			}
 		}
+		inactiveAllows = allows > activeAllows
 
 		if deny || !deny && !allow && inactiveAllows {
-			s, err := windows.CanSync(true, nil)
-			if err == nil && s {
+			if windows.GetCanSync() {
 				status = "Manual Allowed"
 			} else {
 				status = "Sync Denied"
@@ -691,8 +695,8 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 		} else {
 			status = "Sync Allowed"
 		}
-		for _, w := range *windows {
-			s := w.Kind + ":" + w.Schedule + ":" + w.Duration
+		for _, w := range windows.GetAssignedWindows() {
+			s := w.GetKind() + ":" + w.GetSchedule() + ":" + w.GetDuration()
 			wds = append(wds, s)
 		}
 	} else {
@@ -2655,10 +2659,22 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 	var (
 		output       string
 		appNamespace string
+		detailed     bool
+		since        string
+		until        string
+		initiator    string
 	)
 	command := &cobra.Command{
 		Use:   "history APPNAME",
 		Short: "Show application deployment history",
+		Example: `  # Show the deployment history of an application
+  argocd app history my-app
+
+  # Show the outcome of every resource and hook of the syncs of the last 24 hours
+  argocd app history my-app --detailed --since 24h
+
+  # Show the automated syncs of an application between two dates
+  argocd app history my-app --detailed --initiator automated --since 2024-01-01T00:00:00Z --until 2024-02-01T00:00:00Z`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -2669,6 +2685,31 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 			defer utilio.Close(conn)
 			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+
+			if detailed {
+				query := &application.ApplicationSyncRecordsQuery{
+					Name:         &appName,
+					AppNamespace: &appNs,
+					Initiator:    &initiator,
+				}
+				var err error
+				query.Since, err = parseHistoryTime(since)
+				errors.CheckError(err)
+				query.Until, err = parseHistoryTime(until)
+				errors.CheckError(err)
+				records, err := appIf.ListSyncRecords(ctx, query)
+				errors.CheckError(err)
+				switch output {
+				case "json", "yaml":
+					errors.CheckError(PrintResourceList(records.Items, output, false))
+				case "wide", "":
+					printSyncRecords(records.Items)
+				default:
+					errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+				}
+				return
+			}
+
 			app, err := appIf.Get(ctx, &application.ApplicationQuery{
 				Name:         &appName,
 				AppNamespace: &appNs,
@@ -2683,10 +2724,60 @@ func NewApplicationHistoryCommand(clientOpts *argocdclient.ClientOptions) *cobra
 		},
 	}
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only show application deployment history in namespace")
-	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|id")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: wide|id, or json|yaml with --detailed")
+	command.Flags().BoolVar(&detailed, "detailed", false, "Show the persisted outcome of every sync, including the result of each resource and hook. Requires sync records to be enabled in the application controller")
+	command.Flags().StringVar(&since, "since", "", "Only show the syncs started after this time, as an RFC3339 timestamp or a duration relative to now (e.g. 24h). Requires --detailed")
+	command.Flags().StringVar(&until, "until", "", "Only show the syncs started before this time, as an RFC3339 timestamp or a duration relative to now (e.g. 1h). Requires --detailed")
+	command.Flags().StringVar(&initiator, "initiator", "", "Only show the syncs initiated by this user, or 'automated' for the automated syncs. Requires --detailed")
 	return command
 }
 
+// parseHistoryTime parses either an RFC3339 timestamp or a duration before the current time, returns nil if empty
+func parseHistoryTime(value string) (*metav1.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &metav1.Time{Time: t}, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time '%s': must be an RFC3339 timestamp or a duration", value)
+	}
+	return &metav1.Time{Time: time.Now().Add(-duration)}, nil
+}
+
+// printSyncRecords prints the outcome of each sync followed by the results of its resources and hooks
+func printSyncRecords(records []argoappv1.ApplicationSyncRecord) {
+	for i, record := range records {
+		opState := record.Spec.OperationState
+		initiatedBy := opState.Operation.InitiatedBy.Username
+		if opState.Operation.InitiatedBy.Automated {
+			initiatedBy = argoappv1.SyncRecordInitiatorAutomated
+		}
+		fmt.Printf(printOpFmtStr, "Record:", record.Name)
+		fmt.Printf(printOpFmtStr, "Initiated By:", initiatedBy)
+		printOperationResult(&opState)
+		if opState.SyncResult != nil && len(opState.SyncResult.Resources) > 0 {
+			fmt.Println()
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprint(w, "GROUP\tKIND\tNAMESPACE\tNAME\tSTATUS\tHOOK\tMESSAGE\n")
+			for _, res := range opState.SyncResult.Resources {
+				status := string(res.Status)
+				if res.HookType != "" {
+					status = string(res.HookPhase)
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", res.Group, res.Kind, res.Namespace, res.Name, status, res.HookType, res.Message)
+			}
+			_ = w.Flush()
+		}
+		// Add a newline if it's not the last record
+		if i < len(records)-1 {
+			fmt.Println()
+		}
+	}
+}
+
 func findRevisionHistory(application *argoappv1.Application, historyId int64) (*argoappv1.RevisionHistory, error) {
 	// in case if history id not passed and need fetch previous history revision
 	if historyId == -1 {
@@ -138,6 +138,23 @@ func TestFindRevisionHistoryWithoutPassedId(t *testing.T) {
 	require.NotNil(t, history, "History should be found")
 }
 
+func TestParseHistoryTime(t *testing.T) {
+	parsed, err := parseHistoryTime("")
+	require.NoError(t, err)
+	assert.Nil(t, parsed)
+
+	parsed, err = parseHistoryTime("2024-01-02T03:04:05Z")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), parsed.UTC())
+
+	parsed, err = parseHistoryTime("2h")
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(-2*time.Hour), parsed.Time, time.Minute)
+
+	_, err = parseHistoryTime("yesterday")
+	require.EqualError(t, err, "invalid time 'yesterday': must be an RFC3339 timestamp or a duration")
+}
+
 func TestPrintTreeViewAppGet(t *testing.T) {
 	var nodes [3]v1alpha1.ResourceNode
 	nodes[0].ResourceRef = v1alpha1.ResourceRef{Group: "", Version: "v1", Kind: "Pod", Namespace: "sandbox-rollout-numalogic-demo", Name: "numalogic-rollout-demo-5dcd5457d5-6trpt", UID: "92c3a5fe-d13e-4ae2-b8ec-c10dd3543b28"}
@@ -648,34 +665,32 @@ func TestPrintAppSummaryTable(t *testing.T) {
 			},
 		}
 
-		windows := &v1alpha1.SyncWindows{
+		// the windows are active all day long
+		syncWindows := []*applicationpkg.ApplicationSyncWindow{
 			{
-				Kind:     "allow",
-				Schedule: "0 0 * * *",
-				Duration: "24h",
-				Applications: []string{
-					"*-prod",
-				},
-				ManualSync: true,
+				Kind:       new("allow"),
+				Schedule:   new("0 0 * * *"),
+				Duration:   new("24h"),
+				ManualSync: new(true),
 			},
 			{
-				Kind:     "deny",
-				Schedule: "0 0 * * *",
-				Duration: "24h",
-				Namespaces: []string{
-					"default",
-				},
+				Kind:       new("deny"),
+				Schedule:   new("0 0 * * *"),
+				Duration:   new("24h"),
+				ManualSync: new(false),
 			},
 			{
-				Kind:     "allow",
-				Schedule: "0 0 * * *",
-				Duration: "24h",
-				Clusters: []string{
-					"in-cluster",
-					"cluster1",
-				},
+				Kind:       new("allow"),
+				Schedule:   new("0 0 * * *"),
+				Duration:   new("24h"),
+				ManualSync: new(false),
 			},
 		}
+		windows := &applicationpkg.ApplicationSyncWindowsResponse{
+			ActiveWindows:   syncWindows,
+			AssignedWindows: syncWindows,
+			CanSync:         new(false),
+		}
 
 		printAppSummaryTable(app, "url", windows)
 		return nil
@@ -701,6 +716,46 @@ Health Status:      Progressing
 	assert.Equalf(t, expectation, output, "Incorrect print app summary output %q, should be %q", output, expectation)
 }
 
+func TestPrintAppSummaryTable_SyncWindowStatus(t *testing.T) {
+	allow := &applicationpkg.ApplicationSyncWindow{Kind: new("allow"), Schedule: new("0 0 * * *"), Duration: new("1h"), ManualSync: new(true)}
+	deny := &applicationpkg.ApplicationSyncWindow{Kind: new("deny"), Schedule: new("0 0 * * *"), Duration: new("1h"), ManualSync: new(false)}
+	tests := []struct {
+		name     string
+		windows  *applicationpkg.ApplicationSyncWindowsResponse
+		expected string
+	}{
+		{
+			name:     "no windows",
+			expected: "Sync Allowed",
+		},
+		{
+			name:     "active allow window",
+			windows:  &applicationpkg.ApplicationSyncWindowsResponse{ActiveWindows: []*applicationpkg.ApplicationSyncWindow{allow}, AssignedWindows: []*applicationpkg.ApplicationSyncWindow{allow}, CanSync: new(true)},
+			expected: "Sync Allowed",
+		},
+		{
+			name:     "inactive allow window with manual sync",
+			windows:  &applicationpkg.ApplicationSyncWindowsResponse{AssignedWindows: []*applicationpkg.ApplicationSyncWindow{allow}, CanSync: new(true)},
+			expected: "Manual Allowed",
+		},
+		{
+			name:     "active deny window",
+			windows:  &applicationpkg.ApplicationSyncWindowsResponse{ActiveWindows: []*applicationpkg.ApplicationSyncWindow{deny}, AssignedWindows: []*applicationpkg.ApplicationSyncWindow{allow, deny}, CanSync: new(false)},
+			expected: "Sync Denied",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := captureOutput(func() error {
+				printAppSummaryTable(&v1alpha1.Application{}, "url", tt.windows)
+				return nil
+			})
+			require.NoError(t, err)
+			assert.Contains(t, output, "SyncWindow:         "+tt.expected+"\n")
+		})
+	}
+}
+
 func TestPrintAppSummaryTable_MultipleSources(t *testing.T) {
 	output, _ := captureOutput(func() error {
 		app := &v1alpha1.Application{
@@ -742,34 +797,32 @@ func TestPrintAppSummaryTable_MultipleSources(t *testing.T) {
 			},
 		}
 
-		windows := &v1alpha1.SyncWindows{
+		// the windows are active all day long
+		syncWindows := []*applicationpkg.ApplicationSyncWindow{
 			{
-				Kind:     "allow",
-				Schedule: "0 0 * * *",
-				Duration: "24h",
-				Applications: []string{
-					"*-prod",
-				},
-				ManualSync: true,
+				Kind:       new("allow"),
+				Schedule:   new("0 0 * * *"),
+				Duration:   new("24h"),
+				ManualSync: new(true),
 			},
 			{
-				Kind:     "deny",
-				Schedule: "0 0 * * *",
-				Duration: "24h",
-				Namespaces: []string{
-					"default",
-				},
+				Kind:       new("deny"),
+				Schedule:   new("0 0 * * *"),
+				Duration:   new("24h"),
+				ManualSync: new(false),
 			},
 			{
-				Kind:     "allow",
-				Schedule: "0 0 * * *",
-				Duration: "24h",
-				Clusters: []string{
-					"in-cluster",
-					"cluster1",
-				},
+				Kind:       new("allow"),
+				Schedule:   new("0 0 * * *"),
+				Duration:   new("24h"),
+				ManualSync: new(false),
 			},
 		}
+		windows := &applicationpkg.ApplicationSyncWindowsResponse{
+			ActiveWindows:   syncWindows,
+			AssignedWindows: syncWindows,
+			CanSync:         new(false),
+		}
 
 		printAppSummaryTable(app, "url", windows)
 		return nil
@@ -2436,6 +2489,10 @@ func (c *fakeAppServiceClient) ListResourceEvents(_ context.Context, _ *applicat
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) ListSyncRecords(_ context.Context, _ *applicationpkg.ApplicationSyncRecordsQuery, _ ...grpc.CallOption) (*v1alpha1.ApplicationSyncRecordList, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) Watch(_ context.Context, _ *applicationpkg.ApplicationQuery, _ ...grpc.CallOption) (applicationpkg.ApplicationService_WatchClient, error) {
 	return nil, nil
 }
@@ -0,0 +1,261 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/progressivesync"
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	"github.com/argoproj/argo-cd/v3/common"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	arogappsetv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+var appSetRolloutExample = templates.Examples(`
+	# Show the progress of the RollingSync rollout of an ApplicationSet
+	argocd appset rollout status APPSETNAME
+
+	# Pause the rollout, no Application is synced until it is resumed
+	argocd appset rollout pause APPSETNAME
+
+	# Resume the paused rollout
+	argocd appset rollout resume APPSETNAME
+
+	# Promote the rollout past the step being rolled out, without waiting for its Applications
+	argocd appset rollout promote APPSETNAME
+	`)
+
+// NewApplicationSetRolloutCommand returns a new instance of an `argocd appset rollout` command
+func NewApplicationSetRolloutCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:     "rollout",
+		Short:   "Manage the RollingSync rollout of ApplicationSets",
+		Example: appSetRolloutExample,
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationSetRolloutStatusCommand(clientOpts))
+	command.AddCommand(NewApplicationSetRolloutPauseCommand(clientOpts))
+	command.AddCommand(NewApplicationSetRolloutResumeCommand(clientOpts))
+	command.AddCommand(NewApplicationSetRolloutPromoteCommand(clientOpts))
+	return command
+}
+
+// NewApplicationSetRolloutStatusCommand returns a new instance of an `argocd appset rollout status` command
+func NewApplicationSetRolloutStatusCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output          string
+		appSetNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "status APPSETNAME",
+		Short: "Show the progress of the RollingSync rollout of an ApplicationSet",
+		Example: templates.Examples(`
+	# Show the progress of the rollout
+	argocd appset rollout status APPSETNAME
+
+	# Show the status of the Applications of the rollout in JSON format
+	argocd appset rollout status APPSETNAME -o json
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := acdClient.NewApplicationSetClientOrDie()
+			defer utilio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+
+			appSet, err := appIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName, AppsetNamespace: appSetNs})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(appSet.Status.ApplicationStatus, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printAppSetRolloutSummary(os.Stdout, appSet)
+				if len(appSet.Status.ApplicationStatus) > 0 {
+					fmt.Println()
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					printAppSetRolloutApplications(w, appSet)
+					_ = w.Flush()
+				}
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only get ApplicationSet from a namespace (ignored when qualified name is provided)")
+	return command
+}
+
+// NewApplicationSetRolloutPauseCommand returns a new instance of an `argocd appset rollout pause` command
+func NewApplicationSetRolloutPauseCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var appSetNamespace string
+	command := &cobra.Command{
+		Use:   "pause APPSETNAME",
+		Short: "Pause the RollingSync rollout of an ApplicationSet",
+		Long:  "Pause the RollingSync rollout of an ApplicationSet. The Applications already syncing are not interrupted, but no Application is synced until the rollout is resumed.",
+		Example: templates.Examples(`
+	# Pause the rollout
+	argocd appset rollout pause APPSETNAME
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := acdClient.NewApplicationSetClientOrDie()
+			defer utilio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+
+			appSet, err := appIf.PauseRollout(ctx, &applicationset.ApplicationSetRolloutRequest{Name: appSetName, AppsetNamespace: appSetNs})
+			errors.CheckError(err)
+			fmt.Printf("ApplicationSet '%s' rollout paused\n", appSet.QualifiedName())
+		},
+	}
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only pause ApplicationSet from a namespace (ignored when qualified name is provided)")
+	return command
+}
+
+// NewApplicationSetRolloutResumeCommand returns a new instance of an `argocd appset rollout resume` command
+func NewApplicationSetRolloutResumeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var appSetNamespace string
+	command := &cobra.Command{
+		Use:   "resume APPSETNAME",
+		Short: "Resume the paused RollingSync rollout of an ApplicationSet",
+		Example: templates.Examples(`
+	# Resume the paused rollout
+	argocd appset rollout resume APPSETNAME
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := acdClient.NewApplicationSetClientOrDie()
+			defer utilio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+
+			appSet, err := appIf.ResumeRollout(ctx, &applicationset.ApplicationSetRolloutRequest{Name: appSetName, AppsetNamespace: appSetNs})
+			errors.CheckError(err)
+			fmt.Printf("ApplicationSet '%s' rollout resumed\n", appSet.QualifiedName())
+		},
+	}
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only resume ApplicationSet from a namespace (ignored when qualified name is provided)")
+	return command
+}
+
+// NewApplicationSetRolloutPromoteCommand returns a new instance of an `argocd appset rollout promote` command
+func NewApplicationSetRolloutPromoteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		appSetNamespace string
+		step            int32
+	)
+	command := &cobra.Command{
+		Use:   "promote APPSETNAME",
+		Short: "Promote the RollingSync rollout of an ApplicationSet past a step",
+		Long:  "Promote the RollingSync rollout of an ApplicationSet past a step. The next steps are rolled out without waiting for the Applications of the promoted step, and the previous steps, to become Healthy or for their gates to pass. The promotion is removed once the rollout has completed.",
+		Example: templates.Examples(`
+	# Promote the rollout past the step being rolled out
+	argocd appset rollout promote APPSETNAME
+
+	# Promote the rollout past the step 2
+	argocd appset rollout promote APPSETNAME --step 2
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := acdClient.NewApplicationSetClientOrDie()
+			defer utilio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+
+			appSet, err := appIf.PromoteRollout(ctx, &applicationset.ApplicationSetRolloutRequest{Name: appSetName, AppsetNamespace: appSetNs, Step: step})
+			errors.CheckError(err)
+			fmt.Printf("ApplicationSet '%s' rollout promoted past step %s\n", appSet.QualifiedName(), appSet.Annotations[common.AnnotationApplicationSetRolloutPromotedStep])
+		},
+	}
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only promote ApplicationSet from a namespace (ignored when qualified name is provided)")
+	command.Flags().Int32Var(&step, "step", 0, "Step to promote the rollout past (default is the step being rolled out)")
+	return command
+}
+
+func printAppSetRolloutSummary(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
+	_, _ = fmt.Fprintf(w, printOpFmtStr, "Name:", appSet.QualifiedName())
+	if !progressivesync.IsRollingSyncStrategy(appSet) {
+		_, _ = fmt.Fprintf(w, printOpFmtStr, "Strategy:", "AllAtOnce")
+		return
+	}
+	_, _ = fmt.Fprintf(w, printOpFmtStr, "Strategy:", "RollingSync")
+	_, _ = fmt.Fprintf(w, printOpFmtStr, "Steps:", strconv.Itoa(len(appSet.Spec.Strategy.RollingSync.Steps)))
+
+	currentStep := "<completed>"
+	if step := progressivesync.GetRolloutStep(appSet); step > 0 {
+		currentStep = strconv.Itoa(step)
+	}
+	_, _ = fmt.Fprintf(w, printOpFmtStr, "Current Step:", currentStep)
+	_, _ = fmt.Fprintf(w, printOpFmtStr, "Paused:", strconv.FormatBool(progressivesync.IsRolloutPaused(appSet)))
+	if promotedStep, ok := appSet.Annotations[common.AnnotationApplicationSetRolloutPromotedStep]; ok {
+		_, _ = fmt.Fprintf(w, printOpFmtStr, "Promoted Step:", promotedStep)
+	}
+	for _, condition := range appSet.Status.Conditions {
+		if condition.Type == arogappsetv1.ApplicationSetConditionRolloutProgressing {
+			_, _ = fmt.Fprintf(w, printOpFmtStr, "Message:", condition.Message)
+		}
+	}
+}
+
+func printAppSetRolloutApplications(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
+	appStatuses := make([]arogappsetv1.ApplicationSetApplicationStatus, len(appSet.Status.ApplicationStatus))
+	copy(appStatuses, appSet.Status.ApplicationStatus)
+	sort.SliceStable(appStatuses, func(i, j int) bool {
+		stepI, _ := strconv.Atoi(appStatuses[i].Step)
+		stepJ, _ := strconv.Atoi(appStatuses[j].Step)
+		if stepI != stepJ {
+			return stepI < stepJ
+		}
+		return appStatuses[i].Application < appStatuses[j].Application
+	})
+
+	_, _ = fmt.Fprint(w, "STEP\tAPPLICATION\tSTATUS\tGATE\tMESSAGE\n")
+	for _, appStatus := range appStatuses {
+		gate := ""
+		if appStatus.Gate != nil {
+			gate = string(appStatus.Gate.Phase)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", appStatus.Step, appStatus.Application, appStatus.Status, gate, appStatus.Message)
+	}
+}
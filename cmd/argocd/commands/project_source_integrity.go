@@ -249,7 +249,9 @@ func NewProjectSourceIntegrityGitPoliciesDeleteCommand(clientOpts *argocdclient.
 func cleanupSourceIntegrityIfEmpty(proj *v1alpha1.AppProject) {
 	if proj.Spec.SourceIntegrity != nil && proj.Spec.SourceIntegrity.Git != nil && len(proj.Spec.SourceIntegrity.Git.Policies) == 0 {
 		proj.Spec.SourceIntegrity.Git = nil
-		proj.Spec.SourceIntegrity = nil
+		if proj.Spec.SourceIntegrity.OCI == nil {
+			proj.Spec.SourceIntegrity = nil
+		}
 	}
 }
 
@@ -442,55 +444,58 @@ func NewProjectSourceIntegrityGitPoliciesUpdateCommand(clientOpts *argocdclient.
 				}
 			}
 
-			// There are no other types for now, so turning GPG on is the only sensible thing
-			if policy.GPG == nil {
+			// Turn GPG on when configured by the options, or when there is no other verifier the policy would be useful with
+			gpgOptions := gpgMode != "" || len(gpgKeys) > 0 || len(deleteGPGKeys) > 0 || len(addGPGKeys) > 0
+			if policy.GPG == nil && (gpgOptions || (policy.SSH == nil && policy.X509 == nil)) {
 				policy.GPG = &v1alpha1.SourceIntegrityGitPolicyGPG{}
 			}
 
-			// Update gpg mode
-			if gpgMode != "" {
-				mode, err := validateGpgMode(gpgMode)
-				if err != nil {
-					return err
+			if policy.GPG != nil {
+				// Update gpg mode
+				if gpgMode != "" {
+					mode, err := validateGpgMode(gpgMode)
+					if err != nil {
+						return err
+					}
+					policy.GPG.Mode = mode
+				} else if policy.GPG.Mode == "" {
+					// The policy is updated to a gpg one, but this mandatory field is unset
+					return errors.New("gpg-mode must be set")
 				}
-				policy.GPG.Mode = mode
-			} else if policy.GPG.Mode == "" {
-				// The policy is updated to a gpg one, but this mandatory field is unset
-				return errors.New("gpg-mode must be set")
-			}
 
-			// Reset keys to a new set
-			if len(gpgKeys) > 0 {
-				policy.GPG.Keys = make([]string, len(gpgKeys))
-				for i, key := range gpgKeys {
+				// Reset keys to a new set
+				if len(gpgKeys) > 0 {
+					policy.GPG.Keys = make([]string, len(gpgKeys))
+					for i, key := range gpgKeys {
+						key, err := sourceintegrity.KeyID(key)
+						if err != nil {
+							return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
+						}
+						policy.GPG.Keys[i] = key
+					}
+				}
+				for _, key := range deleteGPGKeys {
 					key, err := sourceintegrity.KeyID(key)
 					if err != nil {
 						return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
 					}
-					policy.GPG.Keys[i] = key
-				}
-			}
-			for _, key := range deleteGPGKeys {
-				key, err := sourceintegrity.KeyID(key)
-				if err != nil {
-					return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
+					policy.GPG.Keys = slices.DeleteFunc(policy.GPG.Keys, func(k string) bool {
+						k, err := sourceintegrity.KeyID(k)
+						return err == nil && k == key
+					})
 				}
-				policy.GPG.Keys = slices.DeleteFunc(policy.GPG.Keys, func(k string) bool {
-					k, err := sourceintegrity.KeyID(k)
-					return err == nil && k == key
-				})
-			}
-			for _, key := range addGPGKeys {
-				key, err := sourceintegrity.KeyID(key)
-				if err != nil {
-					return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
-				}
-				found := slices.ContainsFunc(policy.GPG.Keys, func(k string) bool {
-					k, err := sourceintegrity.KeyID(k)
-					return err == nil && k == key
-				})
-				if !found {
-					policy.GPG.Keys = append(policy.GPG.Keys, key)
+				for _, key := range addGPGKeys {
+					key, err := sourceintegrity.KeyID(key)
+					if err != nil {
+						return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
+					}
+					found := slices.ContainsFunc(policy.GPG.Keys, func(k string) bool {
+						k, err := sourceintegrity.KeyID(k)
+						return err == nil && k == key
+					})
+					if !found {
+						policy.GPG.Keys = append(policy.GPG.Keys, key)
+					}
 				}
 			}
 
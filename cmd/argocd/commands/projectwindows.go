@@ -3,9 +3,12 @@ package commands
 import (
 	"fmt"
 	"os"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -34,7 +37,10 @@ argocd proj windows add my-project \
 argocd proj windows delete <project-name> <window-id>
 
 #List project sync windows
-argocd proj windows list <project-name>`,
+argocd proj windows list <project-name>
+
+#List the periods in which the project sync windows are active in the next 7 days
+argocd proj windows upcoming <project-name>`,
 		Run: func(c *cobra.Command, args []string) {
 			c.HelpFunc()(c, args)
 			os.Exit(1)
@@ -48,6 +54,7 @@ argocd proj windows list <project-name>`,
 	roleCommand.AddCommand(NewProjectWindowsDeleteCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsListCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsUpdateCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectWindowsUpcomingCommand(clientOpts))
 	return roleCommand
 }
 
@@ -187,6 +194,8 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 		timeZone     string
 		andOperator  bool
 		description  string
+		dates        []string
+		calendar     string
 	)
 	command := &cobra.Command{
 		Use:   "add PROJECT",
@@ -210,7 +219,19 @@ argocd proj windows add PROJECT \
     --clusters "prod,staging" \
     --manual-sync \
     --sync-overrun \
-    --description "Ticket 123"`,
+    --description "Ticket 123"
+
+#Add a deny sync window for a change freeze over the new year
+argocd proj windows add PROJECT \
+    --kind deny \
+    --dates "2026-12-24/2027-01-02" \
+    --applications "*"
+
+#Add a deny sync window active on the dates of the holidays calendar defined in argocd-cm
+argocd proj windows add PROJECT \
+    --kind deny \
+    --calendar holidays \
+    --applications "*"`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -225,7 +246,10 @@ argocd proj windows add PROJECT \
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
-			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone, andOperator, description, syncOverrun)
+			dateRanges, err := parseSyncWindowDates(dates)
+			errors.CheckError(err)
+
+			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone, andOperator, description, syncOverrun, dateRanges, calendar)
 			errors.CheckError(err)
 
 			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
@@ -243,6 +267,8 @@ argocd proj windows add PROJECT \
 	command.Flags().StringVar(&timeZone, "time-zone", "UTC", "Time zone of the sync window")
 	command.Flags().BoolVar(&andOperator, "use-and-operator", false, "Use AND operator for matching applications, namespaces and clusters instead of the default OR operator")
 	command.Flags().StringVar(&description, "description", "", `Sync window description`)
+	command.Flags().StringSliceVar(&dates, "dates", []string{}, "Periods during which the sync window is active, as a date, a start and end date or a start and end time separated by a slash. Comma separated (e.g. --dates 2026-12-25,2026-12-31/2027-01-01,2026-11-02T18:00:00/2026-11-02T22:00:00)")
+	command.Flags().StringVar(&calendar, "calendar", "", "Name of a sync window calendar defined in the argocd-cm ConfigMap, whose dates the sync window is active on")
 
 	return command
 }
@@ -302,6 +328,8 @@ func NewProjectWindowsUpdateCommand(clientOpts *argocdclient.ClientOptions) *cob
 		clusters     []string
 		timeZone     string
 		description  string
+		dates        []string
+		calendar     string
 	)
 	command := &cobra.Command{
 		Use:   "update PROJECT ID",
@@ -326,12 +354,15 @@ argocd proj windows update PROJECT ID \
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
 
+			dateRanges, err := parseSyncWindowDates(dates)
+			errors.CheckError(err)
+
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
 			for i, window := range proj.Spec.SyncWindows {
 				if id == i {
-					err := window.Update(schedule, duration, applications, namespaces, clusters, timeZone, description)
+					err := window.Update(schedule, duration, applications, namespaces, clusters, timeZone, description, dateRanges, calendar)
 					if err != nil {
 						errors.CheckError(err)
 					}
@@ -349,9 +380,69 @@ argocd proj windows update PROJECT ID \
 	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Clusters that the schedule will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
 	command.Flags().StringVar(&timeZone, "time-zone", "UTC", "Time zone of the sync window. (e.g. --time-zone \"America/New_York\")")
 	command.Flags().StringVar(&description, "description", "", "Sync window description")
+	command.Flags().StringSliceVar(&dates, "dates", []string{}, "Periods during which the sync window is active, as a date, a start and end date or a start and end time separated by a slash. Comma separated (e.g. --dates 2026-12-25,2026-12-31/2027-01-01)")
+	command.Flags().StringVar(&calendar, "calendar", "", "Name of a sync window calendar defined in the argocd-cm ConfigMap, whose dates the sync window is active on")
 	return command
 }
 
+// NewProjectWindowsUpcomingCommand returns a new instance of an `argocd proj windows upcoming` command
+func NewProjectWindowsUpcomingCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output string
+		days   int32
+	)
+	command := &cobra.Command{
+		Use:   "upcoming PROJECT",
+		Short: "List the periods in which the project sync windows are active in the coming days",
+		Example: `
+#List the upcoming sync windows of the next 7 days
+argocd proj windows upcoming PROJECT
+
+#List the upcoming sync windows of the next 30 days in yaml format
+argocd proj windows upcoming PROJECT --days 30 -o yaml`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			res, err := projIf.ListUpcomingSyncWindows(ctx, &projectpkg.UpcomingSyncWindowsQuery{Name: projName, Days: days})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(res.Occurrences, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printUpcomingSyncWindows(res.Occurrences)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().Int32Var(&days, "days", 7, "Number of days to list the upcoming sync windows for")
+	return command
+}
+
+// parseSyncWindowDates parses periods formatted as a date or time, optionally followed by a slash and an end date or time
+func parseSyncWindowDates(dates []string) ([]v1alpha1.SyncWindowDateRange, error) {
+	var ranges []v1alpha1.SyncWindowDateRange
+	for _, date := range dates {
+		start, end, _ := strings.Cut(date, "/")
+		dateRange := v1alpha1.SyncWindowDateRange{Start: strings.TrimSpace(start), End: strings.TrimSpace(end)}
+		if err := dateRange.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid sync window dates '%s': %w", date, err)
+		}
+		ranges = append(ranges, dateRange)
+	}
+	return ranges, nil
+}
+
 // NewProjectWindowsListCommand returns a new instance of an `argocd proj windows list` command
 func NewProjectWindowsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var output string
@@ -385,7 +476,10 @@ argocd proj windows list test-project`,
 				err := PrintResourceList(proj.Spec.SyncWindows, output, false)
 				errors.CheckError(err)
 			case "wide", "":
-				printSyncWindows(proj)
+				// the server resolves the calendars the sync windows refer to
+				state, err := projIf.GetSyncWindowsState(ctx, &projectpkg.SyncWindowsQuery{Name: projName})
+				errors.CheckError(err)
+				printSyncWindows(proj, state.Windows)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
@@ -395,8 +489,8 @@ argocd proj windows list test-project`,
 	return command
 }
 
-// Print table of sync window data
-func printSyncWindows(proj *v1alpha1.AppProject) {
+// Print table of sync window data, with the status of the active windows computed by the server
+func printSyncWindows(proj *v1alpha1.AppProject, activeWindows []*v1alpha1.SyncWindow) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	var fmtStr string
 	headers := []any{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR"}
@@ -404,12 +498,11 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 	fmt.Fprintf(w, fmtStr, headers...)
 	if proj.Spec.SyncWindows.HasWindows() {
 		for i, window := range proj.Spec.SyncWindows {
-			isActive, _ := window.Active()
 			vals := []any{
 				strconv.Itoa(i),
-				formatBoolOutput(isActive),
+				formatBoolOutput(isSyncWindowActive(window, activeWindows)),
 				window.Kind,
-				window.Schedule,
+				formatSyncWindowSchedule(window),
 				window.Duration,
 				formatListOutput(window.Applications),
 				formatListOutput(window.Namespaces),
@@ -425,6 +518,68 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 	_ = w.Flush()
 }
 
+// Print table of upcoming sync window occurrences
+func printUpcomingSyncWindows(occurrences []*v1alpha1.SyncWindowOccurrence) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	var fmtStr string
+	headers := []any{"ID", "KIND", "START", "END", "SCHEDULE", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "DESCRIPTION"}
+	fmtStr = strings.Repeat("%s\t", len(headers)) + "\n"
+	fmt.Fprintf(w, fmtStr, headers...)
+	for _, occurrence := range occurrences {
+		window := occurrence.Window
+		vals := []any{
+			strconv.FormatInt(occurrence.ID, 10),
+			window.Kind,
+			occurrence.Start.Format(time.RFC3339),
+			occurrence.End.Format(time.RFC3339),
+			formatSyncWindowSchedule(window),
+			formatListOutput(window.Applications),
+			formatListOutput(window.Namespaces),
+			formatListOutput(window.Clusters),
+			formatBoolEnabledOutput(window.ManualSync),
+			window.Description,
+		}
+		fmt.Fprintf(w, fmtStr, vals...)
+	}
+	_ = w.Flush()
+}
+
+// formatSyncWindowSchedule returns the cron schedule of a sync window, followed by its dates and calendar if any
+// isSyncWindowActive returns true if the sync window is one of the active windows. The active windows computed by the
+// server hold the periods of the calendar they refer to after their own periods.
+func isSyncWindowActive(window *v1alpha1.SyncWindow, activeWindows []*v1alpha1.SyncWindow) bool {
+	for _, active := range activeWindows {
+		n := len(window.Dates)
+		if len(active.Dates) < n || window.Calendar == "" && len(active.Dates) != n || !slices.Equal(active.Dates[:n], window.Dates) {
+			continue
+		}
+		candidate := active.DeepCopy()
+		candidate.Dates = window.Dates
+		if reflect.DeepEqual(candidate, window) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatSyncWindowSchedule(window *v1alpha1.SyncWindow) string {
+	var schedule []string
+	if window.Schedule != "" {
+		schedule = append(schedule, window.Schedule)
+	}
+	for _, date := range window.Dates {
+		if date.End == "" {
+			schedule = append(schedule, date.Start)
+		} else {
+			schedule = append(schedule, date.Start+"/"+date.End)
+		}
+	}
+	if window.Calendar != "" {
+		schedule = append(schedule, "calendar:"+window.Calendar)
+	}
+	return strings.Join(schedule, ",")
+}
+
 func formatListOutput(list []string) string {
 	var o string
 	if len(list) == 0 {
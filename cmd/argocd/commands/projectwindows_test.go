@@ -21,6 +21,7 @@ func TestPrintSyncWindows(t *testing.T) {
 	tests := []struct {
 		name           string
 		project        *v1alpha1.AppProject
+		calendars      map[string][]v1alpha1.SyncWindowDateRange
 		expectedHeader []string
 		expectedRows   [][]string
 	}{
@@ -93,6 +94,38 @@ func TestPrintSyncWindows(t *testing.T) {
 				{"0", "Inactive", "allow", "0 1 * * *", "30m", "-", "-", "-", "Disabled", "Disabled", "UTC", "Disabled"},
 			},
 		},
+		{
+			name: "Project with sync windows referring to calendars",
+			project: &v1alpha1.AppProject{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-project",
+				},
+				Spec: v1alpha1.AppProjectSpec{
+					SyncWindows: v1alpha1.SyncWindows{
+						{
+							Kind:         "deny",
+							Calendar:     "freeze",
+							Applications: []string{"*"},
+						},
+						{
+							Kind:         "deny",
+							Dates:        []v1alpha1.SyncWindowDateRange{{Start: "2000-02-01"}},
+							Calendar:     "holidays",
+							Applications: []string{"*"},
+						},
+					},
+				},
+			},
+			calendars: map[string][]v1alpha1.SyncWindowDateRange{
+				"freeze":   {{Start: "1999-12-31", End: "2000-01-02"}},
+				"holidays": {{Start: "2000-12-25"}},
+			},
+			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR"},
+			expectedRows: [][]string{
+				{"0", "Active", "deny", "calendar:freeze", "*", "-", "-", "Disabled", "Disabled", "Disabled"},
+				{"1", "Inactive", "deny", "2000-02-01,calendar:holidays", "*", "-", "-", "Disabled", "Disabled", "Disabled"},
+			},
+		},
 		{
 			name: "Project with no sync windows",
 			project: &v1alpha1.AppProject{
@@ -122,8 +155,18 @@ func TestPrintSyncWindows(t *testing.T) {
 				r, w, _ := os.Pipe()
 				os.Stdout = w
 
+				// Compute the active windows like the server does
+				windows, err := tt.project.Spec.SyncWindows.WithCalendars(tt.calendars)
+				require.NoError(t, err)
+				active, err := windows.Active()
+				require.NoError(t, err)
+				var activeWindows []*v1alpha1.SyncWindow
+				if active != nil {
+					activeWindows = *active
+				}
+
 				// Call the function
-				printSyncWindows(tt.project)
+				printSyncWindows(tt.project, activeWindows)
 
 				// Restore stdout
 				w.Close()
@@ -131,7 +174,7 @@ func TestPrintSyncWindows(t *testing.T) {
 
 				// Read captured output
 				var buf bytes.Buffer
-				_, err := io.Copy(&buf, r)
+				_, err = io.Copy(&buf, r)
 				require.NoError(t, err)
 				output := buf.String()
 
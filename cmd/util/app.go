@@ -100,6 +100,9 @@ type AppOptions struct {
 	syncSourceBranch                string
 	syncSourcePath                  string
 	hydrateToBranch                 string
+	hydrateToOCIRepo                string
+	hydrateToOCITag                 string
+	dependsOn                       []string
 }
 
 func AddAppFlags(command *cobra.Command, opts *AppOptions) {
@@ -115,7 +118,10 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringVar(&opts.syncSourceBranch, "sync-source-branch", "", "The branch from which the app will sync")
 	command.Flags().StringVar(&opts.syncSourcePath, "sync-source-path", "", "The path in the repository from which the app will sync")
 	command.Flags().StringVar(&opts.hydrateToBranch, "hydrate-to-branch", "", "The branch to hydrate the app to")
+	command.Flags().StringVar(&opts.hydrateToOCIRepo, "hydrate-to-oci-repo", "", "The OCI repository to push the hydrated manifests of the app to, instead of a branch (e.g. oci://registry.example.com/org/repo)")
+	command.Flags().StringVar(&opts.hydrateToOCITag, "hydrate-to-oci-tag", "", "The tag of the OCI repository the app will sync from (default \"latest\")")
 	command.Flags().IntVar(&opts.revisionHistoryLimit, "revision-history-limit", argoappv1.RevisionHistoryLimit, "How many items to keep in revision history")
+	command.Flags().StringArrayVar(&opts.dependsOn, "depends-on", []string{}, "Application which must be synced and healthy before the app is synced, in the form [<namespace>/]<name>. Can be repeated, an empty value removes the dependencies")
 	command.Flags().StringVar(&opts.destServer, "dest-server", "", "K8s cluster URL (e.g. https://kubernetes.default.svc)")
 	command.Flags().StringVar(&opts.destName, "dest-name", "", "K8s cluster Name (e.g. minikube)")
 	command.Flags().StringVar(&opts.destNamespace, "dest-namespace", "", "K8s target namespace")
@@ -211,6 +217,18 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 		case "revision-history-limit":
 			i := int64(appOpts.revisionHistoryLimit)
 			spec.RevisionHistoryLimit = &i
+		case "depends-on":
+			spec.DependsOn = nil
+			for _, dependency := range appOpts.dependsOn {
+				if dependency == "" {
+					continue
+				}
+				namespace, name, ok := strings.Cut(dependency, "/")
+				if !ok {
+					namespace, name = "", dependency
+				}
+				spec.DependsOn = append(spec.DependsOn, argoappv1.ApplicationDependency{Namespace: namespace, Name: name})
+			}
 		case "dest-name":
 			spec.Destination.Name = appOpts.destName
 		case "dest-server":
@@ -840,6 +858,27 @@ func constructSourceHydrator(h *argoappv1.SourceHydrator, appOpts AppOptions, fl
 			} else {
 				h.HydrateTo = &argoappv1.HydrateTo{TargetBranch: appOpts.hydrateToBranch}
 			}
+		case "hydrate-to-oci-repo":
+			ensureNotNil(appOpts.hydrateToOCIRepo != "")
+			switch {
+			case appOpts.hydrateToOCIRepo != "":
+				if h.HydrateTo == nil {
+					h.HydrateTo = &argoappv1.HydrateTo{}
+				}
+				if h.HydrateTo.OCI == nil {
+					h.HydrateTo.OCI = &argoappv1.HydrateToOCI{}
+				}
+				h.HydrateTo.OCI.RepoURL = appOpts.hydrateToOCIRepo
+			case h != nil && h.HydrateTo != nil && h.HydrateTo.TargetBranch == "":
+				h.HydrateTo = nil
+			case h != nil && h.HydrateTo != nil:
+				h.HydrateTo.OCI = nil
+			}
+		case "hydrate-to-oci-tag":
+			ensureNotNil(false)
+			if h != nil && h.HydrateTo.IsOCI() {
+				h.HydrateTo.OCI.Tag = appOpts.hydrateToOCITag
+			}
 		}
 	})
 	return h, hasHydratorFlag
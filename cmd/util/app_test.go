@@ -380,6 +380,26 @@ func Test_setAppSpecOptions(t *testing.T) {
 
 		require.NoError(t, f.SetFlag("hydrate-to-branch", ""))
 		assert.Nil(t, f.spec.SourceHydrator.HydrateTo)
+
+		require.NoError(t, f.SetFlag("hydrate-to-oci-repo", "oci://registry.example.com/hydrated"))
+		assert.Equal(t, "oci://registry.example.com/hydrated", f.spec.SourceHydrator.HydrateTo.OCI.RepoURL)
+
+		require.NoError(t, f.SetFlag("hydrate-to-oci-tag", "prod"))
+		assert.Equal(t, "prod", f.spec.SourceHydrator.HydrateTo.OCI.Tag)
+
+		require.NoError(t, f.SetFlag("hydrate-to-oci-repo", ""))
+		assert.Nil(t, f.spec.SourceHydrator.HydrateTo)
+	})
+	t.Run("DependsOn", func(t *testing.T) {
+		f := newAppOptionsFixture()
+		require.NoError(t, f.SetFlag("depends-on", "database"))
+		require.NoError(t, f.SetFlag("depends-on", "infra/cert-manager"))
+		assert.Equal(t, []v1alpha1.ApplicationDependency{{Name: "database"}, {Namespace: "infra", Name: "cert-manager"}}, f.spec.DependsOn)
+
+		f = newAppOptionsFixture()
+		f.spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "database"}}
+		require.NoError(t, f.SetFlag("depends-on", ""))
+		assert.Nil(t, f.spec.DependsOn)
 	})
 }
 
@@ -130,10 +130,12 @@ type ManifestRequestMetadata struct {
 	// size relates to the file size in bytes
 	Size_ int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
 	// env is a list with the environment variables needed to generate manifests
-	Env                  []*EnvEntry `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Env []*EnvEntry `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty"`
+	// stdinFile is the path of a file inside the tarball whose content is passed to the generate command on stdin
+	StdinFile            string   `protobuf:"bytes,6,opt,name=stdinFile,proto3" json:"stdinFile,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ManifestRequestMetadata) Reset()         { *m = ManifestRequestMetadata{} }
@@ -204,6 +206,13 @@ func (m *ManifestRequestMetadata) GetEnv() []*EnvEntry {
 	return nil
 }
 
+func (m *ManifestRequestMetadata) GetStdinFile() string {
+	if m != nil {
+		return m.StdinFile
+	}
+	return ""
+}
+
 // EnvEntry represents an entry in the application's environment
 type EnvEntry struct {
 	// Name is the name of the variable, usually expressed in uppercase
@@ -538,49 +547,50 @@ func init() {
 func init() { proto.RegisterFile("cmpserver/plugin/plugin.proto", fileDescriptor_b21875a7079a06ed) }
 
 var fileDescriptor_b21875a7079a06ed = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
+	// 676 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
 	0x10, 0x8e, 0x9b, 0xb4, 0x4d, 0x26, 0x95, 0x1a, 0xad, 0xa0, 0x98, 0xd0, 0x86, 0xe0, 0x03, 0xca,
 	0x05, 0x47, 0x4a, 0x7b, 0x45, 0xa2, 0x2d, 0xa1, 0x15, 0x28, 0x28, 0x72, 0xb9, 0xc0, 0x01, 0x69,
-	0xe3, 0x4c, 0x92, 0xa5, 0xf6, 0xee, 0xb2, 0x5e, 0x5b, 0x0a, 0x5c, 0x10, 0x2f, 0xc3, 0xab, 0x70,
-	0xe4, 0x11, 0x50, 0x5f, 0x83, 0x0b, 0xf2, 0xda, 0x4e, 0xa2, 0x36, 0x6d, 0x4f, 0x9e, 0xbf, 0xfd,
-	0xfc, 0xcd, 0xec, 0x37, 0x0b, 0x07, 0x7e, 0x28, 0x23, 0x54, 0x09, 0xaa, 0xae, 0x0c, 0xe2, 0x29,
-	0xe3, 0xf9, 0xc7, 0x95, 0x4a, 0x68, 0x41, 0xb6, 0x32, 0xaf, 0xd9, 0x9f, 0x32, 0x3d, 0x8b, 0x47,
-	0xae, 0x2f, 0xc2, 0x2e, 0x55, 0x53, 0x21, 0x95, 0xf8, 0x62, 0x8c, 0x17, 0xfe, 0xb8, 0x9b, 0x1c,
-	0x76, 0x15, 0x4a, 0x91, 0xc3, 0x18, 0x93, 0x69, 0xa1, 0xe6, 0x2b, 0x66, 0x06, 0xd7, 0x7c, 0x32,
-	0x15, 0x62, 0x1a, 0x60, 0xd7, 0x78, 0xa3, 0x78, 0xd2, 0xc5, 0x50, 0xea, 0x3c, 0xe9, 0xfc, 0xb0,
-	0xa0, 0x71, 0x2c, 0xe5, 0x85, 0x56, 0x48, 0x43, 0x0f, 0xbf, 0xc6, 0x18, 0x69, 0xf2, 0x12, 0xaa,
-	0x21, 0x6a, 0x3a, 0xa6, 0x9a, 0xda, 0x56, 0xdb, 0xea, 0xd4, 0x7b, 0x4f, 0xdd, 0x9c, 0xe1, 0x80,
-	0x72, 0x36, 0xc1, 0x48, 0xe7, 0xa5, 0x83, 0xbc, 0xec, 0xbc, 0xe4, 0x2d, 0x8e, 0x10, 0x07, 0x2a,
-	0x13, 0x16, 0xa0, 0xbd, 0x61, 0x8e, 0xee, 0x14, 0x47, 0xdf, 0xb0, 0x00, 0xcf, 0x4b, 0x9e, 0xc9,
-	0x9d, 0xd4, 0x60, 0x5b, 0x65, 0x10, 0xce, 0x2f, 0x0b, 0x1e, 0xdd, 0x02, 0x4b, 0x6c, 0xd8, 0xa6,
-	0x52, 0xbe, 0xa7, 0x21, 0x1a, 0x22, 0x35, 0xaf, 0x70, 0x49, 0x0b, 0x80, 0x4a, 0xe9, 0x61, 0x30,
-	0xa4, 0x7a, 0x66, 0x7e, 0x55, 0xf3, 0x56, 0x22, 0xa4, 0x09, 0x55, 0x7f, 0x86, 0xfe, 0x65, 0x14,
-	0x87, 0x76, 0xd9, 0x64, 0x17, 0x3e, 0x21, 0x50, 0x89, 0xd8, 0x37, 0xb4, 0x2b, 0x6d, 0xab, 0x53,
-	0xf6, 0x8c, 0x4d, 0x1c, 0x28, 0x23, 0x4f, 0xec, 0xcd, 0x76, 0xb9, 0x53, 0xef, 0x35, 0x0a, 0xce,
-	0x7d, 0x9e, 0xf4, 0xb9, 0x56, 0x73, 0x2f, 0x4d, 0x3a, 0x47, 0x50, 0x2d, 0x02, 0x29, 0x06, 0x5f,
-	0xd2, 0x32, 0x36, 0x79, 0x00, 0x9b, 0x09, 0x0d, 0x62, 0xcc, 0xe9, 0x64, 0x8e, 0x33, 0x84, 0xc6,
-	0xb2, 0xbd, 0x48, 0x0a, 0x1e, 0x21, 0xd9, 0x87, 0x5a, 0x98, 0xc7, 0x22, 0xdb, 0x6a, 0x97, 0x3b,
-	0x35, 0x6f, 0x19, 0x48, 0x7b, 0x8b, 0x44, 0xac, 0x7c, 0xfc, 0x30, 0x97, 0x05, 0xd8, 0x4a, 0xc4,
-	0x99, 0x00, 0xf1, 0x16, 0xb7, 0xbc, 0xc0, 0x6c, 0x43, 0x9d, 0x45, 0x17, 0xb1, 0x94, 0x42, 0x69,
-	0x1c, 0x1b, 0x62, 0x55, 0x6f, 0x35, 0x44, 0x5c, 0x20, 0x2c, 0x7a, 0xcd, 0x22, 0x5f, 0x24, 0xa8,
-	0xe6, 0x7d, 0x4e, 0x47, 0x01, 0x8e, 0x0d, 0x7e, 0xd5, 0x5b, 0x93, 0x71, 0xbe, 0x43, 0x6b, 0x48,
-	0x15, 0x0d, 0x51, 0xa3, 0x8a, 0x8e, 0x39, 0x17, 0x31, 0xf7, 0x31, 0x44, 0xbe, 0xec, 0xe3, 0x23,
-	0xec, 0xc9, 0xa2, 0x62, 0xb5, 0x20, 0x6b, 0xaa, 0xde, 0x7b, 0xe6, 0xae, 0xc8, 0x71, 0xb8, 0xae,
-	0xd2, 0xbb, 0x05, 0xc0, 0xd9, 0x87, 0x4a, 0xaa, 0x98, 0x74, 0xa8, 0xfe, 0x2c, 0xe6, 0x97, 0xa6,
-	0xa1, 0x1d, 0x2f, 0x73, 0x9c, 0x9f, 0x16, 0xb4, 0x4f, 0xd3, 0xfb, 0x1c, 0x9a, 0x8b, 0x3a, 0x15,
-	0x7c, 0xc2, 0xa6, 0xb1, 0xa2, 0x9a, 0x09, 0xbe, 0x60, 0x77, 0x04, 0x0f, 0x57, 0xba, 0x2a, 0x6a,
-	0x16, 0xb3, 0x59, 0x9f, 0x24, 0x1d, 0xd8, 0x95, 0x4a, 0x24, 0x6c, 0x8c, 0x67, 0x4c, 0x9f, 0x2a,
-	0x1c, 0x47, 0xf9, 0x88, 0xae, 0x87, 0x7b, 0xff, 0x36, 0xe0, 0x20, 0x3b, 0x38, 0xa0, 0x9c, 0x4e,
-	0x0d, 0xf1, 0x8c, 0xcf, 0x05, 0xaa, 0x84, 0xf9, 0x48, 0xde, 0x42, 0xe3, 0x0c, 0x39, 0x2a, 0xaa,
-	0xb1, 0xd0, 0x00, 0xb1, 0x0b, 0x71, 0x5d, 0xdf, 0xbb, 0xa6, 0x7d, 0x73, 0xcb, 0xb2, 0x4e, 0x9c,
-	0x52, 0xc7, 0x22, 0x9f, 0xc1, 0xbe, 0xad, 0x63, 0xb2, 0xe7, 0x66, 0x4b, 0xee, 0x16, 0x4b, 0xee,
-	0xf6, 0xd3, 0x25, 0x6f, 0x76, 0x0a, 0xc4, 0xfb, 0x66, 0xe5, 0x94, 0xc8, 0x3b, 0xd8, 0x1d, 0x50,
-	0xed, 0xcf, 0x96, 0xd2, 0xba, 0x83, 0x6a, 0xb3, 0xc8, 0xdc, 0x14, 0xa2, 0x21, 0x4b, 0xe1, 0xf1,
-	0x19, 0xea, 0xf5, 0xea, 0xb9, 0x03, 0xf6, 0x79, 0x91, 0xb9, 0x5b, 0x77, 0xe9, 0x2f, 0x4e, 0x5e,
-	0xfd, 0xbe, 0x6a, 0x59, 0x7f, 0xae, 0x5a, 0xd6, 0xdf, 0xab, 0x96, 0xf5, 0xa9, 0x77, 0xcf, 0x63,
-	0xb9, 0x7c, 0x72, 0xa9, 0x64, 0x7e, 0xc0, 0x90, 0xeb, 0xd1, 0x96, 0x99, 0xd6, 0xe1, 0xff, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xb0, 0x8d, 0xb8, 0x47, 0x90, 0x05, 0x00, 0x00,
+	0xe3, 0x4c, 0x92, 0xa5, 0xf6, 0xee, 0xb2, 0x5e, 0x5b, 0x0a, 0x5c, 0x10, 0xef, 0x86, 0xc4, 0x91,
+	0x47, 0x40, 0x7d, 0x0d, 0x2e, 0xc8, 0x6b, 0x3b, 0x89, 0xda, 0xb4, 0x3d, 0x65, 0xfe, 0xf6, 0x9b,
+	0xef, 0x9b, 0x8c, 0x07, 0x0e, 0xfc, 0x50, 0x46, 0xa8, 0x12, 0x54, 0x5d, 0x19, 0xc4, 0x53, 0xc6,
+	0xf3, 0x1f, 0x57, 0x2a, 0xa1, 0x05, 0xd9, 0xca, 0xbc, 0x66, 0x7f, 0xca, 0xf4, 0x2c, 0x1e, 0xb9,
+	0xbe, 0x08, 0xbb, 0x54, 0x4d, 0x85, 0x54, 0xe2, 0x8b, 0x31, 0x5e, 0xf8, 0xe3, 0x6e, 0x72, 0xd8,
+	0x55, 0x28, 0x45, 0x0e, 0x63, 0x4c, 0xa6, 0x85, 0x9a, 0xaf, 0x98, 0x19, 0x5c, 0xf3, 0xc9, 0x54,
+	0x88, 0x69, 0x80, 0x5d, 0xe3, 0x8d, 0xe2, 0x49, 0x17, 0x43, 0xa9, 0xf3, 0xa4, 0xf3, 0xc3, 0x82,
+	0xc6, 0xb1, 0x94, 0x17, 0x5a, 0x21, 0x0d, 0x3d, 0xfc, 0x1a, 0x63, 0xa4, 0xc9, 0x4b, 0xa8, 0x86,
+	0xa8, 0xe9, 0x98, 0x6a, 0x6a, 0x5b, 0x6d, 0xab, 0x53, 0xef, 0x3d, 0x75, 0x73, 0x86, 0x03, 0xca,
+	0xd9, 0x04, 0x23, 0x9d, 0x97, 0x0e, 0xf2, 0xb2, 0xf3, 0x92, 0xb7, 0x78, 0x42, 0x1c, 0xa8, 0x4c,
+	0x58, 0x80, 0xf6, 0x86, 0x79, 0xba, 0x53, 0x3c, 0x7d, 0xc3, 0x02, 0x3c, 0x2f, 0x79, 0x26, 0x77,
+	0x52, 0x83, 0x6d, 0x95, 0x41, 0x38, 0xbf, 0x2c, 0x78, 0x74, 0x0b, 0x2c, 0xb1, 0x61, 0x9b, 0x4a,
+	0xf9, 0x9e, 0x86, 0x68, 0x88, 0xd4, 0xbc, 0xc2, 0x25, 0x2d, 0x00, 0x2a, 0xa5, 0x87, 0xc1, 0x90,
+	0xea, 0x99, 0x69, 0x55, 0xf3, 0x56, 0x22, 0xa4, 0x09, 0x55, 0x7f, 0x86, 0xfe, 0x65, 0x14, 0x87,
+	0x76, 0xd9, 0x64, 0x17, 0x3e, 0x21, 0x50, 0x89, 0xd8, 0x37, 0xb4, 0x2b, 0x6d, 0xab, 0x53, 0xf6,
+	0x8c, 0x4d, 0x1c, 0x28, 0x23, 0x4f, 0xec, 0xcd, 0x76, 0xb9, 0x53, 0xef, 0x35, 0x0a, 0xce, 0x7d,
+	0x9e, 0xf4, 0xb9, 0x56, 0x73, 0x2f, 0x4d, 0x92, 0x7d, 0xa8, 0x45, 0x7a, 0xcc, 0x78, 0xaa, 0xc4,
+	0xde, 0x32, 0xa0, 0xcb, 0x80, 0x73, 0x04, 0xd5, 0xa2, 0x3c, 0xed, 0xc0, 0x97, 0xa4, 0x8d, 0x4d,
+	0x1e, 0xc0, 0x66, 0x42, 0x83, 0x18, 0x73, 0xb2, 0x99, 0xe3, 0x0c, 0xa1, 0xb1, 0x14, 0x1f, 0x49,
+	0xc1, 0x23, 0x4c, 0xfb, 0x84, 0x79, 0x2c, 0xb2, 0xad, 0x76, 0x39, 0xed, 0xb3, 0x08, 0xa4, 0xca,
+	0x23, 0x11, 0x2b, 0x1f, 0x3f, 0xcc, 0x65, 0x01, 0xb6, 0x12, 0x71, 0x26, 0x40, 0xbc, 0xc5, 0x0e,
+	0x2c, 0x30, 0xdb, 0x50, 0x67, 0xd1, 0x45, 0x2c, 0xa5, 0x50, 0x1a, 0xc7, 0x86, 0x58, 0xd5, 0x5b,
+	0x0d, 0x11, 0x17, 0x08, 0x8b, 0x5e, 0xb3, 0xc8, 0x17, 0x09, 0xaa, 0x79, 0x9f, 0xd3, 0x51, 0x80,
+	0x63, 0x83, 0x5f, 0xf5, 0xd6, 0x64, 0x9c, 0xef, 0xd0, 0x1a, 0x52, 0x45, 0x43, 0xd4, 0xa8, 0xa2,
+	0x63, 0xce, 0x45, 0xcc, 0x7d, 0x0c, 0x91, 0x2f, 0x75, 0x7c, 0x84, 0x3d, 0x59, 0x54, 0xac, 0x16,
+	0x64, 0xa2, 0xea, 0xbd, 0x67, 0xee, 0xca, 0xb2, 0x0e, 0xd7, 0x55, 0x7a, 0xb7, 0x00, 0x38, 0xfb,
+	0x50, 0x49, 0x87, 0x9e, 0x0e, 0xd5, 0x9f, 0xc5, 0xfc, 0xd2, 0x08, 0xda, 0xf1, 0x32, 0xc7, 0xf9,
+	0x69, 0x41, 0xfb, 0x34, 0xfd, 0xb7, 0x87, 0xe6, 0x6f, 0x3c, 0x15, 0x7c, 0xc2, 0xa6, 0xb1, 0xa2,
+	0x9a, 0x09, 0xbe, 0x60, 0x77, 0x04, 0x0f, 0x57, 0x54, 0x15, 0x35, 0x8b, 0xd9, 0xac, 0x4f, 0x92,
+	0x0e, 0xec, 0x4a, 0x25, 0x12, 0x36, 0xc6, 0x33, 0xa6, 0x4f, 0x15, 0x8e, 0xa3, 0x7c, 0x44, 0xd7,
+	0xc3, 0xbd, 0x7f, 0x1b, 0x70, 0x90, 0x3d, 0x1c, 0x50, 0x4e, 0xa7, 0x86, 0x78, 0xc6, 0xe7, 0x02,
+	0x55, 0xc2, 0x7c, 0x24, 0x6f, 0xa1, 0x71, 0x86, 0x1c, 0x15, 0xd5, 0x58, 0xec, 0x00, 0xb1, 0x8b,
+	0xd5, 0xbb, 0xfe, 0x55, 0x36, 0xed, 0x9b, 0xdf, 0x60, 0xa6, 0xc4, 0x29, 0x75, 0x2c, 0xf2, 0x19,
+	0xec, 0xdb, 0x14, 0x93, 0x3d, 0x37, 0x3b, 0x01, 0x6e, 0x71, 0x02, 0xdc, 0x7e, 0x7a, 0x02, 0x9a,
+	0x9d, 0x02, 0xf1, 0xbe, 0x59, 0x39, 0x25, 0xf2, 0x0e, 0x76, 0x07, 0x54, 0xfb, 0xb3, 0xe5, 0x6a,
+	0xdd, 0x41, 0xb5, 0x59, 0x64, 0x6e, 0x2e, 0xa2, 0x21, 0x4b, 0xe1, 0xf1, 0x19, 0xea, 0xf5, 0xdb,
+	0x73, 0x07, 0xec, 0xf3, 0x22, 0x73, 0xf7, 0xde, 0xa5, 0x2d, 0x4e, 0x5e, 0xfd, 0xbe, 0x6a, 0x59,
+	0x7f, 0xae, 0x5a, 0xd6, 0xdf, 0xab, 0x96, 0xf5, 0xa9, 0x77, 0xcf, 0x29, 0x5d, 0x1e, 0x64, 0x2a,
+	0x99, 0x1f, 0x30, 0xe4, 0x7a, 0xb4, 0x65, 0xa6, 0x75, 0xf8, 0x7f, 0x00, 0xf5, 0xbc, 0x87, 0x3d,
+	0xae, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -988,6 +998,13 @@ func (m *ManifestRequestMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.StdinFile) > 0 {
+		i -= len(m.StdinFile)
+		copy(dAtA[i:], m.StdinFile)
+		i = encodeVarintPlugin(dAtA, i, uint64(len(m.StdinFile)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Env) > 0 {
 		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1361,6 +1378,10 @@ func (m *ManifestRequestMetadata) Size() (n int) {
 			n += 1 + l + sovPlugin(uint64(l))
 		}
 	}
+	l = len(m.StdinFile)
+	if l > 0 {
+		n += 1 + l + sovPlugin(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1784,6 +1805,38 @@ func (m *ManifestRequestMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StdinFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StdinFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlugin(dAtA[iNdEx:])
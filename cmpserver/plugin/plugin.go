@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -22,6 +23,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/buffered_context"
 	"github.com/argoproj/argo-cd/v3/util/cmp"
 	argoexec "github.com/argoproj/argo-cd/v3/util/exec"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
@@ -73,6 +75,10 @@ func randExecID() (string, error) {
 }
 
 func runCommand(ctx context.Context, command Command, path string, env []string) (string, error) {
+	return runCommandWithStdin(ctx, command, path, env, nil)
+}
+
+func runCommandWithStdin(ctx context.Context, command Command, path string, env []string, stdin io.Reader) (string, error) {
 	if len(command.Command) == 0 {
 		return "", errors.New("Command is empty")
 	}
@@ -80,6 +86,7 @@ func runCommand(ctx context.Context, command Command, path string, env []string)
 
 	cmd.Env = env
 	cmd.Dir = path
+	cmd.Stdin = stdin
 
 	execId, err := randExecID()
 	if err != nil {
@@ -225,7 +232,20 @@ func (s *Service) generateManifestGeneric(stream GenerateManifestStream) error {
 	if !strings.HasPrefix(appPath, workDir) {
 		return errors.New("illegal appPath: out of workDir bound")
 	}
-	response, err := s.generateManifest(ctx, appPath, metadata.GetEnv())
+	var stdin io.Reader
+	if metadata.GetStdinFile() != "" {
+		stdinPath, err := securejoin.SecureJoin(workDir, metadata.GetStdinFile())
+		if err != nil {
+			return fmt.Errorf("illegal stdinFile: %w", err)
+		}
+		stdinFile, err := os.Open(stdinPath)
+		if err != nil {
+			return fmt.Errorf("error opening stdin file: %w", err)
+		}
+		defer utilio.Close(stdinFile)
+		stdin = stdinFile
+	}
+	response, err := s.generateManifest(ctx, appPath, metadata.GetEnv(), stdin)
 	if err != nil {
 		return fmt.Errorf("error generating manifests: %w", err)
 	}
@@ -239,8 +259,9 @@ func (s *Service) generateManifestGeneric(stream GenerateManifestStream) error {
 	return nil
 }
 
-// generateManifest runs generate command from plugin config file and returns generated manifest files
-func (s *Service) generateManifest(ctx context.Context, appDir string, envEntries []*apiclient.EnvEntry) (*apiclient.ManifestResponse, error) {
+// generateManifest runs generate command from plugin config file and returns generated manifest files. The stdin, if
+// not nil, is passed to the generate command.
+func (s *Service) generateManifest(ctx context.Context, appDir string, envEntries []*apiclient.EnvEntry, stdin io.Reader) (*apiclient.ManifestResponse, error) {
 	if deadline, ok := ctx.Deadline(); ok {
 		log.Infof("Generating manifests with deadline %v from now", time.Until(deadline))
 	} else {
@@ -257,7 +278,7 @@ func (s *Service) generateManifest(ctx context.Context, appDir string, envEntrie
 		}
 	}
 
-	out, err := runCommand(ctx, config.Spec.Generate, appDir, env)
+	out, err := runCommandWithStdin(ctx, config.Spec.Generate, appDir, env, stdin)
 	if err != nil {
 		return &apiclient.ManifestResponse{}, err
 	}
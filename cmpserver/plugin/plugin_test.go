@@ -323,7 +323,7 @@ func TestGenerateManifest(t *testing.T) {
 		service, err := newService(configFilePath)
 		require.NoError(t, err)
 
-		res1, err := service.generateManifest(t.Context(), "testdata/kustomize", nil)
+		res1, err := service.generateManifest(t.Context(), "testdata/kustomize", nil, nil)
 		require.NoError(t, err)
 		require.NotNil(t, res1)
 
@@ -338,7 +338,7 @@ func TestGenerateManifest(t *testing.T) {
 		require.NoError(t, err)
 		service.WithGenerateCommand(Command{Command: []string{"bad-command"}})
 
-		res, err := service.generateManifest(t.Context(), "testdata/kustomize", nil)
+		res, err := service.generateManifest(t.Context(), "testdata/kustomize", nil, nil)
 		require.ErrorContains(t, err, "executable file not found")
 		assert.Nil(t, res.Manifests)
 	})
@@ -348,7 +348,7 @@ func TestGenerateManifest(t *testing.T) {
 		require.NoError(t, err)
 		service.WithGenerateCommand(Command{Command: []string{"echo", "invalid yaml: }"}})
 
-		res, err := service.generateManifest(t.Context(), "testdata/kustomize", nil)
+		res, err := service.generateManifest(t.Context(), "testdata/kustomize", nil, nil)
 		require.ErrorContains(t, err, "failed to unmarshal manifest")
 		assert.Nil(t, res.Manifests)
 	})
@@ -362,7 +362,7 @@ func TestGenerateManifest_deadline_exceeded(t *testing.T) {
 
 	expiredCtx, cancel := context.WithTimeout(t.Context(), time.Second*0)
 	defer cancel()
-	_, err = service.generateManifest(expiredCtx, "", nil)
+	_, err = service.generateManifest(expiredCtx, "", nil, nil)
 	require.ErrorContains(t, err, "context deadline exceeded")
 }
 
@@ -707,6 +707,31 @@ func TestService_GenerateManifest(t *testing.T) {
 		require.ErrorContains(t, err, "illegal appPath")
 		assert.Nil(t, s.response)
 	})
+
+	t.Run("generate with stdin file", func(t *testing.T) {
+		t.Parallel()
+		service, err := newService(configFilePath)
+		require.NoError(t, err)
+		service.initConstants.PluginConfig.Spec.Init = Command{}
+		service.WithGenerateCommand(Command{Command: []string{"cat"}})
+		s, err := NewMockGenerateManifestStream("./testdata/kustomize", "./testdata/kustomize", nil)
+		require.NoError(t, err)
+		s.metadataRequest.Request.(*apiclient.AppStreamRequest_Metadata).Metadata.StdinFile = "cm.yaml"
+		err = service.generateManifestGeneric(s)
+		require.NoError(t, err)
+		require.NotNil(t, s.response)
+		assert.Equal(t, []string{"{\"apiVersion\":\"v1\",\"data\":{\"foo\":\"bar\"},\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"}, s.response.Manifests)
+	})
+
+	t.Run("out-of-bounds stdin file", func(t *testing.T) {
+		t.Parallel()
+		s, err := NewMockGenerateManifestStream("./testdata/kustomize", "./testdata/kustomize", nil)
+		require.NoError(t, err)
+		s.metadataRequest.Request.(*apiclient.AppStreamRequest_Metadata).Metadata.StdinFile = "../../../../etc/passwd"
+		err = service.generateManifestGeneric(s)
+		require.Error(t, err)
+		assert.Nil(t, s.response)
+	})
 }
 
 type MockMatchRepositoryStream struct {
@@ -34,7 +34,8 @@ type CommitHydratedManifestsRequest struct {
 	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	// SyncBranch is the branch Argo CD syncs from, i.e. the hydrated branch.
 	SyncBranch string `protobuf:"bytes,2,opt,name=syncBranch,proto3" json:"syncBranch,omitempty"`
-	// TargetBranch is the branch Argo CD is committing to, i.e. the branch that will be updated.
+	// TargetBranch is the branch Argo CD is committing to, i.e. the branch that will be updated. For an OCI repository,
+	// it is the tag moved to the pushed artifact.
 	TargetBranch string `protobuf:"bytes,3,opt,name=targetBranch,proto3" json:"targetBranch,omitempty"`
 	// DrySha is the commit SHA from the dry branch, i.e. pre-rendered manifest branch.
 	DrySha string `protobuf:"bytes,4,opt,name=drySha,proto3" json:"drySha,omitempty"`
@@ -49,10 +50,13 @@ type CommitHydratedManifestsRequest struct {
 	// AuthorEmail is the author email to use for the commit. If empty, defaults to "argo-cd@example.com".
 	AuthorEmail string `protobuf:"bytes,9,opt,name=authorEmail,proto3" json:"authorEmail,omitempty"`
 	// ReadmeMessage is the message content for README template updates.
-	ReadmeMessage        string   `protobuf:"bytes,10,opt,name=readmeMessage,proto3" json:"readmeMessage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ReadmeMessage string `protobuf:"bytes,10,opt,name=readmeMessage,proto3" json:"readmeMessage,omitempty"`
+	// PullRequest, if set, specifies that the changes are pushed to a short-lived branch and proposed to the target
+	// branch by a pull request, rather than being pushed to the target branch.
+	PullRequest          *v1alpha1.HydratorPullRequest `protobuf:"bytes,11,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *CommitHydratedManifestsRequest) Reset()         { *m = CommitHydratedManifestsRequest{} }
@@ -158,6 +162,13 @@ func (m *CommitHydratedManifestsRequest) GetReadmeMessage() string {
 	return ""
 }
 
+func (m *CommitHydratedManifestsRequest) GetPullRequest() *v1alpha1.HydratorPullRequest {
+	if m != nil {
+		return m.PullRequest
+	}
+	return nil
+}
+
 // PathDetails holds information about hydrated manifests to be written to a particular path in the hydrated manifests
 // commit.
 type PathDetails struct {
@@ -278,10 +289,13 @@ func (m *HydratedManifestDetails) GetManifestJSON() string {
 // ManifestsResponse is the response to the ManifestsRequest.
 type CommitHydratedManifestsResponse struct {
 	// HydratedSha is the commit SHA of the hydrated manifests commit.
-	HydratedSha          string   `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	HydratedSha string `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	// PullRequest is the pull request proposing the hydrated manifests commit, if the request asked for one and the
+	// manifests changed.
+	PullRequest          *v1alpha1.HydratorPullRequestStatus `protobuf:"bytes,2,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
 }
 
 func (m *CommitHydratedManifestsResponse) Reset()         { *m = CommitHydratedManifestsResponse{} }
@@ -324,6 +338,13 @@ func (m *CommitHydratedManifestsResponse) GetHydratedSha() string {
 	return ""
 }
 
+func (m *CommitHydratedManifestsResponse) GetPullRequest() *v1alpha1.HydratorPullRequestStatus {
+	if m != nil {
+		return m.PullRequest
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CommitHydratedManifestsRequest)(nil), "CommitHydratedManifestsRequest")
 	proto.RegisterType((*PathDetails)(nil), "PathDetails")
@@ -334,40 +355,43 @@ func init() {
 func init() { proto.RegisterFile("commitserver/commit/commit.proto", fileDescriptor_cf3a3abbc35e3069) }
 
 var fileDescriptor_cf3a3abbc35e3069 = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x95, 0xb5, 0x2b, 0xab, 0xbb, 0x1d, 0xf0, 0x81, 0x59, 0x3d, 0x74, 0x51, 0xc4, 0xa1,
-	0x17, 0x1c, 0xad, 0x15, 0xdc, 0xb8, 0xac, 0x20, 0x4d, 0x88, 0x15, 0x94, 0xde, 0xd0, 0x24, 0xf4,
-	0x96, 0x3c, 0x12, 0xb3, 0x26, 0x36, 0xb6, 0x1b, 0xa9, 0x12, 0x9f, 0x80, 0x4f, 0xc6, 0x91, 0x8f,
-	0x80, 0xfa, 0x49, 0x50, 0x9c, 0x84, 0xb6, 0xa0, 0xb2, 0x03, 0xa7, 0xfa, 0xfd, 0xdf, 0xeb, 0xff,
-	0xd9, 0x3f, 0x3f, 0x87, 0xf8, 0xb1, 0xcc, 0x73, 0x61, 0x0d, 0xea, 0x12, 0x75, 0x58, 0x07, 0xcd,
-	0x0f, 0x57, 0x5a, 0x5a, 0x39, 0x7c, 0x9b, 0x0a, 0x9b, 0xad, 0xee, 0x78, 0x2c, 0xf3, 0x10, 0x74,
-	0x2a, 0x95, 0x96, 0x9f, 0xdd, 0xe2, 0x59, 0x9c, 0x84, 0xe5, 0x34, 0x54, 0xf7, 0x69, 0x08, 0x4a,
-	0x98, 0x10, 0x94, 0x5a, 0x8a, 0x18, 0xac, 0x90, 0x45, 0x58, 0x5e, 0xc2, 0x52, 0x65, 0x70, 0x19,
-	0xa6, 0x58, 0xa0, 0x06, 0x8b, 0x49, 0xed, 0x16, 0x7c, 0xeb, 0x92, 0xd1, 0xcc, 0xd9, 0x5f, 0xaf,
-	0x13, 0x97, 0xb8, 0x81, 0x42, 0x7c, 0x42, 0x63, 0x4d, 0x84, 0x5f, 0x56, 0x68, 0x2c, 0xbd, 0x25,
-	0x5d, 0x8d, 0x4a, 0x32, 0xcf, 0xf7, 0xc6, 0x83, 0xc9, 0x35, 0xdf, 0xf6, 0xe7, 0x6d, 0x7f, 0xb7,
-	0xf8, 0x18, 0x27, 0xbc, 0x9c, 0x72, 0x75, 0x9f, 0xf2, 0xaa, 0x3f, 0xdf, 0xe9, 0xcf, 0xdb, 0xfe,
-	0x3c, 0x42, 0x25, 0x8d, 0xb0, 0x52, 0xaf, 0x23, 0xe7, 0x4a, 0x47, 0x84, 0x98, 0x75, 0x11, 0x5f,
-	0x69, 0x28, 0xe2, 0x8c, 0x1d, 0xf9, 0xde, 0xb8, 0x1f, 0xed, 0x28, 0x34, 0x20, 0xa7, 0x16, 0x74,
-	0x8a, 0xb6, 0xa9, 0xe8, 0xb8, 0x8a, 0x3d, 0x8d, 0x3e, 0x21, 0xbd, 0x44, 0xaf, 0x17, 0x19, 0xb0,
-	0xae, 0xcb, 0x36, 0x11, 0x7d, 0x4a, 0xce, 0x6a, 0x74, 0x37, 0x68, 0x0c, 0xa4, 0xc8, 0x8e, 0x5d,
-	0x7a, 0x5f, 0xa4, 0x01, 0x39, 0x56, 0x60, 0x33, 0xc3, 0x7a, 0x7e, 0x67, 0x3c, 0x98, 0x9c, 0xf2,
-	0xf7, 0x60, 0xb3, 0x57, 0x68, 0x41, 0x2c, 0x4d, 0x54, 0xa7, 0xe8, 0x57, 0xf2, 0x38, 0xd1, 0xeb,
-	0x59, 0xf3, 0x3f, 0x0b, 0x09, 0x58, 0x60, 0x8f, 0x1c, 0x90, 0xf9, 0xff, 0x02, 0x29, 0x85, 0x11,
-	0xb2, 0x68, 0x5d, 0xa3, 0xbf, 0x1b, 0x55, 0x8c, 0x60, 0x65, 0x33, 0xa9, 0xe7, 0x90, 0x23, 0x3b,
-	0xa9, 0x19, 0x6d, 0x15, 0xea, 0x93, 0x41, 0x1d, 0xbd, 0xce, 0x41, 0x2c, 0x59, 0xdf, 0x15, 0xec,
-	0x4a, 0x15, 0x09, 0x8d, 0x90, 0xe4, 0xd8, 0x92, 0x20, 0x35, 0x89, 0x3d, 0x31, 0x58, 0x91, 0xc1,
-	0xce, 0xd9, 0x29, 0x25, 0xdd, 0xea, 0xf4, 0xee, 0xe2, 0xfb, 0x91, 0x5b, 0xd3, 0x17, 0xa4, 0x9f,
-	0xb7, 0x03, 0xc2, 0x8e, 0x1c, 0x30, 0xc6, 0xff, 0x1c, 0x9d, 0x16, 0xde, 0xb6, 0x94, 0x0e, 0xc9,
-	0x49, 0x45, 0x1d, 0x8a, 0xc4, 0xb0, 0x8e, 0xdf, 0x19, 0xf7, 0xa3, 0xdf, 0x71, 0xf0, 0x92, 0x9c,
-	0x1f, 0x70, 0xa8, 0x6e, 0xbf, 0xf5, 0x78, 0xb3, 0x78, 0x37, 0x6f, 0xb6, 0xb2, 0xa7, 0x05, 0x33,
-	0x72, 0x71, 0x70, 0x82, 0x8d, 0x92, 0x85, 0x71, 0x80, 0xb2, 0x26, 0x59, 0x4d, 0x49, 0xed, 0xb2,
-	0x2b, 0x4d, 0x72, 0x72, 0x56, 0x9b, 0x2c, 0x50, 0x97, 0x22, 0x46, 0x7a, 0x4b, 0xce, 0x0f, 0xb8,
-	0xd2, 0x0b, 0xfe, 0xef, 0x17, 0x33, 0xf4, 0xf9, 0x03, 0x1b, 0xba, 0x9a, 0x7d, 0xdf, 0x8c, 0xbc,
-	0x1f, 0x9b, 0x91, 0xf7, 0x73, 0x33, 0xf2, 0x3e, 0x3c, 0x7f, 0xe0, 0x49, 0xef, 0x7d, 0x13, 0x40,
-	0x89, 0x78, 0x29, 0xb0, 0xb0, 0x77, 0x3d, 0xf7, 0x84, 0xa7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xde, 0xe2, 0xc1, 0x3d, 0x34, 0x04, 0x00, 0x00,
+	// 562 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x31, 0x6f, 0xd4, 0x30,
+	0x14, 0xc7, 0x95, 0xde, 0xb5, 0xf4, 0x9c, 0x76, 0xc0, 0x03, 0xb5, 0x3a, 0x5c, 0xa3, 0x88, 0xe1,
+	0x16, 0x1c, 0xb5, 0x15, 0x6c, 0x2c, 0x2d, 0x48, 0x15, 0xa2, 0xa5, 0xe4, 0x06, 0x24, 0x54, 0x09,
+	0xbd, 0x26, 0x26, 0x31, 0x4d, 0x62, 0x63, 0x3b, 0x91, 0x22, 0xf1, 0xd9, 0x98, 0x19, 0xf9, 0x08,
+	0xa8, 0x5f, 0x04, 0x14, 0x27, 0xe1, 0x12, 0xd0, 0xd1, 0xa1, 0x4c, 0x67, 0xff, 0x9f, 0xef, 0xff,
+	0xec, 0xdf, 0x7b, 0x79, 0xc8, 0x8b, 0x44, 0x9e, 0x73, 0xa3, 0x99, 0xaa, 0x98, 0x0a, 0xda, 0x4d,
+	0xf7, 0x43, 0xa5, 0x12, 0x46, 0xec, 0xbf, 0x4e, 0xb8, 0x49, 0xcb, 0x6b, 0x1a, 0x89, 0x3c, 0x00,
+	0x95, 0x08, 0xa9, 0xc4, 0x27, 0xbb, 0x78, 0x12, 0xc5, 0x41, 0x75, 0x1c, 0xc8, 0x9b, 0x24, 0x00,
+	0xc9, 0x75, 0x00, 0x52, 0x66, 0x3c, 0x02, 0xc3, 0x45, 0x11, 0x54, 0x87, 0x90, 0xc9, 0x14, 0x0e,
+	0x83, 0x84, 0x15, 0x4c, 0x81, 0x61, 0x71, 0xeb, 0xe6, 0xff, 0x9c, 0xa2, 0xf9, 0xa9, 0xb5, 0x3f,
+	0xab, 0x63, 0x1b, 0x38, 0x87, 0x82, 0x7f, 0x64, 0xda, 0xe8, 0x90, 0x7d, 0x2e, 0x99, 0x36, 0xf8,
+	0x0a, 0x4d, 0x15, 0x93, 0x82, 0x38, 0x9e, 0xb3, 0x70, 0x8f, 0xce, 0xe8, 0x2a, 0x3f, 0xed, 0xf3,
+	0xdb, 0xc5, 0x87, 0x28, 0xa6, 0xd5, 0x31, 0x95, 0x37, 0x09, 0x6d, 0xf2, 0xd3, 0x41, 0x7e, 0xda,
+	0xe7, 0xa7, 0x21, 0x93, 0x42, 0x73, 0x23, 0x54, 0x1d, 0x5a, 0x57, 0x3c, 0x47, 0x48, 0xd7, 0x45,
+	0x74, 0xa2, 0xa0, 0x88, 0x52, 0xb2, 0xe1, 0x39, 0x8b, 0x59, 0x38, 0x50, 0xb0, 0x8f, 0x76, 0x0c,
+	0xa8, 0x84, 0x99, 0xee, 0xc4, 0xc4, 0x9e, 0x18, 0x69, 0xf8, 0x11, 0xda, 0x8a, 0x55, 0xbd, 0x4c,
+	0x81, 0x4c, 0x6d, 0xb4, 0xdb, 0xe1, 0xc7, 0x68, 0xb7, 0x45, 0x77, 0xce, 0xb4, 0x86, 0x84, 0x91,
+	0x4d, 0x1b, 0x1e, 0x8b, 0xd8, 0x47, 0x9b, 0x12, 0x4c, 0xaa, 0xc9, 0x96, 0x37, 0x59, 0xb8, 0x47,
+	0x3b, 0xf4, 0x12, 0x4c, 0xfa, 0x82, 0x19, 0xe0, 0x99, 0x0e, 0xdb, 0x10, 0xfe, 0x82, 0x1e, 0xc6,
+	0xaa, 0x3e, 0xed, 0xfe, 0x67, 0x20, 0x06, 0x03, 0xe4, 0x81, 0x05, 0x72, 0x71, 0x5f, 0x20, 0x15,
+	0xd7, 0x5c, 0x14, 0xbd, 0x6b, 0xf8, 0x77, 0xa2, 0x86, 0x11, 0x94, 0x26, 0x15, 0xea, 0x02, 0x72,
+	0x46, 0xb6, 0x5b, 0x46, 0x2b, 0x05, 0x7b, 0xc8, 0x6d, 0x77, 0x2f, 0x73, 0xe0, 0x19, 0x99, 0xd9,
+	0x03, 0x43, 0xa9, 0x21, 0xa1, 0x18, 0xc4, 0x39, 0xeb, 0x49, 0xa0, 0x96, 0xc4, 0x48, 0xc4, 0x1a,
+	0xb9, 0xb2, 0xcc, 0xb2, 0xae, 0xf0, 0xc4, 0xb5, 0xef, 0x7b, 0x7b, 0xbf, 0xf7, 0xb5, 0x6d, 0x25,
+	0xd4, 0xe5, 0xca, 0x38, 0x1c, 0x66, 0xf1, 0x4b, 0xe4, 0x0e, 0x80, 0x63, 0x8c, 0xa6, 0x0d, 0x72,
+	0xdb, 0x6d, 0xb3, 0xd0, 0xae, 0xf1, 0x33, 0x34, 0xcb, 0xfb, 0xae, 0x24, 0x1b, 0xb6, 0x4a, 0x84,
+	0xfe, 0xd9, 0xaf, 0x7d, 0xc5, 0x56, 0x47, 0xf1, 0x3e, 0xda, 0x6e, 0x4a, 0x0d, 0x45, 0xac, 0xc9,
+	0xc4, 0x9b, 0x2c, 0x66, 0xe1, 0xef, 0xbd, 0xff, 0x1c, 0xed, 0xad, 0x71, 0x68, 0x5a, 0xae, 0xf7,
+	0x78, 0xb5, 0x7c, 0x73, 0xd1, 0x5d, 0x65, 0xa4, 0xf9, 0x5f, 0x1d, 0x74, 0xb0, 0xf6, 0xbb, 0xd1,
+	0x52, 0x14, 0xda, 0x96, 0x25, 0xed, 0x82, 0x4d, 0x6f, 0xb6, 0x36, 0x43, 0x09, 0xd7, 0x63, 0xe0,
+	0x1b, 0x16, 0xf8, 0xbb, 0xff, 0x0e, 0x7c, 0x69, 0xc0, 0x94, 0x7a, 0x84, 0xfd, 0x28, 0x47, 0xbb,
+	0xed, 0xfd, 0x97, 0x4c, 0x55, 0x3c, 0x62, 0xf8, 0x0a, 0xed, 0xad, 0x79, 0x10, 0x3e, 0xa0, 0xff,
+	0x1e, 0x11, 0xfb, 0x1e, 0xbd, 0x83, 0xc5, 0xc9, 0xe9, 0xb7, 0xdb, 0xb9, 0xf3, 0xfd, 0x76, 0xee,
+	0xfc, 0xb8, 0x9d, 0x3b, 0xef, 0x9f, 0xde, 0x31, 0xc3, 0x46, 0x43, 0x10, 0x24, 0x8f, 0x32, 0xce,
+	0x0a, 0x73, 0xbd, 0x65, 0x67, 0xd6, 0xf1, 0xaf, 0x01, 0x00, 0x6c, 0x98, 0x80, 0x40, 0x25, 0x05,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -476,6 +500,18 @@ func (m *CommitHydratedManifestsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.ReadmeMessage) > 0 {
 		i -= len(m.ReadmeMessage)
 		copy(dAtA[i:], m.ReadmeMessage)
@@ -681,6 +717,18 @@ func (m *CommitHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.HydratedSha) > 0 {
 		i -= len(m.HydratedSha)
 		copy(dAtA[i:], m.HydratedSha)
@@ -750,6 +798,10 @@ func (m *CommitHydratedManifestsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommit(uint64(l))
 	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -810,6 +862,10 @@ func (m *CommitHydratedManifestsResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommit(uint64(l))
 	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1181,6 +1237,42 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ReadmeMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &v1alpha1.HydratorPullRequest{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
@@ -1496,6 +1588,42 @@ func (m *CommitHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.HydratedSha = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &v1alpha1.HydratorPullRequestStatus{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
@@ -11,6 +11,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
@@ -23,15 +24,19 @@ const (
 
 // Service is the service that handles commit requests.
 type Service struct {
-	metricsServer     *metrics.Server
-	repoClientFactory RepoClientFactory
+	metricsServer               *metrics.Server
+	repoClientFactory           RepoClientFactory
+	ociPusherFactory            OCIPusherFactory
+	pullRequestPublisherFactory PullRequestPublisherFactory
 }
 
 // NewService returns a new instance of the commit service.
 func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server) *Service {
 	return &Service{
-		metricsServer:     metricsServer,
-		repoClientFactory: NewRepoClientFactory(gitCredsStore, metricsServer),
+		metricsServer:               metricsServer,
+		repoClientFactory:           NewRepoClientFactory(gitCredsStore, metricsServer),
+		ociPusherFactory:            NewOCIPusherFactory(),
+		pullRequestPublisherFactory: NewPullRequestPublisherFactory(),
 	}
 }
 
@@ -44,7 +49,8 @@ type CommitNote struct {
 
 // CommitHydratedManifests handles a commit request. It clones the repository, checks out the sync branch, checks out
 // the target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and
-// pushes the changes. It returns the hydrated revision SHA and an error if one occurred.
+// pushes the changes. It returns the hydrated revision SHA and an error if one occurred. For an OCI repository, the
+// manifests are pushed as an artifact instead, and the hydrated revision is the digest of the artifact.
 func (s *Service) CommitHydratedManifests(ctx context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
 	// This method is intentionally short. It's a wrapper around handleCommitRequest that adds metrics and logging.
 	// Keep logic here minimal and put most of the logic in handleCommitRequest.
@@ -71,7 +77,7 @@ func (s *Service) CommitHydratedManifests(ctx context.Context, r *apiclient.Comm
 
 	logCtx := log.WithFields(log.Fields{"branch": r.TargetBranch, "drySHA": r.DrySha})
 
-	out, sha, err := s.handleCommitRequest(ctx, logCtx, r)
+	out, sha, pullRequest, err := s.handleCommitRequest(ctx, logCtx, r)
 	if err != nil {
 		logCtx.WithError(err).WithField("output", out).Error("failed to handle commit request")
 
@@ -82,38 +88,46 @@ func (s *Service) CommitHydratedManifests(ctx context.Context, r *apiclient.Comm
 	logCtx.Info("Successfully handled commit request")
 	return &apiclient.CommitHydratedManifestsResponse{
 		HydratedSha: sha,
+		PullRequest: pullRequest,
 	}, nil
 }
 
 // handleCommitRequest handles the commit request. It clones the repository, checks out the sync branch, checks out the
 // target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and pushes
-// the changes. It returns the output of the git commands and an error if one occurred.
-func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, error) {
+// the changes. When the request has a pull request, the changes are pushed to the pull request branch instead, and a
+// pull request is opened against the target branch. It returns the output of the git commands, the hydrated SHA, the
+// status of the pull request and an error if one occurred.
+func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, *v1alpha1.HydratorPullRequestStatus, error) {
 	if r.Repo == nil {
-		return "", "", errors.New("repo is required")
+		return "", "", nil, errors.New("repo is required")
 	}
 
 	if r.Repo.Repo == "" {
-		return "", "", errors.New("repo URL is required")
+		return "", "", nil, errors.New("repo URL is required")
 	}
 	if r.TargetBranch == "" {
-		return "", "", errors.New("target branch is required")
+		return "", "", nil, errors.New("target branch is required")
+	}
+	if isOCIRequest(r) {
+		logCtx = logCtx.WithField("repo", r.Repo.Repo)
+		digest, err := s.handleOCIRequest(ctx, logCtx, r)
+		return "", digest, nil, err
 	}
 	if r.SyncBranch == "" {
-		return "", "", errors.New("sync branch is required")
+		return "", "", nil, errors.New("sync branch is required")
 	}
 
 	logCtx = logCtx.WithField("repo", r.Repo.Repo)
 	logCtx.Debug("Initiating git client")
 	gitClient, dirPath, cleanup, err := s.initGitClient(ctx, logCtx, r)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to init git client: %w", err)
+		return "", "", nil, fmt.Errorf("failed to init git client: %w", err)
 	}
 	defer cleanup()
 
 	root, err := os.OpenRoot(dirPath)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to open root dir: %w", err)
+		return "", "", nil, fmt.Errorf("failed to open root dir: %w", err)
 	}
 	defer io.Close(root)
 
@@ -121,18 +135,18 @@ func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r
 	var out string
 	out, err = gitClient.CheckoutOrOrphan(ctx, r.SyncBranch, false)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to checkout sync branch: %w", err)
+		return out, "", nil, fmt.Errorf("failed to checkout sync branch: %w", err)
 	}
 
 	logCtx.Debugf("Checking out target branch %s", r.TargetBranch)
 	out, err = gitClient.CheckoutOrNew(ctx, r.TargetBranch, r.SyncBranch, false)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to checkout target branch: %w", err)
+		return out, "", nil, fmt.Errorf("failed to checkout target branch: %w", err)
 	}
 
 	hydratedSha, err := gitClient.CommitSHA(ctx)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+		return "", "", nil, fmt.Errorf("failed to get commit SHA: %w", err)
 	}
 
 	/* git note changes
@@ -145,18 +159,18 @@ func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r
 	*/
 	isHydrated, err := IsHydrated(ctx, gitClient, r.DrySha, hydratedSha)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get notes from git %w", err)
+		return "", "", nil, fmt.Errorf("failed to get notes from git %w", err)
 	}
 	// short-circuit if already hydrated
 	if isHydrated {
 		logCtx.Debugf("this dry sha %s is already hydrated", r.DrySha)
-		return "", hydratedSha, nil
+		return "", hydratedSha, nil, nil
 	}
 
 	logCtx.Debug("Writing manifests")
 	shouldCommit, err := WriteForPaths(ctx, root, r.Repo.Repo, r.DrySha, r.DryCommitMetadata, r.Paths, gitClient, r.ReadmeMessage)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to write manifests: %w", err)
+		return "", "", nil, fmt.Errorf("failed to write manifests: %w", err)
 	}
 	if !shouldCommit {
 		// Manifests did not change, so we don't need to create a new commit.
@@ -164,28 +178,31 @@ func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r
 		logCtx.Debug("Adding commit note")
 		err = AddNote(ctx, gitClient, r.DrySha, hydratedSha)
 		if err != nil {
-			return "", "", fmt.Errorf("failed to add commit note: %w", err)
+			return "", "", nil, fmt.Errorf("failed to add commit note: %w", err)
 		}
-		return "", hydratedSha, nil
+		return "", hydratedSha, nil, nil
+	}
+	if r.PullRequest != nil {
+		return s.handlePullRequest(ctx, logCtx, gitClient, r)
 	}
 	logCtx.Debug("Committing and pushing changes")
 	out, err = gitClient.CommitAndPush(ctx, r.TargetBranch, r.CommitMessage)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to commit and push: %w", err)
+		return out, "", nil, fmt.Errorf("failed to commit and push: %w", err)
 	}
 
 	logCtx.Debug("Getting commit SHA")
 	sha, err := gitClient.CommitSHA(ctx)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+		return "", "", nil, fmt.Errorf("failed to get commit SHA: %w", err)
 	}
 	// add the commit note
 	logCtx.Debug("Adding commit note")
 	err = AddNote(ctx, gitClient, r.DrySha, sha)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to add commit note: %w", err)
+		return "", "", nil, fmt.Errorf("failed to add commit note: %w", err)
 	}
-	return "", sha, nil
+	return "", sha, nil, nil
 }
 
 // initGitClient initializes a git client for the given repository and returns the client, the path to the directory where
@@ -1,19 +1,25 @@
 package commit
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	prmocks "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request/mocks"
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/commitserver/commit/mocks"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	gitmocks "github.com/argoproj/argo-cd/v3/util/git/mocks"
+	ocimocks "github.com/argoproj/argo-cd/v3/util/oci/mocks"
 )
 
 func Test_CommitHydratedManifests(t *testing.T) {
@@ -444,6 +450,291 @@ func Test_CommitHydratedManifests(t *testing.T) {
 	})
 }
 
+func Test_CommitHydratedManifests_OCI(t *testing.T) {
+	t.Parallel()
+
+	ociRequest := &apiclient.CommitHydratedManifestsRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "oci://registry.example.com/hydrated",
+		},
+		TargetBranch:  "latest",
+		DrySha:        "abc123",
+		CommitMessage: "test commit message",
+		Paths: []*apiclient.PathDetails{
+			{
+				Path: "guestbook",
+				Manifests: []*apiclient.HydratedManifestDetails{
+					{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"guestbook"}}`},
+				},
+			},
+		},
+	}
+
+	newServiceWithOCIMocks := func(t *testing.T) (*Service, *ocimocks.Pusher) {
+		t.Helper()
+		service, _ := newServiceWithMocks(t)
+		mockPusher := ocimocks.NewPusher(t)
+		mockOCIPusherFactory := mocks.NewOCIPusherFactory(t)
+		mockOCIPusherFactory.EXPECT().NewPusher(ociRequest.Repo).Return(mockPusher, nil).Once()
+		service.ociPusherFactory = mockOCIPusherFactory
+		return service, mockPusher
+	}
+
+	pathsDigest, err := hydratedPathsDigest(ociRequest.Paths)
+	require.NoError(t, err)
+	contentTag := hydratedContentTag("abc123", "latest", pathsDigest)
+
+	t.Run("pushes the hydrated manifests", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockPusher := newServiceWithOCIMocks(t)
+		mockPusher.EXPECT().ResolveTag(mock.Anything, contentTag).Return("", nil).Once()
+		mockPusher.EXPECT().PushDirectory(mock.Anything, mock.Anything, HydratedManifestsArtifactType, []string{contentTag, "latest"}, mock.Anything).
+			RunAndReturn(func(_ context.Context, dirPath string, _ string, _ []string, annotations map[string]string) (string, error) {
+				assert.FileExists(t, filepath.Join(dirPath, "hydrator.metadata"))
+				assert.FileExists(t, filepath.Join(dirPath, "guestbook", ManifestYaml))
+				assert.FileExists(t, filepath.Join(dirPath, "guestbook", "README.md"))
+				assert.NoFileExists(t, filepath.Join(dirPath, ".gitattributes"))
+				assert.Equal(t, "abc123", annotations["org.opencontainers.image.revision"])
+				assert.Equal(t, pathsDigest, annotations[HydratedPathsDigestAnnotation])
+				return "sha256:pushed", nil
+			}).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), ociRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:pushed", resp.HydratedSha)
+	})
+
+	t.Run("already hydrated", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockPusher := newServiceWithOCIMocks(t)
+		mockPusher.EXPECT().ResolveTag(mock.Anything, contentTag).Return("sha256:existing", nil).Once()
+		mockPusher.EXPECT().Tag(mock.Anything, "sha256:existing", "latest").Return(nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), ociRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:existing", resp.HydratedSha)
+	})
+
+	t.Run("hydrated paths changed", func(t *testing.T) {
+		t.Parallel()
+
+		request := *ociRequest
+		request.Paths = []*apiclient.PathDetails{ociRequest.Paths[0], {Path: "other"}}
+		otherPathsDigest, err := hydratedPathsDigest(request.Paths)
+		require.NoError(t, err)
+		otherContentTag := hydratedContentTag("abc123", "latest", otherPathsDigest)
+		assert.NotEqual(t, contentTag, otherContentTag)
+
+		service, mockPusher := newServiceWithOCIMocks(t)
+		mockPusher.EXPECT().ResolveTag(mock.Anything, otherContentTag).Return("", nil).Once()
+		mockPusher.EXPECT().PushDirectory(mock.Anything, mock.Anything, HydratedManifestsArtifactType, []string{otherContentTag, "latest"}, mock.Anything).Return("sha256:pushed", nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), &request)
+		require.NoError(t, err)
+		assert.Equal(t, "sha256:pushed", resp.HydratedSha)
+	})
+
+	t.Run("two target branches hydrating the same dry sha", func(t *testing.T) {
+		t.Parallel()
+
+		stagingRequest := *ociRequest
+		stagingRequest.TargetBranch = "staging"
+		stagingContentTag := hydratedContentTag("abc123", "staging", pathsDigest)
+		assert.NotEqual(t, contentTag, stagingContentTag)
+
+		// The registry is shared by both target branches, and records the tags pushed by each hydration.
+		tags := map[string]string{}
+		service, _ := newServiceWithMocks(t)
+		mockPusher := ocimocks.NewPusher(t)
+		mockOCIPusherFactory := mocks.NewOCIPusherFactory(t)
+		mockOCIPusherFactory.EXPECT().NewPusher(ociRequest.Repo).Return(mockPusher, nil).Times(4)
+		service.ociPusherFactory = mockOCIPusherFactory
+		mockPusher.EXPECT().ResolveTag(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, tag string) (string, error) {
+			return tags[tag], nil
+		}).Times(4)
+		mockPusher.EXPECT().PushDirectory(mock.Anything, mock.Anything, HydratedManifestsArtifactType, mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, _ string, _ string, pushedTags []string, _ map[string]string) (string, error) {
+				digest := "sha256:" + pushedTags[1]
+				for _, tag := range pushedTags {
+					_, exists := tags[tag]
+					assert.False(t, exists && tag != pushedTags[1], "tag %s must not be moved", tag)
+					tags[tag] = digest
+				}
+				return digest, nil
+			}).Twice()
+		mockPusher.EXPECT().Tag(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, digest string, tag string) error {
+			tags[tag] = digest
+			return nil
+		}).Twice()
+
+		for range 2 {
+			resp, err := service.CommitHydratedManifests(t.Context(), ociRequest)
+			require.NoError(t, err)
+			assert.Equal(t, "sha256:latest", resp.HydratedSha)
+			resp, err = service.CommitHydratedManifests(t.Context(), &stagingRequest)
+			require.NoError(t, err)
+			assert.Equal(t, "sha256:staging", resp.HydratedSha)
+		}
+		assert.Equal(t, map[string]string{
+			contentTag:        "sha256:latest",
+			"latest":          "sha256:latest",
+			stagingContentTag: "sha256:staging",
+			"staging":         "sha256:staging",
+		}, tags)
+		assert.NotContains(t, tags, "abc123")
+	})
+
+	t.Run("push fails", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockPusher := newServiceWithOCIMocks(t)
+		mockPusher.EXPECT().ResolveTag(mock.Anything, contentTag).Return("", nil).Once()
+		mockPusher.EXPECT().PushDirectory(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("", errors.New("denied")).Once()
+
+		_, err := service.CommitHydratedManifests(t.Context(), ociRequest)
+		require.ErrorContains(t, err, "failed to push hydrated manifests: denied")
+	})
+}
+
+func Test_CommitHydratedManifests_PullRequest(t *testing.T) {
+	t.Parallel()
+
+	prRequest := &apiclient.CommitHydratedManifestsRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "https://github.com/argoproj/argocd-example-apps.git",
+		},
+		TargetBranch:  "main",
+		SyncBranch:    "env/test",
+		DrySha:        "abc123",
+		CommitMessage: "test commit message\n\nmore details",
+		PullRequest: &v1alpha1.HydratorPullRequest{
+			Provider: v1alpha1.HydratorPullRequestProviderGitHub,
+		},
+		Paths: []*apiclient.PathDetails{
+			{
+				Path: "guestbook",
+				Manifests: []*apiclient.HydratedManifestDetails{
+					{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"guestbook"}}`},
+				},
+			},
+		},
+	}
+
+	newServiceWithPullRequestMocks := func(t *testing.T) (*Service, *gitmocks.Client, *prmocks.PullRequestPublisher) {
+		t.Helper()
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor(mock.Anything, "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan(mock.Anything, "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrNew(mock.Anything, "main", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote(mock.Anything, mock.Anything, mock.Anything).Return("", fmt.Errorf("test %w", git.ErrNoNoteFound)).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+		mockPublisher := prmocks.NewPullRequestPublisher(t)
+		mockPublisherFactory := mocks.NewPullRequestPublisherFactory(t)
+		mockPublisherFactory.EXPECT().NewPublisher(mock.Anything, prRequest.Repo, mock.Anything).Return(mockPublisher, nil).Maybe()
+		service.pullRequestPublisherFactory = mockPublisherFactory
+		return service, mockGitClient, mockPublisher
+	}
+
+	t.Run("opens a pull request", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockGitClient, mockPublisher := newServiceWithPullRequestMocks(t)
+		mockGitClient.EXPECT().CommitSHA(mock.Anything).Return("target-sha", nil).Once()
+		mockGitClient.EXPECT().HasFileChanged(mock.Anything, mock.Anything).Return(true, nil).Once()
+		mockGitClient.EXPECT().CommitAndPush(mock.Anything, "+HEAD:refs/heads/argocd-hydrator/main", prRequest.CommitMessage).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA(mock.Anything).Return("pr-sha", nil).Once()
+		mockGitClient.EXPECT().AddAndPushNote(mock.Anything, "pr-sha", NoteNamespace, mock.Anything).Return(nil).Once()
+		mockPublisher.EXPECT().Publish(mock.Anything, "argocd-hydrator/main", "main", "test commit message", prRequest.CommitMessage).
+			Return(&pull_request.PullRequest{Number: 42, URL: "https://github.com/argoproj/argocd-example-apps/pull/42"}, true, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), prRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "pr-sha", resp.HydratedSha)
+		assert.Equal(t, &v1alpha1.HydratorPullRequestStatus{
+			URL:          "https://github.com/argoproj/argocd-example-apps/pull/42",
+			Number:       42,
+			Branch:       "argocd-hydrator/main",
+			TargetBranch: "main",
+			State:        v1alpha1.HydratorPullRequestStateOpened,
+			DrySHA:       "abc123",
+		}, resp.PullRequest)
+	})
+
+	t.Run("manifests did not change", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockGitClient, _ := newServiceWithPullRequestMocks(t)
+		mockGitClient.EXPECT().CommitSHA(mock.Anything).Return("target-sha", nil).Once()
+		mockGitClient.EXPECT().HasFileChanged(mock.Anything, mock.Anything).Return(false, nil).Once()
+		mockGitClient.EXPECT().AddAndPushNote(mock.Anything, "target-sha", NoteNamespace, mock.Anything).Return(nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), prRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "target-sha", resp.HydratedSha)
+		assert.Nil(t, resp.PullRequest)
+	})
+
+	t.Run("publish fails", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockGitClient, mockPublisher := newServiceWithPullRequestMocks(t)
+		mockGitClient.EXPECT().CommitSHA(mock.Anything).Return("target-sha", nil).Once()
+		mockGitClient.EXPECT().HasFileChanged(mock.Anything, mock.Anything).Return(true, nil).Once()
+		mockGitClient.EXPECT().CommitAndPush(mock.Anything, "+HEAD:refs/heads/argocd-hydrator/main", prRequest.CommitMessage).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA(mock.Anything).Return("pr-sha", nil).Once()
+		mockGitClient.EXPECT().AddAndPushNote(mock.Anything, "pr-sha", NoteNamespace, mock.Anything).Return(nil).Once()
+		mockPublisher.EXPECT().Publish(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, false, errors.New("forbidden")).Once()
+
+		_, err := service.CommitHydratedManifests(t.Context(), prRequest)
+		require.ErrorContains(t, err, "failed to publish pull request: forbidden")
+	})
+
+	newRequestWithBranch := func(branch string) *apiclient.CommitHydratedManifestsRequest {
+		return &apiclient.CommitHydratedManifestsRequest{
+			Repo:          prRequest.Repo,
+			TargetBranch:  prRequest.TargetBranch,
+			SyncBranch:    prRequest.SyncBranch,
+			DrySha:        prRequest.DrySha,
+			CommitMessage: prRequest.CommitMessage,
+			PullRequest:   &v1alpha1.HydratorPullRequest{Provider: prRequest.PullRequest.Provider, Branch: branch},
+			Paths:         prRequest.Paths,
+		}
+	}
+
+	t.Run("custom branch is not force-pushed", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockGitClient, mockPublisher := newServiceWithPullRequestMocks(t)
+		mockGitClient.EXPECT().CommitSHA(mock.Anything).Return("target-sha", nil).Once()
+		mockGitClient.EXPECT().HasFileChanged(mock.Anything, mock.Anything).Return(true, nil).Once()
+		mockGitClient.EXPECT().CommitAndPush(mock.Anything, "HEAD:refs/heads/hydrated-changes", prRequest.CommitMessage).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA(mock.Anything).Return("pr-sha", nil).Once()
+		mockGitClient.EXPECT().AddAndPushNote(mock.Anything, "pr-sha", NoteNamespace, mock.Anything).Return(nil).Once()
+		mockPublisher.EXPECT().Publish(mock.Anything, "hydrated-changes", "main", "test commit message", prRequest.CommitMessage).
+			Return(&pull_request.PullRequest{Number: 42}, false, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), newRequestWithBranch("hydrated-changes"))
+		require.NoError(t, err)
+		assert.Equal(t, "hydrated-changes", resp.PullRequest.Branch)
+	})
+
+	t.Run("branch of the source hydrator is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockGitClient, _ := newServiceWithPullRequestMocks(t)
+		mockGitClient.EXPECT().CommitSHA(mock.Anything).Return("target-sha", nil).Once()
+		mockGitClient.EXPECT().HasFileChanged(mock.Anything, mock.Anything).Return(true, nil).Once()
+
+		_, err := service.CommitHydratedManifests(t.Context(), newRequestWithBranch("env/test"))
+		require.ErrorContains(t, err, `pull request branch "env/test" must differ from the branches of the source hydrator`)
+	})
+}
+
 func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
 	t.Helper()
 
@@ -35,7 +35,8 @@ func init() {
 }
 
 // WriteForPaths writes the manifests, hydrator.metadata, and README.md files for each path in the provided paths. It
-// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA.
+// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA. If gitClient is nil, the root
+// is not a git repository, so the manifests are always considered changed and no .gitattributes file is written.
 func WriteForPaths(ctx context.Context, root *os.Root, repoUrl, drySha string, dryCommitMetadata *appv1.RevisionMetadata, paths []*apiclient.PathDetails, gitClient git.Client, rawReadmeTemplate string) (bool, error) { //nolint:revive //FIXME(var-naming)
 	hydratorMetadata, err := hydrator.GetCommitMetadata(repoUrl, drySha, dryCommitMetadata)
 	if err != nil {
@@ -48,10 +49,12 @@ func WriteForPaths(ctx context.Context, root *os.Root, repoUrl, drySha string, d
 		return false, fmt.Errorf("failed to write top-level hydrator metadata: %w", err)
 	}
 
-	// Write .gitattributes
-	err = writeGitAttributes(root)
-	if err != nil {
-		return false, fmt.Errorf("failed to write git attributes: %w", err)
+	if gitClient != nil {
+		// Write .gitattributes
+		err = writeGitAttributes(root)
+		if err != nil {
+			return false, fmt.Errorf("failed to write git attributes: %w", err)
+		}
 	}
 	var atleastOneManifestChanged bool
 	for _, p := range paths {
@@ -74,9 +77,12 @@ func WriteForPaths(ctx context.Context, root *os.Root, repoUrl, drySha string, d
 			return false, fmt.Errorf("failed to write manifests: %w", err)
 		}
 		// Check if the manifest file has been modified compared to the git index
-		changed, err := gitClient.HasFileChanged(ctx, filepath.Join(hydratePath, ManifestYaml))
-		if err != nil {
-			return false, fmt.Errorf("failed to check if anything changed on the manifest: %w", err)
+		changed := true
+		if gitClient != nil {
+			changed, err = gitClient.HasFileChanged(ctx, filepath.Join(hydratePath, ManifestYaml))
+			if err != nil {
+				return false, fmt.Errorf("failed to check if anything changed on the manifest: %w", err)
+			}
 		}
 
 		if !changed {
@@ -0,0 +1,139 @@
+package commit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+)
+
+// HydratedManifestsArtifactType is the artifact type of the OCI artifacts holding hydrated manifests.
+const HydratedManifestsArtifactType = "application/vnd.argoproj.argo-cd.hydrated-manifests.v1"
+
+// HydratedPathsDigestAnnotation is the manifest annotation holding the digest of the hydrated paths of an artifact.
+const HydratedPathsDigestAnnotation = "argocd.argoproj.io/hydrated-paths-digest"
+
+// OCIPusherFactory is a factory for creating OCI pushers for a repository.
+type OCIPusherFactory interface {
+	NewPusher(repo *v1alpha1.Repository) (oci.Pusher, error)
+}
+
+type ociPusherFactory struct{}
+
+// NewOCIPusherFactory returns a new instance of the OCI pusher factory.
+func NewOCIPusherFactory() OCIPusherFactory {
+	return &ociPusherFactory{}
+}
+
+// NewPusher creates a new OCI pusher for the repository.
+func (f *ociPusherFactory) NewPusher(repo *v1alpha1.Repository) (oci.Pusher, error) {
+	return oci.NewPusher(repo.Repo, repo.GetOCICreds(), repo.Proxy, repo.NoProxy) //nolint:wrapcheck // wrapping the error wouldn't add any information
+}
+
+// isOCIRequest returns whether the hydrated manifests of the request are pushed to an OCI repository rather than
+// committed to a git repository.
+func isOCIRequest(r *apiclient.CommitHydratedManifestsRequest) bool {
+	return strings.HasPrefix(r.Repo.Repo, "oci://")
+}
+
+// handleOCIRequest handles a commit request for an OCI repository. It writes the manifests to a temporary directory and
+// pushes them as an artifact tagged with its content tag and the target branch, which is the tag the application syncs
+// from. The content tag identifies the hydrated manifests of the target branch for the dry SHA, so it is never moved: if
+// it already exists, only the target branch tag is moved to its artifact. It returns the digest of the artifact and an
+// error if one occurred.
+func (s *Service) handleOCIRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, error) {
+	if r.DrySha == "" {
+		return "", errors.New("dry sha is required")
+	}
+
+	pathsDigest, err := hydratedPathsDigest(r.Paths)
+	if err != nil {
+		return "", err
+	}
+	contentTag := hydratedContentTag(r.DrySha, r.TargetBranch, pathsDigest)
+	logCtx = logCtx.WithField("contentTag", contentTag)
+
+	logCtx.Debug("Initiating OCI pusher")
+	pusher, err := s.ociPusherFactory.NewPusher(r.Repo)
+	if err != nil {
+		return "", fmt.Errorf("failed to create OCI pusher: %w", err)
+	}
+
+	digest, err := pusher.ResolveTag(ctx, contentTag)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve content tag: %w", err)
+	}
+	if digest != "" {
+		logCtx.Debugf("this dry sha %s is already hydrated", r.DrySha)
+		err = pusher.Tag(ctx, digest, r.TargetBranch)
+		if err != nil {
+			return "", fmt.Errorf("failed to tag hydrated manifests: %w", err)
+		}
+		return digest, nil
+	}
+
+	dirPath, err := files.CreateTempDir("/tmp/_commit-service")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() {
+		err := os.RemoveAll(dirPath)
+		if err != nil {
+			logCtx.WithError(err).Error("failed to cleanup temp dir")
+		}
+	}()
+
+	root, err := os.OpenRoot(dirPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open root dir: %w", err)
+	}
+	defer io.Close(root)
+
+	logCtx.Debug("Writing manifests")
+	_, err = WriteForPaths(ctx, root, r.Repo.Repo, r.DrySha, r.DryCommitMetadata, r.Paths, nil, r.ReadmeMessage)
+	if err != nil {
+		return "", fmt.Errorf("failed to write manifests: %w", err)
+	}
+
+	logCtx.Debug("Pushing artifact")
+	annotations := map[string]string{
+		imagev1.AnnotationRevision:    r.DrySha,
+		imagev1.AnnotationDescription: r.CommitMessage,
+		HydratedPathsDigestAnnotation: pathsDigest,
+	}
+	digest, err = pusher.PushDirectory(ctx, dirPath, HydratedManifestsArtifactType, []string{contentTag, r.TargetBranch}, annotations)
+	if err != nil {
+		return "", fmt.Errorf("failed to push hydrated manifests: %w", err)
+	}
+	return digest, nil
+}
+
+// hydratedPathsDigest returns the digest of the given hydrated paths, which identifies the content of the artifact they
+// are pushed as.
+func hydratedPathsDigest(paths []*apiclient.PathDetails) (string, error) {
+	data, err := json.Marshal(paths)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal hydrated paths: %w", err)
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(data)), nil
+}
+
+// hydratedContentTag returns the tag of the artifact holding the given hydrated paths of the target branch for the dry
+// SHA. Target branches hydrating the same dry SHA get their own tag, and the tag changes with the hydrated paths, for
+// instance when an application is added, so that a pushed tag never has to be moved to another artifact.
+func hydratedContentTag(drySha, targetBranch, pathsDigest string) string {
+	scope := sha256.Sum256([]byte(targetBranch + "\x00" + pathsDigest))
+	return fmt.Sprintf("%s-%x", drySha, scope[:8])
+}
@@ -0,0 +1,198 @@
+package commit
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// PullRequestPublisherFactory is a factory for creating pull request publishers for a repository.
+type PullRequestPublisherFactory interface {
+	NewPublisher(ctx context.Context, repo *v1alpha1.Repository, pullRequest *v1alpha1.HydratorPullRequest) (pull_request.PullRequestPublisher, error)
+}
+
+type pullRequestPublisherFactory struct{}
+
+// NewPullRequestPublisherFactory returns a new instance of the pull request publisher factory.
+func NewPullRequestPublisherFactory() PullRequestPublisherFactory {
+	return &pullRequestPublisherFactory{}
+}
+
+// NewPublisher creates a new pull request publisher for the repository. The publisher authenticates with the write
+// credentials of the repository: the GitHub App, the bearer token or the password, which is expected to be a token.
+// The credentials are only sent to an API hosted by the host of the repository.
+func (f *pullRequestPublisherFactory) NewPublisher(ctx context.Context, repo *v1alpha1.Repository, pullRequest *v1alpha1.HydratorPullRequest) (pull_request.PullRequestPublisher, error) {
+	if err := pullRequest.ValidateAPI(repo.Repo); err != nil {
+		return nil, err
+	}
+
+	repository := pullRequest.Repository
+	if repository == "" {
+		repository = getRepositoryPath(repo.Repo)
+	}
+
+	var service pull_request.PullRequestService
+	var err error
+	switch pullRequest.Provider {
+	case v1alpha1.HydratorPullRequestProviderGitHub:
+		owner, name, splitErr := splitRepository(repository, 2)
+		if splitErr != nil {
+			return nil, splitErr
+		}
+		if repo.GithubAppId != 0 {
+			service, err = pull_request.NewGithubAppService(ctx, github_app_auth.Authentication{
+				Id:                repo.GithubAppId,
+				InstallationId:    repo.GithubAppInstallationId,
+				EnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
+				PrivateKey:        repo.GithubAppPrivateKey,
+			}, pullRequest.API, owner, name, nil)
+		} else {
+			service, err = pull_request.NewGithubService(repo.Password, pullRequest.API, owner, name, nil)
+		}
+	case v1alpha1.HydratorPullRequestProviderGitLab:
+		service, err = pull_request.NewGitLabService(repo.Password, pullRequest.API, repository, nil, "", "", repo.Insecure, nil, repo.Proxy, repo.NoProxy)
+	case v1alpha1.HydratorPullRequestProviderGitea:
+		owner, name, splitErr := splitRepository(repository, 2)
+		if splitErr != nil {
+			return nil, splitErr
+		}
+		service, err = pull_request.NewGiteaService(repo.Password, pullRequest.API, owner, name, nil, repo.Insecure, repo.Proxy, repo.NoProxy)
+	case v1alpha1.HydratorPullRequestProviderBitbucketServer:
+		project, name, splitErr := splitRepository(repository, 2)
+		if splitErr != nil {
+			return nil, splitErr
+		}
+		if repo.BearerToken != "" {
+			service, err = pull_request.NewBitbucketServiceBearerToken(ctx, repo.BearerToken, pullRequest.API, project, name, "", repo.Insecure, nil, repo.Proxy, repo.NoProxy)
+		} else {
+			service, err = pull_request.NewBitbucketServiceBasicAuth(ctx, repo.Username, repo.Password, pullRequest.API, project, name, "", repo.Insecure, nil, repo.Proxy, repo.NoProxy)
+		}
+	case v1alpha1.HydratorPullRequestProviderBitbucketCloud:
+		owner, name, splitErr := splitRepository(repository, 2)
+		if splitErr != nil {
+			return nil, splitErr
+		}
+		if repo.BearerToken != "" {
+			service, err = pull_request.NewBitbucketCloudServiceBearerToken(pullRequest.API, repo.BearerToken, owner, name)
+		} else {
+			service, err = pull_request.NewBitbucketCloudServiceBasicAuth(pullRequest.API, repo.Username, repo.Password, owner, name)
+		}
+	case v1alpha1.HydratorPullRequestProviderAzureDevOps:
+		organization, projectAndName, splitErr := splitRepository(repository, 3)
+		if splitErr != nil {
+			return nil, splitErr
+		}
+		project, name, _ := strings.Cut(projectAndName, "/")
+		service, err = pull_request.NewAzureDevOpsService(repo.Password, pullRequest.API, organization, project, name, nil)
+	default:
+		return nil, fmt.Errorf("unsupported pull request provider %q", pullRequest.Provider)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s pull request service: %w", pullRequest.Provider, err)
+	}
+	publisher, ok := service.(pull_request.PullRequestPublisher)
+	if !ok {
+		return nil, fmt.Errorf("pull request provider %q cannot open pull requests", pullRequest.Provider)
+	}
+	return publisher, nil
+}
+
+// handlePullRequest commits the hydrated manifests written to the work tree, pushes them to the pull request branch, and
+// opens a pull request against the target branch, or updates the one already open. The branches named after the target
+// branch by the hydrator are reset to the target branch with every hydration, and are force-pushed, while the other
+// branches are only fast-forwarded. It returns the output of the git commands, the SHA of the pull request commit, the
+// status of the pull request and an error if one occurred.
+func (s *Service) handlePullRequest(ctx context.Context, logCtx *log.Entry, gitClient git.Client, r *apiclient.CommitHydratedManifestsRequest) (string, string, *v1alpha1.HydratorPullRequestStatus, error) {
+	if err := r.PullRequest.ValidateBranch(r.TargetBranch, r.SyncBranch); err != nil {
+		return "", "", nil, err
+	}
+	branch := r.PullRequest.GetBranch(r.TargetBranch)
+	logCtx = logCtx.WithField("pullRequestBranch", branch)
+
+	logCtx.Debug("Initiating pull request publisher")
+	publisher, err := s.pullRequestPublisherFactory.NewPublisher(ctx, r.Repo, r.PullRequest)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to create pull request publisher: %w", err)
+	}
+
+	logCtx.Debug("Committing and pushing changes to the pull request branch")
+	refspec := "HEAD:refs/heads/" + branch
+	if r.PullRequest.IsHydratorBranch(r.TargetBranch) {
+		refspec = "+" + refspec
+	}
+	out, err := gitClient.CommitAndPush(ctx, refspec, r.CommitMessage)
+	if err != nil {
+		return out, "", nil, fmt.Errorf("failed to commit and push: %w", err)
+	}
+
+	logCtx.Debug("Getting commit SHA")
+	sha, err := gitClient.CommitSHA(ctx)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+	// The note is kept when the pull request is merged without rewriting the commit, e.g. by a fast-forward merge.
+	logCtx.Debug("Adding commit note")
+	err = AddNote(ctx, gitClient, r.DrySha, sha)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to add commit note: %w", err)
+	}
+
+	logCtx.Debug("Publishing pull request")
+	title, _, _ := strings.Cut(r.CommitMessage, "\n")
+	pr, opened, err := publisher.Publish(ctx, branch, r.TargetBranch, title, r.CommitMessage)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to publish pull request: %w", err)
+	}
+	state := v1alpha1.HydratorPullRequestStateUpdated
+	if opened {
+		state = v1alpha1.HydratorPullRequestStateOpened
+	}
+	logCtx.WithFields(log.Fields{"pullRequest": pr.Number, "state": state}).Info("Published pull request")
+	return "", sha, &v1alpha1.HydratorPullRequestStatus{
+		URL:          pr.URL,
+		Number:       pr.Number,
+		Branch:       branch,
+		TargetBranch: r.TargetBranch,
+		State:        state,
+		DrySHA:       r.DrySha,
+	}, nil
+}
+
+// getRepositoryPath returns the path of the repository at its SCM provider from the repository URL, e.g.
+// "owner/repo" for https://github.com/owner/repo.git. The path segments of Bitbucket Server and Azure DevOps clone URLs
+// which are not part of the repository identity are removed.
+func getRepositoryPath(repoURL string) string {
+	var path string
+	if u, err := url.Parse(repoURL); err == nil && u.Scheme != "" {
+		path = u.Path
+	} else if _, p, ok := strings.Cut(repoURL, ":"); ok {
+		// SCP-like SSH URL, e.g. git@github.com:owner/repo.git
+		path = p
+	}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	// Bitbucket Server HTTP clone URLs, e.g. https://bitbucket.example.com/scm/project/repo.git
+	path = strings.TrimPrefix(path, "scm/")
+	// Azure DevOps clone URLs, e.g. https://dev.azure.com/org/project/_git/repo and git@ssh.dev.azure.com:v3/org/project/repo
+	path = strings.Replace(path, "/_git/", "/", 1)
+	path = strings.TrimPrefix(path, "v3/")
+	return path
+}
+
+// splitRepository splits the repository path into its first segment and the remaining ones, checking it has the
+// expected number of segments.
+func splitRepository(repository string, segments int) (string, string, error) {
+	if strings.Count(repository, "/") != segments-1 {
+		return "", "", fmt.Errorf("repository %q must have %d path segments", repository, segments)
+	}
+	first, rest, _ := strings.Cut(repository, "/")
+	return first, rest, nil
+}
@@ -0,0 +1,65 @@
+package commit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_getRepositoryPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		repoURL  string
+		expected string
+	}{
+		{repoURL: "https://github.com/owner/repo.git", expected: "owner/repo"},
+		{repoURL: "https://github.com/owner/repo", expected: "owner/repo"},
+		{repoURL: "git@github.com:owner/repo.git", expected: "owner/repo"},
+		{repoURL: "ssh://git@gitlab.example.com:2222/group/subgroup/repo.git", expected: "group/subgroup/repo"},
+		{repoURL: "https://bitbucket.example.com/scm/project/repo.git", expected: "project/repo"},
+		{repoURL: "https://dev.azure.com/org/project/_git/repo", expected: "org/project/repo"},
+		{repoURL: "git@ssh.dev.azure.com:v3/org/project/repo", expected: "org/project/repo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.repoURL, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, getRepositoryPath(tt.repoURL))
+		})
+	}
+}
+
+func Test_pullRequestPublisherFactory_NewPublisher(t *testing.T) {
+	t.Parallel()
+
+	factory := NewPullRequestPublisherFactory()
+	repo := &v1alpha1.Repository{Repo: "https://github.com/owner/repo.git", Password: "token"}
+
+	t.Run("creates a publisher from the repository URL", func(t *testing.T) {
+		t.Parallel()
+		publisher, err := factory.NewPublisher(t.Context(), repo, &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderGitHub})
+		require.NoError(t, err)
+		assert.NotNil(t, publisher)
+	})
+
+	t.Run("repository with an unexpected path", func(t *testing.T) {
+		t.Parallel()
+		_, err := factory.NewPublisher(t.Context(), repo, &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderGitHub, Repository: "owner"})
+		require.EqualError(t, err, `repository "owner" must have 2 path segments`)
+	})
+
+	t.Run("API not hosted by the repository host", func(t *testing.T) {
+		t.Parallel()
+		_, err := factory.NewPublisher(t.Context(), repo, &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderGitHub, API: "https://attacker.example.net/api/v3"})
+		require.EqualError(t, err, `pull request API "https://attacker.example.net/api/v3" is not hosted by the host of repository "https://github.com/owner/repo.git"`)
+	})
+
+	t.Run("unsupported provider", func(t *testing.T) {
+		t.Parallel()
+		_, err := factory.NewPublisher(t.Context(), repo, &v1alpha1.HydratorPullRequest{Provider: "unknown"})
+		require.EqualError(t, err, `unsupported pull request provider "unknown"`)
+	})
+}
@@ -108,6 +108,8 @@ const (
 	DefaultPluginSockFilePath = "/home/argocd/cmp-server/plugins"
 	// DefaultPluginConfigFilePath is the Default path to cmp server plugin configuration file
 	DefaultPluginConfigFilePath = "/home/argocd/cmp-server/config"
+	// DefaultHealthPluginSockFilePath is the Default path to the health plugin socket files
+	DefaultHealthPluginSockFilePath = "/home/argocd/health-plugins"
 	// PluginConfigFileName is the Plugin Config File is a ConfigManagementPlugin manifest located inside the plugin container
 	PluginConfigFileName = "plugin.yaml"
 )
@@ -156,6 +158,16 @@ const (
 	// cluster changes, this algorithm minimises the changes between shard and clusters assignments.
 	ConsistentHashingWithBoundedLoadsAlgorithm = "consistent-hashing"
 
+	// WeightedShardingAlgorithm uses an algorithm that weights the clusters by their number of resources, so that
+	// the shards get a similar load rather than a similar number of clusters. Clusters sharing the same shard group
+	// label are assigned to the same shard, and clusters are only moved once the load of their shard exceeds the
+	// average load by more than the rebalance threshold.
+	WeightedShardingAlgorithm = "weighted"
+
+	// DefaultShardingRebalanceThreshold is the default ratio by which the load of a shard can exceed the average load
+	// before the weighted sharding algorithm moves clusters to other shards
+	DefaultShardingRebalanceThreshold = 0.2
+
 	DefaultShardingAlgorithm = LegacyShardingAlgorithm
 )
 
@@ -196,8 +208,13 @@ const (
 	LabelKeyLegacyApplicationName = "applications.argoproj.io/app-name"
 	// LabelKeySecretType contains the type of argocd secret (currently: 'cluster', 'repository', 'repo-config' or 'repo-creds')
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
+	// LabelKeyClusterShardGroup is the label key of the cluster secrets that the weighted sharding algorithm assigns
+	// to the same shard. If the value is a shard number, the clusters are pinned to that shard.
+	LabelKeyClusterShardGroup = "argocd.argoproj.io/shard-group"
 	// LabelKeyClusterKubernetesVersion contains the kubernetes version of the cluster secret if it has been enabled
 	LabelKeyClusterKubernetesVersion = "argocd.argoproj.io/kubernetes-version"
+	// LabelKeySyncRecordApplication contains the name of the application of a sync record
+	LabelKeySyncRecordApplication = "argocd.argoproj.io/application-name"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
 	LabelValueSecretTypeCluster = "cluster"
 	// LabelValueSecretTypeRepository indicates a secret type of repository
@@ -294,6 +311,8 @@ const (
 	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
 	// EnvControllerShardingAlgorithm is the distribution sharding algorithm to be used: legacy or round-robin
 	EnvControllerShardingAlgorithm = "ARGOCD_CONTROLLER_SHARDING_ALGORITHM"
+	// EnvControllerShardingRebalanceThreshold is the ratio by which the load of a shard can exceed the average load before the weighted sharding algorithm moves clusters to other shards
+	EnvControllerShardingRebalanceThreshold = "ARGOCD_CONTROLLER_SHARDING_REBALANCE_THRESHOLD"
 	// EnvEnableDynamicClusterDistribution enables dynamic sharding (ALPHA)
 	EnvEnableDynamicClusterDistribution = "ARGOCD_ENABLE_DYNAMIC_CLUSTER_DISTRIBUTION"
 	// EnvEnableGRPCTimeHistogramEnv enables gRPC metrics collection
@@ -320,6 +339,10 @@ const (
 	EnvMaxCookieNumber = "ARGOCD_MAX_COOKIE_NUMBER"
 	// EnvPluginSockFilePath allows to override the pluginSockFilePath for repo server and cmp server
 	EnvPluginSockFilePath = "ARGOCD_PLUGINSOCKFILEPATH"
+	// EnvHealthPluginSockFilePath allows to override the path to the health plugin socket files for the application controller
+	EnvHealthPluginSockFilePath = "ARGOCD_HEALTH_PLUGIN_SOCKFILEPATH"
+	// EnvHealthPluginTimeout defines the timeout of the health assessment of a resource by a health plugin
+	EnvHealthPluginTimeout = "ARGOCD_HEALTH_PLUGIN_TIMEOUT"
 	// EnvCMPChunkSize defines the chunk size in bytes used when sending files to the cmp server
 	EnvCMPChunkSize = "ARGOCD_CMP_CHUNK_SIZE"
 	// EnvCMPWorkDir defines the full path of the work directory used by the CMP server
@@ -417,6 +440,16 @@ func GetPluginSockFilePath() string {
 	return pluginSockFilePath
 }
 
+// GetHealthPluginSockFilePath retrieves the path of the health plugin socket files, which is either taken from the
+// EnvHealthPluginSockFilePath environment or a default value
+func GetHealthPluginSockFilePath() string {
+	sockFilePath := os.Getenv(EnvHealthPluginSockFilePath)
+	if sockFilePath == "" {
+		return DefaultHealthPluginSockFilePath
+	}
+	return sockFilePath
+}
+
 // GetCMPChunkSize will return the env var EnvCMPChunkSize value if defined or DefaultCMPChunkSize otherwise.
 // If EnvCMPChunkSize is defined but not a valid int, DefaultCMPChunkSize will be returned
 func GetCMPChunkSize() int {
@@ -443,6 +476,10 @@ func GetCMPWorkDir() string {
 const (
 	// AnnotationApplicationSetRefresh is an annotation that is added when an ApplicationSet is requested to be refreshed by a webhook. The ApplicationSet controller will remove this annotation at the end of reconciliation.
 	AnnotationApplicationSetRefresh = "argocd.argoproj.io/application-set-refresh"
+	// AnnotationApplicationSetRolloutPaused is an annotation that pauses the RollingSync rollout of an ApplicationSet when set to "true". No Application is synced by the rollout until it is removed.
+	AnnotationApplicationSetRolloutPaused = "argocd.argoproj.io/application-set-rollout-paused"
+	// AnnotationApplicationSetRolloutPromotedStep is an annotation holding the last step of the RollingSync rollout of an ApplicationSet that is promoted, i.e. that the rollout proceeds past without waiting for its Applications. The ApplicationSet controller will remove this annotation once the rollout has completed.
+	AnnotationApplicationSetRolloutPromotedStep = "argocd.argoproj.io/application-set-rollout-promoted-step"
 )
 
 // gRPC settings
@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// appGetter returns the application with the given namespace and name, or nil if it does not exist
+type appGetter func(namespace, name string) (*appv1.Application, error)
+
+func (ctrl *ApplicationController) getApp(namespace, name string) (*appv1.Application, error) {
+	app, err := ctrl.appLister.Applications(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting application %s/%s: %w", namespace, name, err)
+	}
+	return app, nil
+}
+
+// projectAppGetter returns a getter of the applications of the given project only. The applications of other projects
+// are reported as missing, so that the dependencies of an application do not disclose their status.
+func projectAppGetter(project string, getApp appGetter) appGetter {
+	return func(namespace, name string) (*appv1.Application, error) {
+		app, err := getApp(namespace, name)
+		if err != nil || app == nil || app.Spec.GetProject() != project {
+			return nil, err
+		}
+		return app, nil
+	}
+}
+
+// getDependencyGraph returns the applications which the application depends on, directly or transitively. It also
+// returns the first dependency cycle found in the graph, as the list of the applications of the cycle, or nil if the
+// graph has no cycle. The applications of other projects are reported as missing.
+func getDependencyGraph(app *appv1.Application, getApp appGetter) ([]appv1.ApplicationDependencyNode, []string, error) {
+	getApp = projectAppGetter(app.Spec.GetProject(), getApp)
+	var nodes []appv1.ApplicationDependencyNode
+	var cycle []string
+	// path holds the applications from the application to the one being visited
+	var path []string
+	visited := map[string]bool{}
+
+	var visit func(app *appv1.Application) error
+	visit = func(app *appv1.Application) error {
+		key := appv1.ApplicationDependency{Namespace: app.Namespace, Name: app.Name}.QualifiedName()
+		path = append(path, key)
+		defer func() {
+			path = path[:len(path)-1]
+		}()
+		for _, dependency := range app.GetDependencies() {
+			depKey := dependency.QualifiedName()
+			if cycle == nil {
+				for i := range path {
+					if path[i] == depKey {
+						cycle = append(append([]string{}, path[i:]...), depKey)
+						break
+					}
+				}
+			}
+			if visited[depKey] {
+				continue
+			}
+			visited[depKey] = true
+
+			depApp, err := getApp(dependency.Namespace, dependency.Name)
+			if err != nil {
+				return err
+			}
+			if depApp == nil {
+				nodes = append(nodes, appv1.ApplicationDependencyNode{
+					Name:       dependency.Name,
+					Namespace:  dependency.Namespace,
+					SyncStatus: appv1.SyncStatusCodeUnknown,
+					Health:     health.HealthStatusMissing,
+				})
+				continue
+			}
+			nodes = append(nodes, appv1.ApplicationDependencyNode{
+				Name:       depApp.Name,
+				Namespace:  depApp.Namespace,
+				DependsOn:  depApp.GetDependencies(),
+				SyncStatus: depApp.Status.Sync.Status,
+				Health:     depApp.Status.Health.Status,
+			})
+			if err := visit(depApp); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	visited[appv1.ApplicationDependency{Namespace: app.Namespace, Name: app.Name}.QualifiedName()] = true
+	if err := visit(app); err != nil {
+		return nil, nil, err
+	}
+	return nodes, cycle, nil
+}
+
+// getPendingDependency returns a message which explains which dependency the sync of the application waits for, or an
+// empty string if all the applications which the application directly depends on are synced and healthy. An error is
+// returned if the dependency graph of the application has a cycle, since the sync would wait forever. The applications
+// of other projects are never synced and healthy dependencies.
+func getPendingDependency(app *appv1.Application, getApp appGetter) (string, error) {
+	if len(app.Spec.DependsOn) == 0 {
+		return "", nil
+	}
+	getApp = projectAppGetter(app.Spec.GetProject(), getApp)
+	_, cycle, err := getDependencyGraph(app, getApp)
+	if err != nil {
+		return "", err
+	}
+	if cycle != nil {
+		return "", fmt.Errorf("dependency cycle detected: %s", strings.Join(cycle, " -> "))
+	}
+	for _, dependency := range app.GetDependencies() {
+		depApp, err := getApp(dependency.Namespace, dependency.Name)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case depApp == nil:
+			return fmt.Sprintf("waiting for dependency %s: application not found in project %s", dependency.QualifiedName(), app.Spec.GetProject()), nil
+		case depApp.Operation != nil || depApp.Status.OperationState != nil && !depApp.Status.OperationState.Phase.Completed():
+			return fmt.Sprintf("waiting for dependency %s: operation in progress", dependency.QualifiedName()), nil
+		case depApp.Status.Sync.Status != appv1.SyncStatusCodeSynced || depApp.Status.Health.Status != health.HealthStatusHealthy:
+			return fmt.Sprintf("waiting for dependency %s to be Synced and Healthy", dependency.QualifiedName()), nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,195 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newDependencyApp(name string, syncStatus v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode, dependsOn ...string) *v1alpha1.Application {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: test.FakeArgoCDNamespace},
+	}
+	for _, dependency := range dependsOn {
+		app.Spec.DependsOn = append(app.Spec.DependsOn, v1alpha1.ApplicationDependency{Name: dependency})
+	}
+	app.Status.Sync.Status = syncStatus
+	app.Status.Health.Status = healthStatus
+	return app
+}
+
+func fakeAppGetter(apps ...*v1alpha1.Application) appGetter {
+	return func(namespace, name string) (*v1alpha1.Application, error) {
+		for _, app := range apps {
+			if app.Namespace == namespace && app.Name == name {
+				return app, nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+func TestGetDependencyGraph(t *testing.T) {
+	t.Run("Transitive", func(t *testing.T) {
+		app := newDependencyApp("frontend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "backend")
+		backend := newDependencyApp("backend", v1alpha1.SyncStatusCodeSynced, health.HealthStatusProgressing, "database", "missing")
+		database := newDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+
+		nodes, cycle, err := getDependencyGraph(app, fakeAppGetter(app, backend, database))
+		require.NoError(t, err)
+		assert.Nil(t, cycle)
+		assert.Equal(t, []v1alpha1.ApplicationDependencyNode{{
+			Name:       "backend",
+			Namespace:  test.FakeArgoCDNamespace,
+			DependsOn:  []v1alpha1.ApplicationDependency{{Name: "database", Namespace: test.FakeArgoCDNamespace}, {Name: "missing", Namespace: test.FakeArgoCDNamespace}},
+			SyncStatus: v1alpha1.SyncStatusCodeSynced,
+			Health:     health.HealthStatusProgressing,
+		}, {
+			Name:       "database",
+			Namespace:  test.FakeArgoCDNamespace,
+			SyncStatus: v1alpha1.SyncStatusCodeSynced,
+			Health:     health.HealthStatusHealthy,
+		}, {
+			Name:       "missing",
+			Namespace:  test.FakeArgoCDNamespace,
+			SyncStatus: v1alpha1.SyncStatusCodeUnknown,
+			Health:     health.HealthStatusMissing,
+		}}, nodes)
+	})
+	t.Run("SharedDependency", func(t *testing.T) {
+		app := newDependencyApp("frontend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "backend", "database")
+		backend := newDependencyApp("backend", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "database")
+		database := newDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+
+		nodes, cycle, err := getDependencyGraph(app, fakeAppGetter(app, backend, database))
+		require.NoError(t, err)
+		assert.Nil(t, cycle)
+		assert.Len(t, nodes, 2)
+	})
+	t.Run("OtherProject", func(t *testing.T) {
+		app := newDependencyApp("frontend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "backend")
+		backend := newDependencyApp("backend", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		backend.Spec.Project = "other"
+
+		nodes, cycle, err := getDependencyGraph(app, fakeAppGetter(app, backend))
+		require.NoError(t, err)
+		assert.Nil(t, cycle)
+		assert.Equal(t, []v1alpha1.ApplicationDependencyNode{{
+			Name:       "backend",
+			Namespace:  test.FakeArgoCDNamespace,
+			SyncStatus: v1alpha1.SyncStatusCodeUnknown,
+			Health:     health.HealthStatusMissing,
+		}}, nodes)
+	})
+	t.Run("Cycle", func(t *testing.T) {
+		app := newDependencyApp("frontend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "backend")
+		backend := newDependencyApp("backend", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "database")
+		database := newDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "frontend")
+
+		nodes, cycle, err := getDependencyGraph(app, fakeAppGetter(app, backend, database))
+		require.NoError(t, err)
+		assert.Len(t, nodes, 2)
+		assert.Equal(t, []string{"fake-argocd-ns/frontend", "fake-argocd-ns/backend", "fake-argocd-ns/database", "fake-argocd-ns/frontend"}, cycle)
+	})
+}
+
+func TestGetPendingDependency(t *testing.T) {
+	database := newDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+
+	t.Run("NoDependencies", func(t *testing.T) {
+		pending, err := getPendingDependency(database, fakeAppGetter(database))
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+	t.Run("Ready", func(t *testing.T) {
+		app := newDependencyApp("backend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "database")
+		pending, err := getPendingDependency(app, fakeAppGetter(app, database))
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		app := newDependencyApp("backend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "database")
+		pending, err := getPendingDependency(app, fakeAppGetter(app))
+		require.NoError(t, err)
+		assert.Equal(t, "waiting for dependency fake-argocd-ns/database: application not found in project default", pending)
+	})
+	t.Run("OtherProject", func(t *testing.T) {
+		app := newDependencyApp("backend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "database")
+		other := database.DeepCopy()
+		other.Spec.Project = "other"
+		pending, err := getPendingDependency(app, fakeAppGetter(app, other))
+		require.NoError(t, err)
+		assert.Equal(t, "waiting for dependency fake-argocd-ns/database: application not found in project default", pending)
+	})
+	t.Run("OperationInProgress", func(t *testing.T) {
+		app := newDependencyApp("backend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "database")
+		syncing := database.DeepCopy()
+		syncing.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationRunning}
+		pending, err := getPendingDependency(app, fakeAppGetter(app, syncing))
+		require.NoError(t, err)
+		assert.Equal(t, "waiting for dependency fake-argocd-ns/database: operation in progress", pending)
+	})
+	t.Run("NotHealthy", func(t *testing.T) {
+		app := newDependencyApp("backend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "database")
+		degraded := newDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusDegraded)
+		pending, err := getPendingDependency(app, fakeAppGetter(app, degraded))
+		require.NoError(t, err)
+		assert.Equal(t, "waiting for dependency fake-argocd-ns/database to be Synced and Healthy", pending)
+	})
+	t.Run("Cycle", func(t *testing.T) {
+		app := newDependencyApp("backend", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing, "database")
+		dependent := newDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, "backend")
+		_, err := getPendingDependency(app, fakeAppGetter(app, dependent))
+		require.EqualError(t, err, "dependency cycle detected: fake-argocd-ns/backend -> fake-argocd-ns/database -> fake-argocd-ns/backend")
+	})
+}
+
+func TestProcessRequestedAppOperation_WaitsForDependency(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "default"
+	app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "database"}}
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	database := newDependencyApp("database", v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, database, &defaultProj}}, nil)
+
+	ctrl.processRequestedAppOperation(app)
+
+	patchedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, patchedApp.Status.OperationState)
+	assert.Equal(t, synccommon.OperationRunning, patchedApp.Status.OperationState.Phase)
+	assert.Equal(t, "waiting for dependency fake-argocd-ns/database to be Synced and Healthy", patchedApp.Status.OperationState.Message)
+	assert.Nil(t, patchedApp.Status.OperationState.SyncResult)
+	conditions := patchedApp.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionDependencyWarning: true})
+	require.Len(t, conditions, 1)
+	assert.Equal(t, "waiting for dependency fake-argocd-ns/database to be Synced and Healthy", conditions[0].Message)
+}
+
+func TestProcessRequestedAppOperation_DependencyCycle(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Project = "default"
+	app.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "database"}}
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	database := newDependencyApp("database", v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy, app.Name)
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, database, &defaultProj}}, nil)
+
+	ctrl.processRequestedAppOperation(app)
+
+	patchedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, patchedApp.Status.OperationState)
+	assert.Equal(t, synccommon.OperationError, patchedApp.Status.OperationState.Phase)
+	assert.Equal(t, "dependency cycle detected: fake-argocd-ns/my-app -> fake-argocd-ns/database -> fake-argocd-ns/my-app", patchedApp.Status.OperationState.Message)
+}
@@ -80,6 +80,8 @@ const (
 	defaultDeploymentInformerResyncDuration = 10 * time.Second
 	// orphanedIndex contains application which monitor orphaned resources by namespace
 	orphanedIndex = "orphaned"
+	// dependencyIndex contains applications by the <namespace>/<name> of the applications they depend on
+	dependencyIndex = "dependency"
 	// appOperationRequeueDelay is the batching window used when a managed resource changes.
 	// The burst of resource change events during a sync is batched by the delaying queue into a
 	// single operation processing per window, batching status and operationState writes.
@@ -87,6 +89,9 @@ const (
 	// appOperationMaxRequeueInterval is the backstop interval at which an in-progress operation
 	// re-enqueues itself. It bounds how long the controller can go without polling an ongoing sync.
 	appOperationMaxRequeueInterval = 30 * time.Second
+	// appDependencyRequeueInterval is the interval at which an operation waiting for the applications the app depends
+	// on checks them again
+	appDependencyRequeueInterval = 10 * time.Second
 )
 
 type CompareWith int
@@ -312,7 +317,7 @@ func NewApplicationController(
 			return nil, err
 		}
 	}
-	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
+	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking(), ctrl.cache, ctrl.onResourceDrift())
 	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
@@ -514,6 +519,7 @@ func (ctrl *ApplicationController) setAppManagedResources(ctx context.Context, d
 	if err != nil {
 		return nil, fmt.Errorf("error getting resource tree: %w", err)
 	}
+	setPluginHealth(tree, comparisonResult.pluginHealth)
 	err = ctrl.cache.SetAppResourcesTree(a.InstanceName(ctrl.namespace), tree)
 	ts.AddCheckpoint("set_app_resources_tree_ms")
 	if err != nil {
@@ -527,6 +533,23 @@ func (ctrl *ApplicationController) setAppManagedResources(ctx context.Context, d
 	return tree, nil
 }
 
+// getCachedPluginHealth returns the health assessed by the health plugins in the cached resource tree of the app
+func (ctrl *ApplicationController) getCachedPluginHealth(a *appv1.Application) map[kube.ResourceKey]*appv1.HealthStatus {
+	overrides, err := ctrl.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		log.WithFields(applog.GetAppLogFields(a)).Warnf("Failed to get resource overrides: %v", err)
+		return nil
+	}
+	if !hasHealthPlugins(overrides) {
+		return nil
+	}
+	var tree appv1.ApplicationTree
+	if err := ctrl.cache.GetAppResourcesTree(a.InstanceName(ctrl.namespace), &tree); err != nil {
+		return nil
+	}
+	return getPluginHealth(&tree, overrides)
+}
+
 // returns true of given resources exist in the namespace by default and not managed by the user
 func isKnownOrphanedResourceExclusion(key kube.ResourceKey, proj *appv1.AppProject) bool {
 	if key.Namespace == "default" && key.Group == "" && key.Kind == kube.ServiceKind && key.Name == "kubernetes" {
@@ -684,7 +707,13 @@ func (ctrl *ApplicationController) getResourceTree(destCluster *appv1.Cluster, a
 		return nil, fmt.Errorf("failed to get app hosts: %w", err)
 	}
 	ts.AddCheckpoint("get_app_hosts_ms")
-	return &appv1.ApplicationTree{Nodes: nodes, OrphanedNodes: orphanedNodes, Hosts: hosts}, nil
+
+	dependencies, _, err := getDependencyGraph(a, ctrl.getApp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get app dependencies: %w", err)
+	}
+	ts.AddCheckpoint("get_app_dependencies_ms")
+	return &appv1.ApplicationTree{Nodes: nodes, OrphanedNodes: orphanedNodes, Hosts: hosts, Dependencies: dependencies}, nil
 }
 
 func (ctrl *ApplicationController) getAppHosts(destCluster *appv1.Cluster, a *appv1.Application, appNodes []appv1.ResourceNode) ([]appv1.HostInfo, error) {
@@ -1450,7 +1479,6 @@ func (ctrl *ApplicationController) updateFinalizers(app *appv1.Application) erro
 }
 
 func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condition appv1.ApplicationCondition) {
-	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	// do nothing if app already has same condition
 	for _, c := range app.Status.Conditions {
 		if c.Message == condition.Message && c.Type == condition.Type {
@@ -1459,7 +1487,21 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 	}
 
 	app.Status.SetConditions([]appv1.ApplicationCondition{condition}, map[appv1.ApplicationConditionType]bool{condition.Type: true})
+	ctrl.patchAppConditions(app)
+}
+
+// clearAppCondition removes the conditions of the given type from the app
+func (ctrl *ApplicationController) clearAppCondition(app *appv1.Application, conditionType appv1.ApplicationConditionType) {
+	evaluatedTypes := map[appv1.ApplicationConditionType]bool{conditionType: true}
+	if len(app.Status.GetConditions(evaluatedTypes)) == 0 {
+		return
+	}
+	app.Status.SetConditions(nil, evaluatedTypes)
+	ctrl.patchAppConditions(app)
+}
 
+func (ctrl *ApplicationController) patchAppConditions(app *appv1.Application) {
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	var patch []byte
 	patch, err := json.Marshal(map[string]any{
 		"status": map[string]any{
@@ -1609,6 +1651,29 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 
 	terminating := state.Phase == synccommon.OperationTerminating
 
+	// the sync waits for the applications the app depends on, until it starts to apply resources
+	if !terminating && state.SyncResult == nil && state.Operation.Sync != nil && !state.Operation.Sync.DryRun {
+		pendingDependency, err := getPendingDependency(app, ctrl.getApp)
+		if err != nil {
+			state.Phase = synccommon.OperationError
+			state.Message = err.Error()
+			ctrl.setAppCondition(app, appv1.ApplicationCondition{Type: appv1.ApplicationConditionDependencyWarning, Message: err.Error()})
+			ctrl.setOperationState(ctx, app, state)
+			return
+		}
+		if pendingDependency != "" {
+			if state.Message != pendingDependency {
+				logCtx.Info(pendingDependency)
+				state.Message = pendingDependency
+				ctrl.setOperationState(ctx, app, state)
+			}
+			ctrl.setAppCondition(app, appv1.ApplicationCondition{Type: appv1.ApplicationConditionDependencyWarning, Message: pendingDependency})
+			requeueAfter = appDependencyRequeueInterval
+			return
+		}
+	}
+	ctrl.clearAppCondition(app, appv1.ApplicationConditionDependencyWarning)
+
 	project, err := ctrl.getAppProj(app)
 	if err == nil {
 		// Start or resume the sync
@@ -1757,6 +1822,7 @@ func (ctrl *ApplicationController) setOperationState(ctx context.Context, app *a
 		}
 		ctrl.metricsServer.IncSync(app, destServer, state)
 		ctrl.metricsServer.IncAppSyncDuration(app, destServer, state)
+		ctrl.persistSyncRecord(ctx, app, state)
 	}
 }
 
@@ -1859,8 +1925,12 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		if destCluster, err = argo.GetDestinationCluster(ctx, app.Spec.Destination, ctrl.db); err == nil {
 			managedResources := make([]*appv1.ResourceDiff, 0)
 			if err := ctrl.cache.GetAppManagedResources(app.InstanceName(ctrl.namespace), &managedResources); err == nil {
+				// the health assessed by the health plugins is only refreshed by a comparison, so it is carried over from
+				// the previous resource tree
+				pluginHealth := ctrl.getCachedPluginHealth(app)
 				var tree *appv1.ApplicationTree
 				if tree, err = ctrl.getResourceTree(destCluster, app, managedResources); err == nil {
+					setPluginHealth(tree, pluginHealth)
 					app.Status.Summary = tree.GetSummary(app)
 					if err := ctrl.cache.SetAppResourcesTree(app.InstanceName(ctrl.namespace), tree); err != nil {
 						logCtx.WithError(err).Error("Failed to cache resources tree")
@@ -1927,6 +1997,9 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		}
 	} else {
 		revision := app.Spec.GetSource().TargetRevision
+		if hydratedRevision := app.GetHydratedOCIRevision(); hydratedRevision != "" {
+			revision = hydratedRevision
+		}
 		if comparisonLevel == CompareWithRecent {
 			revision = app.Status.Sync.Revision
 		}
@@ -1958,7 +2031,12 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
-	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false, nil)
+	canSync := false
+	if windows, err := ctrl.settingsMgr.ResolveSyncWindowCalendars(project.Spec.SyncWindows.Matches(app)); err != nil {
+		logCtx.WithError(err).Warn("Failed to evaluate sync windows")
+	} else {
+		canSync, _ = windows.CanSync(false, nil)
+	}
 	if canSync {
 		syncErrCond, opDuration := ctrl.autoSync(ctx, app, compareResult.syncStatus, compareResult.resources, compareResult.revisionsMayHaveChanges)
 		setOpDuration = opDuration
@@ -2788,6 +2866,17 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				return nil, nil
 			},
+			dependencyIndex: func(obj any) ([]string, error) {
+				app, ok := obj.(*appv1.Application)
+				if !ok {
+					return nil, nil
+				}
+				var dependencies []string
+				for _, dependency := range app.GetDependencies() {
+					dependencies = append(dependencies, dependency.QualifiedName())
+				}
+				return dependencies, nil
+			},
 		},
 	)
 	lister := applisters.NewApplicationLister(informer.GetIndexer())
@@ -2890,6 +2979,10 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 			if newOK && ctrl.shouldProcessOperation(newApp) {
 				ctrl.appOperationQueue.AddRateLimited(key)
 			}
+			if oldOK && newOK && (oldApp.Status.Sync.Status != newApp.Status.Sync.Status || oldApp.Status.Health.Status != newApp.Status.Health.Status ||
+				isOperationInProgress(oldApp) != isOperationInProgress(newApp)) {
+				ctrl.requestDependentsRefresh(newApp)
+			}
 			ctrl.clusterSharding.UpdateApp(newApp)
 		},
 		DeleteFunc: func(obj any) {
@@ -2915,6 +3008,25 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 	}
 }
 
+// requestDependentsRefresh refreshes the resource tree of the apps which depend on the given app, and resumes their
+// operations which wait for it
+func (ctrl *ApplicationController) requestDependentsRefresh(app *appv1.Application) {
+	objs, err := ctrl.appInformer.GetIndexer().ByIndex(dependencyIndex, appv1.ApplicationDependency{Namespace: app.Namespace, Name: app.Name}.QualifiedName())
+	if err != nil {
+		return
+	}
+	for i := range objs {
+		dependent, ok := objs[i].(*appv1.Application)
+		if !ok || !ctrl.canProcessApp(dependent) {
+			continue
+		}
+		ctrl.requestAppRefresh(dependent.QualifiedName(), ComparisonWithNothing.Pointer(), nil)
+		if isOperationInProgress(dependent) {
+			ctrl.appOperationQueue.AddRateLimited(ctrl.toAppKey(dependent.QualifiedName()))
+		}
+	}
+}
+
 func (ctrl *ApplicationController) projectErrorToCondition(err error, app *appv1.Application) appv1.ApplicationCondition {
 	var condition appv1.ApplicationCondition
 	if apierrors.IsNotFound(err) {
@@ -2929,7 +3041,7 @@ func (ctrl *ApplicationController) projectErrorToCondition(err error, app *appv1
 }
 
 func (ctrl *ApplicationController) RegisterClusterSecretUpdater(ctx context.Context) {
-	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.namespace)
+	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.clusterSharding.UpdateClustersCacheInfo, ctrl.namespace)
 	go updater.Run(ctx)
 }
 
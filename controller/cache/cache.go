@@ -36,6 +36,7 @@ import (
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
@@ -76,6 +77,19 @@ const (
 	// EnvClusterCacheEventsProcessingInterval is the env variable to control the interval between processing events when BatchEventsProcessing is enabled
 	EnvClusterCacheEventsProcessingInterval = "ARGOCD_CLUSTER_CACHE_EVENTS_PROCESSING_INTERVAL"
 
+	// EnvClusterCacheSnapshotStore is the env variable to control where cluster cache snapshots are persisted: "disk" or
+	// "redis". Snapshots are disabled if empty.
+	EnvClusterCacheSnapshotStore = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_STORE"
+
+	// EnvClusterCacheSnapshotDir is the env variable that holds the directory of the cluster cache snapshots persisted to disk
+	EnvClusterCacheSnapshotDir = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_DIR"
+
+	// EnvClusterCacheSnapshotInterval is the env variable that holds the interval between cluster cache snapshots
+	EnvClusterCacheSnapshotInterval = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_INTERVAL"
+
+	// EnvClusterCacheSnapshotMaxAge is the env variable that holds the maximum age of a cluster cache snapshot to be restored
+	EnvClusterCacheSnapshotMaxAge = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_MAX_AGE"
+
 	// AnnotationIgnoreResourceUpdates when set to true on an untracked resource,
 	// argo will apply `ignoreResourceUpdates` configuration on it.
 	AnnotationIgnoreResourceUpdates = "argocd.argoproj.io/ignore-resource-updates"
@@ -116,6 +130,19 @@ var (
 
 	// clusterCacheEventsProcessingInterval specifies the interval between processing events when BatchEventsProcessing is enabled
 	clusterCacheEventsProcessingInterval = 100 * time.Millisecond
+
+	// clusterCacheSnapshotStore specifies where cluster cache snapshots are persisted, snapshots are disabled if empty
+	clusterCacheSnapshotStore = ""
+
+	// clusterCacheSnapshotDir is the directory of the cluster cache snapshots persisted to disk, which must be configured
+	// to use the disk store
+	clusterCacheSnapshotDir = ""
+
+	// clusterCacheSnapshotInterval specifies the interval between cluster cache snapshots
+	clusterCacheSnapshotInterval = 5 * time.Minute
+
+	// clusterCacheSnapshotMaxAge specifies the maximum age of a cluster cache snapshot to be restored
+	clusterCacheSnapshotMaxAge = 1 * time.Hour
 )
 
 func init() {
@@ -129,6 +156,10 @@ func init() {
 	clusterCacheRetryUseBackoff = env.ParseBoolFromEnv(EnvClusterCacheRetryUseBackoff, false)
 	clusterCacheBatchEventsProcessing = env.ParseBoolFromEnv(EnvClusterCacheBatchEventsProcessing, true)
 	clusterCacheEventsProcessingInterval = env.ParseDurationFromEnv(EnvClusterCacheEventsProcessingInterval, clusterCacheEventsProcessingInterval, 0, math.MaxInt64)
+	clusterCacheSnapshotStore = env.StringFromEnv(EnvClusterCacheSnapshotStore, clusterCacheSnapshotStore)
+	clusterCacheSnapshotDir = env.StringFromEnv(EnvClusterCacheSnapshotDir, clusterCacheSnapshotDir)
+	clusterCacheSnapshotInterval = env.ParseDurationFromEnv(EnvClusterCacheSnapshotInterval, clusterCacheSnapshotInterval, 0, math.MaxInt64)
+	clusterCacheSnapshotMaxAge = env.ParseDurationFromEnv(EnvClusterCacheSnapshotMaxAge, clusterCacheSnapshotMaxAge, 0, math.MaxInt64)
 }
 
 type LiveStateCache interface {
@@ -194,6 +225,8 @@ func NewLiveStateCache(
 	onObjectUpdated ObjectUpdatedHandler,
 	clusterSharding sharding.ClusterShardingCache,
 	resourceTracking argo.ResourceTracking,
+	argoCache *appstatecache.Cache,
+	onResourceDrift ResourceDriftHandler,
 ) LiveStateCache {
 	return &liveStateCache{
 		appInformer:      appInformer,
@@ -204,6 +237,8 @@ func NewLiveStateCache(
 		metricsServer:    metricsServer,
 		clusterSharding:  clusterSharding,
 		resourceTracking: resourceTracking,
+		snapshotStore:    newClusterCacheSnapshotStore(argoCache),
+		onResourceDrift:  onResourceDrift,
 	}
 }
 
@@ -217,6 +252,9 @@ type cacheSettings struct {
 
 	// ignoreResourceUpdates is a flag to enable resource-ignore rules.
 	ignoreResourceUpdatesEnabled bool
+
+	// sensitiveAnnotations are the annotations of secrets whose values are hidden in the diff of resource drifts
+	sensitiveAnnotations map[string]bool
 }
 
 type liveStateCache struct {
@@ -228,6 +266,10 @@ type liveStateCache struct {
 	clusterSharding      sharding.ClusterShardingCache
 	resourceTracking     argo.ResourceTracking
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+	// snapshotStore persists snapshots of the cluster caches, nil if snapshots are disabled
+	snapshotStore clustercache.SnapshotStore
+	// onResourceDrift is notified about changes of managed resources made outside of Argo CD, nil if disabled
+	onResourceDrift ResourceDriftHandler
 
 	clusters      map[string]clustercache.ClusterCache
 	cacheSettings cacheSettings
@@ -266,9 +308,78 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 	clusterSettings := clustercache.Settings{
 		ResourceHealthOverride: lua.ResourceHealthOverrides(resourceOverrides),
 		ResourcesFilter:        resourcesFilter,
+		MetadataOnlyFilter:     c.getMetadataOnlyFilter(resourcesFilter, resourceOverrides),
+	}
+
+	return &cacheSettings{clusterSettings, appInstanceLabelKey, appv1.TrackingMethod(trackingMethod), installationID, resourceUpdatesOverrides, ignoreResourceUpdatesEnabled, c.settingsMgr.GetSensitiveAnnotations()}, nil
+}
+
+// getMetadataOnlyFilter returns the metadata-only filter of the current settings if the resources filter and the
+// resource overrides did not change. The filter memoizes the health checks of the kinds it is asked about, so a new
+// filter would never be equal to the current one, and every settings update would invalidate the cluster caches.
+func (c *liveStateCache) getMetadataOnlyFilter(resourcesFilter *settings.ResourcesFilter, resourceOverrides map[string]appv1.ResourceOverride) *metadataOnlyFilter {
+	c.lock.RLock()
+	current, ok := c.cacheSettings.clusterSettings.MetadataOnlyFilter.(*metadataOnlyFilter)
+	c.lock.RUnlock()
+	if ok && reflect.DeepEqual(current.resourcesFilter, resourcesFilter) && reflect.DeepEqual(current.resourceOverrides, resourceOverrides) {
+		return current
+	}
+	return newMetadataOnlyFilter(resourcesFilter, resourceOverrides)
+}
+
+// metadataOnlyFilter selects the metadata-only resources of the resources filter, except the kinds which have a health
+// check: the health of a resource is assessed from its spec and status, so these kinds are always fully watched.
+type metadataOnlyFilter struct {
+	// resourcesFilter and resourceOverrides are the first fields, so that comparing two filters with different settings
+	// never reads the memoized health checks, which are updated concurrently
+	resourcesFilter   *settings.ResourcesFilter
+	resourceOverrides map[string]appv1.ResourceOverride
+
+	lock sync.Mutex
+	// hasHealthCheck caches whether the kinds selected by the resources filter have a health check
+	hasHealthCheck map[schema.GroupKind]bool
+}
+
+func newMetadataOnlyFilter(resourcesFilter *settings.ResourcesFilter, resourceOverrides map[string]appv1.ResourceOverride) *metadataOnlyFilter {
+	return &metadataOnlyFilter{
+		resourcesFilter:   resourcesFilter,
+		resourceOverrides: resourceOverrides,
+		hasHealthCheck:    map[schema.GroupKind]bool{},
+	}
+}
+
+func (f *metadataOnlyFilter) IsMetadataOnlyResource(apiGroup, kind, cluster string) bool {
+	if !f.resourcesFilter.IsMetadataOnlyResource(apiGroup, kind, cluster) {
+		return false
+	}
+	gk := schema.GroupKind{Group: apiGroup, Kind: kind}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	healthCheck, ok := f.hasHealthCheck[gk]
+	if !ok {
+		healthCheck = hasHealthCheck(gk, f.resourceOverrides)
+		f.hasHealthCheck[gk] = healthCheck
+	}
+	return !healthCheck
+}
+
+// hasHealthCheck returns true if the health of the resources of the group kind is assessed, either by a built-in check
+// or by a Lua script
+func hasHealthCheck(gk schema.GroupKind, resourceOverrides map[string]appv1.ResourceOverride) bool {
+	gvk := gk.WithVersion("")
+	if health.GetHealthCheckFunc(gvk) != nil {
+		return true
 	}
+	un := &unstructured.Unstructured{}
+	un.SetGroupVersionKind(gvk)
+	script, _, err := lua.VM{ResourceOverrides: resourceOverrides}.GetHealthScript(un)
+	// the resources whose health script cannot be loaded are fully watched, so that the error is reported in their health
+	return err != nil || script != ""
+}
 
-	return &cacheSettings{clusterSettings, appInstanceLabelKey, appv1.TrackingMethod(trackingMethod), installationID, resourceUpdatesOverrides, ignoreResourceUpdatesEnabled}, nil
+// isMetadataOnlyResource returns true if the resources of the group kind are watched through the metadata-only API
+func isMetadataOnlyResource(clusterSettings clustercache.Settings, gk schema.GroupKind, server string) bool {
+	return clusterSettings.MetadataOnlyFilter != nil && clusterSettings.MetadataOnlyFilter.IsMetadataOnlyResource(gk.Group, gk.Kind, server)
 }
 
 func asResourceNode(r *clustercache.Resource, namespaceResources map[kube.ResourceKey]*clustercache.Resource) appv1.ResourceNode {
@@ -554,20 +665,24 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		clustercache.SetClusterResources(cluster.ClusterResources),
 		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (any, bool) {
 			res := &ResourceInfo{}
-			populateNodeInfo(un, res, resourceCustomLabels)
 			c.lock.RLock()
 			cacheSettings := c.cacheSettings
 			c.lock.RUnlock()
 
-			res.Health, _ = health.GetResourceHealth(un, cacheSettings.clusterSettings.ResourceHealthOverride)
+			gvk := un.GroupVersionKind()
+			if isMetadataOnlyResource(cacheSettings.clusterSettings, gvk.GroupKind(), cluster.Server) {
+				// the spec and status of the resources watched through the metadata-only API are unknown
+				populateMetadataInfo(un, res, resourceCustomLabels)
+			} else {
+				populateNodeInfo(un, res, resourceCustomLabels)
+				res.Health, _ = health.GetResourceHealth(un, cacheSettings.clusterSettings.ResourceHealthOverride)
+			}
 
 			appName := c.resourceTracking.GetAppName(un, cacheSettings.appInstanceLabelKey, cacheSettings.trackingMethod, cacheSettings.installationID)
 			if isRoot && appName != "" {
 				res.AppName = appName
 			}
 
-			gvk := un.GroupVersionKind()
-
 			if cacheSettings.ignoreResourceUpdatesEnabled && shouldHashManifest(appName, gvk, un) {
 				hash, err := generateManifestHash(un, nil, cacheSettings.resourceOverrides, c.ignoreNormalizerOpts)
 				if err != nil {
@@ -587,6 +702,10 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		clustercache.SetBatchEventsProcessing(clusterCacheBatchEventsProcessing),
 		clustercache.SetEventProcessingInterval(clusterCacheEventsProcessingInterval),
 	}
+	if c.snapshotStore != nil {
+		codec := &resourceInfoSnapshotCodec{cache: c, customLabels: resourceCustomLabels}
+		clusterCacheOpts = append(clusterCacheOpts, clustercache.SetSnapshotStore(c.snapshotStore, codec, clusterCacheSnapshotInterval))
+	}
 
 	clusterCache = clustercache.NewClusterCache(clusterCacheConfig, clusterCacheOpts...)
 
@@ -622,6 +741,17 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 			return
 		}
 
+		if c.onResourceDrift != nil && oldRes != nil && newRes != nil && oldRes.Resource != nil && newRes.Resource != nil {
+			if appName := resInfo(newRes).AppName; appName != "" {
+				drift, err := detectResourceDrift(oldRes.Resource, newRes.Resource, cacheSettings.sensitiveAnnotations, time.Now())
+				if err != nil {
+					log.WithField("server", cluster.Server).Warnf("Failed to detect drift of %s/%s: %v", ref.Namespace, ref.Name, err)
+				} else if drift != nil {
+					c.onResourceDrift(appName, drift)
+				}
+			}
+		}
+
 		for _, r := range []*clustercache.Resource{newRes, oldRes} {
 			if r == nil {
 				continue
@@ -770,22 +900,7 @@ func (c *liveStateCache) watchSettings(ctx context.Context) {
 	for !done {
 		select {
 		case <-updateCh:
-			nextCacheSettings, err := c.loadCacheSettings()
-			if err != nil {
-				log.Warnf("Failed to read updated settings: %v", err)
-				continue
-			}
-
-			c.lock.Lock()
-			needInvalidate := false
-			if !reflect.DeepEqual(c.cacheSettings, *nextCacheSettings) {
-				c.cacheSettings = *nextCacheSettings
-				needInvalidate = true
-			}
-			c.lock.Unlock()
-			if needInvalidate {
-				c.invalidate(*nextCacheSettings)
-			}
+			c.updateSettings()
 		case <-ctx.Done():
 			done = true
 		}
@@ -795,6 +910,26 @@ func (c *liveStateCache) watchSettings(ctx context.Context) {
 	close(updateCh)
 }
 
+// updateSettings reloads the cache settings, and invalidates the cluster caches if the settings changed
+func (c *liveStateCache) updateSettings() {
+	nextCacheSettings, err := c.loadCacheSettings()
+	if err != nil {
+		log.Warnf("Failed to read updated settings: %v", err)
+		return
+	}
+
+	c.lock.Lock()
+	needInvalidate := false
+	if !reflect.DeepEqual(c.cacheSettings, *nextCacheSettings) {
+		c.cacheSettings = *nextCacheSettings
+		needInvalidate = true
+	}
+	c.lock.Unlock()
+	if needInvalidate {
+		c.invalidate(*nextCacheSettings)
+	}
+}
+
 func (c *liveStateCache) Init() error {
 	cacheSettings, err := c.loadCacheSettings()
 	if err != nil {
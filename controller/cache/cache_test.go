@@ -833,6 +833,61 @@ func TestLoadCacheSettings(t *testing.T) {
 	assert.True(t, res.ignoreResourceUpdatesEnabled)
 }
 
+func TestUpdateSettings(t *testing.T) {
+	kubeClient, settingsManager := fixtures(t.Context(), map[string]string{
+		"application.instanceLabelKey": "testLabel",
+		"resource.metadataOnly":        "- apiGroups: [\"\"]\n  kinds: [\"ConfigMap\"]\n  clusters: [\"*\"]\n",
+	})
+	clusterCache := &mocks.ClusterCache{}
+	c := &liveStateCache{
+		settingsMgr: settingsManager,
+		clusters:    map[string]cache.ClusterCache{"https://mycluster": clusterCache},
+	}
+	require.NoError(t, c.Init())
+	// the lookup memoizes the health check of the kind in the metadata-only filter
+	assert.True(t, isMetadataOnlyResource(c.cacheSettings.clusterSettings, schema.GroupKind{Kind: "ConfigMap"}, "https://mycluster"))
+
+	// the settings did not change
+	c.updateSettings()
+	clusterCache.AssertNotCalled(t, "Invalidate", mock.Anything)
+
+	cm, err := kubeClient.CoreV1().ConfigMaps("default").Get(t.Context(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	cm.Data["application.instanceLabelKey"] = "otherLabel"
+	_, err = kubeClient.CoreV1().ConfigMaps("default").Update(t.Context(), cm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		label, err := settingsManager.GetAppInstanceLabelKey()
+		return err == nil && label == "otherLabel"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	clusterCache.EXPECT().Invalidate(mock.Anything).Return().Once()
+	c.updateSettings()
+	clusterCache.AssertNumberOfCalls(t, "Invalidate", 1)
+	assert.Equal(t, "otherLabel", c.cacheSettings.appInstanceLabelKey)
+}
+
+func TestMetadataOnlyFilter(t *testing.T) {
+	t.Parallel()
+	filter := newMetadataOnlyFilter(&argosettings.ResourcesFilter{
+		MetadataOnlyResources: []argosettings.FilteredResource{{APIGroups: []string{"*"}, Kinds: []string{"*"}, Clusters: []string{"*"}}},
+	}, map[string]appv1.ResourceOverride{
+		"example.com/Widget": {HealthLua: `return {status = "Healthy"}`},
+	})
+
+	// no health check
+	assert.True(t, filter.IsMetadataOnlyResource("", "ConfigMap", "https://kubernetes.default.svc"))
+	assert.True(t, filter.IsMetadataOnlyResource("", "Secret", "https://kubernetes.default.svc"))
+	// built-in health check
+	assert.False(t, filter.IsMetadataOnlyResource("apps", "Deployment", "https://kubernetes.default.svc"))
+	// built-in Lua health script
+	assert.False(t, filter.IsMetadataOnlyResource("cert-manager.io", "Certificate", "https://kubernetes.default.svc"))
+	// health script of the resource customizations
+	assert.False(t, filter.IsMetadataOnlyResource("example.com", "Widget", "https://kubernetes.default.svc"))
+
+	assert.False(t, newMetadataOnlyFilter(&argosettings.ResourcesFilter{}, nil).IsMetadataOnlyResource("", "ConfigMap", "https://kubernetes.default.svc"))
+}
+
 func Test_ownerRefGV(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
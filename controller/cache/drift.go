@@ -0,0 +1,123 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	jsonpatch "github.com/evanphx/json-patch"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ResourceDriftHandler is called when a resource managed by an application is changed by another field manager than
+// Argo CD. The appName is given as <namespace>_<name>.
+type ResourceDriftHandler = func(appName string, drift *appv1.ResourceDrift)
+
+// maxDriftDiffLength is the maximum length of the diff of a drift
+const maxDriftDiffLength = 4096
+
+// lastManagedFieldsChange returns the managed fields entry of the manager which made the change from the old to the new
+// state of the object, ignoring the changes of subresources such as the status. It returns nil if the change cannot be
+// attributed.
+func lastManagedFieldsChange(oldObj, newObj *unstructured.Unstructured) *metav1.ManagedFieldsEntry {
+	oldEntries := oldObj.GetManagedFields()
+	var last *metav1.ManagedFieldsEntry
+	for _, entry := range newObj.GetManagedFields() {
+		if entry.Subresource != "" || entry.Time == nil {
+			continue
+		}
+		unchanged := false
+		for _, oldEntry := range oldEntries {
+			if oldEntry.Manager == entry.Manager && oldEntry.Operation == entry.Operation && oldEntry.Subresource == entry.Subresource {
+				unchanged = oldEntry.Time.Equal(entry.Time) && managedFieldsEqual(oldEntry.FieldsV1, entry.FieldsV1)
+				break
+			}
+		}
+		if !unchanged && (last == nil || last.Time.Before(entry.Time)) {
+			last = entry.DeepCopy()
+		}
+	}
+	return last
+}
+
+func managedFieldsEqual(a, b *metav1.FieldsV1) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return bytes.Equal(a.Raw, b.Raw)
+}
+
+// driftState returns the JSON of the state of the object without the fields which are not changed by users
+func driftState(obj *unstructured.Unstructured) ([]byte, error) {
+	obj = obj.DeepCopy()
+	unstructured.RemoveNestedField(obj.Object, "status")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "generation")
+	return json.Marshal(obj.Object)
+}
+
+// hideSecretData replaces the values of the data and of the given sensitive annotations of the old and new state of a
+// secret, while preserving which values differ, so that the diff of a drift only reveals which keys were changed
+func hideSecretData(oldObj, newObj *unstructured.Unstructured, sensitiveAnnotations map[string]bool) (*unstructured.Unstructured, *unstructured.Unstructured, error) {
+	oldObj, newObj = oldObj.DeepCopy(), newObj.DeepCopy()
+	// the last applied configuration holds the data of the secret
+	for _, obj := range []*unstructured.Unstructured{oldObj, newObj} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations", corev1.LastAppliedConfigAnnotation)
+	}
+	return diff.HideSecretData(oldObj, newObj, sensitiveAnnotations)
+}
+
+// detectResourceDrift returns the drift of the resource if its state was changed from the old to the new state by
+// another field manager than Argo CD, or nil. The data of secrets is hidden in the diff.
+func detectResourceDrift(oldObj, newObj *unstructured.Unstructured, sensitiveAnnotations map[string]bool, now time.Time) (*appv1.ResourceDrift, error) {
+	entry := lastManagedFieldsChange(oldObj, newObj)
+	if entry == nil || entry.Manager == common.ArgoCDSSAManager {
+		return nil, nil
+	}
+	if gvk := newObj.GroupVersionKind(); gvk.Group == "" && gvk.Kind == kube.SecretKind {
+		var err error
+		oldObj, newObj, err = hideSecretData(oldObj, newObj, sensitiveAnnotations)
+		if err != nil {
+			return nil, fmt.Errorf("error hiding secret data: %w", err)
+		}
+	}
+	oldState, err := driftState(oldObj)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling previous state: %w", err)
+	}
+	newState, err := driftState(newObj)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling new state: %w", err)
+	}
+	patch, err := jsonpatch.CreateMergePatch(oldState, newState)
+	if err != nil {
+		return nil, fmt.Errorf("error creating diff: %w", err)
+	}
+	if string(patch) == "{}" {
+		return nil, nil
+	}
+	diff := string(patch)
+	if len(diff) > maxDriftDiffLength {
+		diff = diff[:maxDriftDiffLength] + "..."
+	}
+	gvk := newObj.GroupVersionKind()
+	return &appv1.ResourceDrift{
+		Group:      gvk.Group,
+		Kind:       gvk.Kind,
+		Namespace:  newObj.GetNamespace(),
+		Name:       newObj.GetName(),
+		Manager:    entry.Manager,
+		Operation:  string(entry.Operation),
+		Diff:       diff,
+		DetectedAt: metav1.NewTime(now),
+	}, nil
+}
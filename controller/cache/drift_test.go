@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newDriftTestDeployment(replicas int, managedFields string) *unstructured.Unstructured {
+	return strToUnstructured(fmt.Sprintf(`
+  apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: guestbook
+    namespace: default
+    resourceVersion: "%d"
+    managedFields:%s
+  spec:
+    replicas: %d
+  status:
+    replicas: %d
+`, replicas, managedFields, replicas, replicas))
+}
+
+const argoCDManagedFields = `
+    - manager: argocd-controller
+      operation: Apply
+      apiVersion: apps/v1
+      time: "2025-01-01T00:00:00Z"
+      fieldsType: FieldsV1
+      fieldsV1: {"f:spec": {"f:replicas": {}}}
+    - manager: kube-controller-manager
+      operation: Update
+      subresource: status
+      apiVersion: apps/v1
+      time: "2025-01-01T00:00:00Z"
+      fieldsType: FieldsV1
+      fieldsV1: {"f:status": {"f:replicas": {}}}`
+
+func TestDetectResourceDrift(t *testing.T) {
+	oldObj := newDriftTestDeployment(1, argoCDManagedFields)
+	newObj := newDriftTestDeployment(3, argoCDManagedFields+`
+    - manager: kubectl-edit
+      operation: Update
+      apiVersion: apps/v1
+      time: "2025-01-01T00:01:00Z"
+      fieldsType: FieldsV1
+      fieldsV1: {"f:spec": {"f:replicas": {}}}`)
+	now := time.Now()
+
+	drift, err := detectResourceDrift(oldObj, newObj, nil, now)
+	require.NoError(t, err)
+	require.NotNil(t, drift)
+	assert.Equal(t, "apps", drift.Group)
+	assert.Equal(t, "Deployment", drift.Kind)
+	assert.Equal(t, "default", drift.Namespace)
+	assert.Equal(t, "guestbook", drift.Name)
+	assert.Equal(t, "kubectl-edit", drift.Manager)
+	assert.Equal(t, "Update", drift.Operation)
+	assert.JSONEq(t, `{"spec":{"replicas":3}}`, drift.Diff)
+	assert.True(t, now.Equal(drift.DetectedAt.Time))
+}
+
+func TestDetectResourceDrift_ArgoCDManager(t *testing.T) {
+	oldObj := newDriftTestDeployment(1, argoCDManagedFields)
+	newObj := newDriftTestDeployment(3, strings.Replace(argoCDManagedFields, `time: "2025-01-01T00:00:00Z"`, `time: "2025-01-01T00:01:00Z"`, 1))
+
+	drift, err := detectResourceDrift(oldObj, newObj, nil, time.Now())
+	require.NoError(t, err)
+	assert.Nil(t, drift)
+}
+
+func TestDetectResourceDrift_StatusChange(t *testing.T) {
+	oldObj := newDriftTestDeployment(1, argoCDManagedFields)
+	newObj := newDriftTestDeployment(1, strings.Replace(argoCDManagedFields, `time: "2025-01-01T00:00:00Z"
+      fieldsType: FieldsV1
+      fieldsV1: {"f:status"`, `time: "2025-01-01T00:01:00Z"
+      fieldsType: FieldsV1
+      fieldsV1: {"f:status"`, 1))
+	newObj.Object["status"] = map[string]any{"replicas": int64(3)}
+
+	drift, err := detectResourceDrift(oldObj, newObj, nil, time.Now())
+	require.NoError(t, err)
+	assert.Nil(t, drift)
+}
+
+func TestDetectResourceDrift_NoChange(t *testing.T) {
+	oldObj := newDriftTestDeployment(1, argoCDManagedFields)
+	newObj := newDriftTestDeployment(1, argoCDManagedFields+`
+    - manager: kubectl-label
+      operation: Update
+      apiVersion: apps/v1
+      time: "2025-01-01T00:01:00Z"
+      fieldsType: FieldsV1
+      fieldsV1: {"f:metadata": {"f:labels": {}}}`)
+
+	drift, err := detectResourceDrift(oldObj, newObj, nil, time.Now())
+	require.NoError(t, err)
+	assert.Nil(t, drift)
+}
+
+func TestDetectResourceDrift_TruncatesDiff(t *testing.T) {
+	oldObj := newDriftTestDeployment(1, argoCDManagedFields)
+	newObj := newDriftTestDeployment(1, argoCDManagedFields+`
+    - manager: kubectl-annotate
+      operation: Update
+      apiVersion: apps/v1
+      time: "2025-01-01T00:01:00Z"
+      fieldsType: FieldsV1
+      fieldsV1: {"f:metadata": {"f:annotations": {}}}`)
+	newObj.SetAnnotations(map[string]string{"note": strings.Repeat("a", maxDriftDiffLength)})
+
+	drift, err := detectResourceDrift(oldObj, newObj, nil, time.Now())
+	require.NoError(t, err)
+	require.NotNil(t, drift)
+	assert.Len(t, drift.Diff, maxDriftDiffLength+len("..."))
+	assert.True(t, strings.HasSuffix(drift.Diff, "..."))
+}
+
+func TestDetectResourceDrift_HidesSecretData(t *testing.T) {
+	newSecret := func(password string, managedFields string) *unstructured.Unstructured {
+		return strToUnstructured(fmt.Sprintf(`
+  apiVersion: v1
+  kind: Secret
+  metadata:
+    name: credentials
+    namespace: default
+    annotations:
+      kubectl.kubernetes.io/last-applied-configuration: '{"data":{"password":"%[1]s"}}'
+      token: %[1]s
+    managedFields:%[2]s
+  data:
+    username: YWRtaW4=
+    password: %[1]s
+`, password, managedFields))
+	}
+	managedFields := `
+    - manager: argocd-controller
+      operation: Apply
+      apiVersion: v1
+      time: "2025-01-01T00:00:00Z"
+      fieldsType: FieldsV1
+      fieldsV1: {"f:data": {"f:password": {}}}`
+	oldObj := newSecret("b2xk", managedFields)
+	newObj := newSecret("bmV3LXBhc3N3b3Jk", managedFields+`
+    - manager: kubectl-edit
+      operation: Update
+      apiVersion: v1
+      time: "2025-01-01T00:01:00Z"
+      fieldsType: FieldsV1
+      fieldsV1: {"f:data": {"f:password": {}}}`)
+
+	drift, err := detectResourceDrift(oldObj, newObj, map[string]bool{"token": true}, time.Now())
+	require.NoError(t, err)
+	require.NotNil(t, drift)
+	assert.Contains(t, drift.Diff, `"password"`)
+	assert.NotContains(t, drift.Diff, "username")
+	assert.NotContains(t, drift.Diff, "bmV3LXBhc3N3b3Jk")
+	assert.NotContains(t, drift.Diff, "b2xk")
+	// the secret in the cache is not modified
+	assert.Equal(t, "bmV3LXBhc3N3b3Jk", newObj.Object["data"].(map[string]any)["password"])
+}
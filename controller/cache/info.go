@@ -25,8 +25,8 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
-func populateNodeInfo(un *unstructured.Unstructured, res *ResourceInfo, customLabels []string) {
-	gvk := un.GroupVersionKind()
+// populateMetadataInfo populates the information about a resource which is available in its metadata
+func populateMetadataInfo(un *unstructured.Unstructured, res *ResourceInfo, customLabels []string) {
 	revision := resource.GetRevision(un)
 	if revision > 0 {
 		res.Info = append(res.Info, v1alpha1.InfoItem{Name: "Revision", Value: fmt.Sprintf("Rev:%v", revision)})
@@ -60,7 +60,12 @@ func populateNodeInfo(un *unstructured.Unstructured, res *ResourceInfo, customLa
 		}
 		res.NetworkingInfo.ExternalURLs = append(res.NetworkingInfo.ExternalURLs, v)
 	}
+}
 
+func populateNodeInfo(un *unstructured.Unstructured, res *ResourceInfo, customLabels []string) {
+	populateMetadataInfo(un, res, customLabels)
+
+	gvk := un.GroupVersionKind()
 	switch gvk.Group {
 	case "":
 		switch gvk.Kind {
@@ -0,0 +1,273 @@
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// clusterCacheSnapshotStoreDisk persists cluster cache snapshots to the local disk
+	clusterCacheSnapshotStoreDisk = "disk"
+	// clusterCacheSnapshotStoreRedis persists cluster cache snapshots to Redis
+	clusterCacheSnapshotStoreRedis = "redis"
+)
+
+// newClusterCacheSnapshotStore returns the store configured by the ARGOCD_CLUSTER_CACHE_SNAPSHOT_STORE env variable, or
+// nil if snapshots are disabled.
+func newClusterCacheSnapshotStore(argoCache *appstatecache.Cache) clustercache.SnapshotStore {
+	switch clusterCacheSnapshotStore {
+	case "":
+		return nil
+	case clusterCacheSnapshotStoreDisk:
+		if clusterCacheSnapshotDir == "" {
+			log.Warnf("Cluster cache snapshots are disabled: %s store requires the %s env variable to be set to a dedicated volume",
+				clusterCacheSnapshotStoreDisk, EnvClusterCacheSnapshotDir)
+			return nil
+		}
+		return &diskSnapshotStore{dir: clusterCacheSnapshotDir, maxAge: clusterCacheSnapshotMaxAge}
+	case clusterCacheSnapshotStoreRedis:
+		if argoCache == nil {
+			log.Warnf("Cluster cache snapshots are disabled: %s store requires a Redis cache", clusterCacheSnapshotStoreRedis)
+			return nil
+		}
+		return &redisSnapshotStore{cache: argoCache, maxAge: clusterCacheSnapshotMaxAge, maxSize: maxRedisSnapshotSize}
+	default:
+		log.Warnf("Cluster cache snapshots are disabled: unknown %s value '%s', supported values are '%s' and '%s'",
+			EnvClusterCacheSnapshotStore, clusterCacheSnapshotStore, clusterCacheSnapshotStoreDisk, clusterCacheSnapshotStoreRedis)
+		return nil
+	}
+}
+
+// isSnapshotExpired returns true if the snapshot is older than the max age. Snapshots never expire if max age is 0.
+func isSnapshotExpired(snapshot *clustercache.ClusterSnapshot, maxAge time.Duration) bool {
+	return maxAge > 0 && time.Since(snapshot.CreatedAt) > maxAge
+}
+
+// diskSnapshotStore persists cluster cache snapshots as gzipped JSON files, one file per cluster. The directory and the
+// files are only accessible by the controller user.
+type diskSnapshotStore struct {
+	dir    string
+	maxAge time.Duration
+}
+
+func (s *diskSnapshotStore) path(server string) string {
+	sum := sha256.Sum256([]byte(server))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+".json.gz")
+}
+
+func (s *diskSnapshotStore) Load(_ context.Context, server string) (*clustercache.ClusterSnapshot, error) {
+	f, err := os.Open(s.path(server))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error opening snapshot: %w", err)
+	}
+	defer utilio.Close(f)
+	reader, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot: %w", err)
+	}
+	defer utilio.Close(reader)
+	var snapshot clustercache.ClusterSnapshot
+	if err := json.NewDecoder(reader).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("error decoding snapshot: %w", err)
+	}
+	if isSnapshotExpired(&snapshot, s.maxAge) {
+		return nil, nil
+	}
+	return &snapshot, nil
+}
+
+func (s *diskSnapshotStore) Save(_ context.Context, snapshot *clustercache.ClusterSnapshot) error {
+	if err := s.ensureDir(); err != nil {
+		return err
+	}
+	// write to a temporary file first, so that a partially written snapshot never replaces the previous one
+	f, err := os.CreateTemp(s.dir, ".snapshot-*")
+	if err != nil {
+		return fmt.Errorf("error creating snapshot file: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	writer := gzip.NewWriter(f)
+	err = json.NewEncoder(writer).Encode(snapshot)
+	if err == nil {
+		err = writer.Close()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("error writing snapshot: %w", err)
+	}
+	if err := os.Rename(f.Name(), s.path(snapshot.Server)); err != nil {
+		return fmt.Errorf("error replacing snapshot: %w", err)
+	}
+	return nil
+}
+
+// ensureDir creates the snapshot directory, and restricts the permissions of an existing directory to its owner
+func (s *diskSnapshotStore) ensureDir() error {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return fmt.Errorf("error creating snapshot directory: %w", err)
+	}
+	info, err := os.Stat(s.dir)
+	if err != nil {
+		return fmt.Errorf("error reading snapshot directory: %w", err)
+	}
+	if info.Mode().Perm()&0o077 != 0 {
+		if err := os.Chmod(s.dir, 0o700); err != nil {
+			return fmt.Errorf("snapshot directory %s must only be accessible by its owner: %w", s.dir, err)
+		}
+	}
+	return nil
+}
+
+// maxRedisSnapshotSize is the maximum size of a compressed snapshot stored in Redis. Larger snapshots are not stored,
+// since writing them would block Redis and might exceed the maximum size of its values.
+const maxRedisSnapshotSize = 64 * 1024 * 1024
+
+// redisSnapshotStore persists cluster cache snapshots as gzipped JSON to the Redis cache shared by the controller
+// replicas. The snapshots are compressed by the store whatever the compression of the Redis client, because they hold
+// every resource of a cluster.
+type redisSnapshotStore struct {
+	cache   *appstatecache.Cache
+	maxAge  time.Duration
+	maxSize int
+}
+
+func (s *redisSnapshotStore) Load(_ context.Context, server string) (*clustercache.ClusterSnapshot, error) {
+	var data []byte
+	if err := s.cache.GetClusterCacheSnapshot(server, &data); err != nil {
+		if errors.Is(err, appstatecache.ErrCacheMiss) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting snapshot: %w", err)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot: %w", err)
+	}
+	defer utilio.Close(reader)
+	var snapshot clustercache.ClusterSnapshot
+	if err := json.NewDecoder(reader).Decode(&snapshot); err != nil {
+		return nil, fmt.Errorf("error decoding snapshot: %w", err)
+	}
+	if isSnapshotExpired(&snapshot, s.maxAge) {
+		return nil, nil
+	}
+	return &snapshot, nil
+}
+
+// Save stores the snapshot unless its compressed size exceeds the max size. If the snapshot cannot be stored, the
+// previous snapshot of the cluster is deleted, so that the cluster cache is fully synchronized on the next start rather
+// than restored from an outdated snapshot.
+func (s *redisSnapshotStore) Save(_ context.Context, snapshot *clustercache.ClusterSnapshot) error {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	err := json.NewEncoder(writer).Encode(snapshot)
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("error encoding snapshot: %w", err)
+	}
+	if s.maxSize > 0 && buf.Len() > s.maxSize {
+		err = fmt.Errorf("snapshot size %d exceeds the maximum size %d", buf.Len(), s.maxSize)
+	} else {
+		err = s.cache.SetClusterCacheSnapshot(snapshot.Server, buf.Bytes(), s.maxAge)
+	}
+	if err != nil {
+		if deleteErr := s.cache.SetClusterCacheSnapshot(snapshot.Server, nil, 0); deleteErr != nil {
+			log.Warnf("Failed to delete the previous snapshot of cluster %s: %v", snapshot.Server, deleteErr)
+		}
+		return fmt.Errorf("error storing snapshot: %w", err)
+	}
+	return nil
+}
+
+// resourceInfoSnapshot is the serializable form of ResourceInfo
+type resourceInfoSnapshot struct {
+	*ResourceInfo
+	ManifestHash string `json:"manifestHash,omitempty"`
+}
+
+// resourceInfoSnapshotCodec encodes the ResourceInfo of the resources of a cluster in snapshots
+type resourceInfoSnapshotCodec struct {
+	cache        *liveStateCache
+	customLabels []string
+}
+
+// Fingerprint identifies the settings which ResourceInfo is populated with. The version of Argo CD is part of the
+// fingerprint, so that snapshots taken by another version are not restored.
+func (c *resourceInfoSnapshotCodec) Fingerprint() string {
+	c.cache.lock.RLock()
+	cacheSettings := c.cache.cacheSettings
+	c.cache.lock.RUnlock()
+
+	data, err := json.Marshal(struct {
+		Version                      string
+		AppInstanceLabelKey          string
+		TrackingMethod               string
+		InstallationID               string
+		ResourceHealthOverride       health.HealthOverride
+		ResourceOverrides            any
+		IgnoreResourceUpdatesEnabled bool
+		IgnoreNormalizerOpts         normalizers.IgnoreNormalizerOpts
+		CustomLabels                 []string
+	}{
+		Version:                      common.GetVersion().Version,
+		AppInstanceLabelKey:          cacheSettings.appInstanceLabelKey,
+		TrackingMethod:               string(cacheSettings.trackingMethod),
+		InstallationID:               cacheSettings.installationID,
+		ResourceHealthOverride:       cacheSettings.clusterSettings.ResourceHealthOverride,
+		ResourceOverrides:            cacheSettings.resourceOverrides,
+		IgnoreResourceUpdatesEnabled: cacheSettings.ignoreResourceUpdatesEnabled,
+		IgnoreNormalizerOpts:         c.cache.ignoreNormalizerOpts,
+		CustomLabels:                 c.customLabels,
+	})
+	if err != nil {
+		// a unique fingerprint ensures that settings which cannot be fingerprinted never match a snapshot
+		log.Warnf("Failed to fingerprint cluster cache settings: %v", err)
+		return fmt.Sprintf("unknown-%d", time.Now().UnixNano())
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *resourceInfoSnapshotCodec) Encode(info any) ([]byte, error) {
+	res, ok := info.(*ResourceInfo)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource info type %T", info)
+	}
+	return json.Marshal(resourceInfoSnapshot{ResourceInfo: res, ManifestHash: res.manifestHash})
+}
+
+func (c *resourceInfoSnapshotCodec) Decode(data []byte) (any, error) {
+	snapshot := resourceInfoSnapshot{ResourceInfo: &ResourceInfo{}}
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+	snapshot.manifestHash = snapshot.ManifestHash
+	return snapshot.ResourceInfo, nil
+}
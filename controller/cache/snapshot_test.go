@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+)
+
+func testClusterSnapshot(createdAt time.Time) *clustercache.ClusterSnapshot {
+	return &clustercache.ClusterSnapshot{
+		Version:   1,
+		Server:    "https://cluster",
+		CreatedAt: createdAt,
+		APIs: []clustercache.APISnapshot{{
+			Kind:            "Pod",
+			ResourceVersion: "123",
+			Resources: []clustercache.ResourceSnapshot{{
+				ResourceVersion: "100",
+				Resource: &unstructured.Unstructured{Object: map[string]any{
+					"apiVersion": "v1",
+					"kind":       "Pod",
+					"metadata":   map[string]any{"name": "my-pod", "namespace": "default"},
+				}},
+			}},
+		}},
+	}
+}
+
+func TestDiskSnapshotStore(t *testing.T) {
+	store := &diskSnapshotStore{dir: t.TempDir(), maxAge: time.Hour}
+
+	t.Run("NoSnapshot", func(t *testing.T) {
+		snapshot, err := store.Load(t.Context(), "https://cluster")
+		require.NoError(t, err)
+		assert.Nil(t, snapshot)
+	})
+	t.Run("SaveAndLoad", func(t *testing.T) {
+		saved := testClusterSnapshot(time.Now().UTC().Truncate(time.Second))
+		require.NoError(t, store.Save(t.Context(), saved))
+		// saving again replaces the previous snapshot
+		require.NoError(t, store.Save(t.Context(), saved))
+
+		loaded, err := store.Load(t.Context(), "https://cluster")
+		require.NoError(t, err)
+		assert.Equal(t, saved, loaded)
+
+		other, err := store.Load(t.Context(), "https://other-cluster")
+		require.NoError(t, err)
+		assert.Nil(t, other)
+	})
+	t.Run("Expired", func(t *testing.T) {
+		require.NoError(t, store.Save(t.Context(), testClusterSnapshot(time.Now().Add(-2*time.Hour))))
+		snapshot, err := store.Load(t.Context(), "https://cluster")
+		require.NoError(t, err)
+		assert.Nil(t, snapshot)
+	})
+	t.Run("Permissions", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "snapshots")
+		require.NoError(t, os.Mkdir(dir, 0o755))
+		store := &diskSnapshotStore{dir: dir, maxAge: time.Hour}
+		require.NoError(t, store.Save(t.Context(), testClusterSnapshot(time.Now())))
+
+		info, err := os.Stat(dir)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o700), info.Mode().Perm())
+		info, err = os.Stat(store.path("https://cluster"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	})
+}
+
+func TestNewClusterCacheSnapshotStore_DiskRequiresDir(t *testing.T) {
+	defer func(store, dir string) {
+		clusterCacheSnapshotStore, clusterCacheSnapshotDir = store, dir
+	}(clusterCacheSnapshotStore, clusterCacheSnapshotDir)
+
+	clusterCacheSnapshotStore, clusterCacheSnapshotDir = clusterCacheSnapshotStoreDisk, ""
+	assert.Nil(t, newClusterCacheSnapshotStore(nil))
+
+	clusterCacheSnapshotDir = t.TempDir()
+	assert.Equal(t, &diskSnapshotStore{dir: clusterCacheSnapshotDir, maxAge: clusterCacheSnapshotMaxAge}, newClusterCacheSnapshotStore(nil))
+}
+
+func TestRedisSnapshotStore(t *testing.T) {
+	argoCache := appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Minute)), time.Minute)
+	store := &redisSnapshotStore{cache: argoCache, maxAge: time.Hour, maxSize: maxRedisSnapshotSize}
+
+	t.Run("NoSnapshot", func(t *testing.T) {
+		snapshot, err := store.Load(t.Context(), "https://cluster")
+		require.NoError(t, err)
+		assert.Nil(t, snapshot)
+	})
+	t.Run("SaveAndLoad", func(t *testing.T) {
+		saved := testClusterSnapshot(time.Now().UTC().Truncate(time.Second))
+		require.NoError(t, store.Save(t.Context(), saved))
+		loaded, err := store.Load(t.Context(), "https://cluster")
+		require.NoError(t, err)
+		assert.Equal(t, saved, loaded)
+
+		// the snapshot is stored compressed
+		var data []byte
+		require.NoError(t, argoCache.GetClusterCacheSnapshot("https://cluster", &data))
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+	})
+	t.Run("TooLarge", func(t *testing.T) {
+		require.NoError(t, store.Save(t.Context(), testClusterSnapshot(time.Now())))
+
+		small := &redisSnapshotStore{cache: argoCache, maxAge: time.Hour, maxSize: 10}
+		require.ErrorContains(t, small.Save(t.Context(), testClusterSnapshot(time.Now())), "exceeds the maximum size 10")
+		// the previous snapshot is deleted, so that the cluster is fully synchronized on the next start
+		snapshot, err := store.Load(t.Context(), "https://cluster")
+		require.NoError(t, err)
+		assert.Nil(t, snapshot)
+	})
+}
+
+func TestResourceInfoSnapshotCodec(t *testing.T) {
+	c := &liveStateCache{cacheSettings: cacheSettings{appInstanceLabelKey: "app"}}
+	codec := &resourceInfoSnapshotCodec{cache: c, customLabels: []string{"team"}}
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		info := &ResourceInfo{
+			Info:         []appv1.InfoItem{{Name: "Revision", Value: "Rev:2"}},
+			AppName:      "my-app",
+			Images:       []string{"nginx:1.25"},
+			Health:       &health.HealthStatus{Status: health.HealthStatusHealthy},
+			PodInfo:      &PodInfo{NodeName: "node-1"},
+			manifestHash: "abc",
+		}
+		data, err := codec.Encode(info)
+		require.NoError(t, err)
+		decoded, err := codec.Decode(data)
+		require.NoError(t, err)
+		assert.Equal(t, info, decoded)
+	})
+	t.Run("UnexpectedType", func(t *testing.T) {
+		_, err := codec.Encode("info")
+		require.ErrorContains(t, err, "unexpected resource info type string")
+	})
+	t.Run("Fingerprint", func(t *testing.T) {
+		fingerprint := codec.Fingerprint()
+		assert.NotEmpty(t, fingerprint)
+		assert.Equal(t, fingerprint, codec.Fingerprint())
+
+		c.cacheSettings.appInstanceLabelKey = "other"
+		assert.NotEqual(t, fingerprint, codec.Fingerprint())
+		c.cacheSettings.appInstanceLabelKey = "app"
+
+		otherLabels := &resourceInfoSnapshotCodec{cache: c, customLabels: []string{"owner"}}
+		assert.NotEqual(t, fingerprint, otherLabels.Fingerprint())
+	})
+}
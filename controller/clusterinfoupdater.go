@@ -32,14 +32,15 @@ const (
 var clusterInfoTimeout = env.ParseDurationFromEnv(EnvClusterInfoTimeout, defaultSecretUpdateInterval, defaultSecretUpdateInterval, 1*time.Minute)
 
 type clusterInfoUpdater struct {
-	infoSource    metrics.HasClustersInfo
-	db            db.ArgoDB
-	appLister     v1alpha1.ApplicationNamespaceLister
-	cache         *appstatecache.Cache
-	clusterFilter func(cluster *appv1.Cluster) bool
-	projGetter    func(app *appv1.Application) (*appv1.AppProject, error)
-	namespace     string
-	lastUpdated   time.Time
+	infoSource              metrics.HasClustersInfo
+	db                      db.ArgoDB
+	appLister               v1alpha1.ApplicationNamespaceLister
+	cache                   *appstatecache.Cache
+	clusterFilter           func(cluster *appv1.Cluster) bool
+	projGetter              func(app *appv1.Application) (*appv1.AppProject, error)
+	updateClustersCacheInfo func(getClusterCacheInfo func(server string) (*appv1.ClusterCacheInfo, error))
+	namespace               string
+	lastUpdated             time.Time
 }
 
 func NewClusterInfoUpdater(
@@ -49,9 +50,10 @@ func NewClusterInfoUpdater(
 	cache *appstatecache.Cache,
 	clusterFilter func(cluster *appv1.Cluster) bool,
 	projGetter func(app *appv1.Application) (*appv1.AppProject, error),
+	updateClustersCacheInfo func(getClusterCacheInfo func(server string) (*appv1.ClusterCacheInfo, error)),
 	namespace string,
 ) *clusterInfoUpdater {
-	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, namespace, time.Time{}}
+	return &clusterInfoUpdater{infoSource, db, appLister, cache, clusterFilter, projGetter, updateClustersCacheInfo, namespace, time.Time{}}
 }
 
 func (c *clusterInfoUpdater) Run(ctx context.Context) {
@@ -111,6 +113,19 @@ func (c *clusterInfoUpdater) updateClusters() {
 		return nil
 	})
 	log.Debugf("Successfully saved info of %d clusters", len(clustersFiltered))
+
+	if c.updateClustersCacheInfo != nil {
+		// the cache info of the clusters of the other shards is saved by their own controllers
+		c.updateClustersCacheInfo(c.getClusterCacheInfo)
+	}
+}
+
+func (c *clusterInfoUpdater) getClusterCacheInfo(server string) (*appv1.ClusterCacheInfo, error) {
+	var info appv1.ClusterInfo
+	if err := c.cache.GetClusterInfo(server, &info); err != nil {
+		return nil, fmt.Errorf("error getting cluster info from cache: %w", err)
+	}
+	return &info.CacheInfo, nil
 }
 
 func (c *clusterInfoUpdater) updateClusterInfo(ctx context.Context, cluster appv1.Cluster, info *cache.ClusterInfo) error {
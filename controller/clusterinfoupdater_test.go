@@ -88,7 +88,7 @@ func TestClusterSecretUpdater(t *testing.T) {
 		}
 
 		lister := applisters.NewApplicationLister(appInformer.GetIndexer()).Applications(fakeNamespace)
-		updater := NewClusterInfoUpdater(nil, argoDB, lister, appCache, nil, nil, fakeNamespace)
+		updater := NewClusterInfoUpdater(nil, argoDB, lister, appCache, nil, nil, nil, fakeNamespace)
 
 		err = updater.updateClusterInfo(t.Context(), *cluster, info)
 		require.NoError(t, err, "Invoking updateClusterInfo failed.")
@@ -101,6 +101,20 @@ func TestClusterSecretUpdater(t *testing.T) {
 	}
 }
 
+func TestGetClusterCacheInfo(t *testing.T) {
+	appCache := appstate.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Minute)), time.Minute)
+	updater := &clusterInfoUpdater{cache: appCache}
+
+	_, err := updater.getClusterCacheInfo("https://unknown.example.com")
+	require.Error(t, err)
+
+	err = appCache.SetClusterInfo("https://prod.example.com", &v1alpha1.ClusterInfo{CacheInfo: v1alpha1.ClusterCacheInfo{ResourcesCount: 42}})
+	require.NoError(t, err)
+	cacheInfo, err := updater.getClusterCacheInfo("https://prod.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), cacheInfo.ResourcesCount)
+}
+
 func TestGetUpdatedClusterInfo_AppCount(t *testing.T) {
 	const fakeNamespace = "fake-ns"
 	const clusterServer = "https://prod.example.com"
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+const (
+	// EnvDriftEventsEnabled is the env variable which enables the events about changes of managed resources made
+	// outside of Argo CD
+	EnvDriftEventsEnabled = "ARGOCD_APPLICATION_CONTROLLER_DRIFT_EVENTS_ENABLED"
+)
+
+// maxDriftEventDiffLength is the maximum length of the diff included in the message of a drift event
+const maxDriftEventDiffLength = 1024
+
+// driftEventsEnabled specifies whether changes of managed resources made outside of Argo CD are reported
+var driftEventsEnabled = false
+
+func init() {
+	driftEventsEnabled = env.ParseBoolFromEnv(EnvDriftEventsEnabled, driftEventsEnabled)
+}
+
+// onResourceDrift returns the handler of the resource drifts detected by the live state cache, or nil if drift events
+// are disabled
+func (ctrl *ApplicationController) onResourceDrift() func(appName string, drift *appv1.ResourceDrift) {
+	if !driftEventsEnabled {
+		return nil
+	}
+	return func(appName string, drift *appv1.ResourceDrift) {
+		// the handler is called from the cluster cache event handler, which must not be blocked by API calls
+		go ctrl.handleResourceDrift(appName, drift)
+	}
+}
+
+// handleResourceDrift emits an event about the change of a managed resource made outside of Argo CD and records it in
+// the status of the application. Changes made while an operation is in progress are ignored.
+func (ctrl *ApplicationController) handleResourceDrift(appName string, drift *appv1.ResourceDrift) {
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(ctrl.toAppKey(appName))
+	if err != nil || !exists || !ctrl.canProcessApp(obj) {
+		return
+	}
+	app := obj.(*appv1.Application)
+	if app.Operation != nil || (app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed()) {
+		return
+	}
+	logCtx := log.WithFields(log.Fields{"application": app.QualifiedName(), "kind": drift.Kind, "name": drift.Name, "namespace": drift.Namespace})
+
+	diff := drift.Diff
+	if len(diff) > maxDriftEventDiffLength {
+		diff = diff[:maxDriftEventDiffLength] + "..."
+	}
+	message := fmt.Sprintf("Resource %s/%s %s was changed outside of Argo CD by %s (%s): %s", drift.Kind, drift.Namespace, drift.Name, drift.Manager, drift.Operation, diff)
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonResourceDrifted, Type: corev1.EventTypeWarning}, message)
+
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"lastResourceDrift": drift,
+		},
+	})
+	if err != nil {
+		logCtx.Errorf("Failed to marshal resource drift: %v", err)
+		return
+	}
+	_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(context.TODO(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		logCtx.Warnf("Failed to record resource drift: %v", err)
+	}
+}
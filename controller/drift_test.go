@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+func newResourceDrift() *v1alpha1.ResourceDrift {
+	return &v1alpha1.ResourceDrift{
+		Group:      "apps",
+		Kind:       "Deployment",
+		Namespace:  "default",
+		Name:       "guestbook",
+		Manager:    "kubectl-edit",
+		Operation:  "Update",
+		Diff:       `{"spec":{"replicas":3}}`,
+		DetectedAt: metav1.NewTime(time.Now().Truncate(time.Second)),
+	}
+}
+
+func TestOnResourceDrift_Disabled(t *testing.T) {
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{newFakeApp(), &defaultProj}}, nil)
+	assert.Nil(t, ctrl.onResourceDrift())
+}
+
+func TestHandleResourceDrift(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	drift := newResourceDrift()
+
+	ctrl.handleResourceDrift(app.InstanceName(ctrl.namespace), drift)
+
+	updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, updated.Status.LastResourceDrift)
+	assert.Equal(t, *drift, *updated.Status.LastResourceDrift)
+
+	events, err := ctrl.kubeClientset.CoreV1().Events(app.Namespace).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, events.Items, 1)
+	assert.Equal(t, argo.EventReasonResourceDrifted, events.Items[0].Reason)
+	assert.Equal(t, corev1.EventTypeWarning, events.Items[0].Type)
+	assert.Contains(t, events.Items[0].Message, "Deployment/default guestbook was changed outside of Argo CD by kubectl-edit (Update)")
+	assert.Contains(t, events.Items[0].Message, `{"spec":{"replicas":3}}`)
+}
+
+func TestHandleResourceDrift_OperationInProgress(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState.Phase = synccommon.OperationRunning
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+
+	ctrl.handleResourceDrift(app.InstanceName(ctrl.namespace), newResourceDrift())
+
+	updated, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, updated.Status.LastResourceDrift)
+	events, err := ctrl.kubeClientset.CoreV1().Events(app.Namespace).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, events.Items)
+}
+
+func TestHandleResourceDrift_UnknownApp(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+
+	ctrl.handleResourceDrift("unknown", newResourceDrift())
+
+	events, err := ctrl.kubeClientset.CoreV1().Events(app.Namespace).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, events.Items)
+}
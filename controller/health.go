@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -9,20 +10,94 @@ import (
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/ignore"
 	kubeutil "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/healthplugin"
 	"github.com/argoproj/argo-cd/v3/util/lua"
 )
 
+// resourceHealthOverrides assesses the health of the resources with the health plugins configured in the resource
+// overrides, and falls back to the health Lua scripts for the other resources. The health assessed by the plugins is
+// recorded, so that it can be reported in the resource tree.
+type resourceHealthOverrides struct {
+	ctx           context.Context
+	overrides     map[string]appv1.ResourceOverride
+	healthPlugins healthplugin.Clientset
+	// getChildren returns the nodes of the resource tree of the given resource
+	getChildren  func(obj *unstructured.Unstructured) ([]*appv1.ResourceNode, error)
+	pluginHealth map[kubeutil.ResourceKey]*appv1.HealthStatus
+}
+
+func (o *resourceHealthOverrides) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	plugin := healthplugin.GetHealthPlugin(o.overrides, obj.GroupVersionKind())
+	if plugin == "" {
+		return lua.ResourceHealthOverrides(o.overrides).GetResourceHealth(obj)
+	}
+	healthStatus, err := o.getPluginHealth(plugin, obj)
+	if o.pluginHealth == nil {
+		o.pluginHealth = make(map[kubeutil.ResourceKey]*appv1.HealthStatus)
+	}
+	if err != nil {
+		o.pluginHealth[kubeutil.GetResourceKey(obj)] = &appv1.HealthStatus{Status: health.HealthStatusUnknown, Message: err.Error()}
+		return nil, err
+	}
+	o.pluginHealth[kubeutil.GetResourceKey(obj)] = &appv1.HealthStatus{Status: healthStatus.Status, Message: healthStatus.Message}
+	return healthStatus, nil
+}
+
+func (o *resourceHealthOverrides) getPluginHealth(plugin string, obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	children, err := o.getChildren(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting children of the resource: %w", err)
+	}
+	return healthplugin.GetResourceHealth(o.ctx, o.healthPlugins, plugin, obj, children)
+}
+
+// setPluginHealth sets the health assessed by the health plugins on the nodes of the resource tree
+func setPluginHealth(tree *appv1.ApplicationTree, pluginHealth map[kubeutil.ResourceKey]*appv1.HealthStatus) {
+	if len(pluginHealth) == 0 {
+		return
+	}
+	for i := range tree.Nodes {
+		node := &tree.Nodes[i]
+		if healthStatus, ok := pluginHealth[kubeutil.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)]; ok {
+			node.Health = healthStatus.DeepCopy()
+		}
+	}
+}
+
+// hasHealthPlugins returns true if the health of any resource is assessed by a health plugin
+func hasHealthPlugins(overrides map[string]appv1.ResourceOverride) bool {
+	for _, override := range overrides {
+		if override.HealthPlugin != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// getPluginHealth returns the health assessed by the health plugins which is set on the nodes of the resource tree
+func getPluginHealth(tree *appv1.ApplicationTree, overrides map[string]appv1.ResourceOverride) map[kubeutil.ResourceKey]*appv1.HealthStatus {
+	pluginHealth := make(map[kubeutil.ResourceKey]*appv1.HealthStatus)
+	for _, node := range tree.Nodes {
+		if node.Health == nil || healthplugin.GetHealthPlugin(overrides, node.GroupKindVersion()) == "" {
+			continue
+		}
+		pluginHealth[kubeutil.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)] = node.Health
+	}
+	return pluginHealth
+}
+
 // maxHealthCausesShown bounds how many causes are rendered in events/logs to keep them readable.
 const maxHealthCausesShown = 3
 
 // setApplicationHealth updates the health statuses of all resources performed in the comparison.
 // It returns the aggregated application health status along with the resources that caused that status.
-func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool) (health.HealthStatusCode, string, error) {
+func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, healthOverrides health.HealthOverride, app *appv1.Application, persistResourceHealth bool) (health.HealthStatusCode, string, error) {
 	var savedErr error
 	var errCount uint
 	var containsResources, containsLiveResources bool
@@ -50,7 +125,6 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 
 		var healthStatus *health.HealthStatus
 		var err error
-		healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
 		if res.Live == nil {
 			healthStatus = &health.HealthStatus{Status: health.HealthStatusMissing}
 		} else {
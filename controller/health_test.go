@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -12,6 +14,7 @@ import (
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -20,6 +23,8 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/healthplugin"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/lua"
 )
 
@@ -398,3 +403,97 @@ return hs`,
 		assert.Empty(t, healthCauses)
 	})
 }
+
+type fakeHealthPluginClient struct {
+	response *healthplugin.HealthResponse
+	err      error
+	requests []*healthplugin.HealthRequest
+}
+
+func (c *fakeHealthPluginClient) GetHealth(_ context.Context, in *healthplugin.HealthRequest, _ ...grpc.CallOption) (*healthplugin.HealthResponse, error) {
+	c.requests = append(c.requests, in)
+	return c.response, c.err
+}
+
+type fakeHealthPluginClientset struct {
+	client *fakeHealthPluginClient
+}
+
+func (c *fakeHealthPluginClientset) NewHealthPluginClient(_ context.Context, _ string) (utilio.Closer, healthplugin.HealthPluginServiceClient, error) {
+	return utilio.NopCloser, c.client, nil
+}
+
+func TestSetApplicationHealth_HealthPlugin(t *testing.T) {
+	runningPod := resourceFromFile("./testdata/pod-running-restart-always.yaml")
+	failedJob := resourceFromFile("./testdata/job-failed.yaml")
+	resources := []managedResource{{
+		Group: "", Version: "v1", Kind: "Pod", Namespace: "default", Name: "running-pod", Live: &runningPod,
+	}, {
+		Group: "batch", Version: "v1", Kind: "Job", Namespace: "default", Name: "failed-job", Live: &failedJob,
+	}}
+	children := []*appv1.ResourceNode{{ResourceRef: appv1.ResourceRef{Kind: "Event", Namespace: "argocd", Name: "my-pod.1"}}}
+	newHealthOverrides := func(client *fakeHealthPluginClient) *resourceHealthOverrides {
+		return &resourceHealthOverrides{
+			ctx: t.Context(),
+			overrides: map[string]appv1.ResourceOverride{
+				"Pod": {HealthPlugin: "pod-health", HealthLua: `return {status = "Healthy"}`},
+			},
+			healthPlugins: &fakeHealthPluginClientset{client: client},
+			getChildren: func(obj *unstructured.Unstructured) ([]*appv1.ResourceNode, error) {
+				assert.Equal(t, runningPod.GetName(), obj.GetName())
+				return children, nil
+			},
+		}
+	}
+
+	t.Run("Assessed", func(t *testing.T) {
+		client := &fakeHealthPluginClient{response: &healthplugin.HealthResponse{Status: "Suspended", Message: "paused"}}
+		healthOverrides := newHealthOverrides(client)
+		resourceStatuses := initStatuses(resources)
+
+		healthStatus, _, err := setApplicationHealth(resources, resourceStatuses, healthOverrides, app, false)
+		require.NoError(t, err)
+		// the failed job is assessed by the built-in health check
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus)
+
+		// the plugin takes precedence over the health Lua script of the pod
+		require.Len(t, client.requests, 1)
+		assert.Equal(t, children, client.requests[0].Children)
+		assert.Equal(t, map[kube.ResourceKey]*appv1.HealthStatus{
+			kube.GetResourceKey(&runningPod): {Status: health.HealthStatusSuspended, Message: "paused"},
+		}, healthOverrides.pluginHealth)
+	})
+
+	t.Run("PluginError", func(t *testing.T) {
+		client := &fakeHealthPluginClient{err: errors.New("connection refused")}
+		healthOverrides := newHealthOverrides(client)
+		resourceStatuses := initStatuses(resources)
+
+		_, _, err := setApplicationHealth(resources, resourceStatuses, healthOverrides, app, true)
+		require.ErrorContains(t, err, "connection refused")
+		assert.Equal(t, health.HealthStatusUnknown, resourceStatuses[0].Health.Status)
+		assert.Equal(t, health.HealthStatusUnknown, healthOverrides.pluginHealth[kube.GetResourceKey(&runningPod)].Status)
+	})
+}
+
+func TestPluginHealthInResourceTree(t *testing.T) {
+	overrides := map[string]appv1.ResourceOverride{"example.com/*": {HealthPlugin: "my-plugin"}}
+	tree := &appv1.ApplicationTree{Nodes: []appv1.ResourceNode{{
+		ResourceRef: appv1.ResourceRef{Group: "example.com", Version: "v1", Kind: "MyKind", Namespace: "default", Name: "my-resource"},
+	}, {
+		ResourceRef: appv1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: "default", Name: "my-pod"},
+		Health:      &appv1.HealthStatus{Status: health.HealthStatusHealthy},
+	}}}
+	assert.Empty(t, getPluginHealth(tree, overrides))
+
+	pluginHealth := map[kube.ResourceKey]*appv1.HealthStatus{
+		kube.NewResourceKey("example.com", "MyKind", "default", "my-resource"): {Status: health.HealthStatusDegraded, Message: "failed"},
+	}
+	setPluginHealth(tree, pluginHealth)
+	assert.Equal(t, &appv1.HealthStatus{Status: health.HealthStatusDegraded, Message: "failed"}, tree.Nodes[0].Health)
+	assert.Equal(t, &appv1.HealthStatus{Status: health.HealthStatusHealthy}, tree.Nodes[1].Health)
+
+	assert.Equal(t, pluginHealth, getPluginHealth(tree, overrides))
+	assert.True(t, hasHealthPlugins(overrides))
+	assert.False(t, hasHealthPlugins(map[string]appv1.ResourceOverride{"Pod": {HealthLua: "return {}"}}))
+}
@@ -1155,7 +1155,7 @@ func TestHydrator_hydrate_Success(t *testing.T) {
 	})
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(t.Context(), logCtx, apps, projects)
+	sha, hydratedSha, _, errs, err := h.hydrate(t.Context(), logCtx, apps, projects)
 
 	require.NoError(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -1163,6 +1163,153 @@ func TestHydrator_hydrate_Success(t *testing.T) {
 	assert.Empty(t, errs)
 }
 
+func TestHydrator_hydrate_OCI_Success(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	h := &Hydrator{
+		dependencies:    d,
+		repoGetter:      r,
+		repoClientset:   &reposervermocks.Clientset{RepoServerServiceClient: rc},
+		commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc},
+	}
+
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator.HydrateTo = &v1alpha1.HydrateTo{
+		OCI: &v1alpha1.HydrateToOCI{RepoURL: "oci://registry.example.com/hydrated", Tag: "prod"},
+	}
+	proj := newTestProject()
+	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
+	readRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+	writeRepo := &v1alpha1.Repository{Repo: "oci://registry.example.com/hydrated"}
+
+	d.EXPECT().GetRepoObjs(mock.Anything, app, app.Spec.SourceHydrator.GetDrySource(), "main", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	r.EXPECT().GetRepository(mock.Anything, readRepo.Repo, proj.Name).Return(readRepo, nil)
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{Message: "metadata"}, nil)
+	d.EXPECT().GetWriteCredentials(mock.Anything, writeRepo.Repo, proj.Name).Return(writeRepo, nil)
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil)
+	d.EXPECT().GetHydratorReadmeMessageTemplate().Return("readme message", nil)
+	d.EXPECT().GetCommitAuthorName().Return("", nil)
+	d.EXPECT().GetCommitAuthorEmail().Return("", nil)
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "sha256:hydrated"}, nil).Run(func(_ context.Context, in *commitclient.CommitHydratedManifestsRequest, _ ...grpc.CallOption) {
+		assert.Empty(t, in.SyncBranch)
+		assert.Equal(t, "prod", in.TargetBranch)
+		assert.Equal(t, "sha123", in.DrySha)
+		assert.Equal(t, writeRepo, in.Repo)
+		require.Len(t, in.Paths, 1)
+		assert.Equal(t, app.Spec.SourceHydrator.SyncSource.Path, in.Paths[0].Path)
+	})
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	sha, hydratedSha, _, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
+
+	require.NoError(t, err)
+	assert.Equal(t, "sha123", sha)
+	assert.Equal(t, "sha256:hydrated", hydratedSha)
+	assert.Empty(t, errs)
+}
+
+func TestHydrator_hydrate_PullRequest_Success(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	h := &Hydrator{
+		dependencies:    d,
+		repoGetter:      r,
+		repoClientset:   &reposervermocks.Clientset{RepoServerServiceClient: rc},
+		commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc},
+	}
+
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator.PullRequest = &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderGitHub}
+	proj := newTestProject()
+	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
+	readRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+	pullRequest := &v1alpha1.HydratorPullRequestStatus{
+		URL:          "https://example.com/repo/pull/1",
+		Number:       1,
+		Branch:       "argocd-hydrator/hydrated",
+		TargetBranch: "hydrated",
+		State:        v1alpha1.HydratorPullRequestStateOpened,
+		DrySHA:       "sha123",
+	}
+
+	d.EXPECT().GetRepoObjs(mock.Anything, app, app.Spec.SourceHydrator.GetDrySource(), "main", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	r.EXPECT().GetRepository(mock.Anything, readRepo.Repo, proj.Name).Return(readRepo, nil)
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{Message: "metadata"}, nil)
+	d.EXPECT().GetWriteCredentials(mock.Anything, readRepo.Repo, proj.Name).Return(readRepo, nil)
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil)
+	d.EXPECT().GetHydratorReadmeMessageTemplate().Return("readme message", nil)
+	d.EXPECT().GetCommitAuthorName().Return("", nil)
+	d.EXPECT().GetCommitAuthorEmail().Return("", nil)
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "hydrated123", PullRequest: pullRequest}, nil).Run(func(_ context.Context, in *commitclient.CommitHydratedManifestsRequest, _ ...grpc.CallOption) {
+		assert.Equal(t, app.Spec.SourceHydrator.PullRequest, in.PullRequest)
+	})
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	sha, hydratedSha, pr, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
+
+	require.NoError(t, err)
+	assert.Equal(t, "sha123", sha)
+	assert.Equal(t, "hydrated123", hydratedSha)
+	assert.Equal(t, pullRequest, pr)
+	assert.Empty(t, errs)
+}
+
+func TestHydrator_hydrate_PullRequest_ManifestsUnchanged(t *testing.T) {
+	t.Parallel()
+
+	d := mocks.NewDependencies(t)
+	r := mocks.NewRepoGetter(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	rc := reposervermocks.NewRepoServerServiceClient(t)
+	h := &Hydrator{
+		dependencies:    d,
+		repoGetter:      r,
+		repoClientset:   &reposervermocks.Clientset{RepoServerServiceClient: rc},
+		commitClientset: &commitservermocks.Clientset{CommitServiceClient: cc},
+	}
+
+	app := newTestApp("app1")
+	app.Spec.SourceHydrator.PullRequest = &v1alpha1.HydratorPullRequest{Provider: v1alpha1.HydratorPullRequestProviderGitHub}
+	previousPullRequest := &v1alpha1.HydratorPullRequestStatus{
+		URL:          "https://example.com/repo/pull/1",
+		Number:       1,
+		Branch:       "argocd-hydrator/hydrated",
+		TargetBranch: "hydrated",
+		State:        v1alpha1.HydratorPullRequestStateOpened,
+		DrySHA:       "sha122",
+	}
+	app.Status.SourceHydrator.PullRequest = previousPullRequest
+	proj := newTestProject()
+	projects := map[string]*v1alpha1.AppProject{app.Spec.Project: proj}
+	readRepo := &v1alpha1.Repository{Repo: "https://example.com/repo"}
+
+	d.EXPECT().GetRepoObjs(mock.Anything, app, app.Spec.SourceHydrator.GetDrySource(), "main", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil)
+	r.EXPECT().GetRepository(mock.Anything, readRepo.Repo, proj.Name).Return(readRepo, nil)
+	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{Message: "metadata"}, nil)
+	d.EXPECT().GetWriteCredentials(mock.Anything, readRepo.Repo, proj.Name).Return(readRepo, nil)
+	d.EXPECT().GetHydratorCommitMessageTemplate().Return("commit message", nil)
+	d.EXPECT().GetHydratorReadmeMessageTemplate().Return("readme message", nil)
+	d.EXPECT().GetCommitAuthorName().Return("", nil)
+	d.EXPECT().GetCommitAuthorEmail().Return("", nil)
+	// the commit server does not publish a pull request since the manifests are unchanged
+	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.CommitHydratedManifestsResponse{HydratedSha: "hydrated123"}, nil)
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	_, _, pr, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
+
+	require.NoError(t, err)
+	assert.Equal(t, previousPullRequest, pr)
+	assert.Empty(t, errs)
+}
+
 func TestHydrator_hydrate_GetManifestsError(t *testing.T) {
 	t.Parallel()
 
@@ -1184,7 +1331,7 @@ func TestHydrator_hydrate_GetManifestsError(t *testing.T) {
 	d.EXPECT().GetRepoObjs(mock.Anything, app, mock.Anything, mock.Anything, proj).Return(nil, nil, errors.New("manifests error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
+	sha, hydratedSha, _, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.NoError(t, err)
 	assert.Empty(t, sha)
@@ -1216,7 +1363,7 @@ func TestHydrator_hydrate_RevisionMetadataError(t *testing.T) {
 	rc.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(nil, errors.New("metadata error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
+	sha, hydratedSha, _, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -1249,7 +1396,7 @@ func TestHydrator_hydrate_GetWriteCredentialsError(t *testing.T) {
 	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("creds error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
+	sha, hydratedSha, _, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -1283,7 +1430,7 @@ func TestHydrator_hydrate_CommitMessageTemplateError(t *testing.T) {
 	d.EXPECT().GetHydratorCommitMessageTemplate().Return("", errors.New("template error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
+	sha, hydratedSha, _, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -1317,7 +1464,7 @@ func TestHydrator_hydrate_TemplatedCommitMessageError(t *testing.T) {
 	d.EXPECT().GetHydratorCommitMessageTemplate().Return("{{ notAFunction }} template", nil)
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
+	sha, hydratedSha, _, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -1355,7 +1502,7 @@ func TestHydrator_hydrate_CommitHydratedManifestsError(t *testing.T) {
 	cc.EXPECT().CommitHydratedManifests(mock.Anything, mock.Anything).Return(nil, errors.New("commit error"))
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
+	sha, hydratedSha, _, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{app}, projects)
 
 	require.Error(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -1370,7 +1517,7 @@ func TestHydrator_hydrate_EmptyApps(t *testing.T) {
 	logCtx := log.NewEntry(log.StandardLogger())
 	h := &Hydrator{dependencies: d}
 
-	sha, hydratedSha, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{}, nil)
+	sha, hydratedSha, _, errs, err := h.hydrate(t.Context(), logCtx, []*v1alpha1.Application{}, nil)
 
 	require.NoError(t, err)
 	assert.Empty(t, sha)
@@ -1561,7 +1708,7 @@ func TestHydrator_hydrate_DeDupe_Success(t *testing.T) {
 	d.On("GetRepoObjs", mock.Anything, app1, app1.Spec.SourceHydrator.GetDrySource(), "main", proj).Return(nil, &repoclient.ManifestResponse{Revision: "sha123"}, nil).Once()
 	logCtx := log.NewEntry(log.StandardLogger())
 
-	sha, hydratedSha, errs, err := h.hydrate(t.Context(), logCtx, apps, projects)
+	sha, hydratedSha, _, errs, err := h.hydrate(t.Context(), logCtx, apps, projects)
 
 	require.NoError(t, err)
 	assert.Equal(t, "sha123", sha)
@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+const (
+	// EnvManifestStreamingEnabled is the env variable which enables receiving the manifests generated by the repo server
+	// in chunks
+	EnvManifestStreamingEnabled = "ARGOCD_APPLICATION_CONTROLLER_MANIFEST_STREAMING_ENABLED"
+)
+
+// manifestStreamingEnabled specifies whether the manifests are received from the repo server in chunks
+var manifestStreamingEnabled = false
+
+func init() {
+	manifestStreamingEnabled = env.ParseBoolFromEnv(EnvManifestStreamingEnabled, manifestStreamingEnabled)
+}
+
+// manifestUnmarshalError is returned by generateManifests if one of the generated manifests cannot be unmarshalled
+type manifestUnmarshalError struct {
+	err error
+}
+
+func (e *manifestUnmarshalError) Error() string {
+	return e.err.Error()
+}
+
+func (e *manifestUnmarshalError) Unwrap() error {
+	return e.err
+}
+
+// generateManifests generates the manifests of a source with the repo server and unmarshals them. If manifest
+// streaming is enabled, the manifests are received in chunks and unmarshalled as they arrive, so that the manifests of
+// very large applications are neither sent in a single gRPC message nor held twice in memory. The manifests are then
+// omitted from the returned response. Repo servers which do not support streaming are called with GenerateManifest.
+func generateManifests(ctx context.Context, repoClient apiclient.RepoServerServiceClient, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, []*unstructured.Unstructured, error) {
+	if manifestStreamingEnabled {
+		res, targetObjs, err := generateManifestsStream(ctx, repoClient, q)
+		if status.Code(err) != codes.Unimplemented {
+			return res, targetObjs, err
+		}
+	}
+	res, err := repoClient.GenerateManifest(ctx, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	targetObjs, err := unmarshalManifests(res.Manifests)
+	if err != nil {
+		return nil, nil, &manifestUnmarshalError{err}
+	}
+	return res, targetObjs, nil
+}
+
+func generateManifestsStream(ctx context.Context, repoClient apiclient.RepoServerServiceClient, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, []*unstructured.Unstructured, error) {
+	// stop receiving the remaining chunks if one of them cannot be unmarshalled
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := repoClient.GenerateManifestStream(ctx, q)
+	if err != nil {
+		return nil, nil, err
+	}
+	targetObjs := make([]*unstructured.Unstructured, 0)
+	res, err := apiclient.RecvManifests(stream, func(manifests []string) error {
+		objs, err := unmarshalManifests(manifests)
+		if err != nil {
+			return &manifestUnmarshalError{err}
+		}
+		targetObjs = append(targetObjs, objs...)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, targetObjs, nil
+}
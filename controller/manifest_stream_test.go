@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	mockrepoclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+)
+
+const (
+	streamTestConfigMap = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-config"}}`
+	streamTestSecret    = `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"my-secret"}}`
+)
+
+func enableManifestStreaming(t *testing.T) {
+	t.Helper()
+	manifestStreamingEnabled = true
+	t.Cleanup(func() {
+		manifestStreamingEnabled = false
+	})
+}
+
+func TestGenerateManifests(t *testing.T) {
+	repoClient := &mockrepoclient.RepoServerServiceClient{}
+	repoClient.EXPECT().GenerateManifest(mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{Manifests: []string{streamTestConfigMap}, Revision: "abc"}, nil)
+
+	res, objs, err := generateManifests(t.Context(), repoClient, &apiclient.ManifestRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "abc", res.Revision)
+	require.Len(t, objs, 1)
+	assert.Equal(t, "my-config", objs[0].GetName())
+	repoClient.AssertNotCalled(t, "GenerateManifestStream", mock.Anything, mock.Anything)
+}
+
+func TestGenerateManifests_Stream(t *testing.T) {
+	enableManifestStreaming(t)
+	stream := &mockrepoclient.RepoServerService_GenerateManifestStreamClient{}
+	stream.EXPECT().Recv().Return(&apiclient.ManifestResponse{Manifests: []string{streamTestConfigMap}, Revision: "abc"}, nil).Once()
+	stream.EXPECT().Recv().Return(&apiclient.ManifestResponse{Manifests: []string{streamTestSecret}}, nil).Once()
+	stream.EXPECT().Recv().Return(nil, io.EOF).Once()
+	repoClient := &mockrepoclient.RepoServerServiceClient{}
+	repoClient.EXPECT().GenerateManifestStream(mock.Anything, mock.Anything).Return(stream, nil)
+
+	res, objs, err := generateManifests(t.Context(), repoClient, &apiclient.ManifestRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "abc", res.Revision)
+	assert.Empty(t, res.Manifests)
+	require.Len(t, objs, 2)
+	assert.Equal(t, "my-config", objs[0].GetName())
+	assert.Equal(t, "my-secret", objs[1].GetName())
+}
+
+func TestGenerateManifests_StreamUnimplemented(t *testing.T) {
+	enableManifestStreaming(t)
+	stream := &mockrepoclient.RepoServerService_GenerateManifestStreamClient{}
+	stream.EXPECT().Recv().Return(nil, status.Error(codes.Unimplemented, "method GenerateManifestStream not implemented")).Once()
+	repoClient := &mockrepoclient.RepoServerServiceClient{}
+	repoClient.EXPECT().GenerateManifestStream(mock.Anything, mock.Anything).Return(stream, nil)
+	repoClient.EXPECT().GenerateManifest(mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{Manifests: []string{streamTestConfigMap}}, nil)
+
+	_, objs, err := generateManifests(t.Context(), repoClient, &apiclient.ManifestRequest{})
+	require.NoError(t, err)
+	assert.Len(t, objs, 1)
+}
+
+func TestGenerateManifests_StreamInvalidManifest(t *testing.T) {
+	enableManifestStreaming(t)
+	stream := &mockrepoclient.RepoServerService_GenerateManifestStreamClient{}
+	stream.EXPECT().Recv().Return(&apiclient.ManifestResponse{Manifests: []string{"invalid"}}, nil).Once()
+	repoClient := &mockrepoclient.RepoServerServiceClient{}
+	repoClient.EXPECT().GenerateManifestStream(mock.Anything, mock.Anything).Return(stream, nil)
+
+	_, _, err := generateManifests(t.Context(), repoClient, &apiclient.ManifestRequest{})
+	var unmarshalErr *manifestUnmarshalError
+	require.ErrorAs(t, err, &unmarshalErr)
+}
@@ -44,6 +44,7 @@ type MetricsServer struct {
 	redisRequestHistogram             *prometheus.HistogramVec
 	resourceEventsProcessingHistogram *prometheus.HistogramVec
 	resourceEventsNumberGauge         *prometheus.GaugeVec
+	serverSideDiffCacheCounter        *prometheus.CounterVec
 	registry                          *prometheus.Registry
 	hostname                          string
 	cron                              *cron.Cron
@@ -156,6 +157,14 @@ var (
 		Name: "argocd_resource_events_processed_in_batch",
 		Help: "Number of resource events processed in batch",
 	}, []string{"server"})
+
+	serverSideDiffCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_app_server_side_diff_cache_total",
+			Help: "Number of lookups of server-side dry run results in the cache during application reconciliation.",
+		},
+		append(descAppDefaultLabels, "dest_server", "result"),
+	)
 )
 
 // NewMetricsServer returns a new prometheus server which collects application metrics
@@ -208,6 +217,7 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(redisRequestHistogram)
 	registry.MustRegister(resourceEventsProcessingHistogram)
 	registry.MustRegister(resourceEventsNumberGauge)
+	registry.MustRegister(serverSideDiffCacheCounter)
 
 	kubectl.RegisterWithClientGo()
 	kubectl.RegisterWithPrometheus(registry)
@@ -230,6 +240,7 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		redisRequestHistogram:             redisRequestHistogram,
 		resourceEventsProcessingHistogram: resourceEventsProcessingHistogram,
 		resourceEventsNumberGauge:         resourceEventsNumberGauge,
+		serverSideDiffCacheCounter:        serverSideDiffCacheCounter,
 		hostname:                          hostname,
 		// This cron is used to expire the metrics cache.
 		// Currently clearing the metrics cache is logging and deleting from the map
@@ -314,6 +325,16 @@ func (m *MetricsServer) ObserveResourceEventsProcessingDuration(server string, d
 	m.resourceEventsNumberGauge.WithLabelValues(server).Set(float64(processedEventsNumber))
 }
 
+// IncServerSideDiffCache increments the counter of hits or misses of the server-side dry run results cache for an
+// application
+func (m *MetricsServer) IncServerSideDiffCache(app *argoappv1.Application, destServer string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.serverSideDiffCacheCounter.WithLabelValues(app.Namespace, app.Name, app.Spec.GetProject(), destServer, result).Inc()
+}
+
 // IncReconcile increments the reconcile counter for an application
 func (m *MetricsServer) IncReconcile(app *argoappv1.Application, destServer string, duration time.Duration) {
 	m.reconcileHistogram.WithLabelValues(app.Namespace, destServer).Observe(duration.Seconds())
@@ -344,6 +365,7 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 		m.redisRequestHistogram.Reset()
 		m.resourceEventsProcessingHistogram.Reset()
 		m.resourceEventsNumberGauge.Reset()
+		m.serverSideDiffCacheCounter.Reset()
 		kubectl.ResetAll()
 	})
 	if err != nil {
@@ -600,6 +600,34 @@ argocd_app_reconcile_count{dest_server="https://localhost:6443",namespace="argoc
 	assertMetricsPrinted(t, appReconcileMetrics, body)
 }
 
+func TestServerSideDiffCacheMetric(t *testing.T) {
+	cancel, appLister := newFakeLister(t.Context())
+	defer cancel()
+	mockDB := mocks.NewArgoDB(t)
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{}, []string{}, mockDB)
+	require.NoError(t, err)
+
+	expectedMetrics := `
+# HELP argocd_app_server_side_diff_cache_total Number of lookups of server-side dry run results in the cache during application reconciliation.
+# TYPE argocd_app_server_side_diff_cache_total counter
+argocd_app_server_side_diff_cache_total{dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="important-project",result="hit"} 2
+argocd_app_server_side_diff_cache_total{dest_server="https://localhost:6443",name="my-app",namespace="argocd",project="important-project",result="miss"} 1
+`
+	app := newFakeApp(fakeApp)
+	metricsServ.IncServerSideDiffCache(app, "https://localhost:6443", false)
+	metricsServ.IncServerSideDiffCache(app, "https://localhost:6443", true)
+	metricsServ.IncServerSideDiffCache(app, "https://localhost:6443", true)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/metrics", http.NoBody)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	body := rr.Body.String()
+	log.Println(body)
+	assertMetricsPrinted(t, expectedMetrics, body)
+}
+
 func TestOrphanedResourcesMetric(t *testing.T) {
 	cancel, appLister := newFakeLister(t.Context())
 	defer cancel()
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
+	kubeutil "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+)
+
+// cachingServerSideDryRunner caches the results of the server-side dry runs of the target manifests. A result is
+// reused as long as the target manifest, the field manager and the resourceVersion of the live resource are unchanged,
+// so that the resources which did not change since the previous reconciliation do not hit the API server.
+type cachingServerSideDryRunner struct {
+	dryRunner diff.ServerSideDryRunner
+	cache     *appstatecache.Cache
+	server    string
+	// liveResourceVersions holds the resourceVersion of the live resources by key
+	liveResourceVersions map[kubeutil.ResourceKey]string
+	// onLookup is called with the outcome of every lookup of the cache
+	onLookup func(hit bool)
+	logCtx   *log.Entry
+}
+
+// newCachingServerSideDryRunner returns a dry runner which caches the results of the given dry runner for the target
+// resources of the given live resources
+func newCachingServerSideDryRunner(dryRunner diff.ServerSideDryRunner, cache *appstatecache.Cache, server string, live []*unstructured.Unstructured, onLookup func(hit bool), logCtx *log.Entry) *cachingServerSideDryRunner {
+	liveResourceVersions := make(map[kubeutil.ResourceKey]string, len(live))
+	for _, obj := range live {
+		if obj != nil && obj.GetResourceVersion() != "" {
+			liveResourceVersions[kubeutil.GetResourceKey(obj)] = obj.GetResourceVersion()
+		}
+	}
+	return &cachingServerSideDryRunner{
+		dryRunner:            dryRunner,
+		cache:                cache,
+		server:               server,
+		liveResourceVersions: liveResourceVersions,
+		onLookup:             onLookup,
+		logCtx:               logCtx,
+	}
+}
+
+// Run returns the cached result of the server-side dry run of the target manifest, or runs it on a cache miss. The dry
+// runs of Secrets are never cached, as their result holds the data of the Secret.
+func (r *cachingServerSideDryRunner) Run(ctx context.Context, obj *unstructured.Unstructured, manager string) (string, error) {
+	key := kubeutil.GetResourceKey(obj)
+	resourceVersion, ok := r.liveResourceVersions[key]
+	if !ok || (key.Group == "" && key.Kind == kubeutil.SecretKind) {
+		//nolint:wrapcheck // the error is wrapped by the caller
+		return r.dryRunner.Run(ctx, obj, manager)
+	}
+	// the target is marshaled before the dry run, which might modify it
+	target := obj.DeepCopy()
+
+	var result string
+	err := r.cache.GetServerSideDiffResult(r.server, target, resourceVersion, manager, &result)
+	if err == nil {
+		r.onLookup(true)
+		return result, nil
+	}
+	if !errors.Is(err, appstatecache.ErrCacheMiss) {
+		r.logCtx.Warnf("Failed to get server-side dry run result of %s from cache: %v", key, err)
+	}
+	r.onLookup(false)
+
+	result, err = r.dryRunner.Run(ctx, obj, manager)
+	if err != nil {
+		//nolint:wrapcheck // the error is wrapped by the caller
+		return "", err
+	}
+	if err := r.cache.SetServerSideDiffResult(r.server, target, resourceVersion, manager, result); err != nil {
+		r.logCtx.Warnf("Failed to cache server-side dry run result of %s: %v", key, err)
+	}
+	return result, nil
+}
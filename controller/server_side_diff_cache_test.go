@@ -0,0 +1,150 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+)
+
+type fakeServerSideDryRunner struct {
+	calls int
+	err   error
+}
+
+func (r *fakeServerSideDryRunner) Run(_ context.Context, obj *unstructured.Unstructured, _ string) (string, error) {
+	r.calls++
+	if r.err != nil {
+		return "", r.err
+	}
+	data, err := obj.MarshalJSON()
+	return string(data), err
+}
+
+func newServerSideDiffConfigMap(resourceVersion string, data string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "my-config", "namespace": "default"},
+		"data":       map[string]any{"foo": data},
+	}}
+	if resourceVersion != "" {
+		obj.SetResourceVersion(resourceVersion)
+	}
+	return obj
+}
+
+func TestCachingServerSideDryRunner(t *testing.T) {
+	cache := appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour)
+	dryRunner := &fakeServerSideDryRunner{}
+	var hits, misses int
+	onLookup := func(hit bool) {
+		if hit {
+			hits++
+		} else {
+			misses++
+		}
+	}
+	newRunner := func(live *unstructured.Unstructured) *cachingServerSideDryRunner {
+		return newCachingServerSideDryRunner(dryRunner, cache, "https://localhost:6443", []*unstructured.Unstructured{live, nil}, onLookup, log.NewEntry(log.New()))
+	}
+	target := newServerSideDiffConfigMap("", "bar")
+
+	runner := newRunner(newServerSideDiffConfigMap("1", "baz"))
+	result, err := runner.Run(t.Context(), target, "argocd-controller")
+	require.NoError(t, err)
+	assert.Contains(t, result, `"foo":"bar"`)
+	assert.Equal(t, 1, dryRunner.calls)
+	assert.Equal(t, 1, misses)
+
+	// unchanged target and live resource, the dry run is skipped
+	runner = newRunner(newServerSideDiffConfigMap("1", "baz"))
+	cachedResult, err := runner.Run(t.Context(), target, "argocd-controller")
+	require.NoError(t, err)
+	assert.Equal(t, result, cachedResult)
+	assert.Equal(t, 1, dryRunner.calls)
+	assert.Equal(t, 1, hits)
+
+	// the live resource changed
+	runner = newRunner(newServerSideDiffConfigMap("2", "qux"))
+	_, err = runner.Run(t.Context(), target, "argocd-controller")
+	require.NoError(t, err)
+	assert.Equal(t, 2, dryRunner.calls)
+
+	// the target manifest changed
+	_, err = runner.Run(t.Context(), newServerSideDiffConfigMap("", "other"), "argocd-controller")
+	require.NoError(t, err)
+	assert.Equal(t, 3, dryRunner.calls)
+
+	// the field manager changed
+	_, err = runner.Run(t.Context(), target, "kubectl")
+	require.NoError(t, err)
+	assert.Equal(t, 4, dryRunner.calls)
+	assert.Equal(t, 1, hits)
+	assert.Equal(t, 4, misses)
+}
+
+func TestCachingServerSideDryRunner_NoLiveResource(t *testing.T) {
+	cache := appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour)
+	dryRunner := &fakeServerSideDryRunner{}
+	lookups := 0
+	runner := newCachingServerSideDryRunner(dryRunner, cache, "https://localhost:6443", nil, func(bool) { lookups++ }, log.NewEntry(log.New()))
+
+	for range 2 {
+		_, err := runner.Run(t.Context(), newServerSideDiffConfigMap("", "bar"), "argocd-controller")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, dryRunner.calls)
+	assert.Equal(t, 0, lookups)
+}
+
+func TestCachingServerSideDryRunner_Error(t *testing.T) {
+	cache := appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour)
+	dryRunner := &fakeServerSideDryRunner{err: errors.New("admission webhook denied the request")}
+	runner := newCachingServerSideDryRunner(dryRunner, cache, "https://localhost:6443", []*unstructured.Unstructured{newServerSideDiffConfigMap("1", "baz")}, func(bool) {}, log.NewEntry(log.New()))
+
+	// errors are not cached
+	for range 2 {
+		_, err := runner.Run(t.Context(), newServerSideDiffConfigMap("", "bar"), "argocd-controller")
+		require.ErrorContains(t, err, "admission webhook denied the request")
+	}
+	assert.Equal(t, 2, dryRunner.calls)
+}
+
+func TestCachingServerSideDryRunner_Secret(t *testing.T) {
+	cache := appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour)
+	dryRunner := &fakeServerSideDryRunner{}
+	newSecret := func(resourceVersion string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]any{"name": "my-secret", "namespace": "default"},
+			"data":       map[string]any{"password": "czNjcjN0"},
+		}}
+		if resourceVersion != "" {
+			obj.SetResourceVersion(resourceVersion)
+		}
+		return obj
+	}
+	lookups := 0
+	runner := newCachingServerSideDryRunner(dryRunner, cache, "https://localhost:6443", []*unstructured.Unstructured{newSecret("1")}, func(bool) { lookups++ }, log.NewEntry(log.New()))
+
+	// the dry runs of secrets are neither looked up nor stored
+	for range 2 {
+		result, err := runner.Run(t.Context(), newSecret(""), "argocd-controller")
+		require.NoError(t, err)
+		assert.Contains(t, result, "czNjcjN0")
+	}
+	assert.Equal(t, 2, dryRunner.calls)
+	assert.Equal(t, 0, lookups)
+	var result string
+	require.ErrorIs(t, cache.GetServerSideDiffResult("https://localhost:6443", newSecret(""), "1", "argocd-controller", &result), appstatecache.ErrCacheMiss)
+}
@@ -2,10 +2,13 @@ package sharding
 
 import (
 	"maps"
+	"math"
+	"slices"
 	"strconv"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -24,31 +27,40 @@ type ClusterShardingCache interface {
 	GetDistribution() map[string]int
 	GetAppDistribution() map[string]int
 	UpdateShard(shard int) bool
+	UpdateClustersCacheInfo(getClusterCacheInfo func(server string) (*v1alpha1.ClusterCacheInfo, error))
 }
 
 type ClusterSharding struct {
-	Shard           int
-	Replicas        int
-	Shards          map[string]int
-	Clusters        map[string]*v1alpha1.Cluster
-	Apps            map[string]*v1alpha1.Application
-	lock            sync.RWMutex
-	getClusterShard DistributionFunction
+	Shard             int
+	Replicas          int
+	Shards            map[string]int
+	Clusters          map[string]*v1alpha1.Cluster
+	Apps              map[string]*v1alpha1.Application
+	ClustersCacheInfo map[string]*v1alpha1.ClusterCacheInfo
+	lock              sync.RWMutex
+	getClusterShard   DistributionFunction
+	shardingAlgorithm string
+	// assignmentStore shares the assignment of the clusters of the weighted sharding algorithm between the replicas
+	assignmentStore *weightedShardAssignmentStore
+	// persistedShards is the last assignment read from, or written to, the assignment store
+	persistedShards map[string]int
 }
 
 func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm string) ClusterShardingCache {
 	log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
 	clusterSharding := &ClusterSharding{
-		Shard:    shard,
-		Replicas: replicas,
-		Shards:   make(map[string]int),
-		Clusters: make(map[string]*v1alpha1.Cluster),
-		Apps:     make(map[string]*v1alpha1.Application),
+		Shard:             shard,
+		Replicas:          replicas,
+		Shards:            make(map[string]int),
+		Clusters:          make(map[string]*v1alpha1.Cluster),
+		Apps:              make(map[string]*v1alpha1.Application),
+		ClustersCacheInfo: make(map[string]*v1alpha1.ClusterCacheInfo),
+		shardingAlgorithm: shardingAlgorithm,
 	}
 	distributionFunction := NoShardingDistributionFunction()
 	if replicas > 1 {
 		log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
-		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), shardingAlgorithm, replicas)
+		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), clusterSharding.getClusterCacheInfoAccessor(), clusterSharding.getShardAccessor(), shardingAlgorithm, replicas)
 	} else {
 		log.Info("Processing all cluster shards")
 	}
@@ -56,6 +68,19 @@ func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm stri
 	return clusterSharding
 }
 
+// ShareWeightedDistribution makes the weighted sharding algorithm share the assignment of the clusters to the shards
+// between the replicas, through the shard mapping ConfigMap. The shard 0 rebalances the clusters using its view of their
+// weights, and stores its assignment, while the other shards follow the stored assignment, assigning the clusters it does
+// not have yet to the shard of their hash. If readOnly is true, the assignment is only followed, whatever the shard.
+func ShareWeightedDistribution(clusterSharding ClusterShardingCache, kubeClient kubernetes.Interface, namespace string, readOnly bool) ClusterShardingCache {
+	if sharding, ok := clusterSharding.(*ClusterSharding); ok {
+		sharding.lock.Lock()
+		sharding.assignmentStore = &weightedShardAssignmentStore{kubeClient: kubeClient, namespace: namespace, readOnly: readOnly}
+		sharding.lock.Unlock()
+	}
+	return clusterSharding
+}
+
 // IsManagedCluster returns whether or not the cluster should be processed by a given shard.
 func (sharding *ClusterSharding) IsManagedCluster(c *v1alpha1.Cluster) bool {
 	sharding.lock.RLock()
@@ -146,6 +171,25 @@ func (sharding *ClusterSharding) GetDistribution() map[string]int {
 }
 
 func (sharding *ClusterSharding) updateDistribution() {
+	getClusterShard := sharding.getClusterShard
+	if sharding.Replicas > 1 && sharding.shardingAlgorithm == common.WeightedShardingAlgorithm {
+		// the weighted distribution depends on all the clusters, so it is computed once rather than for each cluster
+		rebalanceThreshold := RebalanceThreshold
+		if !sharding.rebalancesShards() {
+			// the clusters keep their stored shard, or are assigned to the shard of their hash
+			rebalanceThreshold = math.Inf(1)
+		}
+		distribution := createWeightedDistribution(sharding.Replicas, sharding.getClusterAccessor(), sharding.getClusterCacheInfoAccessor(), sharding.getShardAccessor(), rebalanceThreshold)
+		getClusterShard = func(c *v1alpha1.Cluster) int {
+			if shard, ok := distribution[c.ID]; ok {
+				return shard
+			}
+			return -1
+		}
+	}
+	// the distribution function may depend on the current shards, so they are only replaced once all clusters are
+	// assigned
+	shards := maps.Clone(sharding.Shards)
 	for k, c := range sharding.Clusters {
 		shard := 0
 		if c.Shard != nil {
@@ -156,7 +200,7 @@ func (sharding *ClusterSharding) updateDistribution() {
 				log.Warnf("Specified cluster shard (%d) for cluster: %s is greater than the number of available shard (%d). Using shard 0.", requestedShard, c.Server, sharding.Replicas)
 			}
 		} else {
-			shard = sharding.getClusterShard(c)
+			shard = getClusterShard(c)
 		}
 
 		existingShard, ok := sharding.Shards[k]
@@ -168,8 +212,15 @@ func (sharding *ClusterSharding) updateDistribution() {
 		default:
 			log.Debugf("Cluster %s has not changed shard", k)
 		}
-		sharding.Shards[k] = shard
+		shards[k] = shard
 	}
+	sharding.Shards = shards
+}
+
+// rebalancesShards returns whether the shard rebalances the clusters of the weighted sharding algorithm, which only the
+// shard 0 does when the assignment is shared between the replicas.
+func (sharding *ClusterSharding) rebalancesShards() bool {
+	return sharding.assignmentStore == nil || (!sharding.assignmentStore.readOnly && sharding.Shard == 0)
 }
 
 // hasShardingUpdates returns true if the sharding distribution has explicitly changed
@@ -187,6 +238,11 @@ func hasShardingUpdates(old, newCluster *v1alpha1.Cluster) bool {
 		return true
 	}
 
+	// returns true if the shard group has changed because the weighted sharding algorithm depends on it.
+	if old.Labels[common.LabelKeyClusterShardGroup] != newCluster.Labels[common.LabelKeyClusterShardGroup] {
+		return true
+	}
+
 	// return false if the shard field has not been modified
 	if old.Shard == nil && newCluster.Shard == nil {
 		return false
@@ -217,6 +273,23 @@ func (sharding *ClusterSharding) getAppAccessor() appAccessor {
 	}
 }
 
+// A read lock should be acquired before calling getClusterCacheInfoAccessor.
+func (sharding *ClusterSharding) getClusterCacheInfoAccessor() clusterCacheInfoAccessor {
+	return func() map[string]*v1alpha1.ClusterCacheInfo {
+		return sharding.ClustersCacheInfo
+	}
+}
+
+// A read lock should be acquired before calling getShardAccessor.
+func (sharding *ClusterSharding) getShardAccessor() shardAccessor {
+	return func() map[string]int {
+		if sharding.assignmentStore != nil {
+			return sharding.persistedShards
+		}
+		return sharding.Shards
+	}
+}
+
 func (sharding *ClusterSharding) AddApp(a *v1alpha1.Application) {
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
@@ -281,3 +354,75 @@ func (sharding *ClusterSharding) UpdateShard(shard int) bool {
 	}
 	return false
 }
+
+// UpdateClustersCacheInfo refreshes the cache info of the clusters, which the weighted sharding algorithm uses to
+// weight the clusters, and the shared assignment of the clusters, and updates the sharding distribution accordingly.
+// The shard rebalancing the clusters then stores its assignment.
+func (sharding *ClusterSharding) UpdateClustersCacheInfo(getClusterCacheInfo func(server string) (*v1alpha1.ClusterCacheInfo, error)) {
+	if sharding.Replicas <= 1 || sharding.shardingAlgorithm != common.WeightedShardingAlgorithm {
+		return
+	}
+
+	sharding.lock.RLock()
+	servers := slices.Collect(maps.Keys(sharding.Clusters))
+	store := sharding.assignmentStore
+	sharding.lock.RUnlock()
+
+	// get the cache info without holding the lock, as it may be fetched from Redis
+	clustersCacheInfo := make(map[string]*v1alpha1.ClusterCacheInfo, len(servers))
+	for _, server := range servers {
+		info, err := getClusterCacheInfo(server)
+		if err != nil || info == nil {
+			log.Debugf("Cache info of cluster %s is not available: %v", server, err)
+			continue
+		}
+		clustersCacheInfo[server] = info
+	}
+	var persistedShards map[string]int
+	if store != nil {
+		var err error
+		persistedShards, err = store.get()
+		if err != nil {
+			log.Warnf("Failed to get the weighted shard assignment: %v", err)
+		}
+	}
+
+	sharding.lock.Lock()
+	cacheInfoChanged := !maps.EqualFunc(sharding.ClustersCacheInfo, clustersCacheInfo, func(a, b *v1alpha1.ClusterCacheInfo) bool {
+		return a.ResourcesCount == b.ResourcesCount
+	})
+	assignmentChanged := persistedShards != nil && !maps.Equal(sharding.persistedShards, persistedShards)
+	if cacheInfoChanged || assignmentChanged {
+		sharding.ClustersCacheInfo = clustersCacheInfo
+		if persistedShards != nil {
+			sharding.persistedShards = persistedShards
+		}
+		sharding.updateDistribution()
+	} else {
+		log.Debugf("Skipping sharding distribution update. No cluster cache info or shard assignment changes")
+	}
+	// the assignment is only stored once the stored one was read, so that it is based on it
+	var assignment map[string]int
+	if persistedShards != nil && sharding.rebalancesShards() {
+		assignment = make(map[string]int, len(sharding.Shards))
+		for server, shard := range sharding.Shards {
+			if shard >= 0 {
+				assignment[server] = shard
+			}
+		}
+		if maps.Equal(assignment, persistedShards) {
+			assignment = nil
+		}
+	}
+	sharding.lock.Unlock()
+
+	if assignment != nil {
+		if err := store.set(assignment); err != nil {
+			log.Warnf("Failed to store the weighted shard assignment: %v", err)
+			return
+		}
+		sharding.lock.Lock()
+		sharding.persistedShards = assignment
+		sharding.lock.Unlock()
+	}
+}
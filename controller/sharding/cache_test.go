@@ -1,9 +1,15 @@
 package sharding
 
 import (
+	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -406,6 +412,118 @@ func TestClusterSharding_ClusterShardOfResourceShouldNotBeChanged(t *testing.T)
 	assert.Equal(t, 0, distribution[clusterWithToBigValue.Server]) // will be assigned to shard 0 because the value is bigger than the number of replicas
 }
 
+func TestClusterSharding_UpdateClustersCacheInfo(t *testing.T) {
+	t.Parallel()
+	db := &dbmocks.ArgoDB{}
+	sharding := NewClusterSharding(db, 0, 2, common.WeightedShardingAlgorithm).(*ClusterSharding)
+
+	clusters := &v1alpha1.ClusterList{Items: []v1alpha1.Cluster{
+		{ID: "1", Server: "https://cluster-1"},
+		{ID: "2", Server: "https://cluster-2"},
+		{ID: "3", Server: "https://cluster-3"},
+		{ID: "4", Server: "https://cluster-4"},
+	}}
+	sharding.Init(clusters, &v1alpha1.ApplicationList{})
+
+	resourcesCounts := map[string]int64{
+		"https://cluster-1": 40000,
+		"https://cluster-2": 20000,
+		"https://cluster-3": 300,
+		"https://cluster-4": 300,
+	}
+	sharding.UpdateClustersCacheInfo(func(server string) (*v1alpha1.ClusterCacheInfo, error) {
+		return &v1alpha1.ClusterCacheInfo{ResourcesCount: resourcesCounts[server]}, nil
+	})
+
+	assert.Len(t, sharding.ClustersCacheInfo, 4)
+	distribution := sharding.GetDistribution()
+	assert.NotEqual(t, distribution["https://cluster-1"], distribution["https://cluster-2"])
+	assert.Equal(t, distribution["https://cluster-2"], distribution["https://cluster-3"])
+	assert.Equal(t, distribution["https://cluster-2"], distribution["https://cluster-4"])
+}
+
+func TestClusterSharding_UpdateClustersCacheInfoIgnoredWithoutWeightedAlgorithm(t *testing.T) {
+	t.Parallel()
+	sharding := setupTestSharding(0, 2)
+	sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{{ID: "1", Server: "https://cluster-1"}}}, &v1alpha1.ApplicationList{})
+
+	sharding.UpdateClustersCacheInfo(func(_ string) (*v1alpha1.ClusterCacheInfo, error) {
+		t.Fatal("the cluster cache info should not be fetched")
+		return nil, nil
+	})
+
+	assert.Empty(t, sharding.ClustersCacheInfo)
+}
+
+func TestClusterSharding_SharedWeightedDistribution(t *testing.T) {
+	t.Parallel()
+	kubeClient := kubefake.NewSimpleClientset()
+	clusters := &v1alpha1.ClusterList{Items: []v1alpha1.Cluster{
+		{ID: "1", Server: "https://cluster-1"},
+		{ID: "2", Server: "https://cluster-2"},
+		{ID: "3", Server: "https://cluster-3"},
+		{ID: "4", Server: "https://cluster-4"},
+	}}
+	resourcesCounts := map[string]int64{
+		"https://cluster-1": 40000,
+		"https://cluster-2": 20000,
+		"https://cluster-3": 300,
+		"https://cluster-4": 300,
+	}
+	getClusterCacheInfo := func(server string) (*v1alpha1.ClusterCacheInfo, error) {
+		return &v1alpha1.ClusterCacheInfo{ResourcesCount: resourcesCounts[server]}, nil
+	}
+	newSharding := func(shard int) *ClusterSharding {
+		sharding := ShareWeightedDistribution(NewClusterSharding(&dbmocks.ArgoDB{}, shard, 2, common.WeightedShardingAlgorithm), kubeClient, "argocd", false).(*ClusterSharding)
+		sharding.Init(clusters, &v1alpha1.ApplicationList{})
+		return sharding
+	}
+	getStoredAssignment := func() (map[string]int, string) {
+		cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assignment := map[string]int{}
+		require.NoError(t, json.Unmarshal([]byte(cm.Data[WeightedShardAssignmentKey]), &assignment))
+		return assignment, cm.ResourceVersion
+	}
+
+	// the shard 0 rebalances the clusters and stores its assignment
+	leader := newSharding(0)
+	leader.UpdateClustersCacheInfo(getClusterCacheInfo)
+	distribution := leader.GetDistribution()
+	assert.NotEqual(t, distribution["https://cluster-1"], distribution["https://cluster-2"])
+	assignment, resourceVersion := getStoredAssignment()
+	assert.Equal(t, distribution, assignment)
+
+	// the other shards follow the stored assignment, even if their view of the weights of the clusters differs
+	follower := newSharding(1)
+	follower.UpdateClustersCacheInfo(func(_ string) (*v1alpha1.ClusterCacheInfo, error) {
+		return nil, nil
+	})
+	assert.Equal(t, distribution, follower.GetDistribution())
+
+	// a restarted shard 0 keeps the stored assignment
+	restartedLeader := newSharding(0)
+	restartedLeader.UpdateClustersCacheInfo(getClusterCacheInfo)
+	assert.Equal(t, distribution, restartedLeader.GetDistribution())
+	_, newResourceVersion := getStoredAssignment()
+	assert.Equal(t, resourceVersion, newResourceVersion)
+}
+
+func TestClusterSharding_SharedWeightedDistributionReadOnly(t *testing.T) {
+	t.Parallel()
+	kubeClient := kubefake.NewSimpleClientset()
+	sharding := ShareWeightedDistribution(NewClusterSharding(&dbmocks.ArgoDB{}, 0, 2, common.WeightedShardingAlgorithm), kubeClient, "argocd", true).(*ClusterSharding)
+	sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{{ID: "1", Server: "https://cluster-1"}}}, &v1alpha1.ApplicationList{})
+
+	sharding.UpdateClustersCacheInfo(func(_ string) (*v1alpha1.ClusterCacheInfo, error) {
+		return &v1alpha1.ClusterCacheInfo{ResourcesCount: 10}, nil
+	})
+
+	assert.Contains(t, sharding.GetDistribution(), "https://cluster-1")
+	_, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(context.Background(), common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
 func TestHasShardingUpdates(t *testing.T) {
 	t.Parallel()
 	testCases := []struct {
@@ -456,6 +574,18 @@ func TestHasShardingUpdates(t *testing.T) {
 			new:      nil,
 			expected: false,
 		},
+		{
+			name: "Shard group updated",
+			old: &v1alpha1.Cluster{
+				Server: "https://kubernetes.default.svc",
+				Labels: map[string]string{common.LabelKeyClusterShardGroup: "group-a"},
+			},
+			new: &v1alpha1.Cluster{
+				Server: "https://kubernetes.default.svc",
+				Labels: map[string]string{common.LabelKeyClusterShardGroup: "group-b"},
+			},
+			expected: true,
+		},
 		{
 			name:     "Both are nil",
 			old:      nil,
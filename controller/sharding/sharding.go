@@ -40,15 +40,26 @@ var heartbeatCurrentTime = metav1.Now
 var (
 	HeartbeatDuration = env.ParseNumFromEnv(common.EnvControllerHeartbeatTime, 10, 10, 60)
 	HeartbeatTimeout  = 3 * HeartbeatDuration
+	// RebalanceThreshold is the ratio by which the load of a shard can exceed the average load before the weighted
+	// distribution moves clusters to other shards
+	RebalanceThreshold = float64(env.ParseFloatFromEnv(common.EnvControllerShardingRebalanceThreshold, common.DefaultShardingRebalanceThreshold, 0, math.MaxFloat32))
 )
 
-const ShardControllerMappingKey = "shardControllerMapping"
+const (
+	ShardControllerMappingKey = "shardControllerMapping"
+	// WeightedShardAssignmentKey is the key of the shard mapping ConfigMap which stores the assignment of the clusters to
+	// the shards of the weighted sharding algorithm, indexed by cluster server
+	WeightedShardAssignmentKey = "weightedShardAssignment"
+)
 
 type (
-	DistributionFunction  func(c *v1alpha1.Cluster) int
-	ClusterFilterFunction func(c *v1alpha1.Cluster) bool
-	clusterAccessor       func() []*v1alpha1.Cluster
-	appAccessor           func() []*v1alpha1.Application
+	DistributionFunction     func(c *v1alpha1.Cluster) int
+	ClusterFilterFunction    func(c *v1alpha1.Cluster) bool
+	clusterAccessor          func() []*v1alpha1.Cluster
+	appAccessor              func() []*v1alpha1.Application
+	clusterCacheInfoAccessor func() map[string]*v1alpha1.ClusterCacheInfo
+	// shardAccessor returns the shards currently assigned to the clusters, indexed by cluster server
+	shardAccessor func() map[string]int
 )
 
 // shardApplicationControllerMapping stores the mapping of Shard Number to Application Controller in ConfigMap.
@@ -82,7 +93,7 @@ func GetClusterFilter(_ db.ArgoDB, distributionFunction DistributionFunction, re
 
 // GetDistributionFunction returns which DistributionFunction should be used based on the passed algorithm and
 // the current datas.
-func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardingAlgorithm string, replicasCount int) DistributionFunction {
+func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, clustersCacheInfo clusterCacheInfoAccessor, shards shardAccessor, shardingAlgorithm string, replicasCount int) DistributionFunction {
 	log.Debugf("Using filter function:  %s", shardingAlgorithm)
 	distributionFunction := LegacyDistributionFunction(replicasCount)
 	switch shardingAlgorithm {
@@ -92,6 +103,8 @@ func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardin
 		distributionFunction = LegacyDistributionFunction(replicasCount)
 	case common.ConsistentHashingWithBoundedLoadsAlgorithm:
 		distributionFunction = ConsistentHashingWithBoundedLoadsDistributionFunction(clusters, apps, replicasCount)
+	case common.WeightedShardingAlgorithm:
+		distributionFunction = WeightedDistributionFunction(clusters, clustersCacheInfo, shards, replicasCount, RebalanceThreshold)
 	default:
 		log.Warnf("distribution type %s is not supported, defaulting to %s", shardingAlgorithm, common.DefaultShardingAlgorithm)
 	}
@@ -252,6 +265,154 @@ func getAppDistribution(getCluster clusterAccessor, getApps appAccessor) map[str
 	return appDistribution
 }
 
+// WeightedDistributionFunction returns a DistributionFunction using a weighted distribution algorithm:
+// for a given cluster the function will return the shard number based on the number of resources of the clusters,
+// reported in their cache info, so that each shard gets a similar load. Clusters with the same shard group label are
+// assigned to the same shard, or pinned to a shard if the label value is a shard number. Each cluster, or group of
+// clusters, keeps its current shard, or is assigned to the shard of its hash if it has none, unless the load of this
+// shard would exceed the average load by more than the rebalance threshold, so that clusters are not reshuffled when
+// their number of resources changes slightly.
+func WeightedDistributionFunction(clusters clusterAccessor, clustersCacheInfo clusterCacheInfoAccessor, shards shardAccessor, replicas int, rebalanceThreshold float64) DistributionFunction {
+	return func(c *v1alpha1.Cluster) int {
+		if replicas > 0 {
+			if c == nil { // in-cluster does not necessarily have a secret assigned. So we are receiving a nil cluster here.
+				return 0
+			}
+
+			// if Shard is manually set and the assigned value is lower than the number of replicas,
+			// then its value is returned otherwise it is the default calculated value
+			if c.Shard != nil && int(*c.Shard) < replicas {
+				return int(*c.Shard)
+			}
+			// if the cluster is not in the clusters list anymore, we should unassign it from any shard, so we
+			// return the reserved value of -1
+			if !slices.Contains(clusters(), c) {
+				log.Warnf("Cluster with id=%s not found in cluster map.", c.ID)
+				return -1
+			}
+			shardIndexedByCluster := createWeightedDistribution(replicas, clusters, clustersCacheInfo, shards, rebalanceThreshold)
+			shard, ok := shardIndexedByCluster[c.ID]
+			if !ok {
+				log.Warnf("Cluster with id=%s not found in cluster map.", c.ID)
+				return -1
+			}
+			log.Debugf("Cluster with id=%s will be processed by shard %d", c.ID, shard)
+			return shard
+		}
+		log.Warnf("The number of replicas (%d) is lower than 1", replicas)
+		return -1
+	}
+}
+
+// weightedShardingUnit is a cluster, or a group of clusters with the same shard group label, that is assigned to a shard
+type weightedShardingUnit struct {
+	key        string
+	weight     int64
+	clusterIDs []string
+	// currentShard is the shard currently assigned to the clusters of the unit, -1 if they have none or several
+	currentShard int
+}
+
+func createWeightedDistribution(replicas int, getCluster clusterAccessor, getClustersCacheInfo clusterCacheInfoAccessor, getShards shardAccessor, rebalanceThreshold float64) map[string]int {
+	clusters := getSortedClustersList(getCluster)
+	var clustersCacheInfo map[string]*v1alpha1.ClusterCacheInfo
+	if getClustersCacheInfo != nil {
+		clustersCacheInfo = getClustersCacheInfo()
+	}
+	var currentShards map[string]int
+	if getShards != nil {
+		currentShards = getShards()
+	}
+	shardIndexedByCluster := make(map[string]int, len(clusters))
+	loadIndexedByShard := make([]int64, replicas)
+	unitsByKey := make(map[string]*weightedShardingUnit)
+	var units []*weightedShardingUnit
+	var totalWeight int64
+
+	for _, c := range clusters {
+		weight := getClusterWeight(c, clustersCacheInfo)
+		totalWeight += weight
+		if c.Shard != nil && int(*c.Shard) < replicas {
+			shardIndexedByCluster[c.ID] = int(*c.Shard)
+			loadIndexedByShard[*c.Shard] += weight
+			continue
+		}
+		key := c.ID
+		if group := c.Labels[common.LabelKeyClusterShardGroup]; group != "" {
+			if shard, err := strconv.Atoi(group); err == nil && shard >= 0 && shard < replicas {
+				// the group is pinned to a shard
+				shardIndexedByCluster[c.ID] = shard
+				loadIndexedByShard[shard] += weight
+				continue
+			}
+			key = common.LabelKeyClusterShardGroup + "=" + group
+		}
+		currentShard, ok := currentShards[c.Server]
+		if !ok || currentShard < 0 || currentShard >= replicas {
+			currentShard = -1
+		}
+		unit, ok := unitsByKey[key]
+		if !ok {
+			unit = &weightedShardingUnit{key: key, currentShard: currentShard}
+			unitsByKey[key] = unit
+			units = append(units, unit)
+		} else if unit.currentShard != currentShard {
+			unit.currentShard = -1
+		}
+		unit.weight += weight
+		unit.clusterIDs = append(unit.clusterIDs, c.ID)
+	}
+
+	// Assign the heaviest units first, so that they are the ones staying on their current shard, or the shard of their
+	// hash
+	sort.SliceStable(units, func(i, j int) bool {
+		if units[i].weight != units[j].weight {
+			return units[i].weight > units[j].weight
+		}
+		return units[i].key < units[j].key
+	})
+	maxLoad := float64(totalWeight) / float64(replicas) * (1 + rebalanceThreshold)
+	for _, unit := range units {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(unit.key))
+		hashShard := int(h.Sum32() % uint32(replicas))
+
+		// keep the current shard, or use the shard of the hash, or the next shards, unless their load would exceed the
+		// maximum load, in which case the least loaded shard is used
+		shard := -1
+		if unit.currentShard >= 0 && float64(loadIndexedByShard[unit.currentShard]+unit.weight) <= maxLoad {
+			shard = unit.currentShard
+		}
+		leastLoadedShard := hashShard
+		for i := 0; shard == -1 && i < replicas; i++ {
+			candidate := (hashShard + i) % replicas
+			if float64(loadIndexedByShard[candidate]+unit.weight) <= maxLoad {
+				shard = candidate
+				break
+			}
+			if loadIndexedByShard[candidate] < loadIndexedByShard[leastLoadedShard] {
+				leastLoadedShard = candidate
+			}
+		}
+		if shard == -1 {
+			shard = leastLoadedShard
+		}
+		loadIndexedByShard[shard] += unit.weight
+		for _, id := range unit.clusterIDs {
+			shardIndexedByCluster[id] = shard
+		}
+	}
+	return shardIndexedByCluster
+}
+
+// getClusterWeight returns the number of resources of the cluster, or 1 if its cache info is not known
+func getClusterWeight(c *v1alpha1.Cluster, clustersCacheInfo map[string]*v1alpha1.ClusterCacheInfo) int64 {
+	if info, ok := clustersCacheInfo[c.Server]; ok && info != nil && info.ResourcesCount > 0 {
+		return info.ResourcesCount
+	}
+	return 1
+}
+
 // NoShardingDistributionFunction returns a DistributionFunction that will process all cluster by shard 0
 // the function is created for API compatibility purposes and is not supposed to be activated.
 func NoShardingDistributionFunction() DistributionFunction {
@@ -332,11 +493,13 @@ func GetOrUpdateShardFromConfigMap(kubeClient kubernetes.Interface, settingsMgr
 		return shard, nil
 	}
 	// Identify the available shard and update the ConfigMap
-	data := shardMappingCM.Data[ShardControllerMappingKey]
+	// the ConfigMap may have been created to store the weighted shard assignment only
 	var shardMappingData []shardApplicationControllerMapping
-	err = json.Unmarshal([]byte(data), &shardMappingData)
-	if err != nil {
-		return -1, fmt.Errorf("error unmarshalling shard config map data: %w", err)
+	if data := shardMappingCM.Data[ShardControllerMappingKey]; data != "" {
+		err = json.Unmarshal([]byte(data), &shardMappingData)
+		if err != nil {
+			return -1, fmt.Errorf("error unmarshalling shard config map data: %w", err)
+		}
 	}
 
 	shard, shardMappingData = getOrUpdateShardNumberForController(shardMappingData, hostname, replicas, shard)
@@ -344,6 +507,9 @@ func GetOrUpdateShardFromConfigMap(kubeClient kubernetes.Interface, settingsMgr
 	if err != nil {
 		return -1, fmt.Errorf("error marshalling data of shard mapping ConfigMap: %w", err)
 	}
+	if shardMappingCM.Data == nil {
+		shardMappingCM.Data = map[string]string{}
+	}
 	shardMappingCM.Data[ShardControllerMappingKey] = string(updatedShardMappingData)
 
 	_, err = kubeClient.CoreV1().ConfigMaps(settingsMgr.GetNamespace()).Update(context.Background(), shardMappingCM, metav1.UpdateOptions{})
@@ -457,6 +623,67 @@ func getDefaultShardMappingData(replicas int) []shardApplicationControllerMappin
 	return shardMappingData
 }
 
+// weightedShardAssignmentStore stores the assignment of the clusters to the shards of the weighted sharding algorithm in
+// the shard mapping ConfigMap, so that all the replicas share it.
+type weightedShardAssignmentStore struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	// readOnly is true if the assignment is only read, and never computed nor written
+	readOnly bool
+}
+
+// get returns the stored assignment, indexed by cluster server, which is empty if none was stored yet
+func (s *weightedShardAssignmentStore) get() (map[string]int, error) {
+	shardMappingCM, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Get(context.Background(), common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return map[string]int{}, nil
+		}
+		return nil, fmt.Errorf("error getting sharding config map: %w", err)
+	}
+	assignment := map[string]int{}
+	if data := shardMappingCM.Data[WeightedShardAssignmentKey]; data != "" {
+		if err := json.Unmarshal([]byte(data), &assignment); err != nil {
+			return nil, fmt.Errorf("error unmarshalling weighted shard assignment: %w", err)
+		}
+	}
+	return assignment, nil
+}
+
+// set stores the assignment, creating the shard mapping ConfigMap if it does not exist
+func (s *weightedShardAssignmentStore) set(assignment map[string]int) error {
+	data, err := json.Marshal(assignment)
+	if err != nil {
+		return fmt.Errorf("error marshalling weighted shard assignment: %w", err)
+	}
+	configMaps := s.kubeClient.CoreV1().ConfigMaps(s.namespace)
+	shardMappingCM, err := configMaps.Get(context.Background(), common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting sharding config map: %w", err)
+		}
+		shardMappingCM = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.ArgoCDAppControllerShardConfigMapName,
+				Namespace: s.namespace,
+			},
+			Data: map[string]string{WeightedShardAssignmentKey: string(data)},
+		}
+		if _, err := configMaps.Create(context.Background(), shardMappingCM, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("error creating shard mapping configmap: %w", err)
+		}
+		return nil
+	}
+	if shardMappingCM.Data == nil {
+		shardMappingCM.Data = map[string]string{}
+	}
+	shardMappingCM.Data[WeightedShardAssignmentKey] = string(data)
+	if _, err := configMaps.Update(context.Background(), shardMappingCM, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating shard mapping configmap: %w", err)
+	}
+	return nil
+}
+
 func GetClusterSharding(kubeClient kubernetes.Interface, settingsMgr *settings.SettingsManager, shardingAlgorithm string, enableDynamicClusterDistribution bool) (ClusterShardingCache, error) {
 	var replicasCount int
 	if enableDynamicClusterDistribution {
@@ -510,5 +737,6 @@ func GetClusterSharding(kubeClient kubernetes.Interface, settingsMgr *settings.S
 		shardNumber = 0
 	}
 	db := db.NewDB(settingsMgr.GetNamespace(), settingsMgr, kubeClient)
-	return NewClusterSharding(db, shardNumber, replicasCount, shardingAlgorithm), nil
+	clusterSharding := NewClusterSharding(db, shardNumber, replicasCount, shardingAlgorithm)
+	return ShareWeightedDistribution(clusterSharding, kubeClient, settingsMgr.GetNamespace(), false), nil
 }
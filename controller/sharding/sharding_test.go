@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
@@ -110,7 +111,7 @@ func TestGetClusterFilterUnknown(t *testing.T) {
 	t.Setenv(common.EnvControllerShardingAlgorithm, "unknown")
 	replicasCount := 2
 	db.EXPECT().GetApplicationControllerReplicas().Return(replicasCount).Maybe()
-	distributionFunction := GetDistributionFunction(clusterAccessor, appAccessor, "unknown", replicasCount)
+	distributionFunction := GetDistributionFunction(clusterAccessor, appAccessor, nil, nil, "unknown", replicasCount)
 	assert.Equal(t, 0, distributionFunction(nil))
 	assert.Equal(t, 0, distributionFunction(&cluster1))
 	assert.Equal(t, 1, distributionFunction(&cluster2))
@@ -125,7 +126,7 @@ func TestLegacyGetClusterFilterWithFixedShard(t *testing.T) {
 	appAccessor, _, _, _, _, _ := createTestApps()
 	replicasCount := 5
 	db.EXPECT().GetApplicationControllerReplicas().Return(replicasCount).Maybe()
-	filter := GetDistributionFunction(clusterAccessor, appAccessor, common.DefaultShardingAlgorithm, replicasCount)
+	filter := GetDistributionFunction(clusterAccessor, appAccessor, nil, nil, common.DefaultShardingAlgorithm, replicasCount)
 	assert.Equal(t, 0, filter(nil))
 	assert.Equal(t, 4, filter(&cluster1))
 	assert.Equal(t, 1, filter(&cluster2))
@@ -135,13 +136,13 @@ func TestLegacyGetClusterFilterWithFixedShard(t *testing.T) {
 	var fixedShard int64 = 4
 	cluster5 := &v1alpha1.Cluster{ID: "5", Shard: &fixedShard}
 	clusterAccessor = getClusterAccessor([]v1alpha1.Cluster{cluster1, cluster2, cluster2, cluster4, *cluster5})
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.DefaultShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, nil, common.DefaultShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(cluster5))
 
 	fixedShard = 1
 	cluster5.Shard = &fixedShard
 	clusterAccessor = getClusterAccessor([]v1alpha1.Cluster{cluster1, cluster2, cluster2, cluster4, *cluster5})
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.DefaultShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, nil, common.DefaultShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(&v1alpha1.Cluster{ID: "4", Shard: &fixedShard}))
 }
 
@@ -153,7 +154,7 @@ func TestRoundRobinGetClusterFilterWithFixedShard(t *testing.T) {
 	replicasCount := 4
 	db.EXPECT().GetApplicationControllerReplicas().Return(replicasCount).Maybe()
 
-	filter := GetDistributionFunction(clusterAccessor, appAccessor, common.RoundRobinShardingAlgorithm, replicasCount)
+	filter := GetDistributionFunction(clusterAccessor, appAccessor, nil, nil, common.RoundRobinShardingAlgorithm, replicasCount)
 	assert.Equal(t, 0, filter(nil))
 	assert.Equal(t, 0, filter(&cluster1))
 	assert.Equal(t, 1, filter(&cluster2))
@@ -166,14 +167,14 @@ func TestRoundRobinGetClusterFilterWithFixedShard(t *testing.T) {
 	cluster5 := v1alpha1.Cluster{Name: "cluster5", ID: "5", Shard: &fixedShard}
 	clusters := []v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4, cluster5}
 	clusterAccessor = getClusterAccessor(clusters)
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.RoundRobinShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, nil, common.RoundRobinShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(&cluster5))
 
 	fixedShard = 1
 	cluster5 = v1alpha1.Cluster{Name: "cluster5", ID: "5", Shard: &fixedShard}
 	clusters = []v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4, cluster5}
 	clusterAccessor = getClusterAccessor(clusters)
-	filter = GetDistributionFunction(clusterAccessor, appAccessor, common.RoundRobinShardingAlgorithm, replicasCount)
+	filter = GetDistributionFunction(clusterAccessor, appAccessor, nil, nil, common.RoundRobinShardingAlgorithm, replicasCount)
 	assert.Equal(t, int(fixedShard), filter(&v1alpha1.Cluster{Name: "cluster4", ID: "4", Shard: &fixedShard}))
 }
 
@@ -378,6 +379,117 @@ func TestConsistentHashingWhenClusterWithFixedShard(t *testing.T) {
 	assert.Equal(t, fixedShard, int64(distributionFunction(cluster)))
 }
 
+func TestWeightedDistributionFunction(t *testing.T) {
+	clusters := []v1alpha1.Cluster{}
+	for i := range 6 {
+		id := fmt.Sprintf("%06d", i)
+		clusters = append(clusters, createCluster("cluster-"+id, id))
+	}
+	resourcesCounts := []int64{40000, 20000, 300, 300, 300, 300}
+	clustersCacheInfo := map[string]*v1alpha1.ClusterCacheInfo{}
+	for i := range clusters {
+		clustersCacheInfo[clusters[i].Server] = &v1alpha1.ClusterCacheInfo{ResourcesCount: resourcesCounts[i]}
+	}
+	clustersCacheInfoAccessor := func() map[string]*v1alpha1.ClusterCacheInfo { return clustersCacheInfo }
+	replicasCount := 2
+
+	t.Run("balances the resources across shards", func(t *testing.T) {
+		distributionFunction := WeightedDistributionFunction(getClusterAccessor(clusters), clustersCacheInfoAccessor, nil, replicasCount, 0.2)
+		assert.Equal(t, 0, distributionFunction(nil))
+		assert.NotEqual(t, distributionFunction(&clusters[0]), distributionFunction(&clusters[1]))
+		for i := 2; i < len(clusters); i++ {
+			// the small clusters are assigned to the shard of the cluster with the fewest resources
+			assert.Equal(t, distributionFunction(&clusters[1]), distributionFunction(&clusters[i]))
+		}
+	})
+
+	t.Run("does not rebalance below the threshold", func(t *testing.T) {
+		distribution := createWeightedDistribution(replicasCount, getClusterAccessor(clusters), clustersCacheInfoAccessor, nil, 0.2)
+		clustersCacheInfo[clusters[1].Server] = &v1alpha1.ClusterCacheInfo{ResourcesCount: 22000}
+		defer func() { clustersCacheInfo[clusters[1].Server] = &v1alpha1.ClusterCacheInfo{ResourcesCount: 20000} }()
+		assert.Equal(t, distribution, createWeightedDistribution(replicasCount, getClusterAccessor(clusters), clustersCacheInfoAccessor, nil, 0.2))
+	})
+
+	t.Run("keeps the current shards below the threshold", func(t *testing.T) {
+		currentShards := map[string]int{}
+		for i := range clusters {
+			currentShards[clusters[i].Server] = i % replicasCount
+		}
+		distribution := createWeightedDistribution(replicasCount, getClusterAccessor(clusters), nil, func() map[string]int { return currentShards }, 0.2)
+		for i := range clusters {
+			assert.Equal(t, i%replicasCount, distribution[clusters[i].ID])
+		}
+	})
+
+	t.Run("moves clusters from their current shard above the threshold", func(t *testing.T) {
+		currentShards := map[string]int{}
+		for i := range clusters {
+			currentShards[clusters[i].Server] = 1
+		}
+		distribution := createWeightedDistribution(replicasCount, getClusterAccessor(clusters), nil, func() map[string]int { return currentShards }, 0.2)
+		distributionMap := map[int]int{}
+		for i := range clusters {
+			distributionMap[distribution[clusters[i].ID]]++
+		}
+		assert.Equal(t, map[int]int{0: 3, 1: 3}, distributionMap)
+		// the first clusters keep their current shard
+		assert.Equal(t, 1, distribution[clusters[0].ID])
+	})
+
+	t.Run("assigns the clusters of a shard group to the same shard", func(t *testing.T) {
+		groupedClusters := slices.Clone(clusters)
+		for i := range groupedClusters {
+			groupedClusters[i].Labels = map[string]string{common.LabelKeyClusterShardGroup: "group"}
+		}
+		distributionFunction := WeightedDistributionFunction(getClusterAccessor(groupedClusters), clustersCacheInfoAccessor, nil, replicasCount, 0.2)
+		shard := distributionFunction(&groupedClusters[0])
+		for i := range groupedClusters {
+			assert.Equal(t, shard, distributionFunction(&groupedClusters[i]))
+		}
+	})
+
+	t.Run("pins the clusters of a shard group to a shard", func(t *testing.T) {
+		pinnedClusters := slices.Clone(clusters)
+		pinnedClusters[0].Labels = map[string]string{common.LabelKeyClusterShardGroup: "1"}
+		pinnedClusters[1].Labels = map[string]string{common.LabelKeyClusterShardGroup: "1"}
+		distributionFunction := WeightedDistributionFunction(getClusterAccessor(pinnedClusters), clustersCacheInfoAccessor, nil, replicasCount, 0.2)
+		assert.Equal(t, 1, distributionFunction(&pinnedClusters[0]))
+		assert.Equal(t, 1, distributionFunction(&pinnedClusters[1]))
+		for i := 2; i < len(pinnedClusters); i++ {
+			assert.Equal(t, 0, distributionFunction(&pinnedClusters[i]))
+		}
+	})
+
+	t.Run("honours the shard of the cluster", func(t *testing.T) {
+		var fixedShard int64 = 1
+		fixedClusters := slices.Clone(clusters)
+		fixedClusters[0].Shard = &fixedShard
+		fixedClusters[1].Shard = &fixedShard
+		distributionFunction := WeightedDistributionFunction(getClusterAccessor(fixedClusters), clustersCacheInfoAccessor, nil, replicasCount, 0.2)
+		assert.Equal(t, 1, distributionFunction(&fixedClusters[0]))
+		assert.Equal(t, 1, distributionFunction(&fixedClusters[1]))
+	})
+
+	t.Run("unassigns removed clusters", func(t *testing.T) {
+		distributionFunction := WeightedDistributionFunction(getClusterAccessor(clusters[:5]), clustersCacheInfoAccessor, nil, replicasCount, 0.2)
+		assert.Equal(t, -1, distributionFunction(&clusters[5]))
+	})
+
+	t.Run("uses equal weights without cache info", func(t *testing.T) {
+		distributionFunction := WeightedDistributionFunction(getClusterAccessor(clusters), nil, nil, replicasCount, 0)
+		distributionMap := map[int]int{}
+		for i := range clusters {
+			distributionMap[distributionFunction(&clusters[i])]++
+		}
+		assert.Equal(t, map[int]int{0: 3, 1: 3}, distributionMap)
+	})
+
+	t.Run("replicas set to 0", func(t *testing.T) {
+		distributionFunction := WeightedDistributionFunction(getClusterAccessor(clusters), clustersCacheInfoAccessor, nil, 0, 0.2)
+		assert.Equal(t, -1, distributionFunction(nil))
+	})
+}
+
 func TestGetShardByIndexModuloReplicasCountDistributionFunction(t *testing.T) {
 	clusters, db, cluster1, cluster2, _, _, _ := createTestClusters()
 	replicasCount := 2
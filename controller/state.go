@@ -48,7 +48,9 @@ import (
 	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/healthplugin"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/manifestpolicy"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 	"github.com/argoproj/argo-cd/v3/util/stats"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
@@ -118,6 +120,8 @@ type comparisonResult struct {
 	hasPreDeleteHooks  bool
 	// revisionsMayHaveChanges indicates if there are any possibilities that the revisions contain changes
 	revisionsMayHaveChanges bool
+	// pluginHealth holds the health of the managed resources which is assessed by health plugins
+	pluginHealth map[kubeutil.ResourceKey]*v1alpha1.HealthStatus
 }
 
 func (res *comparisonResult) GetSyncStatus() *v1alpha1.SyncStatus {
@@ -147,6 +151,7 @@ type appStateManager struct {
 	repoErrorGracePeriod  time.Duration
 	serverSideDiff        bool
 	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
+	healthPlugins         healthplugin.Clientset
 }
 
 // EvaluateAppRevisionsChanges checks if any source revisions have changes without generating manifests.
@@ -391,7 +396,7 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 			}
 
 			log.Debugf("Generating Manifest for source %s revision %s", source, revision)
-			manifestInfo, err := repoClient.GenerateManifest(srcCtx, &apiclient.ManifestRequest{
+			manifestInfo, targetObj, err := generateManifests(srcCtx, repoClient, &apiclient.ManifestRequest{
 				Repo:                            repo,
 				Repos:                           repos,
 				Revision:                        revision,
@@ -417,18 +422,20 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 				AnnotationManifestGeneratePaths: app.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 				InstallationID:                  installationID,
 			})
+			var unmarshalErr *manifestUnmarshalError
+			if errors.As(err, &unmarshalErr) {
+				return fmt.Errorf("failed to unmarshal manifests for source %d of %d: %w", i+1, len(sources), unmarshalErr.err)
+			}
 			if err != nil {
 				genErr := fmt.Errorf("failed to generate manifest for source %d of %d: %w", i+1, len(sources), err)
-				if app.Spec.SourceHydrator != nil && app.Spec.SourceHydrator.HydrateTo != nil && strings.Contains(err.Error(), path.ErrMessageAppPathDoesNotExist) {
+				if app.Spec.SourceHydrator != nil && app.Spec.SourceHydrator.HydrateTo != nil && !app.Spec.SourceHydrator.HydrateTo.IsOCI() && strings.Contains(err.Error(), path.ErrMessageAppPathDoesNotExist) {
 					genErr = fmt.Errorf("%w - waiting for an external process to update %s from %s", genErr, app.Spec.SourceHydrator.SyncSource.TargetBranch, app.Spec.SourceHydrator.HydrateTo.TargetBranch)
+				} else if app.Spec.SourceHydrator != nil && app.Status.SourceHydrator.PullRequest != nil && strings.Contains(err.Error(), path.ErrMessageAppPathDoesNotExist) {
+					genErr = fmt.Errorf("%w - waiting for pull request %d to be merged into %s", genErr, app.Status.SourceHydrator.PullRequest.Number, app.Status.SourceHydrator.PullRequest.TargetBranch)
 				}
 				return genErr
 			}
 
-			targetObj, err := unmarshalManifests(manifestInfo.Manifests)
-			if err != nil {
-				return fmt.Errorf("failed to unmarshal manifests for source %d of %d: %w", i+1, len(sources), err)
-			}
 			targetObjs = append(targetObjs, targetObj...)
 			manifestInfos = append(manifestInfos, manifestInfo)
 
@@ -822,6 +829,15 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 	}
 	ts.AddCheckpoint("dedup_ms")
 
+	for _, violation := range manifestpolicy.Evaluate(project.Spec.ManifestPolicies, app, targetObjs) {
+		conditionType := v1alpha1.ApplicationConditionManifestPolicyWarning
+		if violation.Policy.Enforce {
+			conditionType = v1alpha1.ApplicationConditionManifestPolicyError
+		}
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: conditionType, Message: violation.Error(), LastTransitionTime: &now})
+	}
+	ts.AddCheckpoint("manifest_policy_ms")
+
 	liveObjByKey, err := m.liveStateCache.GetManagedLiveObjs(destCluster, app, targetObjs)
 	if err != nil {
 		liveObjByKey = make(map[kubeutil.ResourceKey]*unstructured.Unstructured)
@@ -953,7 +969,15 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionUnknownError, Message: err.Error(), LastTransitionTime: &now})
 		} else {
 			defer cleanup()
-			diffConfigBuilder.WithServerSideDryRunner(diff.NewK8sServerSideDryRunner(applier))
+			var dryRunner diff.ServerSideDryRunner = diff.NewK8sServerSideDryRunner(applier)
+			if m.cache != nil {
+				dryRunner = newCachingServerSideDryRunner(dryRunner, m.cache, destCluster.Server, reconciliation.Live, func(hit bool) {
+					if m.metricsServer != nil {
+						m.metricsServer.IncServerSideDiffCache(app, destCluster.Server, hit)
+					}
+				}, logCtx)
+			}
+			diffConfigBuilder.WithServerSideDryRunner(dryRunner)
 		}
 	}
 
@@ -1107,7 +1131,15 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 
 	ts.AddCheckpoint("sync_ms")
 
-	healthStatus, healthMessage, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, m.persistResourceHealth)
+	healthOverrides := &resourceHealthOverrides{
+		ctx:           ctx,
+		overrides:     resourceOverrides,
+		healthPlugins: m.healthPlugins,
+		getChildren: func(obj *unstructured.Unstructured) ([]*v1alpha1.ResourceNode, error) {
+			return m.getResourceChildren(destCluster, obj)
+		},
+	}
+	healthStatus, healthMessage, err := setApplicationHealth(managedResources, resourceSummaries, healthOverrides, app, m.persistResourceHealth)
 	if err != nil {
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: "error setting app health: " + err.Error(), LastTransitionTime: &now})
 	}
@@ -1152,6 +1184,7 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		hasPostDeleteHooks:      hasPostDeleteHooks,
 		hasPreDeleteHooks:       hasPreDeleteHooks,
 		revisionsMayHaveChanges: revisionsMayHaveChanges,
+		pluginHealth:            healthOverrides.pluginHealth,
 	}
 
 	if hasMultipleSources {
@@ -1170,6 +1203,8 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		v1alpha1.ApplicationConditionSharedResourceWarning:   true,
 		v1alpha1.ApplicationConditionRepeatedResourceWarning: true,
 		v1alpha1.ApplicationConditionExcludedResourceWarning: true,
+		v1alpha1.ApplicationConditionManifestPolicyError:     true,
+		v1alpha1.ApplicationConditionManifestPolicyWarning:   true,
 	})
 	ts.AddCheckpoint("health_ms")
 	compRes.timings = ts.Timings()
@@ -1335,7 +1370,24 @@ func NewAppStateManager(
 		repoErrorGracePeriod:  repoErrorGracePeriod,
 		serverSideDiff:        serverSideDiff,
 		ignoreNormalizerOpts:  ignoreNormalizerOpts,
+		healthPlugins:         healthplugin.NewHealthPluginClientSet(common.GetHealthPluginSockFilePath()),
+	}
+}
+
+// getResourceChildren returns the nodes of the resource tree of the given resource, as found in the cluster cache
+func (m *appStateManager) getResourceChildren(destCluster *v1alpha1.Cluster, obj *unstructured.Unstructured) ([]*v1alpha1.ResourceNode, error) {
+	key := kubeutil.GetResourceKey(obj)
+	var children []*v1alpha1.ResourceNode
+	err := m.liveStateCache.IterateHierarchyV2(destCluster, []kubeutil.ResourceKey{key}, func(child v1alpha1.ResourceNode, _ string) bool {
+		if kubeutil.NewResourceKey(child.Group, child.Kind, child.Namespace, child.Name) != key {
+			children = append(children, &child)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate resource hierarchy: %w", err)
 	}
+	return children, nil
 }
 
 // isSelfReferencedObj returns whether the given obj is managed by the application
@@ -321,6 +321,46 @@ func TestCompareAppStateMissing(t *testing.T) {
 	assert.Empty(t, app.Status.Conditions)
 }
 
+// TestCompareAppStateManifestPolicies tests that the violations of the manifest policies of the project are reported
+func TestCompareAppStateManifestPolicies(t *testing.T) {
+	app := newFakeApp()
+	data := fakeData{
+		apps: []runtime.Object{app},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{PodManifest},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+	proj := defaultProj.DeepCopy()
+	proj.Spec.ManifestPolicies = []v1alpha1.ManifestPolicy{{
+		Name:    "resource-limits",
+		Kinds:   []metav1.GroupKind{{Kind: "Pod"}},
+		CEL:     `object.spec.containers.all(c, has(c.resources.limits))`,
+		Message: "containers must have resource limits",
+		Enforce: true,
+	}, {
+		Name: "approved-registry",
+		CEL:  `!has(object.spec.containers) || object.spec.containers.all(c, c.image.startsWith("registry.example.com/"))`,
+	}, {
+		Name:  "replicas",
+		Kinds: []metav1.GroupKind{{Group: "apps", Kind: "*"}},
+		CEL:   `object.spec.replicas > 1`,
+	}}
+	sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+	compRes, err := ctrl.appStateManager.CompareAppState(t.Context(), app, proj, []string{""}, sources, false, false, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	require.Len(t, app.Status.Conditions, 2)
+	assert.Equal(t, v1alpha1.ApplicationConditionManifestPolicyError, app.Status.Conditions[0].Type)
+	assert.Equal(t, "manifest policy 'resource-limits' violated by /Pod "+test.FakeDestNamespace+"/my-pod: containers must have resource limits", app.Status.Conditions[0].Message)
+	assert.Equal(t, v1alpha1.ApplicationConditionManifestPolicyWarning, app.Status.Conditions[1].Type)
+	assert.Contains(t, app.Status.Conditions[1].Message, "manifest policy 'approved-registry' violated by /Pod")
+}
+
 // TestCompareAppStateExtra tests when there is an extra object in live but not defined in git
 func TestCompareAppStateExtra(t *testing.T) {
 	pod := NewPod()
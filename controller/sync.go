@@ -154,6 +154,9 @@ func newSyncOperationResult(app *v1alpha1.Application, op v1alpha1.SyncOperation
 	// concrete git commit SHA, the revision of the SyncOperationResult will be updated with the SHA
 	syncRes.Revision = op.Revision
 	syncRes.Revisions = op.Revisions
+	if syncRes.Revision == "" && len(op.Sources) == 0 && op.Source == nil {
+		syncRes.Revision = app.GetHydratedOCIRevision()
+	}
 	return syncRes
 }
 
@@ -188,7 +191,7 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		state.SyncResult = newSyncOperationResult(app, syncOp)
 	}
 
-	if isBlocked, err := syncWindowPreventsSync(app, project); isBlocked {
+	if isBlocked, err := m.syncWindowPreventsSync(app, project); isBlocked {
 		// If the operation is currently running, simply let the user know the sync is blocked by a current sync window
 		if state.Phase == common.OperationRunning {
 			state.Message = "Sync operation blocked by sync window"
@@ -228,10 +231,11 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		return
 	}
 
-	// If there are any comparison or spec errors error conditions do not perform the operation
+	// If there are any comparison, spec or enforced manifest policy error conditions do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:  true,
-		v1alpha1.ApplicationConditionInvalidSpecError: true,
+		v1alpha1.ApplicationConditionComparisonError:     true,
+		v1alpha1.ApplicationConditionInvalidSpecError:    true,
+		v1alpha1.ApplicationConditionManifestPolicyError: true,
 	}); len(errConditions) > 0 {
 		state.Phase = common.OperationError
 		state.Message = argo.FormatAppConditions(errConditions)
@@ -337,13 +341,15 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		log.Errorf("could not get impersonation feature flag: %v", err)
 		return
 	}
+	var serviceAccountsToImpersonate *settings.ServiceAccountsToImpersonate
 	if impersonationEnabled {
-		serviceAccountToImpersonate, err := settings.DeriveServiceAccountToImpersonate(project, app, destCluster)
+		serviceAccountsToImpersonate, err = settings.DeriveServiceAccountsToImpersonate(project, app, destCluster)
 		if err != nil {
 			state.Phase = common.OperationError
 			state.Message = fmt.Sprintf("failed to derive service account to impersonate: %v", err)
 			return
 		}
+		serviceAccountToImpersonate := serviceAccountsToImpersonate.Default
 
 		if serviceAccountToImpersonate == "" {
 			// No matching service account found - check enforcement
@@ -413,6 +419,13 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		opts = append(opts, sync.WithNamespaceModifier(syncNamespace(app.Spec.SyncPolicy)))
 	}
 
+	if serviceAccountsToImpersonate != nil && serviceAccountsToImpersonate.HasResourceServiceAccounts() {
+		// the resources of specific kinds are synced with their own service account instead of the default one
+		opts = append(opts, sync.WithResourceImpersonation(func(key kube.ResourceKey) string {
+			return serviceAccountsToImpersonate.ForResource(key.Group, key.Kind, key.Namespace)
+		}))
+	}
+
 	syncCtx, cleanup, err := sync.NewSyncContext(
 		compareResult.syncStatus.Revision,
 		reconciliationResult,
@@ -724,8 +737,12 @@ func delayBetweenSyncWaves(_ common.SyncPhase, _ int, finalWave bool) error {
 	return nil
 }
 
-func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, error) {
-	window := proj.Spec.SyncWindows.Matches(app)
+func (m *appStateManager) syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, error) {
+	window, err := m.settingsMgr.ResolveSyncWindowCalendars(proj.Spec.SyncWindows.Matches(app))
+	if err != nil {
+		// prevents sync because sync window has an error
+		return true, err
+	}
 	isManual := false
 	var operationStartTime *time.Time
 	if app.Status.OperationState != nil {
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+	"math"
+
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+const (
+	// EnvSyncRecordsEnabled is the env variable which enables the persistence of the outcome of every sync operation
+	// in an ApplicationSyncRecord resource
+	EnvSyncRecordsEnabled = "ARGOCD_APPLICATION_CONTROLLER_SYNC_RECORDS_ENABLED"
+	// EnvSyncRecordsLimit is the env variable which holds the maximum number of sync records kept per application
+	EnvSyncRecordsLimit = "ARGOCD_APPLICATION_CONTROLLER_SYNC_RECORDS_LIMIT"
+)
+
+var (
+	// syncRecordsEnabled specifies whether the outcome of sync operations is persisted in sync records
+	syncRecordsEnabled = false
+	// syncRecordsLimit specifies the maximum number of sync records kept per application, 0 means unlimited
+	syncRecordsLimit = 100
+)
+
+func init() {
+	syncRecordsEnabled = env.ParseBoolFromEnv(EnvSyncRecordsEnabled, syncRecordsEnabled)
+	syncRecordsLimit = env.ParseNumFromEnv(EnvSyncRecordsLimit, syncRecordsLimit, 0, math.MaxInt32)
+}
+
+// persistSyncRecord stores the outcome of the completed sync operation of the application in a sync record, and
+// deletes the oldest records of the application beyond the limit. Failures are logged but do not fail the operation.
+func (ctrl *ApplicationController) persistSyncRecord(ctx context.Context, app *appv1.Application, state *appv1.OperationState) {
+	if !syncRecordsEnabled || state.Operation.Sync == nil {
+		return
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	recordIf := ctrl.applicationClientset.ArgoprojV1alpha1().ApplicationSyncRecords(app.Namespace)
+	record, err := recordIf.Create(ctx, argo.NewSyncRecord(app, state), metav1.CreateOptions{})
+	if err != nil {
+		logCtx.WithError(err).Warn("error creating sync record")
+		return
+	}
+	logCtx.Debugf("created sync record %s", record.Name)
+
+	if syncRecordsLimit == 0 {
+		return
+	}
+	records, err := argo.ListSyncRecords(ctx, recordIf, app.Name)
+	if err != nil {
+		logCtx.WithError(err).Warn("error pruning sync records")
+		return
+	}
+	for i := 0; i < len(records)-syncRecordsLimit; i++ {
+		err := recordIf.Delete(ctx, records[i].Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			logCtx.WithError(err).Warnf("error deleting sync record %s", records[i].Name)
+		}
+	}
+}
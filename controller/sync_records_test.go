@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+)
+
+func enableSyncRecords(t *testing.T, limit int) {
+	t.Helper()
+	enabled, oldLimit := syncRecordsEnabled, syncRecordsLimit
+	syncRecordsEnabled, syncRecordsLimit = true, limit
+	t.Cleanup(func() {
+		syncRecordsEnabled, syncRecordsLimit = enabled, oldLimit
+	})
+}
+
+// generateSyncRecordNames makes the fake clientset generate the names of the created sync records
+func generateSyncRecordNames(ctrl *ApplicationController) {
+	count := 0
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	fakeAppCs.PrependReactor("create", "applicationsyncrecords", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		record := action.(kubetesting.CreateAction).GetObject().(*v1alpha1.ApplicationSyncRecord)
+		count++
+		record.Name = fmt.Sprintf("%s%d", record.GenerateName, count)
+		return false, nil, nil
+	})
+}
+
+func newSyncOperationState(startedAt time.Time, phase synccommon.OperationPhase) *v1alpha1.OperationState {
+	return &v1alpha1.OperationState{
+		Operation: v1alpha1.Operation{
+			Sync:        &v1alpha1.SyncOperation{Revision: "abc123"},
+			InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"},
+		},
+		Phase:     phase,
+		StartedAt: metav1.NewTime(startedAt),
+		SyncResult: &v1alpha1.SyncOperationResult{
+			Revision: "abc123",
+			Resources: v1alpha1.ResourceResults{{
+				Kind:      "Deployment",
+				Namespace: "default",
+				Name:      "guestbook",
+				Status:    synccommon.ResultCodeSynced,
+				Message:   "deployment.apps/guestbook created",
+			}},
+		},
+	}
+}
+
+func TestPersistSyncRecord(t *testing.T) {
+	enableSyncRecords(t, 100)
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	generateSyncRecordNames(ctrl)
+
+	state := newSyncOperationState(time.Now(), synccommon.OperationFailed)
+	ctrl.persistSyncRecord(t.Context(), app, state)
+
+	records, err := ctrl.applicationClientset.ArgoprojV1alpha1().ApplicationSyncRecords(app.Namespace).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, records.Items, 1)
+	record := records.Items[0]
+	assert.Equal(t, "my-app-1", record.Name)
+	assert.Equal(t, map[string]string{common.LabelKeySyncRecordApplication: app.Name}, record.Labels)
+	require.Len(t, record.OwnerReferences, 1)
+	assert.Equal(t, app.Name, record.OwnerReferences[0].Name)
+	assert.Equal(t, "Application", record.OwnerReferences[0].Kind)
+	assert.Equal(t, app.Name, record.Spec.Application)
+	assert.Equal(t, "default", record.Spec.Project)
+	assert.Equal(t, *state, record.Spec.OperationState)
+}
+
+func TestPersistSyncRecord_Disabled(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+
+	ctrl.persistSyncRecord(t.Context(), app, newSyncOperationState(time.Now(), synccommon.OperationSucceeded))
+
+	records, err := ctrl.applicationClientset.ArgoprojV1alpha1().ApplicationSyncRecords(app.Namespace).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, records.Items)
+}
+
+func TestPersistSyncRecord_Prune(t *testing.T) {
+	enableSyncRecords(t, 2)
+	app := newFakeApp()
+	other := newFakeApp()
+	other.Name = "other-app"
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, other, &defaultProj}}, nil)
+	generateSyncRecordNames(ctrl)
+
+	now := time.Now()
+	ctrl.persistSyncRecord(t.Context(), other, newSyncOperationState(now.Add(-time.Hour), synccommon.OperationSucceeded))
+	for i := 3; i > 0; i-- {
+		ctrl.persistSyncRecord(t.Context(), app, newSyncOperationState(now.Add(-time.Duration(i)*time.Minute), synccommon.OperationSucceeded))
+	}
+
+	records, err := ctrl.applicationClientset.ArgoprojV1alpha1().ApplicationSyncRecords(app.Namespace).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	var names []string
+	for _, record := range records.Items {
+		names = append(names, record.Name)
+	}
+	// the oldest record of the application is deleted, the record of the other application is kept
+	assert.ElementsMatch(t, []string{"other-app-1", "my-app-3", "my-app-4"}, names)
+}
@@ -224,6 +224,47 @@ func TestSyncComparisonError(t *testing.T) {
 	assert.Equal(t, "abc123", opState.SyncResult.Revision)
 }
 
+func TestSyncManifestPolicyViolation(t *testing.T) {
+	for _, enforce := range []bool{true, false} {
+		t.Run("Enforce="+strconv.FormatBool(enforce), func(t *testing.T) {
+			app := newFakeApp()
+			app.Status.OperationState = nil
+			app.Status.History = nil
+
+			project := defaultProj.DeepCopy()
+			project.Spec.ManifestPolicies = []v1alpha1.ManifestPolicy{{
+				Name:    "no-configmaps",
+				CEL:     `object.kind != "ConfigMap"`,
+				Enforce: enforce,
+			}}
+			data := fakeData{
+				apps: []runtime.Object{app, project},
+				manifestResponse: &apiclient.ManifestResponse{
+					Manifests: []string{`{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "my-config"}}`},
+					Namespace: test.FakeDestNamespace,
+					Server:    test.FakeClusterURL,
+					Revision:  "abc123",
+				},
+				managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+			}
+			ctrl := newFakeController(t.Context(), &data, nil)
+
+			opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+				Sync: &v1alpha1.SyncOperation{},
+			}}
+			ctrl.appStateManager.SyncAppState(t.Context(), app, project, opState)
+
+			if enforce {
+				assert.Equal(t, synccommon.OperationError, opState.Phase)
+				assert.Contains(t, opState.Message, "manifest policy 'no-configmaps' violated by /ConfigMap")
+			} else {
+				assert.NotEqual(t, synccommon.OperationError, opState.Phase)
+				assert.Len(t, app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionManifestPolicyWarning: true}), 1)
+			}
+		})
+	}
+}
+
 func TestAppStateManager_SyncAppState(t *testing.T) {
 	t.Parallel()
 
@@ -1930,6 +1971,54 @@ func TestSyncWithImpersonate(t *testing.T) {
 		assert.Contains(t, opState.Message, opMessage)
 	})
 
+	t.Run("sync with impersonation and resource service accounts", func(t *testing.T) {
+		// given app sync impersonation feature is enabled with a matching service account for the resources of some kinds
+		f := setup(true, test.FakeDestNamespace, "test-sa")
+		f.project.Spec.DestinationServiceAccounts[0].ResourceServiceAccounts = []v1alpha1.ResourceServiceAccount{
+			{Group: "apiextensions.k8s.io", Kind: "*", ServiceAccount: "argocd:crd-admin"},
+		}
+		opMessage := "successfully synced (no more tasks)"
+
+		opState := &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{
+				Sync: &v1alpha1.SyncOperation{
+					Source: &v1alpha1.ApplicationSource{},
+				},
+			},
+			Phase: synccommon.OperationRunning,
+		}
+		// when
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
+
+		// then app sync should not fail
+		assert.Equal(t, synccommon.OperationSucceeded, opState.Phase)
+		assert.Contains(t, opState.Message, opMessage)
+	})
+
+	t.Run("sync with impersonation and invalid resource service account", func(t *testing.T) {
+		// given app sync impersonation feature is enabled with an invalid service account for the resources of some kinds
+		f := setup(true, test.FakeDestNamespace, "test-sa")
+		f.project.Spec.DestinationServiceAccounts[0].ResourceServiceAccounts = []v1alpha1.ResourceServiceAccount{
+			{Group: "apiextensions.k8s.io", Kind: "*", ServiceAccount: "crd/admin"},
+		}
+		opMessage := "failed to derive service account to impersonate: service account of apiextensions.k8s.io/* resources contains invalid chars 'crd/admin'"
+
+		opState := &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{
+				Sync: &v1alpha1.SyncOperation{
+					Source: &v1alpha1.ApplicationSource{},
+				},
+			},
+			Phase: synccommon.OperationRunning,
+		}
+		// when
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
+
+		// then app sync should fail with expected error message in operation state
+		assert.Equal(t, synccommon.OperationError, opState.Phase)
+		assert.Equal(t, opMessage, opState.Message)
+	})
+
 	t.Run("sync without impersonation", func(t *testing.T) {
 		// given app sync impersonation feature is disabled with an application referring a project matching service account
 		f := setup(false, test.FakeDestNamespace, "")
@@ -48,6 +48,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	authType1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/pager"
@@ -106,6 +107,9 @@ type apiMeta struct {
 	// watchCancel stops the watch of all resources for this API. This gets called when the cache is invalidated or when
 	// the watched API ceases to exist (e.g. a CRD gets deleted).
 	watchCancel context.CancelFunc
+	// resourceVersions holds the most recent resource version observed by the watch of each namespace, or by the watch
+	// of the whole cluster under the empty namespace. Snapshots of the cache resume the watches from these versions.
+	resourceVersions map[string]string
 }
 
 type eventMeta struct {
@@ -280,6 +284,14 @@ type clusterCache struct {
 
 	respectRBAC int
 
+	// snapshotStore persists snapshots of the cache, which is restored from the latest snapshot on the first sync
+	snapshotStore     SnapshotStore
+	snapshotInfoCodec SnapshotInfoCodec
+	snapshotInterval  time.Duration
+	// snapshotCancel stops saving snapshots periodically
+	snapshotCancel context.CancelFunc
+	snapshotLoaded bool
+
 	// Parent-to-children index for O(1) child lookup during hierarchy traversal
 	// Maps any resource's UID to a set of its direct children's ResourceKeys
 	// Using a set eliminates O(k) duplicate checking on insertions
@@ -455,7 +467,7 @@ func (c *clusterCache) replaceResourceCache(gk schema.GroupKind, resources []*Re
 }
 
 func (c *clusterCache) newResource(un *unstructured.Unstructured) *Resource {
-	ownerRefs, isInferredParentOf := c.resolveResourceReferences(un)
+	ownerRefs, isInferredParentOf, volumeClaimTemplates := c.resolveResourceReferences(un)
 
 	cacheManifest := false
 	var info any
@@ -468,15 +480,21 @@ func (c *clusterCache) newResource(un *unstructured.Unstructured) *Resource {
 		creationTimestamp = &ct
 	}
 	resource := &Resource{
-		ResourceVersion:    un.GetResourceVersion(),
-		Ref:                kube.GetObjectRef(un),
-		OwnerRefs:          ownerRefs,
-		Info:               info,
-		CreationTimestamp:  creationTimestamp,
-		isInferredParentOf: isInferredParentOf,
-	}
+		ResourceVersion:      un.GetResourceVersion(),
+		Ref:                  kube.GetObjectRef(un),
+		OwnerRefs:            ownerRefs,
+		Info:                 info,
+		CreationTimestamp:    creationTimestamp,
+		isInferredParentOf:   isInferredParentOf,
+		volumeClaimTemplates: volumeClaimTemplates,
+	}
+	// the manifests of the resources watched through the metadata-only API are incomplete, and only kept as metadata
 	if cacheManifest {
-		resource.Resource = un
+		if c.isMetadataOnly(un.GroupVersionKind().GroupKind()) {
+			resource.metadataManifest = un
+		} else {
+			resource.Resource = un
+		}
 	}
 
 	return resource
@@ -613,6 +631,7 @@ func (c *clusterCache) Invalidate(opts ...UpdateSettingsFunc) {
 	for i := range c.apisMeta {
 		c.apisMeta[i].watchCancel()
 	}
+	c.stopSnapshots()
 	for i := range opts {
 		opts[i](c)
 	}
@@ -663,6 +682,10 @@ func (c *clusterCache) startMissingWatches() error {
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
+	metadataClient, err := c.newMetadataClient()
+	if err != nil {
+		return err
+	}
 	clientset, err := kubernetes.NewForConfig(c.config)
 	if err != nil {
 		return fmt.Errorf("failed to create clientset: %w", err)
@@ -675,7 +698,7 @@ func (c *clusterCache) startMissingWatches() error {
 			ctx, cancel := context.WithCancel(context.Background())
 			c.apisMeta[api.GroupKind] = &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel}
 
-			err := c.processApi(client, api, func(resClient dynamic.ResourceInterface, ns string) error {
+			err := c.processApi(client, metadataClient, api, func(resClient dynamic.ResourceInterface, ns string) error {
 				resourceVersion, err := c.loadInitialState(ctx, api, resClient, ns, false) // don't lock here, we are already in a lock before startMissingWatches is called inside watchEvents
 				if err != nil && c.isRestrictedResource(err) {
 					keep := false
@@ -782,10 +805,12 @@ func (c *clusterCache) loadInitialState(ctx context.Context, api kube.APIResourc
 	if lock {
 		return resourceVersion, runSynced(&c.lock, func() error {
 			c.replaceResourceCache(api.GroupKind, items, ns)
+			c.setResourceVersion(api.GroupKind, ns, resourceVersion)
 			return nil
 		})
 	}
 	c.replaceResourceCache(api.GroupKind, items, ns)
+	c.setResourceVersion(api.GroupKind, ns, resourceVersion)
 	return resourceVersion, nil
 }
 
@@ -1009,11 +1034,26 @@ func isAPIServiceAvailable(obj *unstructured.Unstructured) bool {
 	return false
 }
 
+// newMetadataClient returns a client of the metadata-only API, or nil if no resource is watched through that API
+func (c *clusterCache) newMetadataClient() (metadata.Interface, error) {
+	if c.settings.MetadataOnlyFilter == nil {
+		return nil, nil
+	}
+	client, err := c.kubectl.NewMetadataClient(c.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metadata client: %w", err)
+	}
+	return client, nil
+}
+
 // processApi processes all the resources for a given API. First we construct an API client for the given API. Then we
 // call the callback. If we're managing the whole cluster, we call the callback with the client and an empty namespace.
 // If we're managing specific namespaces, we call the callback for each namespace.
-func (c *clusterCache) processApi(client dynamic.Interface, api kube.APIResourceInfo, callback func(resClient dynamic.ResourceInterface, ns string) error) error {
-	resClient := client.Resource(api.GroupVersionResource)
+func (c *clusterCache) processApi(client dynamic.Interface, metadataClient metadata.Interface, api kube.APIResourceInfo, callback func(resClient dynamic.ResourceInterface, ns string) error) error {
+	var resClient dynamic.NamespaceableResourceInterface = client.Resource(api.GroupVersionResource)
+	if metadataClient != nil && c.isMetadataOnly(api.GroupKind) {
+		resClient = newMetadataResourceClient(metadataClient, api)
+	}
 	switch {
 	// if manage whole cluster or resource is cluster level and cluster resources enabled
 	case len(c.namespaces) == 0 || (!api.Meta.Namespaced && c.clusterResources):
@@ -1118,6 +1158,7 @@ func (c *clusterCache) sync() (err error) {
 	for i := range c.apisMeta {
 		c.apisMeta[i].watchCancel()
 	}
+	c.stopSnapshots()
 
 	if c.batchEventsProcessing {
 		c.invalidateEventMeta()
@@ -1162,6 +1203,10 @@ func (c *clusterCache) sync() (err error) {
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
+	metadataClient, err := c.newMetadataClient()
+	if err != nil {
+		return err
+	}
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return fmt.Errorf("failed to create clientset: %w", err)
@@ -1171,6 +1216,13 @@ func (c *clusterCache) sync() (err error) {
 		go c.processEvents()
 	}
 
+	// the cache is restored from the snapshot only once, subsequent syncs list the resources
+	var snapshotAPIs map[apiNamespace]*APISnapshot
+	if c.snapshotStore != nil && !c.snapshotLoaded {
+		c.snapshotLoaded = true
+		snapshotAPIs = c.loadSnapshot()
+	}
+
 	discoveryEnd = time.Now()
 	err = kube.RunAllAsync(len(apis), func(i int) error {
 		api := apis[i]
@@ -1182,7 +1234,24 @@ func (c *clusterCache) sync() (err error) {
 		c.namespacedResources[api.GroupKind] = api.Meta.Namespaced
 		syncLock.Unlock()
 
-		return c.processApi(client, api, func(resClient dynamic.ResourceInterface, ns string) error {
+		return c.processApi(client, metadataClient, api, func(resClient dynamic.ResourceInterface, ns string) error {
+			// resources watched through another API than when the snapshot was taken are listed again
+			if apiSnapshot, ok := snapshotAPIs[apiNamespace{api.GroupKind, ns}]; ok && apiSnapshot.MetadataOnly == c.isMetadataOnly(api.GroupKind) {
+				resources, err := c.resourcesFromSnapshot(apiSnapshot)
+				if err == nil {
+					syncLock.Lock()
+					for _, res := range resources {
+						c.setNode(res)
+					}
+					c.setResourceVersion(api.GroupKind, ns, apiSnapshot.ResourceVersion)
+					syncLock.Unlock()
+					// the watch falls back to listing the resources if the resource version has expired
+					go c.watchEvents(ctx, api, resClient, ns, apiSnapshot.ResourceVersion)
+					return nil
+				}
+				c.log.Error(err, fmt.Sprintf("Failed to restore %s from snapshot", api.GroupKind))
+			}
+
 			resourceVersion, err := c.listResources(ctx, resClient, func(listPager *pager.ListPager) error {
 				return listPager.EachListItem(context.Background(), metav1.ListOptions{}, func(obj runtime.Object) error {
 					if un, ok := obj.(*unstructured.Unstructured); !ok {
@@ -1218,6 +1287,10 @@ func (c *clusterCache) sync() (err error) {
 				return fmt.Errorf("failed to load initial state of resource %s: %w", api.GroupKind.String(), err)
 			}
 
+			syncLock.Lock()
+			c.setResourceVersion(api.GroupKind, ns, resourceVersion)
+			syncLock.Unlock()
+
 			go c.watchEvents(ctx, api, resClient, ns, resourceVersion)
 
 			return nil
@@ -1226,6 +1299,7 @@ func (c *clusterCache) sync() (err error) {
 	if err != nil {
 		return fmt.Errorf("failed to sync cluster %s: %w", c.config.Host, err)
 	}
+	c.startSnapshots()
 	return nil
 }
 
@@ -1553,28 +1627,35 @@ func (c *clusterCache) managesNamespace(namespace string) bool {
 // specified in targetObjs list.
 func (c *clusterCache) GetManagedLiveObjs(targetObjs []*unstructured.Unstructured, isManaged func(r *Resource) bool) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
 	c.lock.RLock()
-	defer c.lock.RUnlock()
-
-	for _, o := range targetObjs {
-		if len(c.namespaces) > 0 {
-			if o.GetNamespace() == "" && !c.clusterResources {
-				return nil, fmt.Errorf("cluster level %s %q can not be managed when in namespaced mode", o.GetKind(), o.GetName())
-			} else if o.GetNamespace() != "" && !c.managesNamespace(o.GetNamespace()) {
-				return nil, fmt.Errorf("namespace %q for %s %q is not managed", o.GetNamespace(), o.GetKind(), o.GetName())
-			}
-		}
+	managedObjs, toLoad, targetLookups, err := c.lookupManagedLiveObjs(targetObjs, isManaged)
+	// the lock is released before loading manifests from the cluster, not to block the processing of watch events
+	c.lock.RUnlock()
+	if err != nil {
+		return nil, err
 	}
 
-	managedObjs := make(map[kube.ResourceKey]*unstructured.Unstructured)
-	// iterate all objects in live state cache to find ones associated with app
-	for key, o := range c.resources {
-		if isManaged(o) && o.Resource != nil && len(o.OwnerRefs) == 0 {
-			managedObjs[key] = o.Resource
+	lock := &sync.Mutex{}
+	// load the manifests of the managed target resources watched through the metadata-only API, or restored from a
+	// snapshot without their manifests
+	err = kube.RunAllAsync(len(toLoad), func(i int) error {
+		o := toLoad[i]
+		managedObj, err := c.loadManifest(o, schema.FromAPIVersionAndKind(o.Ref.APIVersion, o.Ref.Kind))
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("unexpected error getting managed object: %w", err)
 		}
+		lock.Lock()
+		managedObjs[o.ResourceKey()] = managedObj
+		lock.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get managed objects: %w", err)
 	}
 	// but are simply missing our label
-	lock := &sync.Mutex{}
-	err := kube.RunAllAsync(len(targetObjs), func(i int) error {
+	err = kube.RunAllAsync(len(targetObjs), func(i int) error {
 		targetObj := targetObjs[i]
 		key := kube.GetResourceKey(targetObj)
 		lock.Lock()
@@ -1582,12 +1663,13 @@ func (c *clusterCache) GetManagedLiveObjs(targetObjs []*unstructured.Unstructure
 		lock.Unlock()
 
 		if managedObj == nil {
-			if existingObj, exists := c.resources[key]; exists {
-				if existingObj.Resource != nil {
-					managedObj = existingObj.Resource
+			lookup := targetLookups[i]
+			if existingObj := lookup.existing; existingObj != nil {
+				if lookup.manifest != nil {
+					managedObj = lookup.manifest
 				} else {
 					var err error
-					managedObj, err = c.kubectl.GetResource(context.TODO(), c.config, targetObj.GroupVersionKind(), existingObj.Ref.Name, existingObj.Ref.Namespace)
+					managedObj, err = c.loadManifest(existingObj, targetObj.GroupVersionKind())
 					if err != nil {
 						if apierrors.IsNotFound(err) {
 							return nil
@@ -1595,7 +1677,7 @@ func (c *clusterCache) GetManagedLiveObjs(targetObjs []*unstructured.Unstructure
 						return fmt.Errorf("unexpected error getting managed object: %w", err)
 					}
 				}
-			} else if _, watched := c.apisMeta[key.GroupKind()]; !watched {
+			} else if !lookup.watched {
 				var err error
 				managedObj, err = c.kubectl.GetResource(context.TODO(), c.config, targetObj.GroupVersionKind(), targetObj.GetName(), targetObj.GetNamespace())
 				if err != nil {
@@ -1635,6 +1717,90 @@ func (c *clusterCache) GetManagedLiveObjs(targetObjs []*unstructured.Unstructure
 	return managedObjs, nil
 }
 
+// targetLookup holds what the cache knows about a target resource of GetManagedLiveObjs
+type targetLookup struct {
+	// existing is the cached resource, if any
+	existing *Resource
+	// manifest is the cached or already loaded manifest of the existing resource, if any
+	manifest *unstructured.Unstructured
+	// watched is whether the API of the resource is watched
+	watched bool
+}
+
+// lookupManagedLiveObjs returns the managed resources of GetManagedLiveObjs whose manifests are available in the cache,
+// the managed resources whose manifests must be loaded from the cluster, and what the cache knows about each target
+// resource. Only the manifests of the target resources are loaded from the cluster, since the other managed resources
+// are not diffed: the resources watched through the metadata-only API are returned with their metadata instead. The
+// cache lock must be held.
+func (c *clusterCache) lookupManagedLiveObjs(targetObjs []*unstructured.Unstructured, isManaged func(r *Resource) bool) (map[kube.ResourceKey]*unstructured.Unstructured, []*Resource, []targetLookup, error) {
+	for _, o := range targetObjs {
+		if len(c.namespaces) > 0 {
+			if o.GetNamespace() == "" && !c.clusterResources {
+				return nil, nil, nil, fmt.Errorf("cluster level %s %q can not be managed when in namespaced mode", o.GetKind(), o.GetName())
+			} else if o.GetNamespace() != "" && !c.managesNamespace(o.GetNamespace()) {
+				return nil, nil, nil, fmt.Errorf("namespace %q for %s %q is not managed", o.GetNamespace(), o.GetKind(), o.GetName())
+			}
+		}
+	}
+
+	targetKeys := make(map[kube.ResourceKey]bool, len(targetObjs))
+	for _, o := range targetObjs {
+		targetKeys[kube.GetResourceKey(o)] = true
+	}
+
+	managedObjs := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	var toLoad []*Resource
+	// iterate all objects in live state cache to find ones associated with app
+	for key, o := range c.resources {
+		if !isManaged(o) || len(o.OwnerRefs) != 0 {
+			continue
+		}
+		switch {
+		case o.Resource != nil:
+			managedObjs[key] = o.Resource
+		case o.currentLoadedManifest() != nil:
+			managedObjs[key] = o.currentLoadedManifest()
+		case !targetKeys[key] && o.metadataManifest != nil:
+			managedObjs[key] = o.metadataManifest
+		case o.resourceOmitted || c.isMetadataOnly(key.GroupKind()):
+			toLoad = append(toLoad, o)
+		}
+	}
+
+	targetLookups := make([]targetLookup, len(targetObjs))
+	for i, o := range targetObjs {
+		key := kube.GetResourceKey(o)
+		_, watched := c.apisMeta[key.GroupKind()]
+		targetLookups[i].watched = watched
+		if existing, ok := c.resources[key]; ok {
+			targetLookups[i].existing = existing
+			targetLookups[i].manifest = existing.Resource
+			if existing.Resource == nil {
+				targetLookups[i].manifest = existing.currentLoadedManifest()
+			}
+		}
+	}
+	return managedObjs, toLoad, targetLookups, nil
+}
+
+// loadManifest loads the manifest of a resource whose manifest is not cached from the cluster. The manifests of the
+// resources watched through the metadata-only API, or restored from a snapshot without their manifests, are kept until
+// the next watch event of the resource. The cache lock must not be held.
+func (c *clusterCache) loadManifest(res *Resource, gvk schema.GroupVersionKind) (*unstructured.Unstructured, error) {
+	manifest, err := c.kubectl.GetResource(context.TODO(), c.config, gvk, res.Ref.Name, res.Ref.Namespace)
+	if err != nil {
+		//nolint:wrapcheck // the error is wrapped by the callers
+		return nil, err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	// the resource may have been updated by a watch event while its manifest was loaded
+	if (res.resourceOmitted || c.isMetadataOnly(gvk.GroupKind())) && c.resources[res.ResourceKey()] == res && manifest.GetResourceVersion() == res.ResourceVersion {
+		res.loadedManifest = manifest
+	}
+	return manifest, nil
+}
+
 func (c *clusterCache) recordEvent(event watch.EventType, un *unstructured.Unstructured) {
 	for _, h := range c.getEventHandlers() {
 		h(event, un)
@@ -1713,6 +1879,7 @@ func (c *clusterCache) processEvent(key kube.ResourceKey, evMeta eventMeta) {
 	} else {
 		c.onNodeUpdated(existingNode, c.newResource(evMeta.un))
 	}
+	c.setResourceVersion(key.GroupKind(), key.Namespace, evMeta.un.GetResourceVersion())
 }
 
 func (c *clusterCache) onNodeUpdated(oldRes *Resource, newRes *Resource) {
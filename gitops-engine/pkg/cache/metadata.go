@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/metadata"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+)
+
+// MetadataOnlyFilter selects the resources which are watched through the metadata-only API
+type MetadataOnlyFilter interface {
+	IsMetadataOnlyResource(group, kind, cluster string) bool
+}
+
+// isMetadataOnly returns true if the resources of the given group kind are watched through the metadata-only API
+func (c *clusterCache) isMetadataOnly(gk schema.GroupKind) bool {
+	return c.settings.MetadataOnlyFilter != nil && c.settings.MetadataOnlyFilter.IsMetadataOnlyResource(gk.Group, gk.Kind, c.config.Host)
+}
+
+// metadataResourceClient lists and watches resources through the metadata-only API. It converts the returned
+// PartialObjectMetadata objects to unstructured objects holding the API version, kind and metadata of the resources, so
+// that they are processed as the objects returned by the dynamic client. Only List and Watch are supported.
+type metadataResourceClient struct {
+	dynamic.NamespaceableResourceInterface
+	getter metadata.Getter
+	client metadata.ResourceInterface
+	gvk    schema.GroupVersionKind
+}
+
+func newMetadataResourceClient(client metadata.Interface, api kube.APIResourceInfo) *metadataResourceClient {
+	getter := client.Resource(api.GroupVersionResource)
+	return &metadataResourceClient{
+		getter: getter,
+		client: getter,
+		gvk:    api.GroupVersionResource.GroupVersion().WithKind(api.GroupKind.Kind),
+	}
+}
+
+func (c *metadataResourceClient) Namespace(ns string) dynamic.ResourceInterface {
+	return &metadataResourceClient{client: c.getter.Namespace(ns), gvk: c.gvk}
+}
+
+func (c *metadataResourceClient) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	list, err := c.client.List(ctx, opts)
+	if err != nil {
+		//nolint:wrapcheck // the error is wrapped by the callers, as errors of the dynamic client
+		return nil, err
+	}
+	res := &unstructured.UnstructuredList{Object: map[string]any{}}
+	res.SetResourceVersion(list.ResourceVersion)
+	res.SetContinue(list.Continue)
+	res.SetRemainingItemCount(list.RemainingItemCount)
+	res.Items = make([]unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		un, err := metadataToUnstructured(&list.Items[i], c.gvk)
+		if err != nil {
+			return nil, err
+		}
+		res.Items = append(res.Items, *un)
+	}
+	return res, nil
+}
+
+func (c *metadataResourceClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	w, err := c.client.Watch(ctx, opts)
+	if err != nil {
+		//nolint:wrapcheck // the error is wrapped by the callers, as errors of the dynamic client
+		return nil, err
+	}
+	return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+		if obj, ok := event.Object.(*metav1.PartialObjectMetadata); ok {
+			un, err := metadataToUnstructured(obj, c.gvk)
+			if err != nil {
+				return watch.Event{Type: watch.Error, Object: &metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}}, true
+			}
+			event.Object = un
+		}
+		return event, true
+	}), nil
+}
+
+// metadataToUnstructured converts the metadata of a resource to an unstructured object. The managed fields and the
+// last applied configuration, which are the largest parts of the metadata, are dropped.
+func metadataToUnstructured(obj *metav1.PartialObjectMetadata, gvk schema.GroupVersionKind) (*unstructured.Unstructured, error) {
+	objMeta := obj.ObjectMeta
+	objMeta.ManagedFields = nil
+	if _, ok := objMeta.Annotations[corev1.LastAppliedConfigAnnotation]; ok {
+		annotations := make(map[string]string, len(objMeta.Annotations)-1)
+		for k, v := range objMeta.Annotations {
+			if k != corev1.LastAppliedConfigAnnotation {
+				annotations[k] = v
+			}
+		}
+		objMeta.Annotations = annotations
+	}
+	metadataObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&objMeta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert metadata of %s %s/%s: %w", gvk.Kind, obj.Namespace, obj.Name, err)
+	}
+	un := &unstructured.Unstructured{Object: map[string]any{"metadata": metadataObj}}
+	un.SetGroupVersionKind(gvk)
+	return un, nil
+}
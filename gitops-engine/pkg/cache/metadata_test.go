@@ -0,0 +1,167 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube/kubetest"
+)
+
+type metadataOnlyKinds map[string]bool
+
+func (k metadataOnlyKinds) IsMetadataOnlyResource(_, kind, _ string) bool {
+	return k[kind]
+}
+
+func testPodMetadata() *metav1.PartialObjectMetadata {
+	pod := testPod1()
+	return &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: kube.PodKind},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            pod.Name,
+			Namespace:       pod.Namespace,
+			UID:             pod.UID,
+			ResourceVersion: pod.ResourceVersion,
+			Labels:          map[string]string{"app": "guestbook"},
+			Annotations: map[string]string{
+				"argocd.argoproj.io/tracking-id":   "guestbook:/Pod:default/" + pod.Name,
+				corev1.LastAppliedConfigAnnotation: `{"apiVersion":"v1","kind":"Pod"}`,
+			},
+			OwnerReferences: pod.OwnerReferences,
+			ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply}},
+		},
+	}
+}
+
+func newMetadataOnlyCluster(t *testing.T) *clusterCache {
+	t.Helper()
+	scheme := metadatafake.NewTestScheme()
+	require.NoError(t, metav1.AddMetaToScheme(scheme))
+	cluster := newCluster(t, testRS())
+	cluster.kubectl.(*kubetest.MockKubectlCmd).MetadataClient = metadatafake.NewSimpleMetadataClient(scheme, testPodMetadata())
+	cluster.Invalidate(
+		SetSettings(Settings{ResourceHealthOverride: &noopSettings{}, ResourcesFilter: &noopSettings{}, MetadataOnlyFilter: metadataOnlyKinds{kube.PodKind: true}}),
+		SetPopulateResourceInfoHandler(func(_ *unstructured.Unstructured, _ bool) (info any, cacheManifest bool) {
+			return nil, true
+		}),
+	)
+	return cluster
+}
+
+func TestMetadataOnlyWatch(t *testing.T) {
+	cluster := newMetadataOnlyCluster(t)
+	require.NoError(t, cluster.EnsureSynced())
+
+	assert.NotContains(t, listActions(cluster), "pods")
+
+	pod := cluster.resources[kube.GetResourceKey(mustToUnstructured(testPod1()))]
+	require.NotNil(t, pod)
+	assert.Nil(t, pod.Resource)
+	assert.Equal(t, testPod1().ResourceVersion, pod.ResourceVersion)
+	assert.Equal(t, corev1.ObjectReference{Kind: kube.PodKind, APIVersion: "v1", Namespace: "default", Name: testPod1().Name, UID: testPod1().UID}, pod.Ref)
+	assert.Equal(t, []*Resource{pod}, getChildren(cluster, mustToUnstructured(testRS())))
+
+	// the manifests of the other resources are still cached
+	rs := cluster.resources[kube.GetResourceKey(mustToUnstructured(testRS()))]
+	require.NotNil(t, rs)
+	assert.NotNil(t, rs.Resource)
+}
+
+func TestMetadataOnlyWatch_GetManagedLiveObjs(t *testing.T) {
+	cluster := newMetadataOnlyCluster(t)
+	var fetched []string
+	cluster.kubectl.(*kubetest.MockKubectlCmd).WithGetResourceFunc(func(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, _ string) (*unstructured.Unstructured, error) {
+		fetched = append(fetched, gvk.Kind+"/"+name)
+		return mustToUnstructured(testPod1()), nil
+	})
+	require.NoError(t, cluster.EnsureSynced())
+
+	managedObjs, err := cluster.GetManagedLiveObjs(nil, func(r *Resource) bool {
+		return r.Ref.Kind == kube.PodKind
+	})
+	require.NoError(t, err)
+	// the pod has an owner, so it is not managed
+	assert.Empty(t, managedObjs)
+
+	cluster.lock.Lock()
+	cluster.resources[kube.GetResourceKey(mustToUnstructured(testPod1()))].OwnerRefs = nil
+	cluster.lock.Unlock()
+
+	podKey := kube.GetResourceKey(mustToUnstructured(testPod1()))
+	managedObjs, err = cluster.GetManagedLiveObjs(nil, func(r *Resource) bool {
+		return r.Ref.Kind == kube.PodKind
+	})
+	require.NoError(t, err)
+	// the pod is not diffed, so only its metadata is returned
+	require.Contains(t, managedObjs, podKey)
+	assert.Equal(t, testPod1().Name, managedObjs[podKey].GetName())
+	assert.Equal(t, map[string]string{"argocd.argoproj.io/tracking-id": "guestbook:/Pod:default/" + testPod1().Name}, managedObjs[podKey].GetAnnotations())
+	assert.NotContains(t, managedObjs[podKey].Object, "spec")
+	assert.Empty(t, fetched)
+
+	// the manifest of a target resource is loaded once, and reused until the resource changes
+	for range 2 {
+		managedObjs, err = cluster.GetManagedLiveObjs([]*unstructured.Unstructured{mustToUnstructured(testPod1())}, func(r *Resource) bool {
+			return r.Ref.Kind == kube.PodKind
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[kube.ResourceKey]*unstructured.Unstructured{podKey: mustToUnstructured(testPod1())}, managedObjs)
+	}
+	assert.Equal(t, []string{"Pod/" + testPod1().Name}, fetched)
+
+	cluster.lock.Lock()
+	cluster.resources[podKey].ResourceVersion = "next"
+	cluster.lock.Unlock()
+	_, err = cluster.GetManagedLiveObjs([]*unstructured.Unstructured{mustToUnstructured(testPod1())}, func(r *Resource) bool {
+		return r.Ref.Kind == kube.PodKind
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Pod/" + testPod1().Name, "Pod/" + testPod1().Name}, fetched)
+}
+
+func TestMetadataOnlyWatch_SnapshotKeepsMetadata(t *testing.T) {
+	store := &memorySnapshotStore{snapshots: map[string][]byte{}}
+	codec := stringInfoCodec{fingerprint: "v1"}
+
+	source := newMetadataOnlyCluster(t)
+	source.Invalidate(SetSnapshotStore(store, codec, 0))
+	require.NoError(t, source.EnsureSynced())
+	require.NoError(t, source.saveSnapshot(t.Context()))
+
+	restored := newMetadataOnlyCluster(t)
+	restored.Invalidate(SetSnapshotStore(store, codec, 0))
+	require.NoError(t, restored.EnsureSynced())
+	for _, action := range restored.kubectl.(*kubetest.MockKubectlCmd).MetadataClient.(*metadatafake.FakeMetadataClient).Actions() {
+		assert.NotEqual(t, "list", action.GetVerb())
+	}
+
+	pod := restored.resources[kube.GetResourceKey(mustToUnstructured(testPod1()))]
+	require.NotNil(t, pod)
+	assert.Nil(t, pod.Resource)
+	require.NotNil(t, pod.metadataManifest)
+	assert.Equal(t, map[string]string{"app": "guestbook"}, pod.metadataManifest.GetLabels())
+}
+
+func TestMetadataToUnstructured(t *testing.T) {
+	un, err := metadataToUnstructured(testPodMetadata(), schema.GroupVersionKind{Version: "v1", Kind: kube.PodKind})
+	require.NoError(t, err)
+
+	assert.Equal(t, "v1", un.GetAPIVersion())
+	assert.Equal(t, kube.PodKind, un.GetKind())
+	assert.Equal(t, testPod1().Name, un.GetName())
+	assert.Equal(t, map[string]string{"app": "guestbook"}, un.GetLabels())
+	assert.Equal(t, map[string]string{"argocd.argoproj.io/tracking-id": "guestbook:/Pod:default/" + testPod1().Name}, un.GetAnnotations())
+	assert.Equal(t, testPod1().OwnerReferences, un.GetOwnerReferences())
+	assert.Empty(t, un.GetManagedFields())
+	assert.NotContains(t, un.Object, "spec")
+}
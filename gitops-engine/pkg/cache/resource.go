@@ -29,6 +29,25 @@ type Resource struct {
 
 	// answers if resource is inferred parent of provided resource
 	isInferredParentOf func(key kube.ResourceKey) bool
+	// names of the volume claim templates of a StatefulSet, from which isInferredParentOf is restored from snapshots
+	volumeClaimTemplates []string
+	// whether the manifest of the resource was omitted from the snapshot the resource is restored from, in which case
+	// it is loaded from the cluster when needed
+	resourceOmitted bool
+	// API version, kind and metadata of a resource watched through the metadata-only API
+	metadataManifest *unstructured.Unstructured
+	// manifest of a resource watched through the metadata-only API or restored without its manifest, loaded from the
+	// cluster when it was diffed. It is reused as long as it matches the resource version, i.e. until the next watch
+	// event of the resource.
+	loadedManifest *unstructured.Unstructured
+}
+
+// currentLoadedManifest returns the manifest loaded from the cluster, if it is still the current version of the resource
+func (r *Resource) currentLoadedManifest() *unstructured.Unstructured {
+	if r.loadedManifest != nil && r.loadedManifest.GetResourceVersion() == r.ResourceVersion {
+		return r.loadedManifest
+	}
+	return nil
 }
 
 func (r *Resource) ResourceKey() kube.ResourceKey {
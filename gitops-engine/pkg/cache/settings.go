@@ -33,6 +33,9 @@ type Settings struct {
 	ResourceHealthOverride health.HealthOverride
 	// ResourcesFilter holds filter that excludes resources
 	ResourcesFilter kube.ResourceFilter
+	// MetadataOnlyFilter selects the resources which are watched through the metadata-only API. The cache keeps only the
+	// metadata of these resources, and their manifests are fetched from the cluster when needed.
+	MetadataOnlyFilter MetadataOnlyFilter
 }
 
 type UpdateSettingsFunc func(cache *clusterCache)
@@ -54,7 +57,7 @@ func SetPopulateResourceInfoHandler(handler OnPopulateResourceInfoHandler) Updat
 // SetSettings updates caching settings
 func SetSettings(settings Settings) UpdateSettingsFunc {
 	return func(cache *clusterCache) {
-		cache.settings = Settings{settings.ResourceHealthOverride, settings.ResourcesFilter}
+		cache.settings = Settings{settings.ResourceHealthOverride, settings.ResourcesFilter, settings.MetadataOnlyFilter}
 	}
 }
 
@@ -183,3 +186,14 @@ func SetEventProcessingInterval(interval time.Duration) UpdateSettingsFunc {
 		cache.eventProcessingInterval = interval
 	}
 }
+
+// SetSnapshotStore allows to set the store the snapshots of the cache are persisted to. The cache is restored from the
+// latest snapshot on the first sync, and a snapshot is saved to the store at the given interval. The codec encodes the
+// additional resource information in snapshots; the information is not persisted if the codec is nil.
+func SetSnapshotStore(store SnapshotStore, codec SnapshotInfoCodec, interval time.Duration) UpdateSettingsFunc {
+	return func(cache *clusterCache) {
+		cache.snapshotStore = store
+		cache.snapshotInfoCodec = codec
+		cache.snapshotInterval = interval
+	}
+}
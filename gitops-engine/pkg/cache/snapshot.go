@@ -0,0 +1,323 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+)
+
+// snapshotFormatVersion is the version of the format of the cluster snapshots. Snapshots of another version are not
+// restored.
+const snapshotFormatVersion = 2
+
+// ClusterSnapshot is a serializable copy of the resources of a cluster cache, along with the resource versions from
+// which the watches of the cache resume when the cache is restored from the snapshot.
+type ClusterSnapshot struct {
+	// Version is the version of the snapshot format
+	Version int `json:"version"`
+	// Server holds cluster API server URL
+	Server string `json:"server"`
+	// Namespaces are the namespaces monitored by the cache, or empty if the whole cluster is monitored
+	Namespaces []string `json:"namespaces,omitempty"`
+	// ClusterResources is whether cluster level resources are monitored when the cache monitors specific namespaces
+	ClusterResources bool `json:"clusterResources,omitempty"`
+	// InfoFingerprint identifies the settings the additional information of the resources has been populated with
+	InfoFingerprint string `json:"infoFingerprint,omitempty"`
+	// CreatedAt is the time the snapshot has been taken at
+	CreatedAt time.Time `json:"createdAt"`
+	// APIs holds the resources of every watched API and namespace
+	APIs []APISnapshot `json:"apis"`
+}
+
+// APISnapshot holds the resources of an API in a namespace, or in the whole cluster if the namespace is empty
+type APISnapshot struct {
+	Group     string `json:"group,omitempty"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	// MetadataOnly is whether the API is watched through the metadata-only API
+	MetadataOnly bool `json:"metadataOnly,omitempty"`
+	// ResourceVersion is the most recent resource version observed by the list or the watch of the API
+	ResourceVersion string             `json:"resourceVersion"`
+	Resources       []ResourceSnapshot `json:"resources,omitempty"`
+}
+
+// ResourceSnapshot is a serializable copy of a Resource
+type ResourceSnapshot struct {
+	ResourceVersion   string                  `json:"resourceVersion,omitempty"`
+	Ref               corev1.ObjectReference  `json:"ref"`
+	OwnerRefs         []metav1.OwnerReference `json:"ownerRefs,omitempty"`
+	CreationTimestamp *metav1.Time            `json:"creationTimestamp,omitempty"`
+	// Info holds the additional information of the resource, encoded with the SnapshotInfoCodec of the cache
+	Info json.RawMessage `json:"info,omitempty"`
+	// Resource holds the cached manifest of the resource, or its metadata if the API is watched through the
+	// metadata-only API
+	Resource *unstructured.Unstructured `json:"resource,omitempty"`
+	// ResourceOmitted is whether the manifest of the resource was cached but omitted from the snapshot, as the manifests
+	// of Secrets are never persisted. It is loaded from the cluster when needed after the cache is restored.
+	ResourceOmitted bool `json:"resourceOmitted,omitempty"`
+	// VolumeClaimTemplates holds the names of the volume claim templates of a StatefulSet
+	VolumeClaimTemplates []string `json:"volumeClaimTemplates,omitempty"`
+}
+
+// SnapshotStore persists the snapshots of cluster caches
+type SnapshotStore interface {
+	// Load returns the latest snapshot of the cluster with the given API server URL, or nil if there is none.
+	Load(ctx context.Context, server string) (*ClusterSnapshot, error)
+	// Save persists the snapshot, replacing the previous snapshot of the same cluster.
+	Save(ctx context.Context, snapshot *ClusterSnapshot) error
+}
+
+// SnapshotInfoCodec encodes and decodes the additional information of the resources, returned by the
+// OnPopulateResourceInfoHandler, in snapshots.
+type SnapshotInfoCodec interface {
+	// Fingerprint identifies the settings used to populate the additional information. Snapshots taken with a
+	// different fingerprint are not restored.
+	Fingerprint() string
+	// Encode encodes the additional information of a resource
+	Encode(info any) ([]byte, error)
+	// Decode decodes the additional information of a resource
+	Decode(data []byte) (any, error)
+}
+
+// apiNamespace identifies the watch of an API in a namespace, or in the whole cluster if the namespace is empty
+type apiNamespace struct {
+	gk schema.GroupKind
+	ns string
+}
+
+// watchNamespace returns the namespace of the watch the resources of the given API and namespace are observed by. The
+// cache lock must be held.
+func (c *clusterCache) watchNamespace(meta *apiMeta, namespace string) string {
+	if len(c.namespaces) != 0 && meta.namespaced {
+		return namespace
+	}
+	return ""
+}
+
+// setResourceVersion records the most recent resource version observed by the watch of the API in the namespace. The
+// cache lock must be held.
+func (c *clusterCache) setResourceVersion(gk schema.GroupKind, ns string, resourceVersion string) {
+	meta, ok := c.apisMeta[gk]
+	if !ok || resourceVersion == "" {
+		return
+	}
+	if meta.resourceVersions == nil {
+		meta.resourceVersions = make(map[string]string)
+	}
+	meta.resourceVersions[c.watchNamespace(meta, ns)] = resourceVersion
+}
+
+// takeSnapshot copies the resources of the cache into a snapshot. The cache lock must be held.
+func (c *clusterCache) takeSnapshot() (*ClusterSnapshot, error) {
+	apis := make(map[apiNamespace]*APISnapshot)
+	for gk, meta := range c.apisMeta {
+		for ns, resourceVersion := range meta.resourceVersions {
+			apis[apiNamespace{gk, ns}] = &APISnapshot{
+				Group:           gk.Group,
+				Kind:            gk.Kind,
+				Namespace:       ns,
+				MetadataOnly:    c.isMetadataOnly(gk),
+				ResourceVersion: resourceVersion,
+			}
+		}
+	}
+	for key, res := range c.resources {
+		meta, ok := c.apisMeta[key.GroupKind()]
+		if !ok {
+			continue
+		}
+		api, ok := apis[apiNamespace{key.GroupKind(), c.watchNamespace(meta, key.Namespace)}]
+		if !ok {
+			continue
+		}
+		resSnapshot := ResourceSnapshot{
+			ResourceVersion:      res.ResourceVersion,
+			Ref:                  res.Ref,
+			OwnerRefs:            slices.Clone(res.OwnerRefs),
+			CreationTimestamp:    res.CreationTimestamp,
+			Resource:             res.Resource,
+			VolumeClaimTemplates: res.volumeClaimTemplates,
+		}
+		if res.Resource == nil {
+			resSnapshot.Resource = res.metadataManifest
+		}
+		if res.Resource != nil && isSecret(key.GroupKind()) {
+			resSnapshot.Resource = nil
+			resSnapshot.ResourceOmitted = true
+		}
+		if res.Info != nil && c.snapshotInfoCodec != nil {
+			info, err := c.snapshotInfoCodec.Encode(res.Info)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode info of resource %s: %w", key.String(), err)
+			}
+			resSnapshot.Info = info
+		}
+		api.Resources = append(api.Resources, resSnapshot)
+	}
+
+	snapshot := &ClusterSnapshot{
+		Version:          snapshotFormatVersion,
+		Server:           c.config.Host,
+		Namespaces:       c.namespaces,
+		ClusterResources: c.clusterResources,
+		InfoFingerprint:  c.snapshotInfoFingerprint(),
+		CreatedAt:        time.Now().UTC(),
+		APIs:             make([]APISnapshot, 0, len(apis)),
+	}
+	for _, api := range apis {
+		snapshot.APIs = append(snapshot.APIs, *api)
+	}
+	sort.Slice(snapshot.APIs, func(i, j int) bool {
+		a, b := snapshot.APIs[i], snapshot.APIs[j]
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.Namespace < b.Namespace
+	})
+	return snapshot, nil
+}
+
+// isSecret returns true if the group kind is the one of Secrets, whose manifests are not persisted in snapshots
+func isSecret(gk schema.GroupKind) bool {
+	return gk.Group == "" && gk.Kind == kube.SecretKind
+}
+
+func (c *clusterCache) snapshotInfoFingerprint() string {
+	if c.snapshotInfoCodec == nil {
+		return ""
+	}
+	return c.snapshotInfoCodec.Fingerprint()
+}
+
+// saveSnapshot takes a snapshot of the cache and saves it to the snapshot store
+func (c *clusterCache) saveSnapshot(ctx context.Context) error {
+	start := time.Now()
+	c.lock.RLock()
+	snapshot, err := c.takeSnapshot()
+	resourcesCount := len(c.resources)
+	c.lock.RUnlock()
+	if err != nil {
+		return fmt.Errorf("failed to take snapshot: %w", err)
+	}
+	if err = c.snapshotStore.Save(ctx, snapshot); err != nil {
+		return fmt.Errorf("failed to save snapshot: %w", err)
+	}
+	c.log.V(1).Info("Saved cluster cache snapshot", "resources", resourcesCount, "duration_ms", time.Since(start).Milliseconds())
+	return nil
+}
+
+// startSnapshots saves snapshots of the cache periodically, until the cache is invalidated or synchronized again. The
+// cache lock must be held.
+func (c *clusterCache) startSnapshots() {
+	c.stopSnapshots()
+	if c.snapshotStore == nil || c.snapshotInterval <= 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.snapshotCancel = cancel
+	go func() {
+		ticker := time.NewTicker(c.snapshotInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.saveSnapshot(ctx); err != nil {
+					c.log.Error(err, "Failed to save cluster cache snapshot")
+				}
+			}
+		}
+	}()
+}
+
+// stopSnapshots stops saving snapshots of the cache. The cache lock must be held.
+func (c *clusterCache) stopSnapshots() {
+	if c.snapshotCancel != nil {
+		c.snapshotCancel()
+		c.snapshotCancel = nil
+	}
+}
+
+// loadSnapshot loads the latest snapshot of the cluster from the snapshot store, and returns the snapshots of the APIs
+// by group kind and namespace. It returns nil if there is no snapshot, or if it has been taken with different settings.
+func (c *clusterCache) loadSnapshot() map[apiNamespace]*APISnapshot {
+	snapshot, err := c.snapshotStore.Load(context.Background(), c.config.Host)
+	if err != nil {
+		c.log.Error(err, "Failed to load cluster cache snapshot")
+		return nil
+	}
+	if snapshot == nil {
+		return nil
+	}
+	log := c.log.WithValues("createdAt", snapshot.CreatedAt)
+	switch {
+	case snapshot.Version != snapshotFormatVersion:
+		log.Info("Ignoring cluster cache snapshot of another format version", "version", snapshot.Version)
+		return nil
+	case snapshot.Server != c.config.Host:
+		log.Info("Ignoring cluster cache snapshot of another server", "snapshotServer", snapshot.Server)
+		return nil
+	case !slices.Equal(snapshot.Namespaces, c.namespaces) || snapshot.ClusterResources != c.clusterResources:
+		log.Info("Ignoring cluster cache snapshot taken with other namespaces")
+		return nil
+	case snapshot.InfoFingerprint != c.snapshotInfoFingerprint():
+		log.Info("Ignoring cluster cache snapshot taken with other settings")
+		return nil
+	}
+	apis := make(map[apiNamespace]*APISnapshot, len(snapshot.APIs))
+	for i := range snapshot.APIs {
+		api := &snapshot.APIs[i]
+		if api.ResourceVersion != "" {
+			apis[apiNamespace{schema.GroupKind{Group: api.Group, Kind: api.Kind}, api.Namespace}] = api
+		}
+	}
+	log.Info("Restoring cluster cache from snapshot", "apis", len(apis))
+	return apis
+}
+
+// resourcesFromSnapshot returns the resources of an API snapshot
+func (c *clusterCache) resourcesFromSnapshot(api *APISnapshot) ([]*Resource, error) {
+	resources := make([]*Resource, 0, len(api.Resources))
+	for i := range api.Resources {
+		resSnapshot := &api.Resources[i]
+		res := &Resource{
+			ResourceVersion:      resSnapshot.ResourceVersion,
+			Ref:                  resSnapshot.Ref,
+			OwnerRefs:            resSnapshot.OwnerRefs,
+			CreationTimestamp:    resSnapshot.CreationTimestamp,
+			Resource:             resSnapshot.Resource,
+			volumeClaimTemplates: resSnapshot.VolumeClaimTemplates,
+			resourceOmitted:      resSnapshot.ResourceOmitted,
+		}
+		if api.MetadataOnly {
+			res.Resource = nil
+			res.metadataManifest = resSnapshot.Resource
+		}
+		if len(resSnapshot.Info) > 0 && c.snapshotInfoCodec != nil {
+			info, err := c.snapshotInfoCodec.Decode(resSnapshot.Info)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode info of resource %s/%s: %w", res.Ref.Namespace, res.Ref.Name, err)
+			}
+			res.Info = info
+		}
+		if res.volumeClaimTemplates != nil {
+			res.isInferredParentOf = statefulSetChildMatcher(res.Ref.Name, res.volumeClaimTemplates)
+		}
+		resources = append(resources, res)
+	}
+	return resources, nil
+}
@@ -0,0 +1,250 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube/kubetest"
+)
+
+// memorySnapshotStore keeps the JSON representation of the snapshots, as a persistent store would
+type memorySnapshotStore struct {
+	snapshots map[string][]byte
+}
+
+func (s *memorySnapshotStore) Load(_ context.Context, server string) (*ClusterSnapshot, error) {
+	data, ok := s.snapshots[server]
+	if !ok {
+		return nil, nil
+	}
+	var snapshot ClusterSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+func (s *memorySnapshotStore) Save(_ context.Context, snapshot *ClusterSnapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	s.snapshots[snapshot.Server] = data
+	return nil
+}
+
+type stringInfoCodec struct {
+	fingerprint string
+}
+
+func (c stringInfoCodec) Fingerprint() string {
+	return c.fingerprint
+}
+
+func (c stringInfoCodec) Encode(info any) ([]byte, error) {
+	return json.Marshal(info)
+}
+
+func (c stringInfoCodec) Decode(data []byte) (any, error) {
+	var info string
+	err := json.Unmarshal(data, &info)
+	return info, err
+}
+
+func newSnapshotCluster(t *testing.T, store SnapshotStore, codec SnapshotInfoCodec, objs ...runtime.Object) *clusterCache {
+	t.Helper()
+	cluster := newCluster(t, objs...)
+	cluster.Invalidate(
+		SetSnapshotStore(store, codec, 0),
+		SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, _ bool) (info any, cacheManifest bool) {
+			return "info-" + un.GetName(), true
+		}),
+	)
+	return cluster
+}
+
+func listActions(cluster *clusterCache) []string {
+	var actions []string
+	for _, action := range cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient).Actions() {
+		if action.GetVerb() == "list" {
+			actions = append(actions, action.GetResource().Resource)
+		}
+	}
+	return actions
+}
+
+func TestSnapshot_RestoreWithoutListing(t *testing.T) {
+	store := &memorySnapshotStore{snapshots: map[string][]byte{}}
+	codec := stringInfoCodec{fingerprint: "v1"}
+
+	source := newSnapshotCluster(t, store, codec, testPod1(), testRS(), testDeploy())
+	require.NoError(t, source.EnsureSynced())
+	require.NoError(t, source.saveSnapshot(t.Context()))
+
+	// the restored cluster has no objects, so any resource in its cache comes from the snapshot
+	restored := newSnapshotCluster(t, store, codec)
+	require.NoError(t, restored.EnsureSynced())
+
+	assert.Empty(t, listActions(restored))
+	assert.Len(t, restored.resources, 3)
+
+	pod := restored.resources[kube.GetResourceKey(mustToUnstructured(testPod1()))]
+	require.NotNil(t, pod)
+	assert.Equal(t, "info-"+testPod1().Name, pod.Info)
+	assert.Equal(t, "123", pod.ResourceVersion)
+	assert.Equal(t, testPod1().Name, pod.Resource.GetName())
+
+	rs := restored.resources[kube.GetResourceKey(mustToUnstructured(testRS()))]
+	require.NotNil(t, rs)
+	children := getChildren(restored, mustToUnstructured(testRS()))
+	require.Len(t, children, 1)
+	assert.Equal(t, pod.Ref, children[0].Ref)
+
+	restored.lock.RLock()
+	defer restored.lock.RUnlock()
+	assert.Equal(t, map[string]string{"": "123"}, restored.apisMeta[pod.ResourceKey().GroupKind()].resourceVersions)
+}
+
+func TestSnapshot_ListWhenSettingsChanged(t *testing.T) {
+	store := &memorySnapshotStore{snapshots: map[string][]byte{}}
+
+	source := newSnapshotCluster(t, store, stringInfoCodec{fingerprint: "v1"}, testPod1())
+	require.NoError(t, source.EnsureSynced())
+	require.NoError(t, source.saveSnapshot(t.Context()))
+
+	restored := newSnapshotCluster(t, store, stringInfoCodec{fingerprint: "v2"}, testRS())
+	require.NoError(t, restored.EnsureSynced())
+
+	assert.NotEmpty(t, listActions(restored))
+	assert.Len(t, restored.resources, 1)
+	assert.Contains(t, restored.resources, kube.GetResourceKey(mustToUnstructured(testRS())))
+}
+
+func TestSnapshot_RestoredOnlyOnFirstSync(t *testing.T) {
+	store := &memorySnapshotStore{snapshots: map[string][]byte{}}
+	codec := stringInfoCodec{fingerprint: "v1"}
+
+	source := newSnapshotCluster(t, store, codec, testPod1())
+	require.NoError(t, source.EnsureSynced())
+	require.NoError(t, source.saveSnapshot(t.Context()))
+
+	restored := newSnapshotCluster(t, store, codec, testRS())
+	require.NoError(t, restored.EnsureSynced())
+	assert.Contains(t, restored.resources, kube.GetResourceKey(mustToUnstructured(testPod1())))
+
+	restored.Invalidate()
+	require.NoError(t, restored.EnsureSynced())
+	assert.Len(t, restored.resources, 1)
+	assert.Contains(t, restored.resources, kube.GetResourceKey(mustToUnstructured(testRS())))
+}
+
+func TestSnapshot_TracksWatchedResourceVersion(t *testing.T) {
+	cluster := newSnapshotCluster(t, &memorySnapshotStore{snapshots: map[string][]byte{}}, nil, testPod1())
+	require.NoError(t, cluster.EnsureSynced())
+
+	pod := testPod1()
+	pod.ResourceVersion = "456"
+	cluster.lock.Lock()
+	cluster.processEvent(kube.GetResourceKey(mustToUnstructured(pod)), eventMeta{event: watch.Modified, un: mustToUnstructured(pod)})
+	snapshot, err := cluster.takeSnapshot()
+	cluster.lock.Unlock()
+	require.NoError(t, err)
+
+	var podAPI *APISnapshot
+	for i := range snapshot.APIs {
+		if snapshot.APIs[i].Kind == "Pod" {
+			podAPI = &snapshot.APIs[i]
+		}
+	}
+	require.NotNil(t, podAPI)
+	assert.Equal(t, "456", podAPI.ResourceVersion)
+	require.Len(t, podAPI.Resources, 1)
+	assert.Equal(t, "456", podAPI.Resources[0].ResourceVersion)
+	// the information is not persisted without a codec
+	assert.Empty(t, podAPI.Resources[0].Info)
+}
+
+func TestSnapshot_StatefulSetInferredChildren(t *testing.T) {
+	sts := &appsv1.StatefulSet{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: kube.StatefulSetKind},
+		ObjectMeta: metav1.ObjectMeta{UID: "123", Name: "web", Namespace: "default"},
+		Spec: appsv1.StatefulSetSpec{
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "www"}}},
+		},
+	}
+	store := &memorySnapshotStore{snapshots: map[string][]byte{}}
+	codec := stringInfoCodec{fingerprint: "v1"}
+
+	source := newSnapshotCluster(t, store, codec, sts)
+	require.NoError(t, source.EnsureSynced())
+	require.NoError(t, source.saveSnapshot(t.Context()))
+
+	restored := newSnapshotCluster(t, store, codec)
+	require.NoError(t, restored.EnsureSynced())
+
+	res := restored.resources[kube.GetResourceKey(mustToUnstructured(sts))]
+	require.NotNil(t, res)
+	require.NotNil(t, res.isInferredParentOf)
+	assert.True(t, res.isInferredParentOf(kube.ResourceKey{Kind: kube.PersistentVolumeClaimKind, Namespace: "default", Name: "www-web-0"}))
+	assert.False(t, res.isInferredParentOf(kube.ResourceKey{Kind: kube.PersistentVolumeClaimKind, Namespace: "default", Name: "data-web-0"}))
+}
+
+func TestSnapshot_SecretManifestsNotPersisted(t *testing.T) {
+	secret := &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: kube.SecretKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "default", ResourceVersion: "123"},
+		Data:       map[string][]byte{"password": []byte("s3cr3t")},
+	}
+	secretAPI := kube.APIResourceInfo{
+		GroupKind:            schema.GroupKind{Kind: kube.SecretKind},
+		GroupVersionResource: schema.GroupVersionResource{Version: "v1", Resource: "secrets"},
+		Meta:                 metav1.APIResource{Namespaced: true},
+	}
+	store := &memorySnapshotStore{snapshots: map[string][]byte{}}
+	codec := stringInfoCodec{fingerprint: "v1"}
+
+	source := newSnapshotCluster(t, store, codec, secret, testPod1()).WithAPIResources([]kube.APIResourceInfo{secretAPI})
+	require.NoError(t, source.EnsureSynced())
+	require.NotNil(t, source.resources[kube.GetResourceKey(mustToUnstructured(secret))].Resource)
+	require.NoError(t, source.saveSnapshot(t.Context()))
+	assert.NotContains(t, string(store.snapshots[source.config.Host]), "password")
+
+	// the manifest of the secret is loaded from the cluster after the cache is restored
+	restored := newSnapshotCluster(t, store, codec).WithAPIResources([]kube.APIResourceInfo{secretAPI})
+	var fetched []string
+	restored.kubectl.(*kubetest.MockKubectlCmd).WithGetResourceFunc(func(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, _ string) (*unstructured.Unstructured, error) {
+		fetched = append(fetched, gvk.Kind+"/"+name)
+		return mustToUnstructured(secret), nil
+	})
+	require.NoError(t, restored.EnsureSynced())
+	assert.Empty(t, listActions(restored))
+
+	secretKey := kube.GetResourceKey(mustToUnstructured(secret))
+	res := restored.resources[secretKey]
+	require.NotNil(t, res)
+	assert.Nil(t, res.Resource)
+	assert.Equal(t, "info-my-secret", res.Info)
+	assert.NotNil(t, restored.resources[kube.GetResourceKey(mustToUnstructured(testPod1()))].Resource)
+
+	managedObjs, err := restored.GetManagedLiveObjs(nil, func(r *Resource) bool {
+		return r.Ref.Kind == kube.SecretKind
+	})
+	require.NoError(t, err)
+	require.Contains(t, managedObjs, secretKey)
+	assert.Equal(t, "my-secret", managedObjs[secretKey].GetName())
+	assert.Equal(t, []string{"Secret/my-secret"}, fetched)
+}
@@ -242,6 +242,14 @@ func WithClientSideApplyMigration(enabled bool, manager string) SyncOpt {
 	}
 }
 
+// WithResourceImpersonation sets a function which returns the user to impersonate to sync, prune or delete the given
+// resource. The user of the rest config of the sync context is used if the function returns an empty string.
+func WithResourceImpersonation(userFor func(key kubeutil.ResourceKey) string) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.impersonatedUserFor = userFor
+	}
+}
+
 // NewSyncContext creates new instance of a SyncContext
 func NewSyncContext(
 	revision string,
@@ -293,7 +301,10 @@ func NewSyncContext(
 	for _, opt := range opts {
 		opt(ctx)
 	}
-	return ctx, cleanup, nil
+	return ctx, func() {
+		cleanup()
+		ctx.cleanupImpersonatedClients()
+	}, nil
 }
 
 func groupResources(reconciliationResult ReconciliationResult) map[kubeutil.ResourceKey]reconciledResource {
@@ -435,6 +446,67 @@ type syncContext struct {
 	applyOutOfSyncOnly bool
 	// stores whether the resource is modified or not
 	modificationResult map[kubeutil.ResourceKey]bool
+
+	// impersonatedUserFor returns the user to impersonate for a resource
+	impersonatedUserFor func(key kubeutil.ResourceKey) string
+	// impersonatedClients holds the clients of the impersonated users by user name
+	impersonatedClients map[string]*resourceClients
+	// impersonatedClientsLock protects the creation of the clients of the impersonated users by concurrent tasks
+	impersonatedClientsLock sync.Mutex
+}
+
+// resourceClients are the clients used to modify the resources of a task
+type resourceClients struct {
+	config      *rest.Config
+	dynamicIf   dynamic.Interface
+	resourceOps kubeutil.ResourceOperations
+	cleanup     func()
+}
+
+// clientsFor returns the clients used to modify the resource, which impersonate the user returned for the resource by
+// the impersonation function if any
+func (sc *syncContext) clientsFor(key kubeutil.ResourceKey) (*resourceClients, error) {
+	user := ""
+	if sc.impersonatedUserFor != nil {
+		user = sc.impersonatedUserFor(key)
+	}
+	if user == "" {
+		return &resourceClients{config: sc.config, dynamicIf: sc.dynamicIf, resourceOps: sc.resourceOps}, nil
+	}
+
+	sc.impersonatedClientsLock.Lock()
+	defer sc.impersonatedClientsLock.Unlock()
+	if clients, ok := sc.impersonatedClients[user]; ok {
+		return clients, nil
+	}
+	config := rest.CopyConfig(sc.config)
+	config.Impersonate = rest.ImpersonationConfig{UserName: user}
+	rawConfig := rest.CopyConfig(sc.rawConfig)
+	rawConfig.Impersonate = rest.ImpersonationConfig{UserName: user}
+	dynamicIf, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client impersonating %s: %w", user, err)
+	}
+	resourceOps, cleanup, err := sc.kubectl.ManageResources(rawConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to manage resources impersonating %s: %w", user, err)
+	}
+	clients := &resourceClients{config: config, dynamicIf: dynamicIf, resourceOps: resourceOps, cleanup: cleanup}
+	if sc.impersonatedClients == nil {
+		sc.impersonatedClients = map[string]*resourceClients{}
+	}
+	sc.impersonatedClients[user] = clients
+	sc.log.WithValues("user", user).V(1).Info("Created clients impersonating user")
+	return clients, nil
+}
+
+func (sc *syncContext) cleanupImpersonatedClients() {
+	sc.impersonatedClientsLock.Lock()
+	defer sc.impersonatedClientsLock.Unlock()
+	for _, clients := range sc.impersonatedClients {
+		clients.cleanup()
+	}
+	sc.impersonatedClients = nil
 }
 
 func (sc *syncContext) setRunningPhase(tasks syncTasks, isPendingDeletion bool) {
@@ -1363,7 +1435,11 @@ func (sc *syncContext) performCSAUpgradeMigration(ctx context.Context, liveObj *
 		return fmt.Errorf("failed to get api resource for %s: %w", gvk, err)
 	}
 	res := kubeutil.ToGroupVersionResource(gvk.GroupVersion().String(), apiResource)
-	resIf := kubeutil.ToResourceInterface(sc.dynamicIf, apiResource, res, liveObj.GetNamespace())
+	clients, err := sc.clientsFor(kubeutil.GetResourceKey(liveObj))
+	if err != nil {
+		return err
+	}
+	resIf := kubeutil.ToResourceInterface(clients.dynamicIf, apiResource, res, liveObj.GetNamespace())
 
 	// Use retry to handle conflicts if managed fields changed between reconciliation and now
 	//nolint:wrapcheck // error is wrapped inside the retry function
@@ -1436,6 +1512,11 @@ func (sc *syncContext) applyObject(ctx context.Context, t *syncTask, dryRun, val
 
 	serverSideApply := sc.shouldUseServerSideApply(t.targetObj, dryRun)
 
+	clients, err := sc.clientsFor(t.resourceKey())
+	if err != nil {
+		return common.ResultCodeSyncFailed, err.Error()
+	}
+
 	// Check if we need to perform client-side apply migration for server-side apply
 	// Perform client-side apply migration for server-side apply
 	// This uses csaupgrade to directly patch managedFields, transferring ownership
@@ -1457,20 +1538,20 @@ func (sc *syncContext) applyObject(ctx context.Context, t *syncTask, dryRun, val
 			if kubeutil.IsCRD(t.targetObj) || t.targetObj.GetKind() == kubeutil.NamespaceKind {
 				update := t.targetObj.DeepCopy()
 				update.SetResourceVersion(t.liveObj.GetResourceVersion())
-				_, err = sc.resourceOps.UpdateResource(ctx, update, dryRunStrategy)
+				_, err = clients.resourceOps.UpdateResource(ctx, update, dryRunStrategy)
 				if err == nil {
 					message = fmt.Sprintf("%s/%s updated", t.targetObj.GetKind(), t.targetObj.GetName())
 				} else {
 					message = fmt.Sprintf("error when updating: %v", err.Error())
 				}
 			} else {
-				message, err = sc.resourceOps.ReplaceResource(ctx, t.targetObj, dryRunStrategy, force)
+				message, err = clients.resourceOps.ReplaceResource(ctx, t.targetObj, dryRunStrategy, force)
 			}
 		} else {
-			message, err = sc.resourceOps.CreateResource(ctx, t.targetObj, dryRunStrategy, validate)
+			message, err = clients.resourceOps.CreateResource(ctx, t.targetObj, dryRunStrategy, validate)
 		}
 	} else {
-		message, err = sc.resourceOps.ApplyResource(ctx, t.targetObj, dryRunStrategy, force, validate, serverSideApply, sc.serverSideApplyManager)
+		message, err = clients.resourceOps.ApplyResource(ctx, t.targetObj, dryRunStrategy, force, validate, serverSideApply, sc.serverSideApplyManager)
 	}
 	if err != nil {
 		return common.ResultCodeSyncFailed, err.Error()
@@ -1505,7 +1586,11 @@ func (sc *syncContext) pruneObject(ctx context.Context, t *syncTask, prune, dryR
 	// Skip deletion if object is already marked for deletion, so we don't cause a resource update hotloop
 	deletionTimestamp := liveObj.GetDeletionTimestamp()
 	if deletionTimestamp == nil || deletionTimestamp.IsZero() {
-		err := sc.kubectl.DeleteResource(ctx, sc.config, liveObj.GroupVersionKind(), liveObj.GetName(), liveObj.GetNamespace(), sc.getDeleteOptions())
+		clients, err := sc.clientsFor(t.resourceKey())
+		if err != nil {
+			return common.ResultCodeSyncFailed, err.Error()
+		}
+		err = sc.kubectl.DeleteResource(ctx, clients.config, liveObj.GroupVersionKind(), liveObj.GetName(), liveObj.GetNamespace(), sc.getDeleteOptions())
 		if err != nil {
 			return common.ResultCodeSyncFailed, err.Error()
 		}
@@ -1603,7 +1688,11 @@ func (sc *syncContext) getResourceIf(task *syncTask, verb string) (dynamic.Resou
 		return nil, fmt.Errorf("failed to get api resource: %w", err)
 	}
 	res := kubeutil.ToGroupVersionResource(task.groupVersionKind().GroupVersion().String(), apiResource)
-	resIf := kubeutil.ToResourceInterface(sc.dynamicIf, apiResource, res, task.namespace())
+	clients, err := sc.clientsFor(task.resourceKey())
+	if err != nil {
+		return nil, err
+	}
+	resIf := kubeutil.ToResourceInterface(clients.dynamicIf, apiResource, res, task.namespace())
 	return resIf, nil
 }
 
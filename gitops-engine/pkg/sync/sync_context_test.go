@@ -3329,3 +3329,52 @@ func TestTerminate_Hooks_Error(t *testing.T) {
 	assert.Equal(t, synccommon.OperationError, results[0].HookPhase)
 	assert.Contains(t, results[0].Message, "update failed")
 }
+
+// impersonatingKubectl records the users impersonated by the resource operations it returns
+type impersonatingKubectl struct {
+	*kubetest.MockKubectlCmd
+	resourceOps map[string]*kubetest.MockResourceOps
+}
+
+func (k *impersonatingKubectl) ManageResources(config *rest.Config) (kube.ResourceOperations, func(), error) {
+	resourceOps := &kubetest.MockResourceOps{}
+	k.resourceOps[config.Impersonate.UserName] = resourceOps
+	return resourceOps, func() {}, nil
+}
+
+func TestSyncResourceImpersonation(t *testing.T) {
+	kubectl := &impersonatingKubectl{MockKubectlCmd: &kubetest.MockKubectlCmd{}, resourceOps: map[string]*kubetest.MockResourceOps{}}
+	syncCtx := newTestSyncCtx(nil, WithResourceImpersonation(func(key kube.ResourceKey) string {
+		if key.Kind == kube.ServiceKind {
+			return "system:serviceaccount:argocd:network-admin"
+		}
+		return ""
+	}))
+	syncCtx.kubectl = kubectl
+	pod := testingutils.NewPod()
+	pod.SetNamespace(testingutils.FakeArgoCDNamespace)
+	service := testingutils.NewService()
+	service.SetNamespace(testingutils.FakeArgoCDNamespace)
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil, nil},
+		Target: []*unstructured.Unstructured{pod, service},
+	})
+
+	syncCtx.Sync(t.Context())
+
+	phase, _, resources := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+	assert.Len(t, resources, 2)
+	// the clients of the impersonated user are created once, and only used for the service
+	require.Len(t, kubectl.resourceOps, 1)
+	impersonatedOps := kubectl.resourceOps["system:serviceaccount:argocd:network-admin"]
+	require.NotNil(t, impersonatedOps)
+	assert.Equal(t, "apply", impersonatedOps.GetLastResourceCommand(kube.GetResourceKey(service)))
+	assert.Empty(t, impersonatedOps.GetLastResourceCommand(kube.GetResourceKey(pod)))
+	defaultOps := syncCtx.resourceOps.(*kubetest.MockResourceOps)
+	assert.Equal(t, "apply", defaultOps.GetLastResourceCommand(kube.GetResourceKey(pod)))
+	assert.Empty(t, defaultOps.GetLastResourceCommand(kube.GetResourceKey(service)))
+
+	syncCtx.cleanupImpersonatedClients()
+	assert.Empty(t, syncCtx.impersonatedClients)
+}
@@ -17,6 +17,7 @@ import (
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/kube-openapi/pkg/util/proto"
 	"k8s.io/kubectl/pkg/util/openapi"
@@ -42,6 +43,7 @@ type Kubectl interface {
 	GetAPIResources(config *rest.Config, preferred bool, resourceFilter ResourceFilter) ([]APIResourceInfo, error)
 	GetServerVersion(config *rest.Config) (string, error)
 	NewDynamicClient(config *rest.Config) (dynamic.Interface, error)
+	NewMetadataClient(config *rest.Config) (metadata.Interface, error)
 	SetOnKubectlRun(onKubectlRun OnKubectlRunFunc)
 }
 
@@ -379,6 +381,11 @@ func (k *KubectlCmd) NewDynamicClient(config *rest.Config) (dynamic.Interface, e
 	return dynamic.NewForConfig(config)
 }
 
+func (k *KubectlCmd) NewMetadataClient(config *rest.Config) (metadata.Interface, error) {
+	//nolint:wrapcheck // wrapped error message would be the same as the caller's wrapped message
+	return metadata.NewForConfig(config)
+}
+
 func (k *KubectlCmd) SetOnKubectlRun(onKubectlRun OnKubectlRunFunc) {
 	k.OnKubectlRun = onKubectlRun
 }
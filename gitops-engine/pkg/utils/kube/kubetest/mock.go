@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/managedfields"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/kubectl/pkg/util/openapi"
 
@@ -26,11 +27,12 @@ type KubectlOutput struct {
 }
 
 type MockKubectlCmd struct {
-	APIResources  []kube.APIResourceInfo
-	Commands      map[string]KubectlOutput
-	Events        chan watch.Event
-	Version       string
-	DynamicClient dynamic.Interface
+	APIResources   []kube.APIResourceInfo
+	Commands       map[string]KubectlOutput
+	Events         chan watch.Event
+	Version        string
+	DynamicClient  dynamic.Interface
+	MetadataClient metadata.Interface
 
 	convertToVersionFunc           *func(obj *unstructured.Unstructured, group, version string) (*unstructured.Unstructured, error)
 	getResourceFunc                *func(ctx context.Context, config *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error)
@@ -66,6 +68,10 @@ func (k *MockKubectlCmd) NewDynamicClient(_ *rest.Config) (dynamic.Interface, er
 	return k.DynamicClient, nil
 }
 
+func (k *MockKubectlCmd) NewMetadataClient(_ *rest.Config) (metadata.Interface, error) {
+	return k.MetadataClient, nil
+}
+
 func (k *MockKubectlCmd) GetAPIResources(_ *rest.Config, _ bool, _ kube.ResourceFilter) ([]kube.APIResourceInfo, error) {
 	return k.APIResources, nil
 }
@@ -388,6 +388,97 @@ func (m *ApplicationResourceEventsQuery) GetProject() string {
 	return ""
 }
 
+// ApplicationSyncRecordsQuery is a query for the sync records of an application
+type ApplicationSyncRecordsQuery struct {
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	// since filters out the syncs which started before the given time
+	Since *v1.Time `protobuf:"bytes,4,opt,name=since" json:"since,omitempty"`
+	// until filters out the syncs which started after the given time
+	Until *v1.Time `protobuf:"bytes,5,opt,name=until" json:"until,omitempty"`
+	// initiator filters the syncs by the user who initiated them, or "automated" for the automated syncs
+	Initiator            *string  `protobuf:"bytes,6,opt,name=initiator" json:"initiator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSyncRecordsQuery) Reset()         { *m = ApplicationSyncRecordsQuery{} }
+func (m *ApplicationSyncRecordsQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSyncRecordsQuery) ProtoMessage()    {}
+func (*ApplicationSyncRecordsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{4}
+}
+func (m *ApplicationSyncRecordsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSyncRecordsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSyncRecordsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSyncRecordsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSyncRecordsQuery.Merge(m, src)
+}
+func (m *ApplicationSyncRecordsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSyncRecordsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSyncRecordsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSyncRecordsQuery proto.InternalMessageInfo
+
+func (m *ApplicationSyncRecordsQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSyncRecordsQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationSyncRecordsQuery) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *ApplicationSyncRecordsQuery) GetSince() *v1.Time {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *ApplicationSyncRecordsQuery) GetUntil() *v1.Time {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
+func (m *ApplicationSyncRecordsQuery) GetInitiator() string {
+	if m != nil && m.Initiator != nil {
+		return *m.Initiator
+	}
+	return ""
+}
+
 // ManifestQuery is a query for manifest resources
 type ApplicationManifestQuery struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -406,7 +497,7 @@ func (m *ApplicationManifestQuery) Reset()         { *m = ApplicationManifestQue
 func (m *ApplicationManifestQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationManifestQuery) ProtoMessage()    {}
 func (*ApplicationManifestQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{4}
+	return fileDescriptor_df6e82b174b5eaec, []int{5}
 }
 func (m *ApplicationManifestQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +586,7 @@ func (m *FileChunk) Reset()         { *m = FileChunk{} }
 func (m *FileChunk) String() string { return proto.CompactTextString(m) }
 func (*FileChunk) ProtoMessage()    {}
 func (*FileChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{5}
+	return fileDescriptor_df6e82b174b5eaec, []int{6}
 }
 func (m *FileChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +636,7 @@ func (m *ApplicationManifestQueryWithFiles) Reset()         { *m = ApplicationMa
 func (m *ApplicationManifestQueryWithFiles) String() string { return proto.CompactTextString(m) }
 func (*ApplicationManifestQueryWithFiles) ProtoMessage()    {}
 func (*ApplicationManifestQueryWithFiles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{6}
+	return fileDescriptor_df6e82b174b5eaec, []int{7}
 }
 func (m *ApplicationManifestQueryWithFiles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +709,7 @@ func (m *ApplicationManifestQueryWithFilesWrapper) Reset() {
 func (m *ApplicationManifestQueryWithFilesWrapper) String() string { return proto.CompactTextString(m) }
 func (*ApplicationManifestQueryWithFilesWrapper) ProtoMessage()    {}
 func (*ApplicationManifestQueryWithFilesWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{7}
+	return fileDescriptor_df6e82b174b5eaec, []int{8}
 }
 func (m *ApplicationManifestQueryWithFilesWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -704,7 +795,7 @@ func (m *ApplicationResponse) Reset()         { *m = ApplicationResponse{} }
 func (m *ApplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResponse) ProtoMessage()    {}
 func (*ApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{8}
+	return fileDescriptor_df6e82b174b5eaec, []int{9}
 }
 func (m *ApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,7 +837,7 @@ func (m *ApplicationCreateRequest) Reset()         { *m = ApplicationCreateReque
 func (m *ApplicationCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationCreateRequest) ProtoMessage()    {}
 func (*ApplicationCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{9}
+	return fileDescriptor_df6e82b174b5eaec, []int{10}
 }
 func (m *ApplicationCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -809,7 +900,7 @@ func (m *ApplicationUpdateRequest) Reset()         { *m = ApplicationUpdateReque
 func (m *ApplicationUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateRequest) ProtoMessage()    {}
 func (*ApplicationUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{10}
+	return fileDescriptor_df6e82b174b5eaec, []int{11}
 }
 func (m *ApplicationUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -874,7 +965,7 @@ func (m *ApplicationDeleteRequest) Reset()         { *m = ApplicationDeleteReque
 func (m *ApplicationDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationDeleteRequest) ProtoMessage()    {}
 func (*ApplicationDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{11}
+	return fileDescriptor_df6e82b174b5eaec, []int{12}
 }
 func (m *ApplicationDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -949,7 +1040,7 @@ func (m *SyncOptions) Reset()         { *m = SyncOptions{} }
 func (m *SyncOptions) String() string { return proto.CompactTextString(m) }
 func (*SyncOptions) ProtoMessage()    {}
 func (*SyncOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{12}
+	return fileDescriptor_df6e82b174b5eaec, []int{13}
 }
 func (m *SyncOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,7 +1101,7 @@ func (m *ApplicationSyncRequest) Reset()         { *m = ApplicationSyncRequest{}
 func (m *ApplicationSyncRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncRequest) ProtoMessage()    {}
 func (*ApplicationSyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{13}
+	return fileDescriptor_df6e82b174b5eaec, []int{14}
 }
 func (m *ApplicationSyncRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,7 +1244,7 @@ func (m *ApplicationUpdateSpecRequest) Reset()         { *m = ApplicationUpdateS
 func (m *ApplicationUpdateSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateSpecRequest) ProtoMessage()    {}
 func (*ApplicationUpdateSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{14}
+	return fileDescriptor_df6e82b174b5eaec, []int{15}
 }
 func (m *ApplicationUpdateSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1233,7 +1324,7 @@ func (m *ApplicationPatchRequest) Reset()         { *m = ApplicationPatchRequest
 func (m *ApplicationPatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPatchRequest) ProtoMessage()    {}
 func (*ApplicationPatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{15}
+	return fileDescriptor_df6e82b174b5eaec, []int{16}
 }
 func (m *ApplicationPatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1313,7 +1404,7 @@ func (m *ApplicationRollbackRequest) Reset()         { *m = ApplicationRollbackR
 func (m *ApplicationRollbackRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationRollbackRequest) ProtoMessage()    {}
 func (*ApplicationRollbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{16}
+	return fileDescriptor_df6e82b174b5eaec, []int{17}
 }
 func (m *ApplicationRollbackRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1402,7 +1493,7 @@ func (m *ApplicationResourceRequest) Reset()         { *m = ApplicationResourceR
 func (m *ApplicationResourceRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceRequest) ProtoMessage()    {}
 func (*ApplicationResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{17}
+	return fileDescriptor_df6e82b174b5eaec, []int{18}
 }
 func (m *ApplicationResourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1507,7 +1598,7 @@ func (m *ApplicationResourcePatchRequest) Reset()         { *m = ApplicationReso
 func (m *ApplicationResourcePatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourcePatchRequest) ProtoMessage()    {}
 func (*ApplicationResourcePatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{18}
+	return fileDescriptor_df6e82b174b5eaec, []int{19}
 }
 func (m *ApplicationResourcePatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1626,7 +1717,7 @@ func (m *ApplicationResourceDeleteRequest) Reset()         { *m = ApplicationRes
 func (m *ApplicationResourceDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceDeleteRequest) ProtoMessage()    {}
 func (*ApplicationResourceDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{19}
+	return fileDescriptor_df6e82b174b5eaec, []int{20}
 }
 func (m *ApplicationResourceDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1737,7 +1828,7 @@ func (m *ResourceActionParameters) Reset()         { *m = ResourceActionParamete
 func (m *ResourceActionParameters) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionParameters) ProtoMessage()    {}
 func (*ResourceActionParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{20}
+	return fileDescriptor_df6e82b174b5eaec, []int{21}
 }
 func (m *ResourceActionParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +1894,7 @@ func (m *ResourceActionRunRequest) Reset()         { *m = ResourceActionRunReque
 func (m *ResourceActionRunRequest) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionRunRequest) ProtoMessage()    {}
 func (*ResourceActionRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{21}
+	return fileDescriptor_df6e82b174b5eaec, []int{22}
 }
 func (m *ResourceActionRunRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2006,7 @@ func (m *ResourceActionRunRequestV2) Reset()         { *m = ResourceActionRunReq
 func (m *ResourceActionRunRequestV2) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionRunRequestV2) ProtoMessage()    {}
 func (*ResourceActionRunRequestV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{22}
+	return fileDescriptor_df6e82b174b5eaec, []int{23}
 }
 func (m *ResourceActionRunRequestV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2025,7 +2116,7 @@ func (m *ResourceActionsListResponse) Reset()         { *m = ResourceActionsList
 func (m *ResourceActionsListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionsListResponse) ProtoMessage()    {}
 func (*ResourceActionsListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{23}
+	return fileDescriptor_df6e82b174b5eaec, []int{24}
 }
 func (m *ResourceActionsListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2072,7 +2163,7 @@ func (m *ApplicationResourceResponse) Reset()         { *m = ApplicationResource
 func (m *ApplicationResourceResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceResponse) ProtoMessage()    {}
 func (*ApplicationResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{24}
+	return fileDescriptor_df6e82b174b5eaec, []int{25}
 }
 func (m *ApplicationResourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2135,7 +2226,7 @@ func (m *ApplicationPodLogsQuery) Reset()         { *m = ApplicationPodLogsQuery
 func (m *ApplicationPodLogsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPodLogsQuery) ProtoMessage()    {}
 func (*ApplicationPodLogsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{25}
+	return fileDescriptor_df6e82b174b5eaec, []int{26}
 }
 func (m *ApplicationPodLogsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2299,7 +2390,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{26}
+	return fileDescriptor_df6e82b174b5eaec, []int{27}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2376,7 +2467,7 @@ func (m *OperationTerminateRequest) Reset()         { *m = OperationTerminateReq
 func (m *OperationTerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateRequest) ProtoMessage()    {}
 func (*OperationTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{27}
+	return fileDescriptor_df6e82b174b5eaec, []int{28}
 }
 func (m *OperationTerminateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2439,7 +2530,7 @@ func (m *ApplicationSyncWindowsQuery) Reset()         { *m = ApplicationSyncWind
 func (m *ApplicationSyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsQuery) ProtoMessage()    {}
 func (*ApplicationSyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{28}
+	return fileDescriptor_df6e82b174b5eaec, []int{29}
 }
 func (m *ApplicationSyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2502,7 +2593,7 @@ func (m *ApplicationSyncWindowsResponse) Reset()         { *m = ApplicationSyncW
 func (m *ApplicationSyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsResponse) ProtoMessage()    {}
 func (*ApplicationSyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{29}
+	return fileDescriptor_df6e82b174b5eaec, []int{30}
 }
 func (m *ApplicationSyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2566,7 +2657,7 @@ func (m *ApplicationSyncWindow) Reset()         { *m = ApplicationSyncWindow{} }
 func (m *ApplicationSyncWindow) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindow) ProtoMessage()    {}
 func (*ApplicationSyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{30}
+	return fileDescriptor_df6e82b174b5eaec, []int{31}
 }
 func (m *ApplicationSyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2633,7 +2724,7 @@ func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateRe
 func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateResponse) ProtoMessage()    {}
 func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{31}
+	return fileDescriptor_df6e82b174b5eaec, []int{32}
 }
 func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2680,7 +2771,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{32}
+	return fileDescriptor_df6e82b174b5eaec, []int{33}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2776,7 +2867,7 @@ func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesRespon
 func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagedResourcesResponse) ProtoMessage()    {}
 func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{33}
+	return fileDescriptor_df6e82b174b5eaec, []int{34}
 }
 func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2827,7 +2918,7 @@ func (m *ApplicationServerSideDiffQuery) Reset()         { *m = ApplicationServe
 func (m *ApplicationServerSideDiffQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationServerSideDiffQuery) ProtoMessage()    {}
 func (*ApplicationServerSideDiffQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{34}
+	return fileDescriptor_df6e82b174b5eaec, []int{35}
 }
 func (m *ApplicationServerSideDiffQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2903,7 +2994,7 @@ func (m *ApplicationServerSideDiffResponse) Reset()         { *m = ApplicationSe
 func (m *ApplicationServerSideDiffResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationServerSideDiffResponse) ProtoMessage()    {}
 func (*ApplicationServerSideDiffResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{35}
+	return fileDescriptor_df6e82b174b5eaec, []int{36}
 }
 func (m *ApplicationServerSideDiffResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2960,7 +3051,7 @@ func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
 func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
 func (*LinkInfo) ProtoMessage()    {}
 func (*LinkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{36}
+	return fileDescriptor_df6e82b174b5eaec, []int{37}
 }
 func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3028,7 +3119,7 @@ func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
 func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
 func (*LinksResponse) ProtoMessage()    {}
 func (*LinksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{37}
+	return fileDescriptor_df6e82b174b5eaec, []int{38}
 }
 func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3077,7 +3168,7 @@ func (m *ListAppLinksRequest) Reset()         { *m = ListAppLinksRequest{} }
 func (m *ListAppLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppLinksRequest) ProtoMessage()    {}
 func (*ListAppLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{38}
+	return fileDescriptor_df6e82b174b5eaec, []int{39}
 }
 func (m *ListAppLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3132,6 +3223,7 @@ func init() {
 	proto.RegisterType((*NodeQuery)(nil), "application.NodeQuery")
 	proto.RegisterType((*RevisionMetadataQuery)(nil), "application.RevisionMetadataQuery")
 	proto.RegisterType((*ApplicationResourceEventsQuery)(nil), "application.ApplicationResourceEventsQuery")
+	proto.RegisterType((*ApplicationSyncRecordsQuery)(nil), "application.ApplicationSyncRecordsQuery")
 	proto.RegisterType((*ApplicationManifestQuery)(nil), "application.ApplicationManifestQuery")
 	proto.RegisterType((*FileChunk)(nil), "application.FileChunk")
 	proto.RegisterType((*ApplicationManifestQueryWithFiles)(nil), "application.ApplicationManifestQueryWithFiles")
@@ -3174,196 +3266,201 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 3018 bytes of a gzipped FileDescriptorProto
+	// 3092 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xcf, 0x8f, 0x1c, 0x47,
-	0xf5, 0xff, 0xd6, 0xcc, 0xce, 0xee, 0xec, 0x1b, 0xaf, 0x7f, 0x54, 0x6c, 0x7f, 0x3b, 0xe3, 0x8d,
-	0xd9, 0xb4, 0xed, 0x78, 0xb3, 0xf6, 0xce, 0xd8, 0x13, 0x03, 0xc9, 0x26, 0x21, 0x38, 0x6b, 0xc7,
-	0x59, 0x58, 0x3b, 0xa6, 0xd7, 0x89, 0x51, 0x38, 0x40, 0xa5, 0xbb, 0x66, 0xa6, 0xd9, 0x9e, 0xee,
-	0x76, 0x77, 0xcf, 0x84, 0x55, 0x88, 0x84, 0x82, 0x90, 0x38, 0xa0, 0x20, 0x20, 0x07, 0x0e, 0xfc,
-	0x4c, 0x14, 0x84, 0x10, 0x88, 0x0b, 0x42, 0x48, 0x08, 0x09, 0x0e, 0x41, 0x70, 0x40, 0x42, 0xf0,
-	0x0f, 0x20, 0x0b, 0x71, 0xe0, 0x40, 0x2e, 0x39, 0x23, 0x54, 0xd5, 0x55, 0xdd, 0x5d, 0xf3, 0xa3,
-	0x67, 0x96, 0x19, 0x88, 0x25, 0x4e, 0xee, 0x57, 0xd3, 0xfd, 0xde, 0xe7, 0xbd, 0x7a, 0xef, 0xd5,
-	0xab, 0xf7, 0xd6, 0x70, 0x3a, 0xa4, 0x41, 0x8f, 0x06, 0x75, 0xe2, 0xfb, 0x8e, 0x6d, 0x92, 0xc8,
-	0xf6, 0xdc, 0xec, 0x73, 0xcd, 0x0f, 0xbc, 0xc8, 0xc3, 0x95, 0xcc, 0x52, 0x75, 0xb9, 0xe5, 0x79,
-	0x2d, 0x87, 0xd6, 0x89, 0x6f, 0xd7, 0x89, 0xeb, 0x7a, 0x11, 0x5f, 0x0e, 0xe3, 0x57, 0xab, 0x97,
-	0x76, 0x1f, 0x0d, 0x6b, 0xb6, 0xc7, 0x7e, 0xed, 0x10, 0xb3, 0x6d, 0xbb, 0x34, 0xd8, 0xab, 0xfb,
-	0xbb, 0x2d, 0xb6, 0x10, 0xd6, 0x3b, 0x34, 0x22, 0xf5, 0xde, 0xc5, 0x7a, 0x8b, 0xba, 0x34, 0x20,
-	0x11, 0xb5, 0xc4, 0x57, 0xdb, 0x2d, 0x3b, 0x6a, 0x77, 0x5f, 0xaa, 0x99, 0x5e, 0xa7, 0x4e, 0x82,
-	0x96, 0xe7, 0x07, 0xde, 0x67, 0xf9, 0xc3, 0xba, 0x69, 0xd5, 0x7b, 0x8f, 0xa4, 0x0c, 0xb2, 0x38,
-	0x7b, 0x17, 0x89, 0xe3, 0xb7, 0xc9, 0x20, 0xb7, 0xab, 0x63, 0xb8, 0x05, 0xd4, 0xf7, 0x84, 0xde,
-	0xfc, 0xd1, 0x8e, 0xbc, 0x60, 0x2f, 0xf3, 0x28, 0xd8, 0x3c, 0x36, 0x86, 0x8d, 0x60, 0x41, 0x7b,
-	0xd4, 0x8d, 0x42, 0xf1, 0x4f, 0xfc, 0xa9, 0xfe, 0x1e, 0x82, 0xc3, 0x97, 0x53, 0xa8, 0x9f, 0xe8,
-	0xd2, 0x60, 0x0f, 0x63, 0x98, 0x73, 0x49, 0x87, 0x6a, 0x68, 0x05, 0xad, 0x2e, 0x1a, 0xfc, 0x19,
-	0x6b, 0xb0, 0x10, 0xd0, 0x66, 0x40, 0xc3, 0xb6, 0x56, 0xe0, 0xcb, 0x92, 0xc4, 0x55, 0x28, 0x33,
-	0x81, 0xd4, 0x8c, 0x42, 0xad, 0xb8, 0x52, 0x5c, 0x5d, 0x34, 0x12, 0x1a, 0xaf, 0xc2, 0xa1, 0x80,
-	0x86, 0x5e, 0x37, 0x30, 0xe9, 0x0b, 0x34, 0x08, 0x6d, 0xcf, 0xd5, 0xe6, 0xf8, 0xd7, 0xfd, 0xcb,
-	0x8c, 0x4b, 0x48, 0x1d, 0x6a, 0x46, 0x5e, 0xa0, 0x95, 0xf8, 0x2b, 0x09, 0xcd, 0xf0, 0x30, 0x9d,
-	0xb5, 0xf9, 0x18, 0x0f, 0x7b, 0xc6, 0x3a, 0x1c, 0x20, 0xbe, 0x7f, 0x83, 0x74, 0x68, 0xe8, 0x13,
-	0x93, 0x6a, 0x0b, 0xfc, 0x37, 0x65, 0x8d, 0x61, 0x16, 0x48, 0xb4, 0x32, 0x07, 0x26, 0x49, 0x7d,
-	0x13, 0x16, 0x6f, 0x78, 0x16, 0x1d, 0xad, 0x6e, 0x3f, 0xfb, 0xc2, 0x20, 0x7b, 0xfd, 0x1d, 0x04,
-	0xc7, 0x0c, 0xda, 0xb3, 0x19, 0xfe, 0xeb, 0x34, 0x22, 0x16, 0x89, 0x48, 0x3f, 0xc7, 0x42, 0xc2,
-	0xb1, 0x0a, 0xe5, 0x40, 0xbc, 0xac, 0x15, 0xf8, 0x7a, 0x42, 0x0f, 0x48, 0x2b, 0xe6, 0x2b, 0x13,
-	0x9b, 0x50, 0x92, 0x78, 0x05, 0x2a, 0xb1, 0x2d, 0xb7, 0x5c, 0x8b, 0x7e, 0x8e, 0x5b, 0xaf, 0x64,
-	0x64, 0x97, 0xf0, 0x32, 0x2c, 0xf6, 0x62, 0x3b, 0x6f, 0x59, 0xdc, 0x8a, 0x25, 0x23, 0x5d, 0xd0,
-	0xff, 0x86, 0xe0, 0x64, 0xc6, 0x07, 0x0c, 0xb1, 0x33, 0x57, 0xb9, 0x9f, 0x8c, 0x56, 0xe8, 0x3c,
-	0x1c, 0x91, 0x9b, 0xd8, 0x6f, 0xa7, 0xc1, 0x1f, 0x98, 0x8a, 0xd9, 0x45, 0xa9, 0x62, 0x76, 0x8d,
-	0x29, 0x22, 0xe9, 0xe7, 0xb7, 0xae, 0x08, 0x35, 0xb3, 0x4b, 0x03, 0x86, 0x2a, 0xe5, 0x1b, 0x6a,
-	0x5e, 0x31, 0x94, 0xfe, 0x77, 0x04, 0x5a, 0x46, 0xd1, 0xeb, 0xc4, 0xb5, 0x9b, 0x34, 0x8c, 0x26,
-	0xdd, 0x33, 0x34, 0xc3, 0x3d, 0x5b, 0x85, 0x43, 0xb1, 0x56, 0x37, 0x59, 0x28, 0xb3, 0xb4, 0xa4,
-	0x95, 0x56, 0x8a, 0xab, 0x45, 0xa3, 0x7f, 0x99, 0xed, 0x9d, 0x94, 0x19, 0x6a, 0xf3, 0xdc, 0x8d,
-	0xd3, 0x05, 0x26, 0xc1, 0xf5, 0x36, 0x89, 0xd9, 0x8e, 0x23, 0xa0, 0x6c, 0x48, 0x52, 0x7f, 0x10,
-	0x16, 0x9f, 0xb1, 0x1d, 0xba, 0xd9, 0xee, 0xba, 0xbb, 0xf8, 0x28, 0x94, 0x4c, 0xf6, 0xc0, 0xb5,
-	0x3b, 0x60, 0xc4, 0x84, 0xfe, 0x35, 0x04, 0x0f, 0x8e, 0xb2, 0xc7, 0x6d, 0x3b, 0x6a, 0xb3, 0xef,
-	0xc3, 0x51, 0x86, 0x31, 0xdb, 0xd4, 0xdc, 0x0d, 0xbb, 0x1d, 0xe9, 0xcc, 0x92, 0x9e, 0xce, 0x30,
-	0xfa, 0x8f, 0x10, 0xac, 0x8e, 0xc5, 0x74, 0x3b, 0x20, 0xbe, 0x4f, 0x03, 0xfc, 0x0c, 0x94, 0xee,
-	0xb0, 0x1f, 0x78, 0xe8, 0x56, 0x1a, 0xb5, 0x5a, 0xf6, 0x44, 0x18, 0xcb, 0xe5, 0xd9, 0xff, 0x33,
-	0xe2, 0xcf, 0x71, 0x4d, 0x9a, 0xa7, 0xc0, 0xf9, 0x1c, 0x57, 0xf8, 0x24, 0x56, 0x64, 0xef, 0xf3,
-	0xd7, 0x9e, 0x9e, 0x87, 0x39, 0x9f, 0x04, 0x91, 0x7e, 0x0c, 0xee, 0x53, 0x03, 0xc7, 0xf7, 0xdc,
-	0x90, 0xea, 0xbf, 0x54, 0xfd, 0x6c, 0x33, 0xa0, 0x24, 0xa2, 0x06, 0xbd, 0xd3, 0xa5, 0x61, 0x84,
-	0x77, 0x21, 0x7b, 0x48, 0x71, 0xab, 0x56, 0x1a, 0x5b, 0xb5, 0x34, 0x85, 0xd7, 0x64, 0x0a, 0xe7,
-	0x0f, 0x9f, 0x36, 0xad, 0x5a, 0xef, 0x91, 0x9a, 0xbf, 0xdb, 0xaa, 0xb1, 0x73, 0x45, 0x41, 0x26,
-	0xcf, 0x95, 0xac, 0xaa, 0x46, 0x96, 0x3b, 0x3e, 0x0e, 0xf3, 0x5d, 0x3f, 0xa4, 0x41, 0xc4, 0x35,
-	0x2b, 0x1b, 0x82, 0x62, 0xfb, 0xd7, 0x23, 0x8e, 0x6d, 0x91, 0x28, 0xde, 0x9f, 0xb2, 0x91, 0xd0,
-	0xfa, 0xaf, 0x54, 0xf4, 0xcf, 0xfb, 0xd6, 0xfb, 0x85, 0x3e, 0x8b, 0xb2, 0xa0, 0xa2, 0xcc, 0x7a,
-	0x50, 0x51, 0xf5, 0xa0, 0x9f, 0xa9, 0xf8, 0xaf, 0x50, 0x87, 0xa6, 0xf8, 0x87, 0x39, 0xb3, 0x06,
-	0x0b, 0x26, 0x09, 0x4d, 0x62, 0x49, 0x29, 0x92, 0x64, 0x29, 0xce, 0x0f, 0x3c, 0x9f, 0xb4, 0x38,
-	0xa7, 0x9b, 0x9e, 0x63, 0x9b, 0x7b, 0x42, 0xdc, 0xe0, 0x0f, 0x03, 0x8e, 0x3f, 0x97, 0xef, 0xf8,
-	0x25, 0x15, 0xf6, 0x29, 0xa8, 0xec, 0xec, 0xb9, 0xe6, 0x73, 0x7e, 0x1c, 0xf6, 0x47, 0xa1, 0x64,
-	0x47, 0xb4, 0x13, 0x6a, 0x88, 0x87, 0x7c, 0x4c, 0xe8, 0xff, 0x2c, 0xc1, 0xf1, 0x8c, 0x6e, 0xec,
-	0x83, 0x3c, 0xcd, 0xf2, 0xf2, 0xd7, 0x71, 0x98, 0xb7, 0x82, 0x3d, 0xa3, 0xeb, 0x0a, 0x07, 0x10,
-	0x14, 0x13, 0xec, 0x07, 0x5d, 0x37, 0x86, 0x5f, 0x36, 0x62, 0x02, 0x37, 0xa1, 0x1c, 0x46, 0xac,
-	0x74, 0x69, 0xed, 0x71, 0xe0, 0x95, 0xc6, 0xc7, 0xa6, 0xdb, 0x74, 0x06, 0x7d, 0x47, 0x70, 0x34,
-	0x12, 0xde, 0xf8, 0x0e, 0xcb, 0x76, 0x71, 0x0a, 0x0c, 0xb5, 0x85, 0x95, 0xe2, 0x6a, 0xa5, 0xb1,
-	0x33, 0xbd, 0xa0, 0xe7, 0x7c, 0x56, 0x76, 0x65, 0xce, 0x36, 0x23, 0x95, 0xc2, 0x12, 0x6c, 0x47,
-	0xe4, 0x87, 0x50, 0xd4, 0x09, 0xe9, 0x02, 0xfe, 0x24, 0x94, 0x6c, 0xb7, 0xe9, 0x85, 0xda, 0x22,
-	0x07, 0xf3, 0xf4, 0x74, 0x60, 0xb6, 0xdc, 0xa6, 0x67, 0xc4, 0x0c, 0xf1, 0x1d, 0x58, 0x0a, 0x68,
-	0x14, 0xec, 0x49, 0x2b, 0x68, 0xc0, 0xed, 0xfa, 0xf1, 0xe9, 0x24, 0x18, 0x59, 0x96, 0x86, 0x2a,
-	0x01, 0x6f, 0x40, 0x25, 0x4c, 0x7d, 0x4c, 0xab, 0x70, 0x81, 0x9a, 0xc2, 0x28, 0xe3, 0x83, 0x46,
-	0xf6, 0xe5, 0x01, 0xef, 0x3e, 0x90, 0xef, 0xdd, 0x4b, 0x63, 0xcf, 0xbb, 0x83, 0x13, 0x9c, 0x77,
-	0x87, 0xfa, 0xce, 0x3b, 0xfd, 0x5d, 0x04, 0xcb, 0x03, 0xc9, 0x69, 0xc7, 0xa7, 0xb9, 0x61, 0x40,
-	0x60, 0x2e, 0xf4, 0xa9, 0xc9, 0x4f, 0xaa, 0x4a, 0xe3, 0xfa, 0xcc, 0xb2, 0x15, 0x97, 0xcb, 0x59,
-	0xe7, 0x25, 0xd4, 0x29, 0xf3, 0xc2, 0x77, 0x11, 0xfc, 0x7f, 0x46, 0xe6, 0x4d, 0x12, 0x99, 0xed,
-	0x3c, 0x65, 0x59, 0xfc, 0xb2, 0x77, 0xc4, 0xb9, 0x1c, 0x13, 0xcc, 0xaa, 0xfc, 0xe1, 0xd6, 0x9e,
-	0xcf, 0x00, 0xb2, 0x5f, 0xd2, 0x85, 0x29, 0xcb, 0xaa, 0x1f, 0x23, 0xa8, 0x66, 0x73, 0xb8, 0xe7,
-	0x38, 0x2f, 0x11, 0x73, 0x37, 0x0f, 0xe4, 0x41, 0x28, 0xd8, 0x16, 0x47, 0x58, 0x34, 0x0a, 0xb6,
-	0xb5, 0xcf, 0x64, 0xd4, 0x0f, 0x77, 0x3e, 0x1f, 0xee, 0x82, 0x0a, 0xf7, 0xbd, 0x3e, 0xb8, 0x32,
-	0x25, 0xe4, 0xc0, 0x5d, 0x86, 0x45, 0xb7, 0xaf, 0xc4, 0x4d, 0x17, 0x86, 0x94, 0xb6, 0x85, 0x81,
-	0xd2, 0x56, 0x83, 0x85, 0x5e, 0x72, 0x01, 0x62, 0x3f, 0x4b, 0x92, 0xa9, 0xd8, 0x0a, 0xbc, 0xae,
-	0x2f, 0x8c, 0x1e, 0x13, 0x0c, 0xc5, 0xae, 0xed, 0xb2, 0x62, 0x9d, 0xa3, 0x60, 0xcf, 0xfb, 0xbf,
-	0xf2, 0x28, 0x6a, 0xff, 0xa4, 0x00, 0x1f, 0x18, 0xa2, 0xf6, 0x58, 0x7f, 0xba, 0x37, 0x74, 0x4f,
-	0xbc, 0x7a, 0x61, 0xa4, 0x57, 0x97, 0xc7, 0x79, 0xf5, 0x62, 0xbe, 0xbd, 0x40, 0xb5, 0xd7, 0x0f,
-	0x0b, 0xb0, 0x32, 0xc4, 0x5e, 0xe3, 0xcb, 0x89, 0x7b, 0xc6, 0x60, 0x4d, 0x2f, 0x30, 0xe5, 0xb5,
-	0x20, 0x26, 0x58, 0x9c, 0x79, 0x81, 0xdf, 0x26, 0x2e, 0xf7, 0x8e, 0xb2, 0x21, 0xa8, 0x29, 0x4d,
-	0x75, 0x05, 0x34, 0x69, 0x9e, 0xcb, 0x66, 0x9c, 0xa4, 0x02, 0xd2, 0xa1, 0x11, 0x0d, 0xc2, 0x51,
-	0x29, 0xaa, 0x47, 0x9c, 0x2e, 0x95, 0x29, 0x8a, 0x13, 0xfa, 0xeb, 0x85, 0x7e, 0x36, 0x46, 0xd7,
-	0xbd, 0xf7, 0x0d, 0x7d, 0x1c, 0xe6, 0x09, 0x47, 0x2b, 0x5c, 0x53, 0x50, 0x03, 0x26, 0x2d, 0xe7,
-	0x9b, 0x74, 0x51, 0x31, 0xe9, 0x46, 0x41, 0x43, 0xfa, 0xbb, 0x05, 0xa8, 0x8e, 0x32, 0xc8, 0x0b,
-	0x8d, 0xff, 0x35, 0x93, 0x60, 0x02, 0x5a, 0x30, 0xc2, 0xcb, 0x34, 0xe0, 0xc5, 0xd9, 0x19, 0xe5,
-	0xc4, 0x1e, 0xe5, 0x92, 0xc6, 0x48, 0x36, 0xfa, 0x97, 0x10, 0x9c, 0x50, 0x3f, 0x0b, 0xb7, 0xed,
-	0x30, 0x92, 0x17, 0x3b, 0xdc, 0x84, 0x85, 0x58, 0x95, 0xb8, 0x2c, 0xaf, 0x34, 0xb6, 0xa7, 0x2d,
-	0xd6, 0x94, 0xdd, 0x95, 0xcc, 0xf5, 0xc7, 0xe0, 0xc4, 0xd0, 0x13, 0x4a, 0xc0, 0xa8, 0x42, 0x59,
-	0x16, 0xa8, 0x62, 0xf7, 0x13, 0x5a, 0x7f, 0x6b, 0x4e, 0x2d, 0x17, 0x3c, 0x6b, 0xdb, 0x6b, 0xe5,
-	0x74, 0x71, 0xf2, 0x3d, 0x86, 0xed, 0x86, 0x67, 0x65, 0x1a, 0x36, 0x92, 0x64, 0xdf, 0x99, 0x9e,
-	0x1b, 0x11, 0xdb, 0xa5, 0x81, 0xa8, 0x68, 0xd2, 0x05, 0xb6, 0xd3, 0xa1, 0xed, 0x9a, 0x74, 0x87,
-	0x9a, 0x9e, 0x6b, 0x85, 0xdc, 0x65, 0x8a, 0x86, 0xb2, 0x86, 0x9f, 0x85, 0x45, 0x4e, 0xdf, 0xb2,
-	0x3b, 0xf1, 0x11, 0x5e, 0x69, 0xac, 0xd5, 0xe2, 0xa6, 0x6c, 0x2d, 0xdb, 0x94, 0x4d, 0x6d, 0xd8,
-	0xa1, 0x11, 0xa9, 0xf5, 0x2e, 0xd6, 0xd8, 0x17, 0x46, 0xfa, 0x31, 0xc3, 0x12, 0x11, 0xdb, 0xd9,
-	0xb6, 0x5d, 0x7e, 0x69, 0x60, 0xa2, 0xd2, 0x05, 0xe6, 0x8d, 0x4d, 0xcf, 0x71, 0xbc, 0x97, 0x65,
-	0xce, 0x8b, 0x29, 0xf6, 0x55, 0xd7, 0x8d, 0x6c, 0x87, 0xcb, 0x8f, 0x7d, 0x2d, 0x5d, 0xe0, 0x5f,
-	0xd9, 0x4e, 0x44, 0x03, 0x91, 0xec, 0x04, 0x95, 0xf8, 0x7b, 0x25, 0x6e, 0x16, 0xca, 0x5c, 0x1b,
-	0x47, 0xc6, 0x81, 0x6c, 0x64, 0xf4, 0x47, 0xdb, 0xd2, 0x90, 0x8e, 0x17, 0xef, 0x9d, 0xd2, 0x9e,
-	0xed, 0x75, 0x59, 0x3d, 0xcc, 0xcb, 0x46, 0x49, 0x0f, 0x44, 0xcb, 0xa1, 0xfc, 0x68, 0x39, 0xac,
-	0x46, 0x0b, 0xbf, 0xd5, 0x44, 0x66, 0x7b, 0x93, 0x84, 0x54, 0x3b, 0xc2, 0x59, 0xa7, 0x0b, 0xfa,
-	0xaf, 0x11, 0x94, 0xb7, 0xbd, 0xd6, 0x55, 0x37, 0x0a, 0xf6, 0xf8, 0xfd, 0xd7, 0x73, 0x23, 0xea,
-	0x4a, 0x6f, 0x92, 0x24, 0xdb, 0xa2, 0xc8, 0xee, 0xd0, 0x9d, 0x88, 0x74, 0x7c, 0x51, 0x3d, 0xef,
-	0x6b, 0x8b, 0x92, 0x8f, 0x99, 0xd9, 0x1c, 0x12, 0x46, 0x3c, 0xe5, 0x94, 0x0d, 0xfe, 0xcc, 0x14,
-	0x4c, 0x5e, 0xd8, 0x89, 0x02, 0x91, 0x6f, 0x94, 0xb5, 0xac, 0x03, 0x96, 0x62, 0x6c, 0x82, 0xd4,
-	0x3b, 0x70, 0x7f, 0x72, 0xad, 0xbb, 0x45, 0x83, 0x8e, 0xed, 0x92, 0xfc, 0x73, 0x79, 0x82, 0x96,
-	0x6e, 0x4e, 0x57, 0xc1, 0x53, 0x42, 0x92, 0xdd, 0x92, 0x6e, 0xdb, 0xae, 0xe5, 0xbd, 0x9c, 0x13,
-	0x5a, 0xd3, 0x09, 0xfc, 0x93, 0xda, 0x95, 0xcd, 0x48, 0x4c, 0xf2, 0xc0, 0xb3, 0xb0, 0xc4, 0x32,
-	0x46, 0x8f, 0x8a, 0x1f, 0x44, 0x52, 0xd2, 0x47, 0xb5, 0xc1, 0x52, 0x1e, 0x86, 0xfa, 0x21, 0xde,
-	0x86, 0x43, 0x24, 0x0c, 0xed, 0x96, 0x4b, 0x2d, 0xc9, 0xab, 0x30, 0x31, 0xaf, 0xfe, 0x4f, 0xe3,
-	0x86, 0x0a, 0x7f, 0x43, 0xec, 0xb7, 0x24, 0xf5, 0x2f, 0x22, 0x38, 0x36, 0x94, 0x49, 0x12, 0x57,
-	0x28, 0x73, 0x8e, 0x54, 0xa1, 0x1c, 0x9a, 0x6d, 0x6a, 0x75, 0x1d, 0x59, 0x2a, 0x24, 0x34, 0xfb,
-	0xcd, 0xea, 0xc6, 0xbb, 0x2f, 0xce, 0xb1, 0x84, 0xc6, 0x27, 0x01, 0x3a, 0xc4, 0xed, 0x12, 0x87,
-	0x43, 0x98, 0xe3, 0x10, 0x32, 0x2b, 0xfa, 0x32, 0x54, 0x87, 0xb9, 0x8e, 0xe8, 0xde, 0xfd, 0x03,
-	0xc1, 0x41, 0x99, 0x72, 0xc5, 0xee, 0xae, 0xc2, 0xa1, 0x8c, 0x19, 0x6e, 0xa4, 0x1b, 0xdd, 0xbf,
-	0x3c, 0x26, 0x9d, 0x4a, 0x2f, 0x29, 0xaa, 0x83, 0x95, 0x9e, 0x32, 0x1a, 0x99, 0xf8, 0xc0, 0x45,
-	0x33, 0xba, 0x19, 0x7c, 0x1e, 0xb4, 0xeb, 0xc4, 0x25, 0x2d, 0x6a, 0x25, 0x6a, 0x27, 0x2e, 0xf6,
-	0x99, 0x6c, 0x1b, 0x6a, 0xea, 0xa6, 0x4f, 0x52, 0x44, 0xdb, 0xcd, 0xa6, 0x6c, 0x69, 0xbd, 0x51,
-	0x50, 0xfd, 0x9c, 0xcf, 0xaa, 0x76, 0x6c, 0x8b, 0xbf, 0x14, 0x9b, 0x5f, 0x83, 0x05, 0xa1, 0x8a,
-	0x4c, 0x50, 0x82, 0x9c, 0x2e, 0xc4, 0xb0, 0x0f, 0x4b, 0x8e, 0xdd, 0xa3, 0x89, 0xd6, 0xda, 0xdc,
-	0xcc, 0x95, 0x54, 0x05, 0x30, 0x47, 0x8a, 0x48, 0xd0, 0xa2, 0xd1, 0xf5, 0xa4, 0xe3, 0x54, 0xe2,
-	0x2d, 0x8e, 0xfe, 0x65, 0xfd, 0xfb, 0x6a, 0x6f, 0x5e, 0x35, 0xcb, 0x7f, 0x6f, 0x7b, 0x78, 0xad,
-	0xe1, 0x59, 0x76, 0xd3, 0xa6, 0xf1, 0x7d, 0xbd, 0x6c, 0x24, 0xb4, 0x1e, 0x40, 0x79, 0xdb, 0x76,
-	0x77, 0xb7, 0xdc, 0xa6, 0xc7, 0x9c, 0x35, 0xb2, 0x23, 0x47, 0xee, 0x50, 0x4c, 0xe0, 0xc3, 0x50,
-	0xec, 0x06, 0x8e, 0x08, 0x5e, 0xf6, 0x88, 0x57, 0xa0, 0x62, 0xd1, 0xd0, 0x0c, 0x6c, 0x5f, 0x84,
-	0x2e, 0x9f, 0xf1, 0x64, 0x96, 0x58, 0x08, 0xd9, 0xa6, 0xe7, 0x6e, 0x3a, 0x24, 0x0c, 0x65, 0x65,
-	0x91, 0x2c, 0xe8, 0x4f, 0xc0, 0x12, 0x93, 0x99, 0x7a, 0xe8, 0x39, 0xd5, 0x04, 0xc7, 0x14, 0xd5,
-	0x24, 0x3c, 0xe9, 0x6c, 0x04, 0xee, 0x63, 0x05, 0xdd, 0x65, 0xdf, 0x17, 0x4c, 0x26, 0xbc, 0x5d,
-	0x14, 0x87, 0x15, 0x46, 0x43, 0x07, 0x18, 0x8d, 0xbb, 0x67, 0x01, 0xf7, 0x6d, 0x9c, 0x6d, 0x52,
-	0xfc, 0x75, 0x04, 0x73, 0x4c, 0x34, 0x7e, 0x60, 0x54, 0x46, 0xe5, 0xbe, 0x5e, 0x9d, 0x5d, 0x77,
-	0x8a, 0x49, 0xd3, 0x97, 0x5f, 0xfb, 0xf3, 0x5f, 0xbf, 0x51, 0x38, 0x8e, 0x8f, 0xf2, 0x29, 0x78,
-	0xef, 0x62, 0x76, 0x2e, 0x1d, 0xe2, 0x2f, 0x20, 0xc0, 0xa2, 0xc0, 0xcd, 0x8c, 0xfc, 0xf0, 0xb9,
-	0x51, 0x10, 0x87, 0x8c, 0x06, 0xab, 0x47, 0x6a, 0x62, 0xa0, 0xcc, 0x17, 0xb9, 0xd0, 0x35, 0x2e,
-	0xf4, 0x34, 0xd6, 0x87, 0x09, 0xad, 0xbf, 0xc2, 0xac, 0xf8, 0xaa, 0x18, 0x43, 0xe3, 0x37, 0x11,
-	0x94, 0x6e, 0xf3, 0xcb, 0xfc, 0x18, 0xc3, 0xec, 0xcc, 0xcc, 0x30, 0x5c, 0x1c, 0x47, 0xab, 0x9f,
-	0xe2, 0x48, 0x1f, 0xc0, 0x27, 0x24, 0xd2, 0x30, 0x0a, 0x28, 0xe9, 0x28, 0x80, 0x2f, 0x20, 0xfc,
-	0x36, 0x82, 0xf9, 0x78, 0x8a, 0x83, 0xcf, 0x8c, 0x42, 0xa9, 0x4c, 0x79, 0xaa, 0xb3, 0x1b, 0x89,
-	0xe8, 0x0f, 0x73, 0x8c, 0xa7, 0xf4, 0xa1, 0x5b, 0xb8, 0xa1, 0x0c, 0x4c, 0xde, 0x40, 0x50, 0xbc,
-	0x46, 0xc7, 0xfa, 0xd8, 0x0c, 0xc1, 0x0d, 0x18, 0x70, 0xc8, 0x56, 0xe3, 0xb7, 0x10, 0xdc, 0x7f,
-	0x8d, 0x46, 0xc3, 0xab, 0x19, 0xbc, 0x3a, 0xbe, 0xc4, 0x10, 0xae, 0x76, 0x6e, 0x82, 0x37, 0x93,
-	0x63, 0xbc, 0xce, 0x91, 0x3d, 0x8c, 0xcf, 0xe6, 0x39, 0x61, 0xb8, 0xe7, 0x9a, 0x2f, 0x0b, 0x1c,
-	0xbf, 0x47, 0x70, 0xb8, 0x7f, 0x9c, 0x8f, 0xf5, 0xbe, 0x2b, 0xe5, 0x90, 0x69, 0x7f, 0xf5, 0xc6,
-	0xb4, 0x59, 0x57, 0x65, 0xaa, 0x5f, 0xe6, 0xc8, 0x1f, 0xc7, 0x8f, 0xe5, 0x21, 0x4f, 0x5a, 0xe2,
-	0xf5, 0x57, 0xe4, 0xe3, 0xab, 0xfc, 0xaf, 0x56, 0x38, 0xec, 0x3f, 0x20, 0x38, 0x2a, 0xf9, 0x6e,
-	0xb6, 0x49, 0x10, 0x5d, 0xa1, 0xec, 0x42, 0x14, 0x4e, 0xa4, 0xcf, 0x94, 0xa7, 0x48, 0x56, 0x9e,
-	0x7e, 0x95, 0xeb, 0xf2, 0x14, 0x7e, 0x72, 0xdf, 0xba, 0x98, 0x8c, 0x8d, 0x25, 0x60, 0xbf, 0x83,
-	0xe0, 0xe0, 0x35, 0x1a, 0x3d, 0xb7, 0xb9, 0xb5, 0xaf, 0x9d, 0x99, 0xd2, 0xd1, 0x33, 0xe2, 0xf4,
-	0x2b, 0x5c, 0x91, 0x8f, 0xe0, 0x27, 0xf6, 0xad, 0x88, 0x67, 0xda, 0xc9, 0xbe, 0xbc, 0x86, 0xe0,
-	0xc0, 0xb5, 0xcc, 0x31, 0x3f, 0x3a, 0x9d, 0x28, 0x23, 0xeb, 0xea, 0x72, 0x2d, 0xf3, 0x47, 0x3f,
-	0xf2, 0xa7, 0xc4, 0xd5, 0xd7, 0x39, 0xb6, 0xb3, 0xf8, 0x4c, 0x1e, 0xb6, 0x74, 0xa4, 0xf5, 0x26,
-	0x82, 0x63, 0x59, 0x10, 0xe9, 0xa8, 0xff, 0x83, 0xfb, 0x1b, 0xa0, 0x8b, 0x31, 0xfc, 0x18, 0x74,
-	0x0d, 0x8e, 0xee, 0xbc, 0x3e, 0x3c, 0x10, 0x3b, 0x03, 0x28, 0x36, 0xd0, 0xda, 0x2a, 0xc2, 0xbf,
-	0x41, 0x30, 0x1f, 0x4f, 0x77, 0x46, 0xdb, 0x48, 0x19, 0x4d, 0xcf, 0x32, 0xab, 0x09, 0xaf, 0xad,
-	0x5e, 0x18, 0x6e, 0xd0, 0xec, 0xf7, 0x72, 0x6b, 0x6b, 0xdc, 0xca, 0x6a, 0x3a, 0xfe, 0x39, 0x02,
-	0x48, 0x27, 0x54, 0xf8, 0xe1, 0x7c, 0x3d, 0x32, 0x53, 0xac, 0xea, 0x6c, 0x67, 0x54, 0x7a, 0x8d,
-	0xeb, 0xb3, 0x5a, 0x5d, 0xc9, 0xcd, 0x85, 0x3e, 0x35, 0x37, 0xe2, 0x69, 0xd6, 0xf7, 0x10, 0x94,
-	0xf8, 0x60, 0x00, 0x9f, 0x1e, 0x85, 0x39, 0x3b, 0x37, 0x98, 0xa5, 0xe9, 0x1f, 0xe2, 0x50, 0x57,
-	0x1a, 0x79, 0x07, 0xca, 0x06, 0x5a, 0xc3, 0x3d, 0x98, 0x8f, 0x5b, 0xf1, 0xa3, 0xdd, 0x43, 0x69,
-	0xd5, 0x57, 0x57, 0x72, 0x8a, 0x9a, 0xd8, 0x51, 0xc5, 0x59, 0xb6, 0x36, 0xee, 0x2c, 0x9b, 0x63,
-	0xc7, 0x0d, 0x3e, 0x95, 0x77, 0x18, 0xfd, 0x07, 0x0c, 0x73, 0x8e, 0xa3, 0x3b, 0xa3, 0xaf, 0x8c,
-	0x3b, 0xcf, 0x98, 0x75, 0xbe, 0x89, 0xe0, 0x70, 0xff, 0x9d, 0x0e, 0x9f, 0x18, 0xda, 0x1e, 0x15,
-	0x67, 0xab, 0x6a, 0xc5, 0x51, 0xf7, 0x41, 0xfd, 0xa3, 0x1c, 0xc5, 0x06, 0x7e, 0x74, 0x6c, 0x64,
-	0xdc, 0x90, 0x59, 0x87, 0x31, 0x5a, 0x4f, 0xc7, 0xed, 0x3f, 0x40, 0x70, 0x50, 0xbd, 0xcd, 0x8c,
-	0xae, 0x37, 0x87, 0x5c, 0x06, 0xab, 0xb5, 0xc9, 0x5e, 0x4e, 0x10, 0x7f, 0x98, 0x23, 0xbe, 0x88,
-	0xeb, 0x23, 0x11, 0xc7, 0x48, 0xe3, 0x3f, 0x92, 0x5c, 0x0f, 0x6d, 0x8b, 0xae, 0x5b, 0x0c, 0xd5,
-	0x2f, 0x10, 0x1c, 0x90, 0x06, 0xb8, 0x15, 0x50, 0x9a, 0x6f, 0xbf, 0xd9, 0x45, 0x2c, 0x93, 0xa5,
-	0x3f, 0xc1, 0x51, 0x7f, 0x08, 0x5f, 0x9a, 0xd0, 0xce, 0xd2, 0xbe, 0xeb, 0x11, 0x43, 0xfa, 0x5b,
-	0x04, 0x47, 0x6e, 0xc7, 0x01, 0xfa, 0x3e, 0xe1, 0xdf, 0xe4, 0xf8, 0x9f, 0xc4, 0x8f, 0xe7, 0x14,
-	0xd6, 0xe3, 0xd4, 0xb8, 0x80, 0xf0, 0x4f, 0x11, 0x94, 0xe5, 0x3c, 0x19, 0x9f, 0x1d, 0x19, 0xc1,
-	0xea, 0xc4, 0x79, 0x96, 0x51, 0x27, 0xaa, 0x48, 0xfd, 0x74, 0xee, 0xb1, 0x2f, 0xe4, 0xb3, 0xc8,
-	0x7b, 0x03, 0x01, 0x4e, 0x7a, 0x4a, 0x49, 0x97, 0x09, 0x3f, 0xa4, 0x88, 0x1a, 0xd9, 0xb8, 0xac,
-	0x9e, 0x1d, 0xfb, 0x9e, 0x7a, 0xe6, 0xaf, 0xe5, 0x9e, 0xf9, 0x5e, 0x22, 0xff, 0x75, 0x04, 0x95,
-	0x6b, 0x34, 0xb9, 0xe8, 0xe5, 0xd8, 0x52, 0x1d, 0x87, 0x57, 0x57, 0xc7, 0xbf, 0x28, 0x10, 0x9d,
-	0xe7, 0x88, 0x1e, 0xc2, 0xf9, 0xa6, 0x92, 0x00, 0xbe, 0x85, 0x60, 0xe9, 0x66, 0xd6, 0x45, 0xf1,
-	0xf9, 0x71, 0x92, 0x94, 0x23, 0x67, 0x72, 0x5c, 0x8f, 0x70, 0x5c, 0xeb, 0xfa, 0x44, 0xb8, 0x36,
-	0xc4, 0x64, 0xf9, 0x3b, 0x28, 0xee, 0x14, 0xf4, 0x4d, 0x83, 0xfe, 0x5d, 0xbb, 0xe5, 0x0c, 0x95,
-	0xf4, 0x4b, 0x1c, 0x5f, 0x0d, 0x9f, 0x9f, 0x04, 0x5f, 0x5d, 0x8c, 0x88, 0xf0, 0xb7, 0x11, 0x1c,
-	0xe1, 0xe3, 0xc0, 0x2c, 0x63, 0x9c, 0x37, 0x01, 0x4b, 0x87, 0x87, 0x13, 0x9c, 0x85, 0x4f, 0xc5,
-	0xf9, 0x47, 0xdf, 0x17, 0xa8, 0x0d, 0x31, 0xe8, 0xfb, 0x72, 0x01, 0xb1, 0xfd, 0xbd, 0x6f, 0x00,
-	0xdf, 0x0b, 0x8d, 0x3e, 0x03, 0x8e, 0x1e, 0x6f, 0x4e, 0x80, 0x71, 0x83, 0x63, 0xbc, 0xa4, 0xd7,
-	0xf7, 0x83, 0xb1, 0xde, 0x6b, 0xb0, 0x30, 0xfd, 0x2a, 0x82, 0x83, 0xb2, 0x3e, 0x10, 0xfe, 0xb7,
-	0x3e, 0x6e, 0x6b, 0xf7, 0x5b, 0x4f, 0x88, 0x80, 0x58, 0x9b, 0x2c, 0x20, 0xde, 0x46, 0xb0, 0x20,
-	0xa6, 0x75, 0x39, 0x55, 0x57, 0x66, 0x9c, 0x57, 0xed, 0x6b, 0x75, 0x89, 0x71, 0x8e, 0xfe, 0x29,
-	0x2e, 0xf6, 0x79, 0x9c, 0x6b, 0x16, 0xdf, 0xb3, 0xc2, 0xfa, 0x2b, 0x62, 0x96, 0xf2, 0x6a, 0xdd,
-	0xf1, 0x5a, 0xe1, 0x8b, 0x3a, 0xce, 0xad, 0x2d, 0xd8, 0x3b, 0x17, 0x10, 0x8e, 0x60, 0x91, 0xb9,
-	0x2f, 0xef, 0x9f, 0xe1, 0x95, 0xbe, 0x6e, 0xdb, 0x40, 0x6b, 0xad, 0x5a, 0x1d, 0xe8, 0xc7, 0xa5,
-	0xc5, 0x84, 0xe8, 0x6c, 0xe0, 0x07, 0x73, 0xc5, 0x72, 0x41, 0x5f, 0x41, 0x70, 0x24, 0x1b, 0x8f,
-	0xb1, 0xf8, 0x89, 0xa3, 0x31, 0x0f, 0x85, 0xb8, 0x9f, 0xe0, 0xb5, 0x89, 0xdc, 0x88, 0xc3, 0x79,
-	0xfa, 0x99, 0xdf, 0xdd, 0x3d, 0x89, 0xfe, 0x78, 0xf7, 0x24, 0xfa, 0xcb, 0xdd, 0x93, 0xe8, 0xc5,
-	0x47, 0x27, 0xfb, 0x4f, 0x21, 0xa6, 0x63, 0x53, 0x37, 0xca, 0xb2, 0xff, 0x57, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x64, 0xec, 0x77, 0x47, 0xd6, 0x32, 0x00, 0x00,
+	0xf5, 0xff, 0xd6, 0xec, 0xce, 0xee, 0xec, 0x5b, 0xaf, 0xd7, 0xae, 0xd8, 0xfe, 0x76, 0xc6, 0x1b,
+	0x7f, 0x37, 0x65, 0x3b, 0x5e, 0xaf, 0xbd, 0x33, 0xf6, 0xc6, 0x5f, 0x48, 0x36, 0x09, 0x89, 0xb3,
+	0x76, 0x1c, 0xc3, 0xda, 0x31, 0xbd, 0x4e, 0x8c, 0xc2, 0x01, 0x2a, 0xdd, 0xb5, 0x33, 0xcd, 0xce,
+	0x74, 0xb7, 0xbb, 0x7b, 0x26, 0xac, 0x42, 0x24, 0x14, 0x84, 0xc4, 0x01, 0x05, 0x05, 0x72, 0xe0,
+	0xc0, 0xcf, 0x44, 0x41, 0x28, 0x02, 0x71, 0x41, 0x08, 0x09, 0x21, 0xc1, 0x21, 0x08, 0x0e, 0x48,
+	0x08, 0xfe, 0x01, 0x14, 0x21, 0x0e, 0x1c, 0xc8, 0x25, 0x67, 0x84, 0xaa, 0xba, 0xaa, 0xbb, 0x7a,
+	0x66, 0xba, 0x67, 0x96, 0x19, 0x48, 0x24, 0x4e, 0xdb, 0xaf, 0xba, 0xeb, 0xbd, 0xcf, 0x7b, 0xf5,
+	0xea, 0xd5, 0xab, 0xf7, 0x66, 0xe1, 0x54, 0xc8, 0x82, 0x2e, 0x0b, 0xea, 0xd4, 0xf7, 0x5b, 0x8e,
+	0x45, 0x23, 0xc7, 0x73, 0xf5, 0xe7, 0x9a, 0x1f, 0x78, 0x91, 0x87, 0xe7, 0xb5, 0xa1, 0xea, 0x52,
+	0xc3, 0xf3, 0x1a, 0x2d, 0x56, 0xa7, 0xbe, 0x53, 0xa7, 0xae, 0xeb, 0x45, 0x62, 0x38, 0x8c, 0x3f,
+	0xad, 0x5e, 0xda, 0x7d, 0x28, 0xac, 0x39, 0x1e, 0x7f, 0xdb, 0xa6, 0x56, 0xd3, 0x71, 0x59, 0xb0,
+	0x57, 0xf7, 0x77, 0x1b, 0x7c, 0x20, 0xac, 0xb7, 0x59, 0x44, 0xeb, 0xdd, 0x8b, 0xf5, 0x06, 0x73,
+	0x59, 0x40, 0x23, 0x66, 0xcb, 0x59, 0x5b, 0x0d, 0x27, 0x6a, 0x76, 0x5e, 0xa8, 0x59, 0x5e, 0xbb,
+	0x4e, 0x83, 0x86, 0xe7, 0x07, 0xde, 0xe7, 0xc4, 0xc3, 0x9a, 0x65, 0xd7, 0xbb, 0x0f, 0xa6, 0x0c,
+	0x74, 0x9c, 0xdd, 0x8b, 0xb4, 0xe5, 0x37, 0x69, 0x3f, 0xb7, 0xab, 0x43, 0xb8, 0x05, 0xcc, 0xf7,
+	0xa4, 0xde, 0xe2, 0xd1, 0x89, 0xbc, 0x60, 0x4f, 0x7b, 0x94, 0x6c, 0x1e, 0x1e, 0xc2, 0x46, 0xb2,
+	0x60, 0x5d, 0xe6, 0x46, 0xa1, 0xfc, 0x13, 0x4f, 0x25, 0xef, 0x23, 0x38, 0x74, 0x39, 0x85, 0xfa,
+	0xc9, 0x0e, 0x0b, 0xf6, 0x30, 0x86, 0x69, 0x97, 0xb6, 0x99, 0x81, 0x96, 0xd1, 0xca, 0x9c, 0x29,
+	0x9e, 0xb1, 0x01, 0xb3, 0x01, 0xdb, 0x09, 0x58, 0xd8, 0x34, 0x4a, 0x62, 0x58, 0x91, 0xb8, 0x0a,
+	0x15, 0x2e, 0x90, 0x59, 0x51, 0x68, 0x4c, 0x2d, 0x4f, 0xad, 0xcc, 0x99, 0x09, 0x8d, 0x57, 0x60,
+	0x31, 0x60, 0xa1, 0xd7, 0x09, 0x2c, 0xf6, 0x1c, 0x0b, 0x42, 0xc7, 0x73, 0x8d, 0x69, 0x31, 0xbb,
+	0x77, 0x98, 0x73, 0x09, 0x59, 0x8b, 0x59, 0x91, 0x17, 0x18, 0x65, 0xf1, 0x49, 0x42, 0x73, 0x3c,
+	0x5c, 0x67, 0x63, 0x26, 0xc6, 0xc3, 0x9f, 0x31, 0x81, 0x03, 0xd4, 0xf7, 0x6f, 0xd2, 0x36, 0x0b,
+	0x7d, 0x6a, 0x31, 0x63, 0x56, 0xbc, 0xcb, 0x8c, 0x71, 0xcc, 0x12, 0x89, 0x51, 0x11, 0xc0, 0x14,
+	0x49, 0x36, 0x61, 0xee, 0xa6, 0x67, 0xb3, 0x7c, 0x75, 0x7b, 0xd9, 0x97, 0xfa, 0xd9, 0x93, 0x77,
+	0x10, 0x1c, 0x35, 0x59, 0xd7, 0xe1, 0xf8, 0x6f, 0xb0, 0x88, 0xda, 0x34, 0xa2, 0xbd, 0x1c, 0x4b,
+	0x09, 0xc7, 0x2a, 0x54, 0x02, 0xf9, 0xb1, 0x51, 0x12, 0xe3, 0x09, 0xdd, 0x27, 0x6d, 0xaa, 0x58,
+	0x99, 0xd8, 0x84, 0x8a, 0xc4, 0xcb, 0x30, 0x1f, 0xdb, 0xf2, 0xba, 0x6b, 0xb3, 0xcf, 0x0b, 0xeb,
+	0x95, 0x4d, 0x7d, 0x08, 0x2f, 0xc1, 0x5c, 0x37, 0xb6, 0xf3, 0x75, 0x5b, 0x58, 0xb1, 0x6c, 0xa6,
+	0x03, 0xe4, 0xaf, 0x08, 0x4e, 0x68, 0x3e, 0x60, 0xca, 0x95, 0xb9, 0x2a, 0xfc, 0x24, 0x5f, 0xa1,
+	0xf3, 0x70, 0x58, 0x2d, 0x62, 0xaf, 0x9d, 0xfa, 0x5f, 0x70, 0x15, 0xf5, 0x41, 0xa5, 0xa2, 0x3e,
+	0xc6, 0x15, 0x51, 0xf4, 0xb3, 0xd7, 0xaf, 0x48, 0x35, 0xf5, 0xa1, 0x3e, 0x43, 0x95, 0x8b, 0x0d,
+	0x35, 0x93, 0x31, 0x14, 0x79, 0xad, 0x04, 0xc7, 0x35, 0x45, 0xb7, 0xf7, 0x5c, 0xcb, 0x64, 0x96,
+	0x17, 0xd8, 0x05, 0x5a, 0x8e, 0xe0, 0x08, 0xba, 0xc4, 0xa9, 0xec, 0xd2, 0x3c, 0x01, 0xe5, 0xd0,
+	0x71, 0x2d, 0x26, 0x74, 0x99, 0x5f, 0x5f, 0xad, 0xc5, 0x41, 0xa7, 0xa6, 0x07, 0x9d, 0x9a, 0xbf,
+	0xdb, 0xe0, 0x03, 0x61, 0x8d, 0x07, 0x9d, 0x5a, 0xf7, 0x62, 0xed, 0xb6, 0xd3, 0x66, 0x66, 0x3c,
+	0x91, 0x73, 0xe8, 0xb8, 0x91, 0xd3, 0x32, 0xca, 0xfb, 0xe7, 0x20, 0x26, 0xf2, 0xc5, 0x77, 0x5c,
+	0x27, 0x72, 0x28, 0xdf, 0x5a, 0xb1, 0x45, 0xd2, 0x01, 0xf2, 0x37, 0x04, 0x86, 0x66, 0x93, 0x1b,
+	0xd4, 0x75, 0x76, 0x58, 0x18, 0x8d, 0xea, 0xc7, 0x68, 0x82, 0x7e, 0xbc, 0x02, 0x8b, 0xf1, 0x4a,
+	0xdf, 0xe2, 0xe1, 0x8d, 0x87, 0x6a, 0xa3, 0xbc, 0x3c, 0xb5, 0x32, 0x65, 0xf6, 0x0e, 0x73, 0x95,
+	0x94, 0xcc, 0xd0, 0x98, 0x11, 0x5b, 0x3b, 0x1d, 0xe0, 0x12, 0x5c, 0x6f, 0x93, 0x5a, 0xcd, 0x38,
+	0x2a, 0x54, 0x4c, 0x45, 0x92, 0xfb, 0x61, 0xee, 0x29, 0xa7, 0xc5, 0x36, 0x9b, 0x1d, 0x77, 0x17,
+	0x1f, 0x81, 0xb2, 0xc5, 0x1f, 0x84, 0x76, 0x07, 0xcc, 0x98, 0x20, 0xaf, 0x21, 0xb8, 0x3f, 0xcf,
+	0x1e, 0x77, 0x9c, 0xa8, 0xc9, 0xe7, 0x87, 0x79, 0x86, 0xb1, 0x9a, 0xcc, 0xda, 0x0d, 0x3b, 0x6d,
+	0xb5, 0xc1, 0x15, 0x3d, 0x9e, 0x61, 0xc8, 0xdb, 0x08, 0x56, 0x86, 0x62, 0xba, 0x13, 0x50, 0xdf,
+	0x67, 0x01, 0x7e, 0x0a, 0xca, 0x77, 0xf9, 0x0b, 0x11, 0xce, 0xe6, 0xd7, 0x6b, 0x35, 0xfd, 0x94,
+	0x1c, 0xca, 0xe5, 0xe9, 0xff, 0x31, 0xe3, 0xe9, 0xb8, 0xa6, 0xcc, 0x53, 0x12, 0x7c, 0x8e, 0x65,
+	0xf8, 0x24, 0x56, 0xe4, 0xdf, 0x8b, 0xcf, 0x9e, 0x9c, 0x81, 0x69, 0x9f, 0x06, 0x11, 0x39, 0x0a,
+	0xf7, 0x64, 0x83, 0x89, 0xef, 0xb9, 0x21, 0x23, 0xbf, 0xc8, 0xfa, 0xd9, 0x66, 0xc0, 0x68, 0xc4,
+	0x4c, 0x76, 0xb7, 0xc3, 0xc2, 0x08, 0xef, 0x82, 0x7e, 0x70, 0x0b, 0xab, 0xce, 0xaf, 0x5f, 0xaf,
+	0xa5, 0xc7, 0x5a, 0x4d, 0x1d, 0x6b, 0xe2, 0xe1, 0x33, 0x96, 0x5d, 0xeb, 0x3e, 0x98, 0x7a, 0xbd,
+	0x8e, 0x4c, 0x9d, 0xb5, 0xba, 0xaa, 0xa6, 0xce, 0x1d, 0x1f, 0x83, 0x99, 0x8e, 0x1f, 0xb2, 0x20,
+	0x12, 0x9a, 0x55, 0x4c, 0x49, 0xf1, 0xf5, 0xeb, 0xd2, 0x96, 0x63, 0xd3, 0x28, 0x5e, 0x9f, 0x8a,
+	0x99, 0xd0, 0xe4, 0x97, 0x59, 0xf4, 0xcf, 0xfa, 0xf6, 0x07, 0x85, 0x5e, 0x47, 0x59, 0xca, 0xa2,
+	0xcc, 0x8f, 0x43, 0xe4, 0xa7, 0x59, 0xfc, 0x57, 0x58, 0x8b, 0xa5, 0xf8, 0x07, 0x39, 0xb3, 0x01,
+	0xb3, 0x16, 0x0d, 0x2d, 0x6a, 0x2b, 0x29, 0x8a, 0xe4, 0x61, 0xdf, 0x0f, 0x3c, 0x9f, 0x36, 0x04,
+	0xa7, 0x5b, 0x5e, 0xcb, 0xb1, 0xf6, 0xa4, 0xb8, 0xfe, 0x17, 0x7d, 0x8e, 0x3f, 0x5d, 0xec, 0xf8,
+	0xe5, 0x2c, 0xec, 0x93, 0x30, 0xcf, 0x83, 0xf4, 0x33, 0x7e, 0xbc, 0xed, 0x8f, 0x40, 0xd9, 0x89,
+	0x58, 0x3b, 0x34, 0x90, 0xd8, 0xf2, 0x31, 0x41, 0xfe, 0x51, 0x86, 0x63, 0x7d, 0x51, 0x3d, 0x5f,
+	0xb3, 0xa2, 0xf8, 0x75, 0x0c, 0x66, 0xec, 0x60, 0xcf, 0xec, 0xb8, 0xd2, 0x01, 0x24, 0xc5, 0x05,
+	0xfb, 0x41, 0xc7, 0x8d, 0xe1, 0x57, 0xcc, 0x98, 0xc0, 0x3b, 0x50, 0x09, 0x23, 0x9e, 0xce, 0x35,
+	0xf6, 0x64, 0x74, 0xfe, 0xf8, 0x78, 0x8b, 0xce, 0xa1, 0x6f, 0x4b, 0x8e, 0x66, 0xc2, 0x1b, 0xdf,
+	0xe5, 0xd1, 0x2e, 0x0e, 0x81, 0xa1, 0x31, 0xbb, 0x3c, 0xb5, 0x32, 0xbf, 0xbe, 0x3d, 0xbe, 0xa0,
+	0x67, 0x7c, 0x16, 0xc4, 0xfe, 0x25, 0x79, 0x9b, 0xa9, 0x14, 0x1e, 0x60, 0xdb, 0x32, 0x3e, 0x84,
+	0x32, 0x77, 0x4a, 0x07, 0xf0, 0xa7, 0xa0, 0xec, 0xb8, 0x3b, 0x5e, 0x68, 0xcc, 0x09, 0x30, 0x4f,
+	0x8e, 0x07, 0xe6, 0xba, 0xbb, 0xe3, 0x99, 0x31, 0x43, 0x7c, 0x17, 0x16, 0x02, 0x16, 0x05, 0x7b,
+	0xca, 0x0a, 0x06, 0x08, 0xbb, 0x7e, 0x62, 0x3c, 0x09, 0xa6, 0xce, 0xd2, 0xcc, 0x4a, 0xc0, 0x1b,
+	0x30, 0x1f, 0xa6, 0x3e, 0x66, 0xcc, 0x0b, 0x81, 0x46, 0x86, 0x91, 0xe6, 0x83, 0xa6, 0xfe, 0x71,
+	0x9f, 0x77, 0x1f, 0x28, 0xf6, 0xee, 0x85, 0xa1, 0xe7, 0xdd, 0xc1, 0x11, 0xce, 0xbb, 0xc5, 0x9e,
+	0xf3, 0x8e, 0xbc, 0x87, 0x60, 0xa9, 0x2f, 0x38, 0x6d, 0xfb, 0xac, 0x70, 0x1b, 0x50, 0x98, 0x0e,
+	0x7d, 0x66, 0x89, 0x93, 0x6a, 0x7e, 0xfd, 0xc6, 0xc4, 0xa2, 0x95, 0x90, 0x2b, 0x58, 0x17, 0x05,
+	0xd4, 0x31, 0xe3, 0xc2, 0x77, 0x11, 0xfc, 0xaf, 0x26, 0xf3, 0x16, 0x8d, 0xac, 0x66, 0x91, 0xb2,
+	0x7c, 0xff, 0xf2, 0x6f, 0xe4, 0xb9, 0x1c, 0x13, 0xdc, 0xaa, 0xe2, 0xe1, 0xf6, 0x9e, 0xcf, 0x01,
+	0xf2, 0x37, 0xe9, 0xc0, 0x98, 0xa9, 0xe6, 0x8f, 0x10, 0x54, 0xf5, 0x18, 0xee, 0xb5, 0x5a, 0x2f,
+	0x50, 0x6b, 0xb7, 0x08, 0xe4, 0x41, 0x28, 0x39, 0xb6, 0x40, 0x38, 0x65, 0x96, 0x1c, 0x7b, 0x9f,
+	0xc1, 0xa8, 0x17, 0xee, 0x4c, 0x31, 0xdc, 0xd9, 0x2c, 0xdc, 0xf7, 0x7b, 0xe0, 0xaa, 0x90, 0x50,
+	0x00, 0x77, 0x09, 0xe6, 0xdc, 0x9e, 0xac, 0x38, 0x1d, 0x18, 0x90, 0xee, 0x97, 0xfa, 0xd2, 0x7d,
+	0x03, 0x66, 0xbb, 0xc9, 0xa5, 0x90, 0xbf, 0x56, 0x24, 0x57, 0xb1, 0x11, 0x78, 0x1d, 0x5f, 0x1a,
+	0x3d, 0x26, 0x38, 0x8a, 0x5d, 0xc7, 0xe5, 0x17, 0x18, 0x81, 0x82, 0x3f, 0xef, 0xff, 0x1a, 0x98,
+	0x51, 0xfb, 0xc7, 0x25, 0xf8, 0xbf, 0x01, 0x6a, 0x0f, 0xf5, 0xa7, 0x0f, 0x87, 0xee, 0x89, 0x57,
+	0xcf, 0xe6, 0x7a, 0x75, 0x65, 0x98, 0x57, 0xcf, 0x15, 0xdb, 0x0b, 0xb2, 0xf6, 0xfa, 0x61, 0x09,
+	0x96, 0x07, 0xd8, 0x6b, 0x78, 0x3a, 0xf1, 0xa1, 0x31, 0xd8, 0x8e, 0x17, 0x58, 0xea, 0x5a, 0x10,
+	0x13, 0x7c, 0x9f, 0x79, 0x81, 0xdf, 0xa4, 0xae, 0xf0, 0x8e, 0x8a, 0x29, 0xa9, 0x31, 0x4d, 0x75,
+	0x05, 0x0c, 0x65, 0x9e, 0xcb, 0x56, 0x1c, 0xa4, 0x02, 0xda, 0x66, 0x11, 0x0b, 0xc2, 0xbc, 0x10,
+	0xd5, 0xa5, 0xad, 0x0e, 0x53, 0x21, 0x4a, 0x10, 0xe4, 0xd5, 0x52, 0x2f, 0x1b, 0xb3, 0xe3, 0x7e,
+	0xf8, 0x0d, 0x7d, 0x0c, 0x66, 0xa8, 0x40, 0x2b, 0x5d, 0x53, 0x52, 0x7d, 0x26, 0xad, 0x14, 0x9b,
+	0x74, 0x2e, 0x63, 0xd2, 0x8d, 0x92, 0x81, 0xc8, 0x7b, 0x25, 0xa8, 0xe6, 0x19, 0xe4, 0xb9, 0xf5,
+	0xff, 0x36, 0x93, 0x60, 0x0a, 0x46, 0x90, 0xe3, 0x65, 0x06, 0x88, 0xe4, 0xec, 0x74, 0xe6, 0xc4,
+	0xce, 0x73, 0x49, 0x33, 0x97, 0x0d, 0xf9, 0x32, 0x82, 0xe3, 0xd9, 0x69, 0xe1, 0x96, 0x13, 0x46,
+	0xea, 0x62, 0x87, 0x77, 0x60, 0x36, 0x56, 0x25, 0x4e, 0xcb, 0xe7, 0xd7, 0xb7, 0xc6, 0x4d, 0xd6,
+	0x32, 0xab, 0xab, 0x98, 0x93, 0x87, 0x33, 0xb5, 0x9b, 0xf4, 0x84, 0x92, 0x30, 0xaa, 0x50, 0x51,
+	0x09, 0xaa, 0x5c, 0xfd, 0x84, 0x26, 0x6f, 0x4e, 0x67, 0xd3, 0x05, 0xcf, 0xde, 0xf2, 0x1a, 0x05,
+	0x35, 0x9f, 0x62, 0x8f, 0xe1, 0xab, 0xe1, 0xd9, 0x5a, 0x11, 0x4b, 0x91, 0x7c, 0x9e, 0xe5, 0xb9,
+	0x11, 0x75, 0x5c, 0x16, 0xc8, 0x8c, 0x26, 0x1d, 0xe0, 0x2b, 0x2d, 0x4a, 0x3a, 0xdb, 0xcc, 0xf2,
+	0x5c, 0x3b, 0x14, 0x2e, 0x33, 0x65, 0x66, 0xc6, 0xf0, 0xd3, 0x30, 0x27, 0x68, 0x5e, 0xbf, 0x31,
+	0x66, 0xf6, 0x5d, 0xf1, 0x49, 0x27, 0x73, 0x2c, 0x11, 0x75, 0x5a, 0x5b, 0x8e, 0x2b, 0x2e, 0x0d,
+	0x5c, 0x54, 0x3a, 0xc0, 0xbd, 0x71, 0xc7, 0x6b, 0xb5, 0xbc, 0x17, 0x55, 0xcc, 0x8b, 0x29, 0x3e,
+	0x4b, 0x14, 0x8d, 0x84, 0xfc, 0xd8, 0xd7, 0xd2, 0x01, 0x31, 0xcb, 0x69, 0x45, 0x2c, 0x90, 0xc1,
+	0x4e, 0x52, 0x89, 0xbf, 0xcf, 0x8b, 0xd1, 0x24, 0xd6, 0xc6, 0x3b, 0xe3, 0x80, 0xbe, 0x33, 0x7a,
+	0x77, 0xdb, 0xc2, 0x80, 0x2a, 0xa0, 0xa8, 0x27, 0xb3, 0xae, 0xe3, 0x75, 0x78, 0x3e, 0x2c, 0xd2,
+	0x46, 0x45, 0xf7, 0xed, 0x96, 0xc5, 0xe2, 0xdd, 0x72, 0x28, 0xbb, 0x5b, 0xc4, 0xad, 0x26, 0xb2,
+	0x9a, 0x9b, 0x34, 0x64, 0xc6, 0x61, 0xc1, 0x3a, 0x1d, 0x20, 0xbf, 0x42, 0x50, 0xd9, 0xf2, 0x1a,
+	0x57, 0xdd, 0x28, 0xd8, 0xe3, 0x4c, 0xf8, 0xca, 0x31, 0x57, 0x79, 0x93, 0x22, 0xf9, 0x12, 0x45,
+	0x4e, 0x9b, 0x6d, 0x47, 0xb4, 0xed, 0xcb, 0xec, 0x79, 0x5f, 0x4b, 0x94, 0x4c, 0xe6, 0x66, 0x6b,
+	0xd1, 0x30, 0x12, 0x21, 0xa7, 0x62, 0x8a, 0x67, 0xae, 0x60, 0xf2, 0xc1, 0x76, 0x14, 0xc8, 0x78,
+	0x93, 0x19, 0xd3, 0x1d, 0xb0, 0x1c, 0x63, 0x93, 0x24, 0x69, 0xc3, 0xbd, 0xc9, 0xb5, 0xee, 0x36,
+	0x0b, 0xda, 0x8e, 0x4b, 0x8b, 0xcf, 0xe5, 0xb1, 0xaa, 0x9b, 0xc4, 0xeb, 0x2b, 0xa7, 0xde, 0x71,
+	0x5c, 0xdb, 0x7b, 0xf1, 0xdf, 0x55, 0x4e, 0x25, 0x7f, 0xcc, 0x56, 0xaa, 0x35, 0x89, 0x49, 0x1c,
+	0x78, 0x1a, 0x16, 0x78, 0xc4, 0xe8, 0x32, 0xf9, 0x42, 0x06, 0x25, 0x92, 0x57, 0x06, 0x4b, 0x79,
+	0x98, 0xd9, 0x89, 0x78, 0x0b, 0x16, 0x69, 0x18, 0x3a, 0x0d, 0x97, 0xd9, 0x8a, 0x57, 0x69, 0x64,
+	0x5e, 0xbd, 0x53, 0xe3, 0x82, 0x8a, 0xf8, 0x42, 0xae, 0xb7, 0x22, 0xc9, 0x97, 0x10, 0x1c, 0x1d,
+	0xc8, 0x24, 0xd9, 0x57, 0x48, 0x3b, 0x47, 0x78, 0x9f, 0xc4, 0x6a, 0x32, 0xbb, 0xd3, 0x52, 0xa9,
+	0x42, 0x42, 0xf3, 0x77, 0x76, 0x27, 0x5e, 0x7d, 0x79, 0x8e, 0x25, 0x34, 0x3e, 0x01, 0xd0, 0xa6,
+	0x6e, 0x87, 0xb6, 0x04, 0x84, 0x69, 0x01, 0x41, 0x1b, 0x21, 0x4b, 0x50, 0x1d, 0xe4, 0x3a, 0xb2,
+	0x7a, 0xf7, 0x77, 0x04, 0x07, 0x55, 0xc8, 0x95, 0xab, 0xbb, 0x02, 0x8b, 0x9a, 0x19, 0x6e, 0xa6,
+	0x0b, 0xdd, 0x3b, 0x3c, 0x24, 0x9c, 0x2a, 0x2f, 0x99, 0xca, 0x36, 0x9b, 0xba, 0x99, 0x76, 0xd1,
+	0xc8, 0x07, 0x2e, 0x9a, 0xd0, 0xcd, 0xe0, 0x0b, 0x60, 0xdc, 0xa0, 0x2e, 0x6d, 0x30, 0x3b, 0x51,
+	0x3b, 0x71, 0xb1, 0xcf, 0xea, 0x65, 0xa8, 0xb1, 0x8b, 0x3e, 0x49, 0x12, 0xed, 0xec, 0xec, 0xa8,
+	0x92, 0xd6, 0xeb, 0xa5, 0xac, 0x9f, 0x8b, 0xfe, 0xdd, 0xb6, 0x63, 0x8b, 0x8f, 0x62, 0xf3, 0x1b,
+	0x30, 0x2b, 0x55, 0x51, 0x01, 0x4a, 0x92, 0x63, 0x76, 0x2c, 0x7c, 0x58, 0x68, 0x39, 0x5d, 0x96,
+	0x68, 0x6d, 0x4c, 0x4f, 0x5c, 0xc9, 0xac, 0x00, 0xee, 0x48, 0x11, 0x0d, 0x1a, 0x2c, 0xba, 0x91,
+	0x54, 0x9c, 0xca, 0xa2, 0xc4, 0xd1, 0x3b, 0x4c, 0xbe, 0x9f, 0xad, 0xcd, 0x67, 0xcd, 0xf2, 0x9f,
+	0x5b, 0x1e, 0x91, 0x6b, 0x78, 0xb6, 0xb3, 0xe3, 0xb0, 0xf8, 0xbe, 0x5e, 0x31, 0x13, 0x9a, 0x04,
+	0x50, 0xd9, 0x72, 0xdc, 0x5d, 0x5e, 0xd4, 0xe2, 0xce, 0x1a, 0x39, 0x51, 0x4b, 0xad, 0x50, 0x4c,
+	0xe0, 0x43, 0x30, 0xd5, 0x09, 0x5a, 0x72, 0xf3, 0xf2, 0x47, 0xde, 0xf7, 0xb2, 0x59, 0x68, 0x05,
+	0x8e, 0x2f, 0xb7, 0xae, 0xe8, 0x7b, 0x69, 0x43, 0xa2, 0x87, 0x63, 0x79, 0xee, 0x66, 0x8b, 0x86,
+	0xa1, 0xca, 0x2c, 0x92, 0x01, 0xf2, 0x28, 0x2c, 0x70, 0x99, 0xa9, 0x87, 0x9e, 0xcb, 0x9a, 0xe0,
+	0x68, 0x46, 0x35, 0x05, 0x4f, 0x39, 0x1b, 0x85, 0x7b, 0x78, 0x42, 0x77, 0xd9, 0xf7, 0x25, 0x93,
+	0x11, 0x6f, 0x17, 0x53, 0x83, 0x12, 0xa3, 0x81, 0x0d, 0x8c, 0xf5, 0xb7, 0xcf, 0x02, 0xee, 0x59,
+	0x38, 0xc7, 0x62, 0xf8, 0xeb, 0x08, 0xa6, 0xb9, 0x68, 0x7c, 0x5f, 0x5e, 0x44, 0x15, 0xbe, 0x5e,
+	0x9d, 0x5c, 0x75, 0x8a, 0x4b, 0x23, 0x4b, 0xaf, 0xfc, 0xe9, 0x2f, 0xdf, 0x28, 0x1d, 0xc3, 0x47,
+	0xc4, 0x2f, 0x03, 0xba, 0x17, 0xf5, 0x5e, 0x7d, 0x88, 0xbf, 0x88, 0x00, 0xcb, 0x04, 0x57, 0x6b,
+	0x83, 0xe2, 0x73, 0x79, 0x10, 0x07, 0xb4, 0x4b, 0xab, 0x87, 0x6b, 0xb2, 0xc9, 0x2e, 0x06, 0x85,
+	0xd0, 0x55, 0x21, 0xf4, 0x14, 0x26, 0x83, 0x84, 0xd6, 0x5f, 0xe2, 0x56, 0x7c, 0x59, 0xb6, 0xe6,
+	0xf1, 0x3b, 0x08, 0x16, 0xf9, 0x24, 0xad, 0x41, 0x89, 0x57, 0x8a, 0x0e, 0x1d, 0xbd, 0x8b, 0x59,
+	0xbd, 0x33, 0xb9, 0x5a, 0x5e, 0xc2, 0x5a, 0xa8, 0x50, 0x17, 0x2a, 0x9c, 0xc5, 0x67, 0x8a, 0x54,
+	0xe0, 0xe5, 0xd1, 0x40, 0x62, 0x7e, 0x03, 0x41, 0xf9, 0x8e, 0x28, 0x4a, 0x0c, 0x59, 0xe0, 0xed,
+	0x89, 0x41, 0x16, 0xe2, 0x84, 0xd5, 0xc9, 0x49, 0x01, 0xf7, 0x3e, 0x7c, 0x5c, 0xc1, 0x0d, 0xa3,
+	0x80, 0xd1, 0x76, 0x06, 0xf5, 0x05, 0x84, 0xdf, 0x42, 0x30, 0x13, 0x77, 0xa3, 0xf0, 0xe9, 0x3c,
+	0x94, 0x99, 0x6e, 0x55, 0x75, 0x72, 0xad, 0x1d, 0x72, 0x56, 0x60, 0x3c, 0x49, 0x06, 0xba, 0xe2,
+	0x46, 0xa6, 0xf1, 0xf3, 0x3a, 0x82, 0xa9, 0x6b, 0x6c, 0xe8, 0x5e, 0x99, 0x20, 0xb8, 0x3e, 0x03,
+	0x0e, 0x58, 0x6f, 0xfc, 0x26, 0x82, 0x7b, 0xaf, 0xb1, 0x68, 0x70, 0x56, 0x56, 0xec, 0xb5, 0x7a,
+	0xb2, 0x58, 0x3d, 0x37, 0xc2, 0x97, 0x49, 0x3a, 0x32, 0xb2, 0x27, 0xbe, 0x28, 0x71, 0xfc, 0x0e,
+	0xc1, 0xa1, 0xde, 0x9f, 0x6a, 0x60, 0xd2, 0x73, 0x35, 0x1e, 0xf0, 0x4b, 0x8e, 0xea, 0xcd, 0x71,
+	0x4f, 0x8f, 0x2c, 0x53, 0x72, 0x59, 0x20, 0x7f, 0x04, 0x3f, 0x5c, 0x84, 0x3c, 0x29, 0xed, 0xd7,
+	0x5f, 0x52, 0x8f, 0x2f, 0xd7, 0xdb, 0x92, 0x05, 0xfe, 0x3d, 0x82, 0x23, 0x8a, 0xef, 0x66, 0x93,
+	0x06, 0xd1, 0x15, 0xc6, 0x2f, 0x76, 0xe1, 0x48, 0xfa, 0x8c, 0x79, 0x1a, 0xea, 0xf2, 0xc8, 0x55,
+	0xa1, 0xcb, 0xe3, 0xf8, 0xb1, 0x7d, 0xeb, 0x62, 0x71, 0x36, 0xb6, 0x84, 0xfd, 0x0e, 0x82, 0x83,
+	0xd7, 0x58, 0xf4, 0xcc, 0xe6, 0xf5, 0x7d, 0xad, 0xcc, 0x98, 0x8e, 0xae, 0x89, 0x23, 0x57, 0x84,
+	0x22, 0x1f, 0xc3, 0x8f, 0xee, 0x5b, 0x11, 0xcf, 0x72, 0x92, 0x75, 0x79, 0x05, 0xc1, 0x81, 0x6b,
+	0x5a, 0xba, 0x92, 0x1f, 0x4e, 0x32, 0xad, 0xf7, 0xea, 0x52, 0x4d, 0xfb, 0x41, 0x97, 0x7a, 0x95,
+	0xb8, 0xfa, 0x9a, 0xc0, 0x76, 0x06, 0x9f, 0x2e, 0xc2, 0x96, 0xb6, 0xe6, 0xde, 0x40, 0x70, 0x54,
+	0x07, 0x91, 0xfe, 0x64, 0xe1, 0xff, 0xf7, 0xf7, 0x43, 0x00, 0xf9, 0x73, 0x82, 0x21, 0xe8, 0xd6,
+	0x05, 0xba, 0xf3, 0x64, 0xf0, 0x46, 0x6c, 0xf7, 0xa1, 0xd8, 0x40, 0xab, 0x2b, 0x08, 0xff, 0x1a,
+	0xc1, 0x4c, 0xdc, 0xa5, 0xca, 0xb7, 0x51, 0xa6, 0xc5, 0x3e, 0xc9, 0xa8, 0x26, 0xbd, 0xb6, 0x7a,
+	0x61, 0xb0, 0x41, 0xf5, 0xf9, 0x6a, 0x69, 0x6b, 0xc2, 0xca, 0xd9, 0x70, 0xfc, 0x33, 0x04, 0x90,
+	0x76, 0xda, 0xf0, 0xd9, 0x62, 0x3d, 0xb4, 0x6e, 0x5c, 0x75, 0xb2, 0xbd, 0x36, 0x52, 0x13, 0xfa,
+	0xac, 0x54, 0x97, 0x0b, 0x63, 0xa1, 0xcf, 0xac, 0x8d, 0xb8, 0x2b, 0xf7, 0x3d, 0x04, 0x65, 0xd1,
+	0xe0, 0xc0, 0xa7, 0xf2, 0x30, 0xeb, 0xfd, 0x8f, 0x49, 0x9a, 0xfe, 0x01, 0x01, 0x75, 0x79, 0xbd,
+	0xe8, 0x40, 0xd9, 0x40, 0xab, 0xb8, 0x0b, 0x33, 0x71, 0x4b, 0x21, 0xdf, 0x3d, 0x32, 0x2d, 0x87,
+	0xea, 0x72, 0x41, 0x72, 0x16, 0x3b, 0xaa, 0x3c, 0xcb, 0x56, 0x87, 0x9d, 0x65, 0xd3, 0xfc, 0xb8,
+	0xc1, 0x27, 0x8b, 0x93, 0xad, 0x89, 0x1b, 0xe6, 0x9c, 0x40, 0x77, 0x9a, 0x2c, 0x0f, 0x3b, 0xcf,
+	0xb8, 0x75, 0xbe, 0x89, 0xe0, 0x50, 0xef, 0xdd, 0x14, 0x1f, 0x1f, 0x58, 0xe6, 0x95, 0x67, 0x6b,
+	0xd6, 0x8a, 0x79, 0xf7, 0x5a, 0xf2, 0x84, 0x40, 0xb1, 0x81, 0x1f, 0x1a, 0xba, 0x33, 0x6e, 0xaa,
+	0xa8, 0xc3, 0x19, 0xad, 0xa5, 0x3f, 0x1b, 0xf8, 0x01, 0x82, 0x83, 0xd9, 0x5b, 0x59, 0x7e, 0xde,
+	0x3c, 0xe0, 0x52, 0x5b, 0xad, 0x8d, 0xf6, 0x71, 0x82, 0xf8, 0xa3, 0x02, 0xf1, 0x45, 0x5c, 0xcf,
+	0x45, 0x1c, 0x23, 0x8d, 0x7f, 0x00, 0xbb, 0x16, 0x3a, 0x36, 0x5b, 0xb3, 0x39, 0xaa, 0x9f, 0x23,
+	0x38, 0xa0, 0x0c, 0x70, 0x3b, 0x60, 0xac, 0xd8, 0x7e, 0x93, 0xdb, 0xb1, 0x5c, 0x16, 0x79, 0x54,
+	0xa0, 0xfe, 0x08, 0xbe, 0x34, 0xa2, 0x9d, 0x95, 0x7d, 0xd7, 0x22, 0x8e, 0xf4, 0x37, 0x08, 0x0e,
+	0xdf, 0x89, 0x37, 0xe8, 0x07, 0x84, 0x7f, 0x53, 0xe0, 0x7f, 0x0c, 0x3f, 0x52, 0x90, 0x58, 0x0f,
+	0x53, 0xe3, 0x02, 0xc2, 0x3f, 0x41, 0x50, 0x51, 0x7d, 0x71, 0x7c, 0x26, 0x77, 0x07, 0x67, 0x3b,
+	0xe7, 0x93, 0xdc, 0x75, 0x32, 0x8b, 0x24, 0xa7, 0x0a, 0x8f, 0x7d, 0x29, 0x9f, 0xef, 0xbc, 0xd7,
+	0x11, 0xe0, 0xa4, 0x36, 0x96, 0x54, 0xcb, 0xf0, 0x03, 0x19, 0x51, 0xb9, 0x05, 0xd8, 0xea, 0x99,
+	0xa1, 0xdf, 0x65, 0xcf, 0xfc, 0xd5, 0xc2, 0x33, 0xdf, 0x4b, 0xe4, 0xbf, 0x8a, 0x60, 0xfe, 0x1a,
+	0x4b, 0x2e, 0xac, 0x05, 0xb6, 0xcc, 0xb6, 0xf5, 0xab, 0x2b, 0xc3, 0x3f, 0x94, 0x88, 0xce, 0x0b,
+	0x44, 0x0f, 0xe0, 0x62, 0x53, 0x29, 0x00, 0xdf, 0x42, 0xb0, 0x70, 0x4b, 0x77, 0x51, 0x7c, 0x7e,
+	0x98, 0xa4, 0xcc, 0x91, 0x33, 0x3a, 0xae, 0x07, 0x05, 0xae, 0x35, 0x32, 0x12, 0xae, 0x0d, 0xd9,
+	0x21, 0xff, 0x0e, 0x8a, 0x2b, 0x1e, 0x3d, 0x5d, 0xad, 0x7f, 0xd5, 0x6e, 0x05, 0xcd, 0x31, 0x72,
+	0x49, 0xe0, 0xab, 0xe1, 0xf3, 0xa3, 0xe0, 0xab, 0xcb, 0x56, 0x17, 0xfe, 0x36, 0x82, 0xc3, 0xa2,
+	0xad, 0xa9, 0x33, 0xc6, 0x45, 0x9d, 0xbc, 0xb4, 0x09, 0x3a, 0xc2, 0x59, 0xf8, 0x78, 0x1c, 0x7f,
+	0xc8, 0xbe, 0x40, 0x6d, 0xc8, 0x86, 0xe5, 0x57, 0x4a, 0x88, 0xaf, 0xef, 0x3d, 0x7d, 0xf8, 0x9e,
+	0x5b, 0xef, 0x31, 0x60, 0x7e, 0x9b, 0x76, 0x04, 0x8c, 0x1b, 0x02, 0xe3, 0x25, 0x52, 0xdf, 0x0f,
+	0xc6, 0x7a, 0x77, 0x9d, 0x6f, 0xd3, 0xaf, 0x21, 0x38, 0xa8, 0xf2, 0x03, 0xe9, 0x7f, 0x6b, 0xc3,
+	0x96, 0x76, 0xbf, 0xf9, 0x84, 0xdc, 0x10, 0xab, 0xa3, 0x6d, 0x88, 0xb7, 0x10, 0xcc, 0xca, 0xae,
+	0x63, 0x41, 0xd6, 0xa5, 0xb5, 0x25, 0xab, 0x3d, 0x25, 0x3b, 0xd9, 0x96, 0x22, 0x9f, 0x16, 0x62,
+	0x9f, 0xc5, 0x85, 0x66, 0xf1, 0x3d, 0x3b, 0xac, 0xbf, 0x24, 0x7b, 0x42, 0x2f, 0xd7, 0x5b, 0x5e,
+	0x23, 0x7c, 0x9e, 0xe0, 0xc2, 0xdc, 0x82, 0x7f, 0x73, 0x01, 0xe1, 0x08, 0xe6, 0xb8, 0xfb, 0x8a,
+	0x3a, 0x20, 0xce, 0x1a, 0x61, 0x40, 0x89, 0xb0, 0x5a, 0xed, 0xab, 0x2b, 0xa6, 0xc9, 0x84, 0xac,
+	0x6c, 0xe0, 0xfb, 0x0b, 0xc5, 0x0a, 0x41, 0x5f, 0x45, 0x70, 0x58, 0xdf, 0x8f, 0xb1, 0xf8, 0x91,
+	0x77, 0x63, 0x11, 0x0a, 0x79, 0x3f, 0xc1, 0xab, 0x23, 0xb9, 0x91, 0x80, 0xf3, 0xe4, 0x53, 0xbf,
+	0x7d, 0xf7, 0x04, 0xfa, 0xc3, 0xbb, 0x27, 0xd0, 0x9f, 0xdf, 0x3d, 0x81, 0x9e, 0x7f, 0x68, 0xb4,
+	0x7f, 0xf8, 0xb1, 0x5a, 0x0e, 0x73, 0x23, 0x9d, 0xfd, 0x3f, 0x07, 0x00, 0x9f, 0x41, 0x65, 0x96,
+	0xb2, 0x34, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3382,6 +3479,8 @@ type ApplicationServiceClient interface {
 	List(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationList, error)
 	// ListResourceEvents returns a list of event resources
 	ListResourceEvents(ctx context.Context, in *ApplicationResourceEventsQuery, opts ...grpc.CallOption) (*events.EventList, error)
+	// ListSyncRecords returns the persisted outcome of the sync operations of an application
+	ListSyncRecords(ctx context.Context, in *ApplicationSyncRecordsQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSyncRecordList, error)
 	// Watch returns stream of application change events
 	Watch(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (ApplicationService_WatchClient, error)
 	// Create creates an application
@@ -3471,6 +3570,15 @@ func (c *applicationServiceClient) ListResourceEvents(ctx context.Context, in *A
 	return out, nil
 }
 
+func (c *applicationServiceClient) ListSyncRecords(ctx context.Context, in *ApplicationSyncRecordsQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSyncRecordList, error) {
+	out := new(v1alpha1.ApplicationSyncRecordList)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListSyncRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) Watch(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (ApplicationService_WatchClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[0], "/application.ApplicationService/Watch", opts...)
 	if err != nil {
@@ -3833,6 +3941,8 @@ type ApplicationServiceServer interface {
 	List(context.Context, *ApplicationQuery) (*v1alpha1.ApplicationList, error)
 	// ListResourceEvents returns a list of event resources
 	ListResourceEvents(context.Context, *ApplicationResourceEventsQuery) (*events.EventList, error)
+	// ListSyncRecords returns the persisted outcome of the sync operations of an application
+	ListSyncRecords(context.Context, *ApplicationSyncRecordsQuery) (*v1alpha1.ApplicationSyncRecordList, error)
 	// Watch returns stream of application change events
 	Watch(*ApplicationQuery, ApplicationService_WatchServer) error
 	// Create creates an application
@@ -3906,6 +4016,9 @@ func (*UnimplementedApplicationServiceServer) List(ctx context.Context, req *App
 func (*UnimplementedApplicationServiceServer) ListResourceEvents(ctx context.Context, req *ApplicationResourceEventsQuery) (*events.EventList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListResourceEvents not implemented")
 }
+func (*UnimplementedApplicationServiceServer) ListSyncRecords(ctx context.Context, req *ApplicationSyncRecordsQuery) (*v1alpha1.ApplicationSyncRecordList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSyncRecords not implemented")
+}
 func (*UnimplementedApplicationServiceServer) Watch(req *ApplicationQuery, srv ApplicationService_WatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
 }
@@ -4034,6 +4147,24 @@ func _ApplicationService_ListResourceEvents_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_ListSyncRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSyncRecordsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListSyncRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ListSyncRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListSyncRecords(ctx, req.(*ApplicationSyncRecordsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ApplicationQuery)
 	if err := stream.RecvMsg(m); err != nil {
@@ -4585,6 +4716,10 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListResourceEvents",
 			Handler:    _ApplicationService_ListResourceEvents_Handler,
 		},
+		{
+			MethodName: "ListSyncRecords",
+			Handler:    _ApplicationService_ListSyncRecords_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _ApplicationService_Create_Handler,
@@ -4979,6 +5114,87 @@ func (m *ApplicationResourceEventsQuery) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSyncRecordsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncRecordsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSyncRecordsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Initiator != nil {
+		i -= len(*m.Initiator)
+		copy(dAtA[i:], *m.Initiator)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Initiator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Until != nil {
+		{
+			size, err := m.Until.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Since != nil {
+		{
+			size, err := m.Since.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationManifestQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7569,6 +7785,42 @@ func (m *ApplicationResourceEventsQuery) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSyncRecordsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Until != nil {
+		l = m.Until.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Initiator != nil {
+		l = len(*m.Initiator)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ApplicationManifestQuery) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9604,6 +9856,266 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSyncRecordsQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncRecordsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncRecordsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Since == nil {
+				m.Since = &v1.Time{}
+			}
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Until == nil {
+				m.Until = &v1.Time{}
+			}
+			if err := m.Until.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Initiator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Initiator = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
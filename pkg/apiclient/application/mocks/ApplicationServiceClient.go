@@ -1254,6 +1254,93 @@ func (_c *ApplicationServiceClient_ListResourceLinks_Call) RunAndReturn(run func
 	return _c
 }
 
+// ListSyncRecords provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) ListSyncRecords(ctx context.Context, in *application.ApplicationSyncRecordsQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSyncRecordList, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSyncRecords")
+	}
+
+	var r0 *v1alpha1.ApplicationSyncRecordList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationSyncRecordsQuery, ...grpc.CallOption) (*v1alpha1.ApplicationSyncRecordList, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationSyncRecordsQuery, ...grpc.CallOption) *v1alpha1.ApplicationSyncRecordList); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ApplicationSyncRecordList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ApplicationSyncRecordsQuery, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ApplicationServiceClient_ListSyncRecords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSyncRecords'
+type ApplicationServiceClient_ListSyncRecords_Call struct {
+	*mock.Call
+}
+
+// ListSyncRecords is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *application.ApplicationSyncRecordsQuery
+//   - opts ...grpc.CallOption
+func (_e *ApplicationServiceClient_Expecter) ListSyncRecords(ctx any, in any, opts ...any) *ApplicationServiceClient_ListSyncRecords_Call {
+	return &ApplicationServiceClient_ListSyncRecords_Call{Call: _e.mock.On("ListSyncRecords",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ApplicationServiceClient_ListSyncRecords_Call) Run(run func(ctx context.Context, in *application.ApplicationSyncRecordsQuery, opts ...grpc.CallOption)) *ApplicationServiceClient_ListSyncRecords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *application.ApplicationSyncRecordsQuery
+		if args[1] != nil {
+			arg1 = args[1].(*application.ApplicationSyncRecordsQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceClient_ListSyncRecords_Call) Return(applicationSyncRecordList *v1alpha1.ApplicationSyncRecordList, err error) *ApplicationServiceClient_ListSyncRecords_Call {
+	_c.Call.Return(applicationSyncRecordList, err)
+	return _c
+}
+
+func (_c *ApplicationServiceClient_ListSyncRecords_Call) RunAndReturn(run func(ctx context.Context, in *application.ApplicationSyncRecordsQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSyncRecordList, error)) *ApplicationServiceClient_ListSyncRecords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ManagedResources provides a mock function for the type ApplicationServiceClient
 func (_mock *ApplicationServiceClient) ManagedResources(ctx context.Context, in *application.ResourcesQuery, opts ...grpc.CallOption) (*application.ManagedResourcesResponse, error) {
 	// grpc.CallOption
@@ -467,6 +467,72 @@ func (m *ApplicationSetTreeQuery) GetAppsetNamespace() string {
 	return ""
 }
 
+// ApplicationSetRolloutRequest is a request to pause, resume or promote the RollingSync rollout of an applicationset
+type ApplicationSetRolloutRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The application set namespace. Default empty is argocd control plane namespace
+	AppsetNamespace string `protobuf:"bytes,2,opt,name=appsetNamespace,proto3" json:"appsetNamespace,omitempty"`
+	// The step to promote, i.e. the last step the rollout proceeds past without waiting for its applications to be healthy. Default is the step being rolled out
+	Step                 int32    `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetRolloutRequest) Reset()         { *m = ApplicationSetRolloutRequest{} }
+func (m *ApplicationSetRolloutRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetRolloutRequest) ProtoMessage()    {}
+func (*ApplicationSetRolloutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{7}
+}
+func (m *ApplicationSetRolloutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetRolloutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetRolloutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutRequest.Merge(m, src)
+}
+func (m *ApplicationSetRolloutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutRequest proto.InternalMessageInfo
+
+func (m *ApplicationSetRolloutRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSetRolloutRequest) GetAppsetNamespace() string {
+	if m != nil {
+		return m.AppsetNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationSetRolloutRequest) GetStep() int32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
 // ApplicationSetGetQuery is a query for applicationset resources
 type ApplicationSetGenerateRequest struct {
 	// the applicationsets
@@ -480,7 +546,7 @@ func (m *ApplicationSetGenerateRequest) Reset()         { *m = ApplicationSetGen
 func (m *ApplicationSetGenerateRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSetGenerateRequest) ProtoMessage()    {}
 func (*ApplicationSetGenerateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eacb9df0ce5738fa, []int{7}
+	return fileDescriptor_eacb9df0ce5738fa, []int{8}
 }
 func (m *ApplicationSetGenerateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,7 +594,7 @@ func (m *ApplicationSetGenerateResponse) Reset()         { *m = ApplicationSetGe
 func (m *ApplicationSetGenerateResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSetGenerateResponse) ProtoMessage()    {}
 func (*ApplicationSetGenerateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eacb9df0ce5738fa, []int{8}
+	return fileDescriptor_eacb9df0ce5738fa, []int{9}
 }
 func (m *ApplicationSetGenerateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -572,6 +638,7 @@ func init() {
 	proto.RegisterType((*ApplicationSetCreateRequest)(nil), "applicationset.ApplicationSetCreateRequest")
 	proto.RegisterType((*ApplicationSetDeleteRequest)(nil), "applicationset.ApplicationSetDeleteRequest")
 	proto.RegisterType((*ApplicationSetTreeQuery)(nil), "applicationset.ApplicationSetTreeQuery")
+	proto.RegisterType((*ApplicationSetRolloutRequest)(nil), "applicationset.ApplicationSetRolloutRequest")
 	proto.RegisterType((*ApplicationSetGenerateRequest)(nil), "applicationset.ApplicationSetGenerateRequest")
 	proto.RegisterType((*ApplicationSetGenerateResponse)(nil), "applicationset.ApplicationSetGenerateResponse")
 }
@@ -581,57 +648,64 @@ func init() {
 }
 
 var fileDescriptor_eacb9df0ce5738fa = []byte{
-	// 792 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcd, 0x6e, 0x13, 0x3b,
-	0x14, 0xc7, 0xe5, 0xa6, 0xcd, 0x4d, 0xdd, 0xea, 0x5e, 0x5d, 0x4b, 0xb7, 0xcd, 0x1d, 0x20, 0x44,
-	0x23, 0xb5, 0x0d, 0x2d, 0xf1, 0xd0, 0x96, 0x0d, 0x65, 0xc5, 0x97, 0xaa, 0x4a, 0x15, 0x82, 0x09,
-	0x6a, 0x25, 0x58, 0x20, 0x77, 0x72, 0x94, 0x0e, 0x4d, 0x66, 0x06, 0xdb, 0x89, 0x54, 0x55, 0xb0,
-	0x40, 0x62, 0xcd, 0x02, 0xc1, 0x03, 0xc0, 0x86, 0x35, 0xb0, 0x62, 0xc3, 0x82, 0x0d, 0x4b, 0x24,
-	0x5e, 0x00, 0x55, 0x3c, 0x08, 0xb2, 0x67, 0xf2, 0x31, 0x26, 0x1f, 0x95, 0x08, 0xac, 0x12, 0x7b,
-	0xec, 0x73, 0x7e, 0x3e, 0xe7, 0xf8, 0xef, 0x83, 0x97, 0x05, 0xf0, 0x16, 0x70, 0x87, 0x45, 0x51,
-	0xdd, 0xf7, 0x98, 0xf4, 0xc3, 0x40, 0x80, 0x34, 0x86, 0x34, 0xe2, 0xa1, 0x0c, 0xc9, 0xdf, 0xe9,
-	0x59, 0xeb, 0x74, 0x2d, 0x0c, 0x6b, 0x75, 0x70, 0x58, 0xe4, 0x3b, 0x2c, 0x08, 0x42, 0x19, 0x7f,
-	0x89, 0x57, 0x5b, 0xdb, 0x35, 0x5f, 0xee, 0x37, 0xf7, 0xa8, 0x17, 0x36, 0x1c, 0xc6, 0x6b, 0x61,
-	0xc4, 0xc3, 0x07, 0xfa, 0x4f, 0xd9, 0xab, 0x3a, 0xad, 0x75, 0x27, 0x3a, 0xa8, 0xa9, 0x9d, 0xa2,
-	0xd7, 0x97, 0xd3, 0x5a, 0x65, 0xf5, 0x68, 0x9f, 0xad, 0x3a, 0x35, 0x08, 0x80, 0x33, 0x09, 0xd5,
-	0xc4, 0xda, 0xa5, 0x11, 0xd6, 0x92, 0x63, 0x40, 0x0b, 0x02, 0x29, 0x92, 0x9f, 0x78, 0xab, 0xbd,
-	0x83, 0xe7, 0xae, 0x74, 0x5d, 0x54, 0x40, 0x6e, 0x82, 0xbc, 0xdd, 0x04, 0x7e, 0x48, 0x08, 0x9e,
-	0x0c, 0x58, 0x03, 0xf2, 0xa8, 0x88, 0x4a, 0xd3, 0xae, 0xfe, 0x4f, 0x4a, 0xf8, 0x1f, 0x16, 0x45,
-	0x02, 0xe4, 0x4d, 0xd6, 0x00, 0x11, 0x31, 0x0f, 0xf2, 0x13, 0xfa, 0xb3, 0x39, 0x6d, 0x1f, 0xe1,
-	0xf9, 0xb4, 0xdd, 0x6d, 0x5f, 0x24, 0x86, 0x2d, 0x9c, 0x53, 0x80, 0xe0, 0x49, 0x91, 0x47, 0xc5,
-	0x4c, 0x69, 0xda, 0xed, 0x8c, 0xd5, 0x37, 0x01, 0x75, 0xf0, 0x64, 0xc8, 0x13, 0xcb, 0x9d, 0x71,
-	0x3f, 0xe7, 0x99, 0xfe, 0xce, 0x3f, 0x20, 0x9c, 0x4f, 0x7b, 0xdf, 0x65, 0xd2, 0xdb, 0x1f, 0x7c,
-	0xae, 0x5e, 0xa4, 0x89, 0x21, 0x48, 0x99, 0xbe, 0x48, 0x95, 0x5e, 0xa4, 0xc9, 0x0e, 0x52, 0xef,
-	0xb4, 0x5a, 0xc9, 0x41, 0x84, 0x4d, 0xee, 0xc1, 0x0e, 0x70, 0xe1, 0x87, 0x41, 0x7e, 0x2a, 0x5e,
-	0x69, 0x4c, 0xdb, 0x6f, 0x90, 0x99, 0x12, 0x17, 0x44, 0xa4, 0x8a, 0x8a, 0xe4, 0xf1, 0x5f, 0x09,
-	0x56, 0x42, 0xdf, 0x1e, 0x12, 0x89, 0x8d, 0xfa, 0xd3, 0xd1, 0x9b, 0x59, 0xdb, 0xa6, 0xdd, 0xd2,
-	0xa0, 0xed, 0xd2, 0xd0, 0x7f, 0xee, 0x7b, 0x55, 0xda, 0x5a, 0xa7, 0xd1, 0x41, 0x8d, 0xaa, 0x42,
-	0xa3, 0x3d, 0xdb, 0x69, 0xbb, 0xd0, 0xa8, 0xc1, 0x61, 0xf8, 0xb0, 0x3f, 0x21, 0x7c, 0x2a, 0xbd,
-	0xe4, 0x1a, 0x07, 0x26, 0xc1, 0x85, 0x87, 0x4d, 0x10, 0xfd, 0xa8, 0xd0, 0xef, 0xa7, 0x22, 0x73,
-	0x38, 0xdb, 0x8c, 0x04, 0xf0, 0x38, 0x06, 0x39, 0x37, 0x19, 0xa9, 0xf9, 0x2a, 0x3f, 0x74, 0x9b,
-	0x81, 0x4e, 0x63, 0xce, 0x4d, 0x46, 0xf6, 0x3d, 0xf3, 0x10, 0xd7, 0xa1, 0x0e, 0xdd, 0x43, 0xfc,
-	0xda, 0x3d, 0xd8, 0x35, 0xef, 0xc1, 0x1d, 0x0e, 0x30, 0x8e, 0x0b, 0xf6, 0x02, 0xe1, 0x33, 0xe6,
-	0xcd, 0x8d, 0x55, 0xa1, 0x7f, 0xf4, 0x2b, 0x7f, 0x20, 0xfa, 0x15, 0x90, 0xf6, 0x33, 0x84, 0x0b,
-	0x83, 0xb8, 0x92, 0x32, 0x6e, 0xe0, 0xd9, 0xde, 0x94, 0x69, 0x11, 0x98, 0x59, 0xdb, 0x1a, 0x1b,
-	0x96, 0x9b, 0x32, 0xbf, 0xf6, 0x16, 0xe3, 0xff, 0xd2, 0x44, 0x15, 0xe0, 0x2d, 0xdf, 0x03, 0xf2,
-	0x1a, 0xe1, 0xcc, 0x26, 0x48, 0xb2, 0x48, 0x0d, 0x49, 0xef, 0x2f, 0x89, 0xd6, 0x58, 0x23, 0x67,
-	0x2f, 0x3e, 0xf9, 0xfa, 0xfd, 0xf9, 0x44, 0x91, 0x14, 0xf4, 0x1b, 0xd1, 0x5a, 0x35, 0xde, 0x15,
-	0xe1, 0x1c, 0xa9, 0x92, 0x78, 0x44, 0x5e, 0x22, 0x9c, 0x6b, 0xc7, 0x90, 0x94, 0x47, 0xa1, 0xa6,
-	0x6a, 0xc0, 0xa2, 0x27, 0x5d, 0x1e, 0xa7, 0xc6, 0x5e, 0xd1, 0x4c, 0x0b, 0x76, 0x71, 0x10, 0x53,
-	0xfb, 0xe9, 0xd9, 0x40, 0xcb, 0xe4, 0x15, 0xc2, 0x93, 0x4a, 0xd6, 0xc9, 0xd2, 0x70, 0x2f, 0x1d,
-	0xe9, 0xb7, 0x6e, 0x8d, 0x33, 0x80, 0xca, 0xac, 0x7d, 0x56, 0x03, 0xff, 0x4f, 0xe6, 0x07, 0x00,
-	0x93, 0xf7, 0x08, 0x67, 0x63, 0x55, 0x22, 0x2b, 0xc3, 0x31, 0x53, 0xda, 0x35, 0xe6, 0x5c, 0x3b,
-	0x1a, 0xf3, 0x9c, 0x3d, 0x08, 0x73, 0xc3, 0x14, 0xb1, 0xa7, 0x08, 0x67, 0x63, 0x1d, 0x1a, 0x85,
-	0x9d, 0x52, 0x2b, 0x6b, 0x44, 0x29, 0x77, 0x12, 0x9d, 0x14, 0xdf, 0xf2, 0xa8, 0xe2, 0xfb, 0x88,
-	0xf0, 0xac, 0x9b, 0xbc, 0x50, 0x4a, 0xba, 0x46, 0xe5, 0xba, 0x23, 0x6f, 0xe3, 0xcd, 0xb5, 0x32,
-	0x6b, 0x5f, 0xd4, 0xcc, 0x94, 0x9c, 0x1f, 0xce, 0xec, 0xb4, 0x5f, 0xd4, 0xb2, 0x54, 0xc0, 0x8f,
-	0x31, 0x51, 0x95, 0xd2, 0x3e, 0xc4, 0x0d, 0xdd, 0xfd, 0x9c, 0xf8, 0xca, 0xff, 0x4b, 0x93, 0x76,
-	0x49, 0xef, 0xd3, 0x25, 0x57, 0xd6, 0x18, 0x4b, 0x64, 0x61, 0x04, 0x46, 0xbc, 0x91, 0xbc, 0x43,
-	0x78, 0x4a, 0xb7, 0x1f, 0xa4, 0x34, 0xdc, 0x67, 0xb7, 0x47, 0xb1, 0x76, 0xc6, 0x19, 0x3b, 0x6d,
-	0x57, 0xe3, 0xff, 0x2c, 0x39, 0x42, 0x72, 0x60, 0x0d, 0xf3, 0x04, 0x17, 0xd0, 0xd5, 0xad, 0xcf,
-	0xc7, 0x05, 0xf4, 0xe5, 0xb8, 0x80, 0xbe, 0x1d, 0x17, 0xd0, 0xdd, 0xcb, 0x27, 0x6b, 0x57, 0xbd,
-	0xba, 0x0f, 0x81, 0xd9, 0x1f, 0xef, 0x65, 0x75, 0xa7, 0xb9, 0xfe, 0x23, 0x00, 0x00, 0xff, 0xff,
-	0x10, 0xae, 0xd0, 0xd1, 0x4e, 0x0b, 0x00, 0x00,
+	// 897 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcd, 0x8f, 0x1b, 0x35,
+	0x14, 0xc0, 0xe5, 0xfd, 0x62, 0xeb, 0x2e, 0xad, 0xb0, 0x44, 0x1b, 0x86, 0x12, 0x56, 0x96, 0xda,
+	0x2e, 0x69, 0x33, 0x43, 0x12, 0x24, 0xe8, 0x72, 0xe2, 0x4b, 0x55, 0xa5, 0x15, 0x5a, 0x26, 0x68,
+	0x2b, 0xc1, 0x01, 0xb9, 0x93, 0xa7, 0xec, 0xd0, 0xc9, 0xd8, 0xd8, 0x9e, 0x48, 0x55, 0x05, 0x07,
+	0x24, 0x2e, 0x5c, 0x38, 0x20, 0xf8, 0x03, 0xe0, 0xc2, 0x1d, 0x4e, 0x5c, 0x38, 0x54, 0x08, 0x8e,
+	0x48, 0xfc, 0x03, 0x68, 0xc5, 0x1f, 0x82, 0xec, 0x99, 0x7c, 0x8c, 0x49, 0x32, 0x91, 0x18, 0xaa,
+	0x9e, 0x62, 0x3b, 0xf6, 0x7b, 0xbf, 0xf7, 0xe1, 0xf7, 0x3c, 0xb8, 0xa5, 0x40, 0x8e, 0x41, 0x06,
+	0x4c, 0x88, 0x24, 0x8e, 0x98, 0x8e, 0x79, 0xaa, 0x40, 0x3b, 0x53, 0x5f, 0x48, 0xae, 0x39, 0xb9,
+	0x50, 0x5e, 0xf5, 0xae, 0x0c, 0x39, 0x1f, 0x26, 0x10, 0x30, 0x11, 0x07, 0x2c, 0x4d, 0xb9, 0xce,
+	0xff, 0xc9, 0x77, 0x7b, 0x47, 0xc3, 0x58, 0x9f, 0x66, 0xf7, 0xfc, 0x88, 0x8f, 0x02, 0x26, 0x87,
+	0x5c, 0x48, 0xfe, 0xb1, 0x1d, 0xb4, 0xa3, 0x41, 0x30, 0xee, 0x05, 0xe2, 0xfe, 0xd0, 0x9c, 0x54,
+	0xf3, 0xba, 0x82, 0x71, 0x87, 0x25, 0xe2, 0x94, 0x75, 0x82, 0x21, 0xa4, 0x20, 0x99, 0x86, 0x41,
+	0x21, 0xed, 0x56, 0x85, 0xb4, 0xc2, 0x0c, 0x18, 0x43, 0xaa, 0x55, 0xf1, 0x93, 0x1f, 0xa5, 0x27,
+	0xf8, 0xd2, 0x1b, 0x33, 0x15, 0x7d, 0xd0, 0xb7, 0x41, 0xbf, 0x97, 0x81, 0x7c, 0x40, 0x08, 0xde,
+	0x4a, 0xd9, 0x08, 0x1a, 0x68, 0x1f, 0x1d, 0x9c, 0x0b, 0xed, 0x98, 0x1c, 0xe0, 0x8b, 0x4c, 0x08,
+	0x05, 0xfa, 0x5d, 0x36, 0x02, 0x25, 0x58, 0x04, 0x8d, 0x0d, 0xfb, 0xb7, 0xbb, 0x4c, 0x1f, 0xe2,
+	0xcb, 0x65, 0xb9, 0x47, 0xb1, 0x2a, 0x04, 0x7b, 0x78, 0xd7, 0x00, 0x42, 0xa4, 0x55, 0x03, 0xed,
+	0x6f, 0x1e, 0x9c, 0x0b, 0xa7, 0x73, 0xf3, 0x9f, 0x82, 0x04, 0x22, 0xcd, 0x65, 0x21, 0x79, 0x3a,
+	0x5f, 0xa4, 0x7c, 0x73, 0xb1, 0xf2, 0x9f, 0x11, 0x6e, 0x94, 0xb5, 0xdf, 0x65, 0x3a, 0x3a, 0x5d,
+	0x6e, 0xd7, 0x3c, 0xd2, 0xc6, 0x0a, 0xa4, 0xcd, 0x85, 0x48, 0xfd, 0x79, 0xa4, 0xad, 0x29, 0xd2,
+	0xfc, 0xb2, 0xd9, 0x29, 0x41, 0xf1, 0x4c, 0x46, 0x70, 0x02, 0x52, 0xc5, 0x3c, 0x6d, 0x6c, 0xe7,
+	0x3b, 0x9d, 0x65, 0xfa, 0x03, 0x72, 0x43, 0x12, 0x82, 0x12, 0x26, 0xa9, 0x48, 0x03, 0x3f, 0x55,
+	0x60, 0x15, 0xf4, 0x93, 0x29, 0xd1, 0xd8, 0xc9, 0x3f, 0xeb, 0xbd, 0xf3, 0xdd, 0x23, 0x7f, 0x96,
+	0x1a, 0xfe, 0x24, 0x35, 0xec, 0xe0, 0xa3, 0x68, 0xe0, 0x8f, 0x7b, 0xbe, 0xb8, 0x3f, 0xf4, 0x4d,
+	0xa2, 0xf9, 0x73, 0xc7, 0xfd, 0x49, 0xa2, 0xf9, 0x0e, 0x87, 0xa3, 0x83, 0x3e, 0x42, 0xf8, 0xf9,
+	0xf2, 0x96, 0xb7, 0x24, 0x30, 0x0d, 0x21, 0x7c, 0x92, 0x81, 0x5a, 0x44, 0x85, 0xfe, 0x7f, 0x2a,
+	0x72, 0x09, 0xef, 0x64, 0x42, 0x81, 0xcc, 0x7d, 0xb0, 0x1b, 0x16, 0x33, 0xb3, 0x3e, 0x90, 0x0f,
+	0xc2, 0x2c, 0xb5, 0x61, 0xdc, 0x0d, 0x8b, 0x19, 0xfd, 0xd0, 0x35, 0xe2, 0x6d, 0x48, 0x60, 0x66,
+	0xc4, 0x7f, 0xbb, 0x07, 0x77, 0xdd, 0x7b, 0xf0, 0xbe, 0x04, 0xa8, 0xe3, 0x82, 0x09, 0x7c, 0xc5,
+	0xf1, 0x03, 0x4f, 0x12, 0x9e, 0xe9, 0x5a, 0xb0, 0xcd, 0x69, 0xa5, 0x41, 0x58, 0x4f, 0x6d, 0x87,
+	0x76, 0x4c, 0xbf, 0x41, 0xf8, 0x05, 0xb7, 0x56, 0xe4, 0x75, 0x68, 0x71, 0xbc, 0xfb, 0x8f, 0x21,
+	0xde, 0x7d, 0xd0, 0xf4, 0x2b, 0x84, 0x9b, 0xcb, 0xb8, 0x8a, 0x8b, 0x33, 0xc2, 0x7b, 0xf3, 0x49,
+	0x62, 0xcb, 0xce, 0xf9, 0xee, 0x9d, 0xda, 0xb0, 0xc2, 0x92, 0xf8, 0xee, 0x97, 0x17, 0xf1, 0xb3,
+	0x65, 0xa2, 0x3e, 0xc8, 0x71, 0x1c, 0x01, 0xf9, 0x1e, 0xe1, 0xcd, 0xdb, 0xa0, 0xc9, 0x35, 0xdf,
+	0x69, 0x22, 0x8b, 0x8b, 0xb0, 0x57, 0xab, 0xe7, 0xe8, 0xb5, 0xcf, 0xff, 0xfc, 0xfb, 0xeb, 0x8d,
+	0x7d, 0xd2, 0xb4, 0x5d, 0x69, 0xdc, 0x71, 0x3a, 0x99, 0x0a, 0x1e, 0x9a, 0x34, 0xf9, 0x94, 0x7c,
+	0x8b, 0xf0, 0xee, 0xc4, 0x87, 0xa4, 0x5d, 0x85, 0x5a, 0xca, 0x01, 0xcf, 0x5f, 0x77, 0x7b, 0x1e,
+	0x1a, 0x7a, 0xc3, 0x32, 0x5d, 0xa5, 0xfb, 0xcb, 0x98, 0x26, 0xcd, 0xee, 0x10, 0xb5, 0xc8, 0x77,
+	0x08, 0x6f, 0x99, 0x46, 0x42, 0xae, 0xaf, 0xd6, 0x32, 0x6d, 0x36, 0xde, 0x71, 0x9d, 0x0e, 0x34,
+	0x62, 0xe9, 0x8b, 0x16, 0xf8, 0x39, 0x72, 0x79, 0x09, 0x30, 0xf9, 0x09, 0xe1, 0x9d, 0xbc, 0x0e,
+	0x92, 0x1b, 0xab, 0x31, 0x4b, 0xd5, 0xb2, 0xe6, 0x58, 0x07, 0x16, 0xf3, 0x25, 0xba, 0x0c, 0xf3,
+	0xd0, 0x2d, 0x9b, 0x5f, 0x20, 0xbc, 0x93, 0x57, 0xbe, 0x2a, 0xec, 0x52, 0x7d, 0xf4, 0x2a, 0x52,
+	0x79, 0x1a, 0xe8, 0x22, 0xf9, 0x5a, 0x55, 0xc9, 0xf7, 0x0b, 0xc2, 0x7b, 0x61, 0xd1, 0x13, 0x4d,
+	0xb1, 0xac, 0x8a, 0xf5, 0xb4, 0xa0, 0xd6, 0x1b, 0x6b, 0x23, 0x96, 0xbe, 0x62, 0x99, 0x7d, 0x72,
+	0x73, 0x35, 0x73, 0x30, 0xe9, 0xe1, 0x6d, 0x6d, 0x80, 0x3f, 0xc3, 0xc4, 0x64, 0xca, 0xc4, 0x88,
+	0x77, 0xec, 0x7b, 0x6b, 0xed, 0x2b, 0xff, 0x8c, 0x5f, 0x3c, 0xd0, 0xec, 0x39, 0x9b, 0x72, 0x6d,
+	0x8b, 0x71, 0x9d, 0x5c, 0xad, 0xc0, 0xc8, 0x0f, 0x92, 0x1f, 0x11, 0xde, 0xb6, 0x0f, 0x1e, 0x72,
+	0xb0, 0x5a, 0xe7, 0xec, 0x55, 0xe4, 0x9d, 0xd4, 0xe9, 0x3b, 0x2b, 0xd7, 0xe2, 0xff, 0xbb, 0xe4,
+	0x28, 0x2d, 0x81, 0x8d, 0x5c, 0x0b, 0x5e, 0x46, 0xe4, 0x11, 0xc2, 0x7b, 0xc7, 0x2c, 0x53, 0x50,
+	0x34, 0x32, 0x72, 0xb3, 0x22, 0xb1, 0x4a, 0xfd, 0xae, 0xe6, 0xdb, 0xf3, 0xaa, 0xc5, 0xee, 0xd0,
+	0xca, 0xc0, 0xe7, 0x10, 0x81, 0x30, 0xe0, 0xa6, 0x42, 0xfd, 0x8a, 0xf0, 0xd3, 0x21, 0xa8, 0x6c,
+	0xf4, 0x44, 0x98, 0xf1, 0x9a, 0x35, 0xa3, 0x4b, 0xdb, 0x6b, 0x9a, 0x21, 0x2d, 0xb9, 0xb1, 0xe3,
+	0x37, 0x84, 0x2f, 0x1c, 0x4b, 0x3e, 0xe2, 0xfa, 0x89, 0x30, 0xe4, 0x96, 0x35, 0xa4, 0x47, 0xfd,
+	0x75, 0xe3, 0x91, 0xa3, 0x1f, 0xa2, 0xd6, 0x9b, 0x77, 0x7e, 0x3f, 0x6b, 0xa2, 0x3f, 0xce, 0x9a,
+	0xe8, 0xaf, 0xb3, 0x26, 0xfa, 0xe0, 0xf5, 0xf5, 0x3e, 0xbc, 0xa2, 0x24, 0x86, 0xd4, 0xfd, 0xd2,
+	0xbb, 0xb7, 0x63, 0xbf, 0x99, 0x7a, 0xff, 0x0c, 0x00, 0x68, 0x3a, 0x42, 0x44, 0x18, 0x0e, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -661,6 +735,12 @@ type ApplicationSetServiceClient interface {
 	// ListResourceEvents returns a list of event resources
 	ListResourceEvents(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*events.EventList, error)
 	Watch(ctx context.Context, in *ApplicationSetWatchQuery, opts ...grpc.CallOption) (ApplicationSetService_WatchClient, error)
+	// PauseRollout pauses the RollingSync rollout of an applicationset, no application is synced until it is resumed
+	PauseRollout(ctx context.Context, in *ApplicationSetRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
+	// ResumeRollout resumes the paused RollingSync rollout of an applicationset
+	ResumeRollout(ctx context.Context, in *ApplicationSetRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
+	// PromoteRollout promotes the RollingSync rollout of an applicationset past a step, without waiting for its applications to be healthy
+	PromoteRollout(ctx context.Context, in *ApplicationSetRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
 }
 
 type applicationSetServiceClient struct {
@@ -766,6 +846,33 @@ func (x *applicationSetServiceWatchClient) Recv() (*v1alpha1.ApplicationSetWatch
 	return m, nil
 }
 
+func (c *applicationSetServiceClient) PauseRollout(ctx context.Context, in *ApplicationSetRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error) {
+	out := new(v1alpha1.ApplicationSet)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/PauseRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationSetServiceClient) ResumeRollout(ctx context.Context, in *ApplicationSetRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error) {
+	out := new(v1alpha1.ApplicationSet)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/ResumeRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationSetServiceClient) PromoteRollout(ctx context.Context, in *ApplicationSetRolloutRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error) {
+	out := new(v1alpha1.ApplicationSet)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/PromoteRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationSetServiceServer is the server API for ApplicationSetService service.
 type ApplicationSetServiceServer interface {
 	// Get returns an applicationset by name
@@ -783,6 +890,12 @@ type ApplicationSetServiceServer interface {
 	// ListResourceEvents returns a list of event resources
 	ListResourceEvents(context.Context, *ApplicationSetGetQuery) (*events.EventList, error)
 	Watch(*ApplicationSetWatchQuery, ApplicationSetService_WatchServer) error
+	// PauseRollout pauses the RollingSync rollout of an applicationset, no application is synced until it is resumed
+	PauseRollout(context.Context, *ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error)
+	// ResumeRollout resumes the paused RollingSync rollout of an applicationset
+	ResumeRollout(context.Context, *ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error)
+	// PromoteRollout promotes the RollingSync rollout of an applicationset past a step, without waiting for its applications to be healthy
+	PromoteRollout(context.Context, *ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error)
 }
 
 // UnimplementedApplicationSetServiceServer can be embedded to have forward compatible implementations.
@@ -813,6 +926,15 @@ func (*UnimplementedApplicationSetServiceServer) ListResourceEvents(ctx context.
 func (*UnimplementedApplicationSetServiceServer) Watch(req *ApplicationSetWatchQuery, srv ApplicationSetService_WatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
 }
+func (*UnimplementedApplicationSetServiceServer) PauseRollout(ctx context.Context, req *ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRollout not implemented")
+}
+func (*UnimplementedApplicationSetServiceServer) ResumeRollout(ctx context.Context, req *ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRollout not implemented")
+}
+func (*UnimplementedApplicationSetServiceServer) PromoteRollout(ctx context.Context, req *ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteRollout not implemented")
+}
 
 func RegisterApplicationSetServiceServer(s *grpc.Server, srv ApplicationSetServiceServer) {
 	s.RegisterService(&_ApplicationSetService_serviceDesc, srv)
@@ -965,6 +1087,60 @@ func (x *applicationSetServiceWatchServer) Send(m *v1alpha1.ApplicationSetWatchE
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ApplicationSetService_PauseRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).PauseRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/PauseRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).PauseRollout(ctx, req.(*ApplicationSetRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationSetService_ResumeRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).ResumeRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/ResumeRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).ResumeRollout(ctx, req.(*ApplicationSetRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationSetService_PromoteRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).PromoteRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/PromoteRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).PromoteRollout(ctx, req.(*ApplicationSetRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "applicationset.ApplicationSetService",
 	HandlerType: (*ApplicationSetServiceServer)(nil),
@@ -997,6 +1173,18 @@ var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListResourceEvents",
 			Handler:    _ApplicationSetService_ListResourceEvents_Handler,
 		},
+		{
+			MethodName: "PauseRollout",
+			Handler:    _ApplicationSetService_PauseRollout_Handler,
+		},
+		{
+			MethodName: "ResumeRollout",
+			Handler:    _ApplicationSetService_ResumeRollout_Handler,
+		},
+		{
+			MethodName: "PromoteRollout",
+			Handler:    _ApplicationSetService_PromoteRollout_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1350,6 +1538,52 @@ func (m *ApplicationSetTreeQuery) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetRolloutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Step != 0 {
+		i = encodeVarintApplicationset(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AppsetNamespace) > 0 {
+		i -= len(m.AppsetNamespace)
+		copy(dAtA[i:], m.AppsetNamespace)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.AppsetNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetGenerateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1603,6 +1837,29 @@ func (m *ApplicationSetTreeQuery) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSetRolloutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.Step != 0 {
+		n += 1 + sovApplicationset(uint64(m.Step))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ApplicationSetGenerateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2592,6 +2849,140 @@ func (m *ApplicationSetTreeQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSetRolloutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetRolloutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetRolloutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ApplicationSetGenerateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
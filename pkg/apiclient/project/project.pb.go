@@ -519,6 +519,109 @@ func (m *SyncWindowsResponse) GetWindows() []*v1alpha1.SyncWindow {
 	return nil
 }
 
+type UpcomingSyncWindowsQuery struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// days is the number of days to list the upcoming sync windows for, 7 by default
+	Days                 int32    `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpcomingSyncWindowsQuery) Reset()         { *m = UpcomingSyncWindowsQuery{} }
+func (m *UpcomingSyncWindowsQuery) String() string { return proto.CompactTextString(m) }
+func (*UpcomingSyncWindowsQuery) ProtoMessage()    {}
+func (*UpcomingSyncWindowsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{9}
+}
+func (m *UpcomingSyncWindowsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpcomingSyncWindowsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpcomingSyncWindowsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpcomingSyncWindowsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpcomingSyncWindowsQuery.Merge(m, src)
+}
+func (m *UpcomingSyncWindowsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpcomingSyncWindowsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpcomingSyncWindowsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpcomingSyncWindowsQuery proto.InternalMessageInfo
+
+func (m *UpcomingSyncWindowsQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpcomingSyncWindowsQuery) GetDays() int32 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+type UpcomingSyncWindowsResponse struct {
+	Occurrences          []*v1alpha1.SyncWindowOccurrence `protobuf:"bytes,1,rep,name=occurrences,proto3" json:"occurrences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *UpcomingSyncWindowsResponse) Reset()         { *m = UpcomingSyncWindowsResponse{} }
+func (m *UpcomingSyncWindowsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpcomingSyncWindowsResponse) ProtoMessage()    {}
+func (*UpcomingSyncWindowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{10}
+}
+func (m *UpcomingSyncWindowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpcomingSyncWindowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpcomingSyncWindowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpcomingSyncWindowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpcomingSyncWindowsResponse.Merge(m, src)
+}
+func (m *UpcomingSyncWindowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpcomingSyncWindowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpcomingSyncWindowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpcomingSyncWindowsResponse proto.InternalMessageInfo
+
+func (m *UpcomingSyncWindowsResponse) GetOccurrences() []*v1alpha1.SyncWindowOccurrence {
+	if m != nil {
+		return m.Occurrences
+	}
+	return nil
+}
+
 type GlobalProjectsResponse struct {
 	Items                []*v1alpha1.AppProject `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -530,7 +633,7 @@ func (m *GlobalProjectsResponse) Reset()         { *m = GlobalProjectsResponse{}
 func (m *GlobalProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*GlobalProjectsResponse) ProtoMessage()    {}
 func (*GlobalProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{9}
+	return fileDescriptor_5f0a51496972c9e2, []int{11}
 }
 func (m *GlobalProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +683,7 @@ func (m *DetailedProjectsResponse) Reset()         { *m = DetailedProjectsRespon
 func (m *DetailedProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*DetailedProjectsResponse) ProtoMessage()    {}
 func (*DetailedProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{10}
+	return fileDescriptor_5f0a51496972c9e2, []int{12}
 }
 func (m *DetailedProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +751,7 @@ func (m *ListProjectLinksRequest) Reset()         { *m = ListProjectLinksRequest
 func (m *ListProjectLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListProjectLinksRequest) ProtoMessage()    {}
 func (*ListProjectLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{11}
+	return fileDescriptor_5f0a51496972c9e2, []int{13}
 }
 func (m *ListProjectLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,6 +797,8 @@ func init() {
 	proto.RegisterType((*EmptyResponse)(nil), "project.EmptyResponse")
 	proto.RegisterType((*SyncWindowsQuery)(nil), "project.SyncWindowsQuery")
 	proto.RegisterType((*SyncWindowsResponse)(nil), "project.SyncWindowsResponse")
+	proto.RegisterType((*UpcomingSyncWindowsQuery)(nil), "project.UpcomingSyncWindowsQuery")
+	proto.RegisterType((*UpcomingSyncWindowsResponse)(nil), "project.UpcomingSyncWindowsResponse")
 	proto.RegisterType((*GlobalProjectsResponse)(nil), "project.GlobalProjectsResponse")
 	proto.RegisterType((*DetailedProjectsResponse)(nil), "project.DetailedProjectsResponse")
 	proto.RegisterType((*ListProjectLinksRequest)(nil), "project.ListProjectLinksRequest")
@@ -702,70 +807,75 @@ func init() {
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 997 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x97, 0x93, 0x36, 0xbb, 0x7d, 0x2d, 0xa5, 0x3b, 0xbb, 0xdb, 0x75, 0x43, 0xb7, 0x0d, 0x83,
-	0xb6, 0x8a, 0x0a, 0xb5, 0xd5, 0x06, 0x24, 0xfe, 0x9c, 0xd8, 0x6e, 0x15, 0x90, 0x7a, 0x00, 0x17,
-	0x04, 0xe2, 0xb0, 0xc8, 0xb1, 0x9f, 0xb2, 0x43, 0x1c, 0x7b, 0xf0, 0x4c, 0xb2, 0x0d, 0x51, 0x2f,
-	0x48, 0x80, 0xc4, 0x81, 0xc3, 0x72, 0xe2, 0x0b, 0xf0, 0x3d, 0xb8, 0x71, 0x44, 0xe2, 0x0b, 0xa0,
-	0x8a, 0x0f, 0x82, 0x3c, 0x1e, 0x3b, 0x71, 0x52, 0xc3, 0xa2, 0x06, 0x4e, 0x1e, 0x8f, 0xdf, 0xfc,
-	0x7e, 0xbf, 0xf7, 0x66, 0xe6, 0x37, 0x63, 0xd8, 0x16, 0x18, 0x0f, 0x31, 0xb6, 0x79, 0x1c, 0x7d,
-	0x81, 0x9e, 0xcc, 0x9e, 0x16, 0x8f, 0x23, 0x19, 0x91, 0x1b, 0xfa, 0xb5, 0xbe, 0xdd, 0x8d, 0xa2,
-	0x6e, 0x80, 0xb6, 0xcb, 0x99, 0xed, 0x86, 0x61, 0x24, 0x5d, 0xc9, 0xa2, 0x50, 0xa4, 0x61, 0xf5,
-	0xd3, 0x2e, 0x93, 0x4f, 0x06, 0x1d, 0xcb, 0x8b, 0xfa, 0xb6, 0x1b, 0x77, 0xa3, 0x64, 0x94, 0x6a,
-	0x1c, 0x78, 0xbe, 0x3d, 0x6c, 0xd9, 0xbc, 0xd7, 0x4d, 0x46, 0x0a, 0xdb, 0xe5, 0x3c, 0x60, 0x9e,
-	0x1a, 0x6b, 0x0f, 0x0f, 0xdd, 0x80, 0x3f, 0x71, 0x0f, 0xed, 0x2e, 0x86, 0x18, 0xbb, 0x12, 0x7d,
-	0x8d, 0x76, 0xfc, 0x0f, 0x68, 0x5a, 0xf1, 0x34, 0xd6, 0x54, 0x5b, 0x83, 0xbc, 0xf5, 0x7c, 0x20,
-	0x38, 0xc4, 0x50, 0x0a, 0xfd, 0x48, 0x87, 0xd2, 0x67, 0x06, 0xdc, 0xf9, 0x20, 0xcd, 0xfb, 0x38,
-	0x46, 0x57, 0xa2, 0x83, 0x5f, 0x0e, 0x50, 0x48, 0xd2, 0x81, 0xac, 0x1e, 0xa6, 0xd1, 0x30, 0x9a,
-	0xab, 0x47, 0xef, 0x59, 0x13, 0x16, 0x2b, 0x63, 0x51, 0x8d, 0xcf, 0x3d, 0xdf, 0x1a, 0xb6, 0x2c,
-	0xde, 0xeb, 0x5a, 0x49, 0xe2, 0xd6, 0xb4, 0xc0, 0x2c, 0x71, 0xeb, 0x5d, 0xce, 0x35, 0x8f, 0x93,
-	0x01, 0x93, 0x4d, 0xa8, 0x0d, 0xb8, 0xc0, 0x58, 0x9a, 0x95, 0x86, 0xd1, 0xbc, 0xe9, 0xe8, 0x37,
-	0xda, 0x83, 0x2d, 0x1d, 0xfb, 0x51, 0xd4, 0xc3, 0xf0, 0x11, 0x06, 0x38, 0x11, 0x66, 0x16, 0x85,
-	0xad, 0x4c, 0xe0, 0x08, 0x2c, 0xc5, 0x51, 0x80, 0x0a, 0x6c, 0xc5, 0x51, 0x6d, 0xb2, 0x01, 0x55,
-	0xe6, 0x4a, 0xb3, 0xda, 0x30, 0x9a, 0x55, 0x27, 0x69, 0x92, 0x75, 0xa8, 0x30, 0xdf, 0x5c, 0x52,
-	0x31, 0x15, 0xe6, 0xd3, 0x9f, 0x8c, 0x22, 0x5b, 0xb1, 0x0c, 0xe5, 0x6c, 0x0d, 0x58, 0xf5, 0x51,
-	0x78, 0x31, 0xe3, 0x49, 0xa2, 0x9a, 0x74, 0xba, 0x2b, 0xd7, 0x53, 0x9d, 0xd2, 0xb3, 0x0d, 0x2b,
-	0x78, 0xce, 0x59, 0x8c, 0xe2, 0xfd, 0x50, 0x89, 0xa8, 0x3a, 0x93, 0x0e, 0xad, 0x6d, 0x39, 0xd7,
-	0xf6, 0x5a, 0x3e, 0x39, 0x4a, 0x9a, 0x83, 0x82, 0x47, 0xa1, 0x40, 0x72, 0x07, 0x96, 0x65, 0xd2,
-	0xa1, 0x35, 0xa5, 0x2f, 0x94, 0xc2, 0x9a, 0x8e, 0xfe, 0x70, 0x80, 0xf1, 0x28, 0xe1, 0x0f, 0xdd,
-	0x3e, 0xea, 0x20, 0xd5, 0xa6, 0x5f, 0xe5, 0x88, 0x1f, 0x73, 0xff, 0xff, 0x9d, 0x6e, 0xfa, 0x22,
-	0xbc, 0x70, 0xd2, 0xe7, 0x72, 0x94, 0xa5, 0x41, 0xf7, 0x60, 0xe3, 0x6c, 0x14, 0x7a, 0x9f, 0xb0,
-	0xd0, 0x8f, 0x9e, 0x8a, 0x72, 0xd1, 0x23, 0xb8, 0x3d, 0x15, 0x97, 0x57, 0xa1, 0x03, 0x37, 0x9e,
-	0xa6, 0x5d, 0xa6, 0xd1, 0xa8, 0x5e, 0x5f, 0xf3, 0x84, 0xc3, 0xc9, 0x80, 0xe9, 0x39, 0x6c, 0xb6,
-	0x83, 0xa8, 0xe3, 0x06, 0x3a, 0x9b, 0x09, 0xfb, 0x63, 0x58, 0x66, 0x12, 0xfb, 0x0b, 0xe2, 0x9e,
-	0xaa, 0x57, 0x0a, 0x4b, 0x7f, 0xa9, 0x82, 0xf9, 0x08, 0xa5, 0xcb, 0x02, 0xf4, 0xe7, 0xc8, 0x39,
-	0xac, 0x77, 0x0b, 0xb2, 0x16, 0xae, 0x62, 0x06, 0x7f, 0x7a, 0x81, 0x54, 0xfe, 0x2b, 0x3f, 0x08,
-	0x60, 0x2d, 0x46, 0x1e, 0x09, 0x26, 0xa3, 0x98, 0xa1, 0x30, 0xab, 0x8b, 0xc8, 0xc9, 0xc9, 0x10,
-	0x47, 0x4e, 0x01, 0x9d, 0xb8, 0x70, 0xd3, 0x0b, 0x06, 0x42, 0x62, 0x2c, 0xcc, 0x25, 0xc5, 0x74,
-	0x72, 0x3d, 0xa6, 0xe3, 0x14, 0xcd, 0xc9, 0x61, 0xe9, 0x01, 0xdc, 0x3b, 0x65, 0x42, 0xea, 0x44,
-	0x4f, 0x59, 0xd8, 0x13, 0xd9, 0x86, 0xbb, 0x62, 0x9d, 0x1f, 0x3d, 0x5b, 0x83, 0x75, 0x1d, 0x7b,
-	0x86, 0xf1, 0x90, 0x79, 0x48, 0xbe, 0x37, 0x60, 0x35, 0x75, 0x24, 0xe5, 0x00, 0x84, 0x5a, 0xd9,
-	0xa1, 0x55, 0xea, 0x59, 0xf5, 0xfb, 0x57, 0xc6, 0xe4, 0xbb, 0xee, 0xcd, 0xaf, 0x7f, 0xff, 0xf3,
-	0xc7, 0xca, 0x11, 0x3d, 0x50, 0x07, 0xdc, 0xf0, 0x30, 0x3b, 0x06, 0x85, 0x3d, 0xd6, 0xad, 0x0b,
-	0x3b, 0xf1, 0x2a, 0x61, 0x8f, 0x93, 0xc7, 0x85, 0xad, 0xdc, 0xe5, 0x6d, 0x63, 0x9f, 0x7c, 0x6b,
-	0xc0, 0x6a, 0x6a, 0xc6, 0x7f, 0x27, 0xa6, 0x60, 0xd7, 0xf5, 0xcd, 0x3c, 0xa6, 0xb8, 0xf7, 0xdf,
-	0x51, 0x2a, 0xde, 0xd8, 0x6f, 0xfd, 0x2b, 0x15, 0xf6, 0x98, 0xb9, 0xf2, 0x82, 0xfc, 0x60, 0x40,
-	0x2d, 0xcd, 0x99, 0xcc, 0x25, 0x5b, 0xac, 0xc5, 0xc2, 0x56, 0x29, 0x7d, 0x49, 0x09, 0xbe, 0x4b,
-	0x37, 0x66, 0x05, 0x27, 0x95, 0xf9, 0xc6, 0x80, 0xa5, 0x64, 0xa6, 0xc9, 0xdd, 0x59, 0x39, 0xca,
-	0xd5, 0xea, 0xa7, 0x8b, 0x92, 0x91, 0x90, 0x50, 0x53, 0x49, 0x21, 0x64, 0x4e, 0x0a, 0x39, 0x07,
-	0xd2, 0x46, 0x39, 0x63, 0x1b, 0x65, 0xa2, 0x5e, 0xce, 0xbb, 0xcb, 0x7c, 0x86, 0x36, 0x15, 0x13,
-	0x25, 0x8d, 0xf9, 0x59, 0x4a, 0x56, 0xec, 0x85, 0xed, 0xeb, 0x91, 0xe4, 0x3b, 0x03, 0xaa, 0x6d,
-	0x2c, 0xe5, 0x5a, 0xdc, 0x3c, 0xec, 0x2a, 0x49, 0x5b, 0xe4, 0x5e, 0x89, 0x24, 0x32, 0x86, 0x5b,
-	0x6d, 0x94, 0x45, 0xd7, 0x2e, 0x93, 0xb5, 0x9b, 0x77, 0x5f, 0xed, 0xf2, 0xd4, 0x52, 0x6c, 0x4d,
-	0xb2, 0x57, 0x56, 0x80, 0xd4, 0x26, 0xf3, 0x09, 0xf8, 0xd9, 0x80, 0x5a, 0x7a, 0xb2, 0xce, 0xaf,
-	0xcc, 0xc2, 0x89, 0xbb, 0xc0, 0x8a, 0xb4, 0x94, 0xc6, 0x83, 0x7a, 0xb3, 0x74, 0x2b, 0x59, 0x7d,
-	0x94, 0xae, 0xef, 0x4a, 0xd7, 0x52, 0xa2, 0x93, 0x15, 0xfb, 0x29, 0xd4, 0xd2, 0x8d, 0x5a, 0x56,
-	0x9a, 0xb2, 0x8d, 0xab, 0xeb, 0xbf, 0x5f, 0x5a, 0xff, 0xc7, 0x00, 0xc9, 0x2a, 0x3d, 0x51, 0xd7,
-	0xcc, 0x32, 0xf4, 0x5b, 0x96, 0xbe, 0x86, 0xaa, 0x30, 0xb5, 0xaa, 0xf7, 0x14, 0x70, 0x83, 0xec,
-	0x94, 0x95, 0x3a, 0x1d, 0x41, 0xc6, 0x70, 0xbb, 0x8d, 0x72, 0xea, 0x42, 0x70, 0x26, 0x93, 0x72,
-	0x6f, 0xe5, 0x44, 0xb3, 0x77, 0x8a, 0xfa, 0xf6, 0x55, 0x9f, 0xf2, 0x84, 0x5e, 0x55, 0xbc, 0x0f,
-	0xc8, 0x2b, 0x65, 0xbc, 0x62, 0x14, 0x7a, 0xfa, 0x3e, 0x40, 0x38, 0xac, 0x24, 0x62, 0x95, 0x95,
-	0x93, 0x46, 0x8e, 0x5b, 0xe2, 0xf2, 0xf5, 0x7a, 0x61, 0xf2, 0xf4, 0x27, 0xcd, 0xfb, 0x40, 0xf1,
-	0xee, 0x92, 0xfb, 0x65, 0xbc, 0x41, 0x12, 0xfe, 0xf0, 0xe1, 0xaf, 0x97, 0x3b, 0xc6, 0x6f, 0x97,
-	0x3b, 0xc6, 0x1f, 0x97, 0x3b, 0xc6, 0x67, 0xaf, 0x3f, 0xdf, 0xdf, 0x87, 0x17, 0x30, 0x0c, 0xf3,
-	0x1f, 0x9c, 0x4e, 0x4d, 0x5d, 0xf6, 0x5b, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x27, 0x88,
-	0x4d, 0x01, 0x0d, 0x00, 0x00,
+	// 1081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe5, 0x6c, 0x92, 0x36, 0x2f, 0x6d, 0x48, 0xa7, 0x69, 0xea, 0xb8, 0x69, 0xb2, 0x0c,
+	0x34, 0x5a, 0x85, 0xc6, 0x56, 0x92, 0x22, 0xf1, 0xe3, 0x44, 0xd2, 0x28, 0x20, 0x45, 0x02, 0x1c,
+	0x2a, 0x10, 0x87, 0x22, 0xc7, 0x7e, 0xda, 0x0e, 0xf1, 0xda, 0x83, 0x67, 0x76, 0x9b, 0x25, 0xca,
+	0x05, 0x09, 0x90, 0x38, 0x70, 0xa0, 0x17, 0xf8, 0x07, 0xb8, 0xf1, 0x47, 0x70, 0xe3, 0x88, 0xc4,
+	0x3f, 0x80, 0x22, 0xfe, 0x10, 0xe4, 0xf1, 0xd8, 0x6b, 0x67, 0xd7, 0x25, 0xa8, 0x0b, 0xa7, 0x1d,
+	0xcf, 0xbe, 0xf9, 0xbe, 0xcf, 0x7b, 0xf3, 0xfc, 0x66, 0x0c, 0xcb, 0x02, 0x93, 0x1e, 0x26, 0x0e,
+	0x4f, 0xe2, 0xcf, 0xd1, 0x97, 0xf9, 0xaf, 0xcd, 0x93, 0x58, 0xc6, 0xe4, 0x8a, 0x7e, 0xb4, 0x96,
+	0xdb, 0x71, 0xdc, 0x0e, 0xd1, 0xf1, 0x38, 0x73, 0xbc, 0x28, 0x8a, 0xa5, 0x27, 0x59, 0x1c, 0x89,
+	0xcc, 0xcc, 0x3a, 0x68, 0x33, 0xf9, 0xa4, 0x7b, 0x64, 0xfb, 0x71, 0xc7, 0xf1, 0x92, 0x76, 0x9c,
+	0xae, 0x52, 0x83, 0x0d, 0x3f, 0x70, 0x7a, 0xdb, 0x0e, 0x3f, 0x6e, 0xa7, 0x2b, 0x85, 0xe3, 0x71,
+	0x1e, 0x32, 0x5f, 0xad, 0x75, 0x7a, 0x9b, 0x5e, 0xc8, 0x9f, 0x78, 0x9b, 0x4e, 0x1b, 0x23, 0x4c,
+	0x3c, 0x89, 0x81, 0x56, 0xdb, 0xfd, 0x07, 0x35, 0x4d, 0x5c, 0xd6, 0x2a, 0x8d, 0xb5, 0xc8, 0x9b,
+	0x97, 0x13, 0xc1, 0x1e, 0x46, 0x52, 0xe8, 0x9f, 0x6c, 0x29, 0xfd, 0xc1, 0x80, 0x85, 0x0f, 0xb2,
+	0xb8, 0x77, 0x13, 0xf4, 0x24, 0xba, 0xf8, 0x45, 0x17, 0x85, 0x24, 0x47, 0x90, 0xe7, 0xc3, 0x34,
+	0x9a, 0x46, 0x6b, 0x76, 0xeb, 0x5d, 0x7b, 0xe0, 0xc5, 0xce, 0xbd, 0xa8, 0xc1, 0x67, 0x7e, 0x60,
+	0xf7, 0xb6, 0x6d, 0x7e, 0xdc, 0xb6, 0xd3, 0xc0, 0xed, 0x32, 0x60, 0x1e, 0xb8, 0xfd, 0x0e, 0xe7,
+	0xda, 0x8f, 0x9b, 0x0b, 0x93, 0x45, 0x98, 0xee, 0x72, 0x81, 0x89, 0x34, 0x27, 0x9a, 0x46, 0xeb,
+	0xaa, 0xab, 0x9f, 0xe8, 0x31, 0x2c, 0x69, 0xdb, 0x8f, 0xe2, 0x63, 0x8c, 0x1e, 0x62, 0x88, 0x03,
+	0x30, 0xb3, 0x0a, 0x36, 0x33, 0x90, 0x23, 0x30, 0x99, 0xc4, 0x21, 0x2a, 0xb1, 0x19, 0x57, 0x8d,
+	0xc9, 0x3c, 0x34, 0x98, 0x27, 0xcd, 0x46, 0xd3, 0x68, 0x35, 0xdc, 0x74, 0x48, 0xe6, 0x60, 0x82,
+	0x05, 0xe6, 0xa4, 0xb2, 0x99, 0x60, 0x01, 0xfd, 0xc9, 0xa8, 0x7a, 0xab, 0xa6, 0xa1, 0xde, 0x5b,
+	0x13, 0x66, 0x03, 0x14, 0x7e, 0xc2, 0x78, 0x1a, 0xa8, 0x76, 0x5a, 0x9e, 0x2a, 0x78, 0x1a, 0x25,
+	0x9e, 0x65, 0x98, 0xc1, 0x13, 0xce, 0x12, 0x14, 0xef, 0x45, 0x0a, 0xa2, 0xe1, 0x0e, 0x26, 0x34,
+	0xdb, 0x54, 0xc1, 0x76, 0x1f, 0x16, 0xca, 0x68, 0x2e, 0x0a, 0x1e, 0x47, 0x02, 0xc9, 0x02, 0x4c,
+	0xc9, 0x74, 0x42, 0x33, 0x65, 0x0f, 0x94, 0xc2, 0x35, 0x6d, 0xfd, 0x61, 0x17, 0x93, 0x7e, 0xea,
+	0x3f, 0xf2, 0x3a, 0xa8, 0x8d, 0xd4, 0x98, 0x7e, 0x59, 0x28, 0x3e, 0xe2, 0xc1, 0xff, 0xbb, 0xdd,
+	0xf4, 0x25, 0xb8, 0xbe, 0xd7, 0xe1, 0xb2, 0x9f, 0x87, 0x41, 0xd7, 0x60, 0xfe, 0xb0, 0x1f, 0xf9,
+	0x1f, 0xb3, 0x28, 0x88, 0x9f, 0x8a, 0x7a, 0xe8, 0x3e, 0xdc, 0x2c, 0xd9, 0x15, 0x59, 0x38, 0x82,
+	0x2b, 0x4f, 0xb3, 0x29, 0xd3, 0x68, 0x36, 0x5e, 0x9c, 0x79, 0xe0, 0xc3, 0xcd, 0x85, 0xe9, 0x0e,
+	0x98, 0x8f, 0xb8, 0x1f, 0x77, 0x58, 0xd4, 0xbe, 0x0c, 0x6a, 0x3a, 0x17, 0x78, 0x7d, 0xa1, 0xca,
+	0x61, 0xca, 0x55, 0x63, 0xfa, 0xcc, 0x80, 0x3b, 0x23, 0x44, 0x8a, 0x38, 0x24, 0xcc, 0xc6, 0xbe,
+	0xdf, 0x4d, 0x12, 0x8c, 0x7c, 0xcc, 0x63, 0x71, 0xc7, 0x15, 0xcb, 0xfb, 0x85, 0xb4, 0x5b, 0x76,
+	0x43, 0x4f, 0x60, 0x71, 0x3f, 0x8c, 0x8f, 0xbc, 0x50, 0xef, 0xd3, 0x80, 0xe7, 0x31, 0x4c, 0x31,
+	0x89, 0x9d, 0x31, 0x65, 0xb5, 0x54, 0x09, 0x99, 0x2c, 0xfd, 0xb5, 0x01, 0xe6, 0x43, 0x94, 0x1e,
+	0x0b, 0x31, 0x18, 0x72, 0xce, 0x61, 0xae, 0x5d, 0xc1, 0x1a, 0x3b, 0xc5, 0x05, 0xfd, 0x72, 0xe9,
+	0x4f, 0xfc, 0x57, 0x9d, 0x2e, 0x84, 0x6b, 0x09, 0xf2, 0x58, 0x30, 0x19, 0x27, 0x0c, 0x85, 0xd9,
+	0x18, 0x47, 0x4c, 0x6e, 0xae, 0xd8, 0x77, 0x2b, 0xea, 0xc4, 0x83, 0xab, 0x7e, 0xd8, 0x15, 0x12,
+	0x13, 0x61, 0x4e, 0x2a, 0x4f, 0x7b, 0x2f, 0xe6, 0x69, 0x37, 0x53, 0x73, 0x0b, 0x59, 0xba, 0x01,
+	0xb7, 0x0f, 0x98, 0x90, 0x3a, 0xd0, 0x03, 0x16, 0x1d, 0x8b, 0xbc, 0x95, 0x8c, 0x78, 0x2d, 0xb6,
+	0x7e, 0xb9, 0x0e, 0x73, 0xda, 0xf6, 0x10, 0x93, 0x1e, 0xf3, 0x91, 0x7c, 0x67, 0xc0, 0x6c, 0xd6,
+	0x6b, 0x55, 0x6f, 0x23, 0xd4, 0xce, 0x8f, 0xe3, 0xda, 0x6e, 0x6c, 0xdd, 0x1d, 0x69, 0x53, 0xf4,
+	0x93, 0x37, 0xbe, 0xfa, 0xe3, 0xaf, 0x67, 0x13, 0x5b, 0x74, 0x43, 0x1d, 0xdd, 0xbd, 0xcd, 0xfc,
+	0x80, 0x17, 0xce, 0xa9, 0x1e, 0x9d, 0x39, 0x69, 0x17, 0x16, 0xce, 0x69, 0xfa, 0x73, 0xe6, 0xa8,
+	0xbe, 0xf9, 0x96, 0xb1, 0x4e, 0xbe, 0x31, 0x60, 0x36, 0x3b, 0x66, 0x9e, 0x07, 0x53, 0x39, 0x88,
+	0xac, 0xc5, 0xc2, 0xa6, 0xda, 0xd5, 0xde, 0x56, 0x14, 0xaf, 0xaf, 0x6f, 0xff, 0x2b, 0x0a, 0xe7,
+	0x94, 0x79, 0xf2, 0x8c, 0x7c, 0x6f, 0xc0, 0x74, 0x16, 0x33, 0x19, 0x0a, 0xb6, 0x9a, 0x8b, 0xb1,
+	0x55, 0x29, 0xbd, 0xa3, 0x80, 0x6f, 0xd1, 0xf9, 0x8b, 0xc0, 0x69, 0x66, 0xbe, 0x36, 0x60, 0x32,
+	0xdd, 0x69, 0x72, 0xeb, 0x22, 0x8e, 0x6a, 0x82, 0xd6, 0xc1, 0xb8, 0x30, 0x52, 0x27, 0xd4, 0x54,
+	0x28, 0x84, 0x0c, 0xa1, 0x90, 0x13, 0x20, 0xfb, 0x28, 0x2f, 0xb4, 0x8d, 0x3a, 0xa8, 0x97, 0x8b,
+	0xe9, 0xba, 0x3e, 0x43, 0x5b, 0xca, 0x13, 0x25, 0xcd, 0xe1, 0x5d, 0x4a, 0x2b, 0xf6, 0xcc, 0x09,
+	0xf4, 0x4a, 0xf2, 0xad, 0x01, 0x8d, 0x7d, 0xac, 0xf5, 0x35, 0xbe, 0x7d, 0x58, 0x55, 0x48, 0x4b,
+	0xe4, 0x76, 0x0d, 0x12, 0x39, 0x85, 0x1b, 0xfb, 0x28, 0xab, 0x5d, 0xbb, 0x0e, 0x6b, 0xb5, 0x98,
+	0x1e, 0xdd, 0xe5, 0xa9, 0xad, 0xbc, 0xb5, 0xc8, 0x5a, 0x5d, 0x02, 0xb2, 0x36, 0x59, 0x6c, 0xc0,
+	0xcf, 0x06, 0x4c, 0x67, 0x77, 0x86, 0xe1, 0xca, 0xac, 0xdc, 0x25, 0xc6, 0x98, 0x91, 0x6d, 0xc5,
+	0xb8, 0x61, 0xb5, 0x6a, 0x5f, 0x25, 0xbb, 0x83, 0xd2, 0x0b, 0x3c, 0xe9, 0xd9, 0x0a, 0x3a, 0xad,
+	0xd8, 0x4f, 0x60, 0x3a, 0x7b, 0x51, 0xeb, 0x52, 0x53, 0xf7, 0xe2, 0xea, 0xfc, 0xaf, 0xd7, 0xe6,
+	0xff, 0x31, 0x40, 0x5a, 0xa5, 0x7b, 0xea, 0x02, 0x5d, 0xa7, 0x7e, 0xc3, 0xd6, 0x17, 0x6c, 0x65,
+	0xa6, 0xaa, 0x7a, 0x4d, 0x09, 0x37, 0xc9, 0x4a, 0x5d, 0xaa, 0xb3, 0x15, 0xe4, 0x14, 0x6e, 0xee,
+	0xa3, 0x2c, 0x5d, 0x11, 0x0e, 0x65, 0x9a, 0xee, 0xa5, 0xc2, 0xd1, 0xc5, 0x2b, 0x88, 0xb5, 0x3c,
+	0xea, 0xaf, 0x22, 0xa0, 0xd7, 0x94, 0xdf, 0x7b, 0xe4, 0x95, 0x3a, 0xbf, 0xa2, 0x1f, 0xf9, 0xfa,
+	0xa6, 0x43, 0x7e, 0x34, 0xb2, 0x96, 0x3e, 0xe2, 0xa6, 0x42, 0x06, 0xef, 0x53, 0xdd, 0x65, 0xc8,
+	0x7a, 0xf5, 0x79, 0x26, 0x05, 0xd1, 0x03, 0x45, 0x64, 0x93, 0xfb, 0x97, 0x20, 0x72, 0xba, 0x5a,
+	0x88, 0x70, 0x98, 0x49, 0xc9, 0xd4, 0x29, 0x43, 0x9a, 0x85, 0xa3, 0x9a, 0x03, 0xc8, 0xb2, 0x2a,
+	0x75, 0xa5, 0xff, 0xd2, 0x00, 0xf7, 0x14, 0xc0, 0x2a, 0xb9, 0x5b, 0x07, 0x10, 0xa6, 0xe6, 0x3b,
+	0x3b, 0xbf, 0x9d, 0xaf, 0x18, 0xbf, 0x9f, 0xaf, 0x18, 0x7f, 0x9e, 0xaf, 0x18, 0x9f, 0x3e, 0xb8,
+	0xdc, 0x27, 0x9f, 0x1f, 0x32, 0x8c, 0x8a, 0xaf, 0xca, 0xa3, 0x69, 0xf5, 0x85, 0xb5, 0xfd, 0xf7,
+	0x00, 0xda, 0x06, 0x79, 0x3f, 0x76, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -802,6 +912,8 @@ type ProjectServiceClient interface {
 	ListEvents(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*events.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(ctx context.Context, in *SyncWindowsQuery, opts ...grpc.CallOption) (*SyncWindowsResponse, error)
+	// ListUpcomingSyncWindows returns the periods in which the sync windows of a project are active in the coming days
+	ListUpcomingSyncWindows(ctx context.Context, in *UpcomingSyncWindowsQuery, opts ...grpc.CallOption) (*UpcomingSyncWindowsResponse, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error)
 }
@@ -913,6 +1025,15 @@ func (c *projectServiceClient) GetSyncWindowsState(ctx context.Context, in *Sync
 	return out, nil
 }
 
+func (c *projectServiceClient) ListUpcomingSyncWindows(ctx context.Context, in *UpcomingSyncWindowsQuery, opts ...grpc.CallOption) (*UpcomingSyncWindowsResponse, error) {
+	out := new(UpcomingSyncWindowsResponse)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/ListUpcomingSyncWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error) {
 	out := new(application.LinksResponse)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/ListLinks", in, out, opts...)
@@ -946,6 +1067,8 @@ type ProjectServiceServer interface {
 	ListEvents(context.Context, *ProjectQuery) (*events.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(context.Context, *SyncWindowsQuery) (*SyncWindowsResponse, error)
+	// ListUpcomingSyncWindows returns the periods in which the sync windows of a project are active in the coming days
+	ListUpcomingSyncWindows(context.Context, *UpcomingSyncWindowsQuery) (*UpcomingSyncWindowsResponse, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(context.Context, *ListProjectLinksRequest) (*application.LinksResponse, error)
 }
@@ -987,6 +1110,9 @@ func (*UnimplementedProjectServiceServer) ListEvents(ctx context.Context, req *P
 func (*UnimplementedProjectServiceServer) GetSyncWindowsState(ctx context.Context, req *SyncWindowsQuery) (*SyncWindowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSyncWindowsState not implemented")
 }
+func (*UnimplementedProjectServiceServer) ListUpcomingSyncWindows(ctx context.Context, req *UpcomingSyncWindowsQuery) (*UpcomingSyncWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUpcomingSyncWindows not implemented")
+}
 func (*UnimplementedProjectServiceServer) ListLinks(ctx context.Context, req *ListProjectLinksRequest) (*application.LinksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
 }
@@ -1193,6 +1319,24 @@ func _ProjectService_GetSyncWindowsState_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_ListUpcomingSyncWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpcomingSyncWindowsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListUpcomingSyncWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/ListUpcomingSyncWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListUpcomingSyncWindows(ctx, req.(*UpcomingSyncWindowsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectLinksRequest)
 	if err := dec(in); err != nil {
@@ -1259,6 +1403,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSyncWindowsState",
 			Handler:    _ProjectService_GetSyncWindowsState_Handler,
 		},
+		{
+			MethodName: "ListUpcomingSyncWindows",
+			Handler:    _ProjectService_ListUpcomingSyncWindows_Handler,
+		},
 		{
 			MethodName: "ListLinks",
 			Handler:    _ProjectService_ListLinks_Handler,
@@ -1639,6 +1787,86 @@ func (m *SyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpcomingSyncWindowsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpcomingSyncWindowsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpcomingSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Days != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.Days))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpcomingSyncWindowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpcomingSyncWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpcomingSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Occurrences) > 0 {
+		for iNdEx := len(m.Occurrences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Occurrences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProject(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GlobalProjectsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1977,6 +2205,43 @@ func (m *SyncWindowsResponse) Size() (n int) {
 	return n
 }
 
+func (m *UpcomingSyncWindowsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.Days != 0 {
+		n += 1 + sovProject(uint64(m.Days))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpcomingSyncWindowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Occurrences) > 0 {
+		for _, e := range m.Occurrences {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *GlobalProjectsResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2994,6 +3259,193 @@ func (m *SyncWindowsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UpcomingSyncWindowsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpcomingSyncWindowsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpcomingSyncWindowsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
+			}
+			m.Days = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Days |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpcomingSyncWindowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpcomingSyncWindowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpcomingSyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Occurrences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Occurrences = append(m.Occurrences, &v1alpha1.SyncWindowOccurrence{})
+			if err := m.Occurrences[len(m.Occurrences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GlobalProjectsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
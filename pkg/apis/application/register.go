@@ -24,4 +24,11 @@ const (
 	ApplicationSetShortName string = "appset"
 	ApplicationSetPlural    string = "applicationsets"
 	ApplicationSetFullName  string = ApplicationSetPlural + "." + Group
+
+	// ApplicationSyncRecord constants
+	ApplicationSyncRecordKind      string = "ApplicationSyncRecord"
+	ApplicationSyncRecordSingular  string = "applicationsyncrecord"
+	ApplicationSyncRecordShortName string = "syncrecord"
+	ApplicationSyncRecordPlural    string = "applicationsyncrecords"
+	ApplicationSyncRecordFullName  string = ApplicationSyncRecordPlural + "." + Group
 )
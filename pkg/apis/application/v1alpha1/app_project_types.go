@@ -185,6 +185,7 @@ func (proj *AppProject) ValidateJWTTokenID(roleName string, id string) error {
 //   - Server and namespace fields must not contain invalid characters or "!"
 //   - Default service account must not be empty or contain disallowed characters
 //   - Server/namespace values must compile as valid glob patterns
+//   - Resource service accounts must have a kind, valid glob patterns and a valid service account
 //   - Each (server/namespace) combination must be unique
 func (proj *AppProject) ValidateProject() error {
 	destKeys := make(map[string]bool)
@@ -312,6 +313,21 @@ func (proj *AppProject) ValidateProject() error {
 			return status.Errorf(codes.InvalidArgument, "namespace has an invalid format, '%s'", destServiceAcct.Namespace)
 		}
 
+		for _, resourceServiceAcct := range destServiceAcct.ResourceServiceAccounts {
+			if resourceServiceAcct.Kind == "" {
+				return status.Errorf(codes.InvalidArgument, "resourceServiceAccount of destination '%s/%s' has no kind", destServiceAcct.Server, destServiceAcct.Namespace)
+			}
+			for _, pattern := range []string{resourceServiceAcct.Group, resourceServiceAcct.Kind, resourceServiceAcct.Namespace} {
+				if _, err := globutil.Compile(pattern); err != nil {
+					return status.Errorf(codes.InvalidArgument, "resourceServiceAccount has an invalid format, '%s'", pattern)
+				}
+			}
+			if strings.Trim(resourceServiceAcct.ServiceAccount, " ") == "" ||
+				strings.ContainsAny(resourceServiceAcct.ServiceAccount, serviceAccountDisallowedCharSet) {
+				return status.Errorf(codes.InvalidArgument, "serviceAccount has an invalid format, '%s'", resourceServiceAcct.ServiceAccount)
+			}
+		}
+
 		key := fmt.Sprintf("%s/%s", destServiceAcct.Server, destServiceAcct.Namespace)
 		if _, ok := destServiceAccts[key]; ok {
 			return status.Errorf(codes.InvalidArgument, "destinationServiceAccount '%s' already added", key)
@@ -319,6 +335,28 @@ func (proj *AppProject) ValidateProject() error {
 		destServiceAccts[key] = true
 	}
 
+	manifestPolicies := make(map[string]bool)
+	for _, policy := range proj.Spec.ManifestPolicies {
+		if policy.Name == "" {
+			return status.Errorf(codes.InvalidArgument, "manifest policy name is required")
+		}
+		if _, ok := manifestPolicies[policy.Name]; ok {
+			return status.Errorf(codes.AlreadyExists, "manifest policy '%s' already exists", policy.Name)
+		}
+		if strings.TrimSpace(policy.CEL) == "" {
+			return status.Errorf(codes.InvalidArgument, "manifest policy '%s' has no CEL expression", policy.Name)
+		}
+		manifestPolicies[policy.Name] = true
+	}
+
+	if proj.Spec.SourceIntegrity != nil && proj.Spec.SourceIntegrity.Git != nil {
+		for i, policy := range proj.Spec.SourceIntegrity.Git.Policies {
+			if policy != nil && policy.X509 != nil && len(policy.X509.Identities) == 0 {
+				return status.Errorf(codes.InvalidArgument, "x509 verification of git source integrity policy %d requires at least one identity", i)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -96,11 +96,37 @@ type ApplicationSetStrategy struct {
 }
 type ApplicationSetRolloutStrategy struct {
 	Steps []ApplicationSetRolloutStep `json:"steps,omitempty" protobuf:"bytes,1,opt,name=steps"`
+	// OnFailure allows specifying what happens when an Application of a step becomes Degraded, or the analysis of a step fails.
+	// accepts values "Halt" (default) and "Rollback"
+	// +kubebuilder:validation:Enum=Halt;Rollback;halt;rollback
+	OnFailure string `json:"onFailure,omitempty" protobuf:"bytes,2,opt,name=onFailure"`
 }
 
 type ApplicationSetRolloutStep struct {
 	MatchExpressions []ApplicationMatchExpression `json:"matchExpressions,omitempty" protobuf:"bytes,1,opt,name=matchExpressions"`
 	MaxUpdate        *intstr.IntOrString          `json:"maxUpdate,omitempty" protobuf:"bytes,2,opt,name=maxUpdate"`
+	// BakeTime is how long all the Applications of the step need to stay Healthy before the next step starts (e.g. "30m")
+	BakeTime string `json:"bakeTime,omitempty" protobuf:"bytes,3,opt,name=bakeTime"`
+	// Analysis needs to pass once the BakeTime elapses, before the next step starts
+	Analysis *ApplicationSetRolloutStepAnalysis `json:"analysis,omitempty" protobuf:"bytes,4,opt,name=analysis"`
+}
+
+// ApplicationSetRolloutStepAnalysis configures the analysis gating the next RollingSync step. Exactly one of the
+// analysis providers needs to be set.
+type ApplicationSetRolloutStepAnalysis struct {
+	// Web queries an HTTP endpoint
+	Web *ApplicationSetRolloutStepWebAnalysis `json:"web,omitempty" protobuf:"bytes,1,opt,name=web"`
+	// Interval is how long to wait before retrying an inconclusive analysis (e.g. "5m"). Defaults to 1m.
+	Interval string `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+}
+
+// ApplicationSetRolloutStepWebAnalysis queries the URL with a GET request. The analysis passes when the endpoint
+// responds with the 200 status code. It is inconclusive when the endpoint cannot be reached or responds with a 5xx
+// status code, and it fails otherwise.
+type ApplicationSetRolloutStepWebAnalysis struct {
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// TimeoutSeconds is the timeout of the request. Defaults to 10 seconds.
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,2,opt,name=timeoutSeconds"`
 }
 
 type ApplicationMatchExpression struct {
@@ -884,6 +910,9 @@ const (
 	ApplicationSetReasonApplicationSetRolloutError       = "ApplicationSetRolloutError"
 	ApplicationSetReasonInvalidRolloutConfig             = "ApplicationSetInvalidRolloutConfig"
 	ApplicationSetReasonValidRolloutConfig               = "ApplicationSetValidRolloutConfig"
+	ApplicationSetReasonApplicationSetRollingBack        = "ApplicationSetRollingBack"
+	ApplicationSetReasonApplicationSetRolledBack         = "ApplicationSetRolledBack"
+	ApplicationSetReasonApplicationSetRolloutPaused      = "ApplicationSetRolloutPaused"
 )
 
 // Represents resource health status
@@ -898,6 +927,10 @@ const (
 	ProgressiveSyncProgressing ProgressiveSyncStatusCode = "Progressing"
 	// Indicates that the application has reached an Healthy state in regards to the requested sync
 	ProgressiveSyncHealthy ProgressiveSyncStatusCode = "Healthy"
+	// Indicates that the rollout failed, and the application is being rolled back to its previous deployment
+	ProgressiveSyncRollingBack ProgressiveSyncStatusCode = "RollingBack"
+	// Indicates that the rollout failed, and the application has been rolled back to its previous deployment
+	ProgressiveSyncRolledBack ProgressiveSyncStatusCode = "RolledBack"
 )
 
 // ApplicationSetApplicationStatus contains details about each Application managed by the ApplicationSet
@@ -914,6 +947,37 @@ type ApplicationSetApplicationStatus struct {
 	Step string `json:"step" protobuf:"bytes,5,opt,name=step"`
 	// TargetRevision tracks the desired revisions the Application should be synced to.
 	TargetRevisions []string `json:"targetRevisions" protobuf:"bytes,6,opt,name=targetrevisions"`
+	// Gate tracks the bake time and analysis of the step, which need to pass before the next step starts
+	Gate *ApplicationSetStepGateStatus `json:"gate,omitempty" protobuf:"bytes,7,opt,name=gate"`
+	// PreviousDeploymentID is the ID of the history entry of the Application deployed before the rollout of the target revisions.
+	// It is the deployment the Application is rolled back to when the rollout fails.
+	PreviousDeploymentID *int64 `json:"previousDeploymentID,omitempty" protobuf:"varint,8,opt,name=previousDeploymentID"`
+}
+
+type ApplicationSetStepGatePhase string
+
+const (
+	// Indicates that the Applications of the step are Healthy, and the bake time has not elapsed yet
+	ApplicationSetStepGateBaking ApplicationSetStepGatePhase = "Baking"
+	// Indicates that the analysis of the step is in progress, or it was inconclusive and will be retried
+	ApplicationSetStepGateAnalyzing ApplicationSetStepGatePhase = "Analyzing"
+	// Indicates that the next step is allowed to start
+	ApplicationSetStepGatePassed ApplicationSetStepGatePhase = "Passed"
+	// Indicates that the analysis of the step failed, and the rollout does not proceed to the next step
+	ApplicationSetStepGateFailed ApplicationSetStepGatePhase = "Failed"
+)
+
+// ApplicationSetStepGateStatus contains details about the gate of the RollingSync step an Application is part of.
+// It is tracked only once all the Applications of the step are Healthy.
+type ApplicationSetStepGateStatus struct {
+	// Phase of the gate
+	Phase ApplicationSetStepGatePhase `json:"phase" protobuf:"bytes,1,opt,name=phase"`
+	// Message contains human-readable message indicating why the rollout is waiting
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// BakeUntil is the time the bake time of the step elapses
+	BakeUntil *metav1.Time `json:"bakeUntil,omitempty" protobuf:"bytes,3,opt,name=bakeUntil"`
+	// LastAnalysisTime is the time the analysis of the step was last performed
+	LastAnalysisTime *metav1.Time `json:"lastAnalysisTime,omitempty" protobuf:"bytes,4,opt,name=lastAnalysisTime"`
 }
 
 // ApplicationSetList contains a list of ApplicationSet
@@ -10,10 +10,11 @@ import (
 
 var (
 	// SchemeGroupVersion is group version used to register these objects
-	SchemeGroupVersion                   = schema.GroupVersion{Group: application.Group, Version: "v1alpha1"}
-	ApplicationSchemaGroupVersionKind    = schema.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationKind}
-	AppProjectSchemaGroupVersionKind     = schema.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: application.AppProjectKind}
-	ApplicationSetSchemaGroupVersionKind = schema.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationSetKind}
+	SchemeGroupVersion                          = schema.GroupVersion{Group: application.Group, Version: "v1alpha1"}
+	ApplicationSchemaGroupVersionKind           = schema.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationKind}
+	AppProjectSchemaGroupVersionKind            = schema.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: application.AppProjectKind}
+	ApplicationSetSchemaGroupVersionKind        = schema.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationSetKind}
+	ApplicationSyncRecordSchemaGroupVersionKind = schema.GroupVersionKind{Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationSyncRecordKind}
 )
 
 // Resource takes an unqualified resource and returns a Group-qualified GroupResource.
@@ -35,6 +36,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&AppProjectList{},
 		&ApplicationSet{},
 		&ApplicationSetList{},
+		&ApplicationSyncRecord{},
+		&ApplicationSyncRecordList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -7,7 +7,9 @@ import (
 
 type SourceIntegrity struct {
 	// Git - policies for git source verification
-	Git *SourceIntegrityGit `json:"git" protobuf:"bytes,1,name=git"` // A mandatory field until there are alternatives
+	Git *SourceIntegrityGit `json:"git,omitempty" protobuf:"bytes,1,opt,name=git"`
+	// OCI - policies for OCI and Helm OCI source verification
+	OCI *SourceIntegrityOCI `json:"oci,omitempty" protobuf:"bytes,2,opt,name=oci"`
 }
 
 type SourceIntegrityGit struct {
@@ -18,7 +20,11 @@ type SourceIntegrityGitPolicy struct {
 	// List of repository criteria restricting repositories the policy will apply to
 	Repos []SourceIntegrityGitPolicyRepo `json:"repos" protobuf:"bytes,1,name=repos"`
 	// Verify GPG commit/tag signatures
-	GPG *SourceIntegrityGitPolicyGPG `json:"gpg" protobuf:"bytes,2,name=gpg"` // A mandatory field until there are alternatives
+	GPG *SourceIntegrityGitPolicyGPG `json:"gpg,omitempty" protobuf:"bytes,2,opt,name=gpg"`
+	// Verify SSH commit/tag signatures
+	SSH *SourceIntegrityGitPolicySSH `json:"ssh,omitempty" protobuf:"bytes,3,opt,name=ssh"`
+	// Verify X.509 commit/tag signatures, such as the ones made by gitsign or gpgsm
+	X509 *SourceIntegrityGitPolicyX509 `json:"x509,omitempty" protobuf:"bytes,4,opt,name=x509"`
 }
 
 type SourceIntegrityGitPolicyRepo struct {
@@ -53,6 +59,62 @@ type SourceIntegrityGitPolicyGPG struct {
 	Keys []string `json:"keys" protobuf:"bytes,3,name=keys"`
 }
 
+// SourceIntegrityGitPolicySSH verifies that the commit(s) are correctly signed by one of the SSH keys listed in Keys.
+//
+// When a policy configures multiple verifiers, each commit or tag is verified by the one matching the format of its
+// signature, and the most thorough of the configured modes is applied to the history.
+type SourceIntegrityGitPolicySSH struct {
+	Mode SourceIntegrityGitPolicyGPGMode `json:"mode" protobuf:"bytes,1,name=mode"`
+	// List of public SSH keys to trust, in the authorized_keys or the allowed_signers format. The principals of the
+	// allowed_signers lines are matched against the e-mail of the committer or tagger, and the namespaces of the lines must
+	// include "git" when they are restricted.
+	Keys []string `json:"keys" protobuf:"bytes,2,name=keys"`
+}
+
+// SourceIntegrityGitPolicyX509 verifies that the commit(s) are correctly signed by an X.509 certificate issued to one of
+// the Identities by one of the CAs listed in CARoots. The certificate must be valid at the time of verification, or at
+// the time of an RFC 3161 timestamp of the signature issued by one of the TimestampAuthorities. The latter is needed
+// for the short-lived certificates issued to gitsign by Fulcio.
+//
+// The Rekor transparency log is not consulted for the signatures made by gitsign.
+type SourceIntegrityGitPolicyX509 struct {
+	Mode SourceIntegrityGitPolicyGPGMode `json:"mode" protobuf:"bytes,1,name=mode"`
+	// List of PEM encoded CA certificates to trust. Intermediate certificates not included in the signatures need to be listed as well.
+	CARoots []string `json:"caRoots" protobuf:"bytes,2,name=caRoots"`
+	// List of signer identities to trust, globs matched against the e-mail and URI subject alternative names of the
+	// signing certificate. At least one identity is required.
+	Identities []string `json:"identities,omitempty" protobuf:"bytes,3,opt,name=identities"`
+	// List of PEM encoded certificates of the RFC 3161 timestamp authorities to trust. The signing certificate is
+	// verified at the time of a timestamp issued by one of them, and at the time of verification otherwise.
+	TimestampAuthorities []string `json:"timestampAuthorities,omitempty" protobuf:"bytes,4,opt,name=timestampAuthorities"`
+}
+
+type SourceIntegrityOCI struct {
+	Policies []*SourceIntegrityOCIPolicy `json:"policies" protobuf:"bytes,1,name=policies"`
+}
+
+type SourceIntegrityOCIPolicy struct {
+	// List of repository criteria restricting repositories the policy will apply to
+	Repos []SourceIntegrityOCIPolicyRepo `json:"repos" protobuf:"bytes,1,name=repos"`
+	// Verify cosign signatures of the artifact digest
+	Cosign *SourceIntegrityOCIPolicyCosign `json:"cosign" protobuf:"bytes,2,name=cosign"` // A mandatory field until there are alternatives
+}
+
+type SourceIntegrityOCIPolicyRepo struct {
+	// URL specifier, glob. For Helm OCI sources, it is matched against the repository URL without the chart name.
+	URL string `json:"url" protobuf:"bytes,1,name=url"`
+}
+
+// SourceIntegrityOCIPolicyCosign verifies that the artifact digest has a cosign signature, stored in the same repository
+// under the `sha256-<digest>.sig` tag, made by one of the public keys listed in Keys.
+//
+// Only key-based signatures are supported. Keyless signatures relying on Fulcio certificates and the Rekor transparency
+// log are not verified.
+type SourceIntegrityOCIPolicyCosign struct {
+	// List of PEM encoded public keys (ECDSA, RSA or Ed25519) to trust.
+	Keys []string `json:"keys" protobuf:"bytes,1,name=keys"`
+}
+
 // CacheKey computes a unique string key to be used for caching.
 // The key's value will change if any of the fields in SourceIntegrity change.
 func (s *SourceIntegrity) CacheKey() string {
@@ -0,0 +1,54 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApplicationSyncRecordList is list of ApplicationSyncRecord resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ApplicationSyncRecordList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []ApplicationSyncRecord `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ApplicationSyncRecord is the durable record of the outcome of a sync operation of an application. Unlike the revision
+// history of the application, it holds the result of every resource and hook of the sync, and is kept for failed syncs
+// too. Records are owned by their application, and are deleted along with it.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=applicationsyncrecords,shortName=syncrecord;syncrecords
+// +kubebuilder:printcolumn:name="Application",type=string,JSONPath=`.spec.application`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.spec.operationState.phase`
+// +kubebuilder:printcolumn:name="Started",type=date,JSONPath=`.spec.operationState.startedAt`
+// +kubebuilder:printcolumn:name="Revision",type=string,JSONPath=`.spec.operationState.syncResult.revision`,priority=10
+type ApplicationSyncRecord struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              ApplicationSyncRecordSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// ApplicationSyncRecordSpec holds the outcome of a sync operation
+type ApplicationSyncRecordSpec struct {
+	// Application is the name of the synced application
+	Application string `json:"application" protobuf:"bytes,1,opt,name=application"`
+	// Project is the project of the application at the time of the sync
+	Project string `json:"project,omitempty" protobuf:"bytes,2,opt,name=project"`
+	// OperationState is the final state of the sync operation, including the initiator of the operation, its duration
+	// and the results of the synced resources and hooks
+	OperationState OperationState `json:"operationState" protobuf:"bytes,3,opt,name=operationState"`
+}
+
+// IsInitiatedBy returns true if the sync was initiated by the given user, or automatically if the initiator is
+// "automated"
+func (r *ApplicationSyncRecord) IsInitiatedBy(initiator string) bool {
+	initiatedBy := r.Spec.OperationState.Operation.InitiatedBy
+	if initiator == SyncRecordInitiatorAutomated {
+		return initiatedBy.Automated
+	}
+	return initiatedBy.Username == initiator
+}
+
+// SyncRecordInitiatorAutomated is the initiator of the syncs which were initiated automatically
+const SyncRecordInitiatorAutomated = "automated"
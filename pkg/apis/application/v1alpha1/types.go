@@ -100,6 +100,38 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// DependsOn lists the applications which must be synced and healthy before the application is synced
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,10,rep,name=dependsOn"`
+}
+
+// ApplicationDependency is a reference to an application which another application depends on
+type ApplicationDependency struct {
+	// Name is the name of the application
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the application. Defaults to the namespace of the dependent application.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+}
+
+// QualifiedName returns the dependency in the <namespace>/<name> form, or <name> if the namespace is not set
+func (d ApplicationDependency) QualifiedName() string {
+	if d.Namespace == "" {
+		return d.Name
+	}
+	return d.Namespace + "/" + d.Name
+}
+
+// GetDependencies returns the dependencies of the application, with the namespace of the dependencies defaulted to the
+// namespace of the application
+func (app *Application) GetDependencies() []ApplicationDependency {
+	var dependencies []ApplicationDependency
+	for _, dependency := range app.Spec.DependsOn {
+		if dependency.Namespace == "" {
+			dependency.Namespace = app.Namespace
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -131,6 +163,10 @@ type ResourceIgnoreDifferences struct {
 	// ManagedFieldsManagers is a list of trusted managers. Fields mutated by those managers will take precedence over the
 	// desired state defined in the SCM and won't be displayed in diffs
 	ManagedFieldsManagers []string `json:"managedFieldsManagers,omitempty" protobuf:"bytes,7,opt,name=managedFieldsManagers"`
+	// CELExpressions is a list of CEL expressions which return the JSON pointers of the fields to ignore. The expressions
+	// can refer to the live and target states of the resource as `live` and `target`, and to the live state of all the
+	// resources of the application as `related`.
+	CELExpressions []string `json:"celExpressions,omitempty" protobuf:"bytes,8,opt,name=celExpressions"`
 }
 
 // EnvEntry represents an entry in the application's environment
@@ -258,9 +294,13 @@ func (spec *ApplicationSpec) GetSource() ApplicationSource {
 }
 
 // GetHydrateToSource returns the hydrateTo source if it exists, otherwise returns the sync source.
-// HydrateTo only specifies a different branch; it inherits repo and path from syncSource.
+// HydrateTo only specifies a different branch or an OCI repository; it inherits the path (and the repo for a branch)
+// from syncSource.
 func (spec *ApplicationSpec) GetHydrateToSource() ApplicationSource {
 	if spec.SourceHydrator != nil {
+		if spec.SourceHydrator.HydrateTo.IsOCI() {
+			return spec.SourceHydrator.GetSyncSource()
+		}
 		targetRevision := spec.SourceHydrator.SyncSource.TargetBranch
 		repoURL := spec.SourceHydrator.SyncSource.RepoURL
 		if repoURL == "" {
@@ -433,10 +473,23 @@ type SourceHydrator struct {
 	// HydrateTo specifies an optional "staging" location to push hydrated manifests to. An external system would then
 	// have to move manifests to the SyncSource, e.g. by pull request.
 	HydrateTo *HydrateTo `json:"hydrateTo,omitempty" protobuf:"bytes,3,opt,name=hydrateTo"`
+	// PullRequest specifies that hydrated manifests should be proposed by a pull request, rather than being committed
+	// directly to the branch they are hydrated to.
+	PullRequest *HydratorPullRequest `json:"pullRequest,omitempty" protobuf:"bytes,4,opt,name=pullRequest"`
 }
 
-// GetSyncSource gets the source from which we should sync when a source hydrator is configured.
+// GetSyncSource gets the source from which we should sync when a source hydrator is configured. When the hydrated
+// manifests are pushed to an OCI repository, this is the tag moved to the most recently hydrated artifact. Since the
+// tag is mutable, the application syncs the tag pinned to the digest of this artifact instead, see
+// Application.GetHydratedOCIRevision.
 func (s SourceHydrator) GetSyncSource() ApplicationSource {
+	if s.HydrateTo.IsOCI() {
+		return ApplicationSource{
+			RepoURL:        s.HydrateTo.OCI.RepoURL,
+			Path:           s.SyncSource.Path,
+			TargetRevision: s.HydrateTo.OCI.GetTag(),
+		}
+	}
 	repoURL := s.SyncSource.RepoURL
 	if repoURL == "" {
 		repoURL = s.DrySource.RepoURL
@@ -448,6 +501,25 @@ func (s SourceHydrator) GetSyncSource() ApplicationSource {
 	}
 }
 
+// GetHydratedOCIRevision returns the revision to sync when the hydrated manifests of the application are pushed to an
+// OCI repository: the tag of the sync source pinned to the digest of the artifact pushed by the last successful hydrate
+// operation, e.g. latest@sha256:..., so that the application never syncs another artifact the tag was moved to. It
+// returns an empty string if the hydrated manifests are not pushed to an OCI repository, or if no artifact was pushed
+// to the current OCI repository and tag yet.
+func (app *Application) GetHydratedOCIRevision() string {
+	hydrator := app.Spec.SourceHydrator
+	if hydrator == nil || !hydrator.HydrateTo.IsOCI() {
+		return ""
+	}
+	operation := app.Status.SourceHydrator.LastSuccessfulOperation
+	if operation == nil || operation.HydratedSHA == "" || !operation.SourceHydrator.HydrateTo.IsOCI() ||
+		operation.SourceHydrator.HydrateTo.OCI.RepoURL != hydrator.HydrateTo.OCI.RepoURL ||
+		operation.SourceHydrator.HydrateTo.OCI.GetTag() != hydrator.HydrateTo.OCI.GetTag() {
+		return ""
+	}
+	return hydrator.HydrateTo.OCI.GetTag() + "@" + operation.HydratedSHA
+}
+
 // GetDrySource gets the dry source when a source hydrator is configured.
 func (s SourceHydrator) GetDrySource() ApplicationSource {
 	return ApplicationSource{
@@ -463,7 +535,7 @@ func (s SourceHydrator) GetDrySource() ApplicationSource {
 
 // DeepEquals returns true if the SourceHydrator is deeply equal to the given SourceHydrator.
 func (s SourceHydrator) DeepEquals(hydrator SourceHydrator) bool {
-	return s.DrySource.Equals(hydrator.DrySource) && s.SyncSource == hydrator.SyncSource && s.HydrateTo.DeepEquals(hydrator.HydrateTo)
+	return s.DrySource.Equals(hydrator.DrySource) && s.SyncSource == hydrator.SyncSource && s.HydrateTo.DeepEquals(hydrator.HydrateTo) && s.PullRequest.DeepEquals(hydrator.PullRequest)
 }
 
 // DrySource specifies a location for dry "don't repeat yourself" manifest source information.
@@ -519,9 +591,35 @@ type SyncSource struct {
 
 // HydrateTo specifies a branch to which hydrated manifests should be pushed as a "staging area" before being moved to
 // the SyncSource. The repository and path are inherited from SyncSource.
+// Alternatively, it specifies an OCI repository to which hydrated manifests should be pushed as artifacts.
 type HydrateTo struct {
-	// TargetBranch is the branch to which hydrated manifests should be committed
-	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
+	// TargetBranch is the branch to which hydrated manifests should be committed. It is required unless OCI is set.
+	TargetBranch string `json:"targetBranch,omitempty" protobuf:"bytes,1,opt,name=targetBranch"`
+	// OCI specifies an OCI repository to which hydrated manifests should be pushed instead of a git branch. The
+	// hydrated manifests of each dry commit are pushed as an immutable artifact with its own content tag, and the
+	// application syncs from this artifact rather than from the SyncSource branch.
+	OCI *HydrateToOCI `json:"oci,omitempty" protobuf:"bytes,2,opt,name=oci"`
+}
+
+// HydrateToOCI specifies an OCI repository to which hydrated manifests should be pushed. The path of the hydrated
+// manifests in the artifact is inherited from SyncSource.
+type HydrateToOCI struct {
+	// RepoURL is the URL of the OCI repository to which the artifacts are pushed, e.g. oci://registry.example.com/org/repo
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,name=repoURL"`
+	// Tag is moved to the artifact of the most recently hydrated dry commit, and is the tag the application syncs
+	// from. Defaults to "latest".
+	Tag string `json:"tag,omitempty" protobuf:"bytes,2,opt,name=tag"`
+}
+
+// DefaultHydrateToOCITag is the tag moved to the most recently hydrated artifact when HydrateToOCI.Tag is not set.
+const DefaultHydrateToOCITag = "latest"
+
+// GetTag returns the tag moved to the most recently hydrated artifact.
+func (in *HydrateToOCI) GetTag() string {
+	if in.Tag == "" {
+		return DefaultHydrateToOCITag
+	}
+	return in.Tag
 }
 
 // DeepEquals returns true if the HydrateTo is deeply equal to the given HydrateTo.
@@ -533,8 +631,143 @@ func (in *HydrateTo) DeepEquals(to *HydrateTo) bool {
 		// We already know in is not nil.
 		return false
 	}
+	if in.TargetBranch != to.TargetBranch {
+		return false
+	}
+	if in.OCI == nil || to.OCI == nil {
+		return in.OCI == to.OCI
+	}
 	// Compare de-referenced structs.
-	return *in == *to
+	return *in.OCI == *to.OCI
+}
+
+// IsOCI returns true if the hydrated manifests are pushed to an OCI repository.
+func (in *HydrateTo) IsOCI() bool {
+	return in != nil && in.OCI != nil
+}
+
+// HydratorPullRequestProvider is the SCM provider with which the source hydrator opens pull requests.
+type HydratorPullRequestProvider string
+
+const (
+	HydratorPullRequestProviderGitHub          HydratorPullRequestProvider = "github"
+	HydratorPullRequestProviderGitLab          HydratorPullRequestProvider = "gitlab"
+	HydratorPullRequestProviderGitea           HydratorPullRequestProvider = "gitea"
+	HydratorPullRequestProviderBitbucketServer HydratorPullRequestProvider = "bitbucketServer"
+	HydratorPullRequestProviderBitbucketCloud  HydratorPullRequestProvider = "bitbucketCloud"
+	HydratorPullRequestProviderAzureDevOps     HydratorPullRequestProvider = "azureDevOps"
+)
+
+// HydratorPullRequest specifies that hydrated manifests should be pushed to a short-lived branch, and proposed by a pull
+// request against the branch they are hydrated to. The pull request is opened with the write credentials of the
+// repository.
+type HydratorPullRequest struct {
+	// Provider is the SCM provider hosting the repository.
+	// +kubebuilder:validation:Enum=github;gitlab;gitea;bitbucketServer;bitbucketCloud;azureDevOps
+	Provider HydratorPullRequestProvider `json:"provider" protobuf:"bytes,1,name=provider"`
+	// API is the URL of the API of the SCM provider. It defaults to the public API of GitHub, GitLab, Bitbucket Cloud
+	// and Azure DevOps, and is required for Gitea and Bitbucket Server. It must be hosted by the host of the repository,
+	// or by its "api." subdomain.
+	API string `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
+	// Repository identifies the repository at the SCM provider: "owner/repo" for GitHub, Gitea and Bitbucket Cloud, the
+	// project path for GitLab, "project/repo" for Bitbucket Server, and "organization/project/repo" for Azure DevOps.
+	// It defaults to the path of the repository URL.
+	Repository string `json:"repository,omitempty" protobuf:"bytes,3,opt,name=repository"`
+	// Branch is the short-lived branch to which hydrated manifests are pushed. It defaults to the branch they are
+	// hydrated to, prefixed with "argocd-hydrator/". It must differ from the sync, target and dry source branches, and
+	// is only force-pushed when it is prefixed with "argocd-hydrator/".
+	Branch string `json:"branch,omitempty" protobuf:"bytes,4,opt,name=branch"`
+}
+
+// DefaultHydratorPullRequestBranchPrefix prefixes the target branch to name the pull request branch when
+// HydratorPullRequest.Branch is not set.
+const DefaultHydratorPullRequestBranchPrefix = "argocd-hydrator/"
+
+// GetBranch returns the branch from which a pull request is opened against the target branch.
+func (in *HydratorPullRequest) GetBranch(targetBranch string) string {
+	if in.Branch == "" {
+		return DefaultHydratorPullRequestBranchPrefix + targetBranch
+	}
+	return in.Branch
+}
+
+// IsHydratorBranch returns true if the pull request branch is named after the target branch by the source hydrator, in
+// which case the hydrator owns the branch and may reset it.
+func (in *HydratorPullRequest) IsHydratorBranch(targetBranch string) bool {
+	return strings.HasPrefix(in.GetBranch(targetBranch), DefaultHydratorPullRequestBranchPrefix)
+}
+
+// ValidateBranch checks that the pull request branch is a plain branch name, and is neither the target branch nor one of
+// the other given branches, e.g. the sync or dry source branch, whose history would be overwritten by the push.
+func (in *HydratorPullRequest) ValidateBranch(targetBranch string, branches ...string) error {
+	branch := in.GetBranch(targetBranch)
+	if branch == "HEAD" || strings.HasPrefix(branch, "refs/") || strings.HasPrefix(branch, "+") || strings.HasPrefix(branch, "-") || strings.ContainsAny(branch, ": ") {
+		return fmt.Errorf("pull request branch %q must be a branch name", branch)
+	}
+	for _, b := range append([]string{targetBranch}, branches...) {
+		if b != "" && strings.TrimPrefix(b, "refs/heads/") == branch {
+			return fmt.Errorf("pull request branch %q must differ from the branches of the source hydrator", branch)
+		}
+	}
+	return nil
+}
+
+// defaultHydratorPullRequestAPIs are the public APIs of the SCM providers, used when HydratorPullRequest.API is not set.
+var defaultHydratorPullRequestAPIs = map[HydratorPullRequestProvider]string{
+	HydratorPullRequestProviderGitHub:          "https://api.github.com",
+	HydratorPullRequestProviderGitLab:          "https://gitlab.com",
+	HydratorPullRequestProviderGitea:           "",
+	HydratorPullRequestProviderBitbucketServer: "",
+	HydratorPullRequestProviderBitbucketCloud:  "https://api.bitbucket.org",
+	HydratorPullRequestProviderAzureDevOps:     "https://dev.azure.com",
+}
+
+// ValidateAPI checks that the API with which pull requests are opened is hosted by the host of the repository, either
+// on the same host or on its "api." subdomain, e.g. https://api.github.com for https://github.com/owner/repo. Pull
+// requests are opened with the write credentials of the repository, which must not be sent to any other host.
+func (in *HydratorPullRequest) ValidateAPI(repoURL string) error {
+	api, ok := defaultHydratorPullRequestAPIs[in.Provider]
+	if !ok {
+		return fmt.Errorf("unsupported pull request provider %q", in.Provider)
+	}
+	if in.API != "" {
+		api = in.API
+	}
+	if api == "" {
+		return fmt.Errorf("the API of pull request provider %s is required", in.Provider)
+	}
+	apiURL, err := url.Parse(api)
+	if err != nil || (apiURL.Scheme != "https" && apiURL.Scheme != "http") || apiURL.Host == "" {
+		return fmt.Errorf("pull request API %q must be an http or https URL", api)
+	}
+	apiHost := strings.ToLower(apiURL.Hostname())
+	// SSH URLs may be served by a dedicated subdomain, e.g. ssh.dev.azure.com
+	repoHost := strings.TrimPrefix(getRepositoryHost(repoURL), "ssh.")
+	if repoHost == "" || (apiHost != repoHost && apiHost != "api."+repoHost) {
+		return fmt.Errorf("pull request API %q is not hosted by the host of repository %q", api, repoURL)
+	}
+	return nil
+}
+
+// getRepositoryHost returns the lower-cased host name of the repository URL, which can be an SCP-like SSH URL, e.g.
+// git@github.com:owner/repo.git.
+func getRepositoryHost(repoURL string) string {
+	if u, err := url.Parse(repoURL); err == nil && u.Host != "" {
+		return strings.ToLower(u.Hostname())
+	}
+	hostAndUser, _, _ := strings.Cut(repoURL, ":")
+	if _, host, found := strings.Cut(hostAndUser, "@"); found {
+		return strings.ToLower(host)
+	}
+	return strings.ToLower(hostAndUser)
+}
+
+// DeepEquals returns true if the HydratorPullRequest is deeply equal to the given HydratorPullRequest.
+func (in *HydratorPullRequest) DeepEquals(pr *HydratorPullRequest) bool {
+	if in == nil || pr == nil {
+		return in == pr
+	}
+	return *in == *pr
 }
 
 // RefreshType specifies how to refresh the sources of a given application
@@ -599,6 +832,30 @@ type ApplicationSourceHelm struct {
 	SkipTests bool `json:"skipTests,omitempty" protobuf:"bytes,14,opt,name=skipTests"`
 	// SkipSchemaValidation skips JSON schema validation (Helm's --skip-schema-validation)
 	SkipSchemaValidation bool `json:"skipSchemaValidation,omitempty" protobuf:"bytes,15,opt,name=skipSchemaValidation"`
+	// PostRenderer post-processes the manifests rendered by Helm with a Kustomize component or a config management
+	// plugin (Helm's --post-renderer)
+	PostRenderer *HelmPostRenderer `json:"postRenderer,omitempty" protobuf:"bytes,16,opt,name=postRenderer"`
+}
+
+// HelmPostRenderer post-processes the manifests rendered by Helm. Exactly one of Kustomize or Plugin must be set.
+type HelmPostRenderer struct {
+	// Kustomize applies a Kustomize component of the repository to the rendered manifests
+	Kustomize *HelmPostRendererKustomize `json:"kustomize,omitempty" protobuf:"bytes,1,opt,name=kustomize"`
+	// Plugin passes the rendered manifests to a config management plugin
+	Plugin *HelmPostRendererPlugin `json:"plugin,omitempty" protobuf:"bytes,2,opt,name=plugin"`
+}
+
+// HelmPostRendererKustomize holds the options of a Kustomize post-renderer
+type HelmPostRendererKustomize struct {
+	// Path is the path of the directory holding the Kustomize component, relative to the path of the application
+	Path string `json:"path" protobuf:"bytes,1,opt,name=path"`
+}
+
+// HelmPostRendererPlugin holds the options of a config management plugin post-renderer
+type HelmPostRendererPlugin struct {
+	// Name is the name of the config management plugin. The generate command of the plugin receives the rendered
+	// manifests on stdin, and writes the post-rendered manifests on stdout.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 }
 
 // HelmParameter is a parameter that's passed to helm template during manifest generation
@@ -680,7 +937,7 @@ func (ash *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
 
 // IsZero Returns true if the Helm options in an application source are considered zero
 func (ash *ApplicationSourceHelm) IsZero() bool {
-	return ash == nil || (ash.Version == "") && (ash.ReleaseName == "") && len(ash.ValueFiles) == 0 && len(ash.Parameters) == 0 && len(ash.FileParameters) == 0 && ash.ValuesIsEmpty() && !ash.PassCredentials && !ash.IgnoreMissingValueFiles && !ash.SkipCrds && !ash.SkipTests && !ash.SkipSchemaValidation && ash.KubeVersion == "" && len(ash.APIVersions) == 0 && ash.Namespace == ""
+	return ash == nil || (ash.Version == "") && (ash.ReleaseName == "") && len(ash.ValueFiles) == 0 && len(ash.Parameters) == 0 && len(ash.FileParameters) == 0 && ash.ValuesIsEmpty() && !ash.PassCredentials && !ash.IgnoreMissingValueFiles && !ash.SkipCrds && !ash.SkipTests && !ash.SkipSchemaValidation && ash.KubeVersion == "" && len(ash.APIVersions) == 0 && ash.Namespace == "" && ash.PostRenderer == nil
 }
 
 // KustomizeImage represents a Kustomize image definition in the format [old_image_name=]<image_name>:<image_tag>
@@ -1241,6 +1498,25 @@ type ApplicationStatus struct {
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
 	// SourceHydrator stores information about the current state of source hydration
 	SourceHydrator SourceHydratorStatus `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
+	// LastResourceDrift holds the last change of a managed resource made outside of a sync operation
+	LastResourceDrift *ResourceDrift `json:"lastResourceDrift,omitempty" protobuf:"bytes,15,opt,name=lastResourceDrift"`
+}
+
+// ResourceDrift is a change of a managed resource made outside of a sync operation, attributed to the field manager
+// which made it according to the managed fields of the resource
+type ResourceDrift struct {
+	Group     string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind      string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	Name      string `json:"name" protobuf:"bytes,4,opt,name=name"`
+	// Manager is the field manager which made the change, e.g. kubectl-edit or kubectl-client-side-apply
+	Manager string `json:"manager" protobuf:"bytes,5,opt,name=manager"`
+	// Operation is the type of the operation which made the change, either Apply or Update
+	Operation string `json:"operation,omitempty" protobuf:"bytes,6,opt,name=operation"`
+	// Diff is the JSON merge patch from the previous to the new state of the resource, which might be truncated
+	Diff string `json:"diff,omitempty" protobuf:"bytes,7,opt,name=diff"`
+	// DetectedAt is the time when the change was detected
+	DetectedAt metav1.Time `json:"detectedAt" protobuf:"bytes,8,opt,name=detectedAt"`
 }
 
 // SourceHydratorStatus contains information about the current state of source hydration
@@ -1252,6 +1528,35 @@ type SourceHydratorStatus struct {
 	// LastComparedDryRevision holds the resolved revision from the most recent dry source comparison.
 	// This is updated on every evaluation, even when hydration is skipped due to no changes.
 	LastComparedDryRevision string `json:"lastComparedDryRevision,omitempty" protobuf:"bytes,3,opt,name=lastComparedDryRevision"`
+	// PullRequest holds info about the pull request published by the most recent hydration which proposed changes
+	PullRequest *HydratorPullRequestStatus `json:"pullRequest,omitempty" protobuf:"bytes,4,opt,name=pullRequest"`
+}
+
+// HydratorPullRequestState is the state of a pull request after the hydration which published it.
+// +kubebuilder:validation:Enum=Opened;Updated
+type HydratorPullRequestState string
+
+const (
+	// HydratorPullRequestStateOpened means the hydration opened the pull request.
+	HydratorPullRequestStateOpened HydratorPullRequestState = "Opened"
+	// HydratorPullRequestStateUpdated means the hydration updated a pull request which was already open.
+	HydratorPullRequestStateUpdated HydratorPullRequestState = "Updated"
+)
+
+// HydratorPullRequestStatus contains information about a pull request published by the source hydrator
+type HydratorPullRequestStatus struct {
+	// URL is the web URL of the pull request
+	URL string `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
+	// Number is the number of the pull request
+	Number int64 `json:"number" protobuf:"varint,2,name=number"`
+	// Branch is the branch from which the pull request is opened
+	Branch string `json:"branch" protobuf:"bytes,3,name=branch"`
+	// TargetBranch is the branch against which the pull request is opened
+	TargetBranch string `json:"targetBranch" protobuf:"bytes,4,name=targetBranch"`
+	// State is the state of the pull request after the hydration which published it
+	State HydratorPullRequestState `json:"state" protobuf:"bytes,5,name=state"`
+	// DrySHA holds the resolved revision (sha) of the dry source whose hydrated manifests the pull request proposes
+	DrySHA string `json:"drySHA,omitempty" protobuf:"bytes,6,opt,name=drySHA"`
 }
 
 func (status *ApplicationStatus) FindResource(key kube.ResourceKey) (*ResourceStatus, bool) {
@@ -1914,6 +2219,12 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionDependencyWarning indicates that the sync of the application waits for the applications it depends on
+	ApplicationConditionDependencyWarning = "DependencyWarning"
+	// ApplicationConditionManifestPolicyError indicates that a manifest of the application violates an enforced policy of the project
+	ApplicationConditionManifestPolicyError = "ManifestPolicyError"
+	// ApplicationConditionManifestPolicyWarning indicates that a manifest of the application violates a policy of the project which is not enforced
+	ApplicationConditionManifestPolicyWarning = "ManifestPolicyWarning"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -2033,12 +2344,29 @@ type ApplicationTree struct {
 	// ShardsCount represents the total number of shards the application tree is split into.
 	// This is used to distribute resource processing across multiple shards.
 	ShardsCount int64 `json:"shardsCount,omitempty" protobuf:"bytes,4,opt,name=shardsCount"`
+	// Dependencies contains the applications which the application depends on, directly or transitively.
+	Dependencies []ApplicationDependencyNode `json:"dependencies,omitempty" protobuf:"bytes,5,rep,name=dependencies"`
+}
+
+// ApplicationDependencyNode is an application of the dependency graph of an application
+type ApplicationDependencyNode struct {
+	// Name is the name of the application
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the application
+	Namespace string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	// DependsOn lists the applications which the application depends on
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,3,rep,name=dependsOn"`
+	// SyncStatus is the sync status of the application
+	SyncStatus SyncStatusCode `json:"syncStatus,omitempty" protobuf:"bytes,4,opt,name=syncStatus,casttype=SyncStatusCode"`
+	// Health is the health status of the application. It is Missing if the application does not exist.
+	Health health.HealthStatusCode `json:"health,omitempty" protobuf:"bytes,5,opt,name=health"`
 }
 
 func (t *ApplicationTree) Merge(other *ApplicationTree) {
 	t.Nodes = append(t.Nodes, other.Nodes...)
 	t.OrphanedNodes = append(t.OrphanedNodes, other.OrphanedNodes...)
 	t.Hosts = append(t.Hosts, other.Hosts...)
+	t.Dependencies = append(t.Dependencies, other.Dependencies...)
 	t.Normalize()
 }
 
@@ -2068,6 +2396,12 @@ func (t *ApplicationTree) GetShards(size int64) []*ApplicationTree {
 			shard.Hosts = append(shard.Hosts, item)
 		})
 	}
+	for i := range t.Dependencies {
+		item := t.Dependencies[i]
+		items = append(items, func(shard *ApplicationTree) {
+			shard.Dependencies = append(shard.Dependencies, item)
+		})
+	}
 	var shards []*ApplicationTree
 	for len(items) > 0 {
 		shard := &ApplicationTree{}
@@ -2099,6 +2433,12 @@ func (t *ApplicationTree) Normalize() {
 	sort.Slice(t.Hosts, func(i, j int) bool {
 		return t.Hosts[i].Name < t.Hosts[j].Name
 	})
+	sort.Slice(t.Dependencies, func(i, j int) bool {
+		if t.Dependencies[i].Namespace != t.Dependencies[j].Namespace {
+			return t.Dependencies[i].Namespace < t.Dependencies[j].Namespace
+		}
+		return t.Dependencies[i].Name < t.Dependencies[j].Name
+	})
 }
 
 // ApplicationSummary contains information about URLs and container images used by an application
@@ -2587,6 +2927,7 @@ type rawResourceOverride struct {
 	IgnoreDifferences     string           `json:"ignoreDifferences,omitempty"`
 	IgnoreResourceUpdates string           `json:"ignoreResourceUpdates,omitempty"`
 	KnownTypeFields       []KnownTypeField `json:"knownTypeFields,omitempty"`
+	HealthPlugin          string           `json:"health.plugin,omitempty"`
 }
 
 // ResourceOverride holds configuration to customize resource diffing and health assessment
@@ -2603,6 +2944,8 @@ type ResourceOverride struct {
 	IgnoreResourceUpdates OverrideIgnoreDiff `protobuf:"bytes,6,opt,name=ignoreResourceUpdates"`
 	// KnownTypeFields lists fields for which unit conversions should be applied.
 	KnownTypeFields []KnownTypeField `protobuf:"bytes,4,opt,name=knownTypeFields"`
+	// HealthPlugin is the name of the health plugin which assesses the health of the resource. It takes precedence over HealthLua.
+	HealthPlugin string `protobuf:"bytes,7,opt,name=healthPlugin"`
 }
 
 // UnmarshalJSON unmarshals a JSON byte slice into a ResourceOverride object.
@@ -2617,6 +2960,7 @@ func (ro *ResourceOverride) UnmarshalJSON(data []byte) error {
 	ro.HealthLua = raw.HealthLua
 	ro.UseOpenLibs = raw.UseOpenLibs
 	ro.Actions = raw.Actions
+	ro.HealthPlugin = raw.HealthPlugin
 	err := yaml.Unmarshal([]byte(raw.IgnoreDifferences), &ro.IgnoreDifferences)
 	if err != nil {
 		return err
@@ -2639,7 +2983,7 @@ func (ro ResourceOverride) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	raw := &rawResourceOverride{ro.HealthLua, ro.UseOpenLibs, ro.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), ro.KnownTypeFields}
+	raw := &rawResourceOverride{ro.HealthLua, ro.UseOpenLibs, ro.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), ro.KnownTypeFields, ro.HealthPlugin}
 	return json.Marshal(raw)
 }
 
@@ -2864,6 +3208,28 @@ type AppProjectSpec struct {
 	// SourceIntegrity represents a constraint on manifest sources integrity to be met before they can be used.
 	// Do not access directly, use EffectiveSourceIntegrity() for correct backwards compatibility handling.
 	SourceIntegrity *SourceIntegrity `json:"sourceIntegrity,omitempty" protobuf:"bytes,15,name=sourceIntegrity"`
+	// ManifestPolicies are the policies which the rendered manifests of the applications of the project are validated
+	// against before they are synced
+	ManifestPolicies []ManifestPolicy `json:"manifestPolicies,omitempty" protobuf:"bytes,16,rep,name=manifestPolicies"`
+}
+
+// ManifestPolicy is a rule which every rendered manifest of an application, matching the kinds of the policy, must
+// satisfy. A violated policy is reported as an application condition, and blocks the sync of the application if the
+// policy is enforced.
+type ManifestPolicy struct {
+	// Name identifies the policy in the reported violations
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Kinds restricts the policy to the manifests of the given groups and kinds. Both fields support glob patterns. The
+	// policy applies to all the manifests if empty.
+	Kinds []metav1.GroupKind `json:"kinds,omitempty" protobuf:"bytes,2,rep,name=kinds"`
+	// CEL is a CEL expression which must evaluate to true for the manifest held by the `object` variable. The
+	// `application` variable holds the name, namespace and project of the application.
+	CEL string `json:"cel" protobuf:"bytes,3,opt,name=cel"`
+	// Message is the message of the violations of the policy
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// Enforce blocks the sync of the applications which violate the policy. Violations are only reported as warnings if
+	// false.
+	Enforce bool `json:"enforce,omitempty" protobuf:"varint,5,opt,name=enforce"`
 }
 
 // EffectiveSourceIntegrity incorporates the legacy SignatureKeys into SourceIntegrity, if possible
@@ -2945,6 +3311,34 @@ type SyncWindow struct {
 	// For deny windows: allows syncs that started before the deny window became active to continue running
 	// For allow windows: allows syncs that started during the allow window to continue after the window ends
 	SyncOverrun bool `json:"syncOverrun,omitempty" protobuf:"bytes,11,opt,name=syncOverrun"`
+	// Dates are absolute periods of time during which the window is active, in addition to its schedule
+	Dates []SyncWindowDateRange `json:"dates,omitempty" protobuf:"bytes,12,rep,name=dates"`
+	// Calendar is the name of a calendar, defined in the argocd-cm ConfigMap, listing periods of time during which the
+	// window is active, in addition to its schedule
+	Calendar string `json:"calendar,omitempty" protobuf:"bytes,13,opt,name=calendar"`
+}
+
+// SyncWindowDateRange is an absolute period of time during which a sync window is active. Its start and end are either
+// dates (e.g. 2025-12-24), times (e.g. 2025-12-24T18:00:00) in the time zone of the sync window, or RFC 3339 times
+// (e.g. 2025-12-24T18:00:00Z).
+type SyncWindowDateRange struct {
+	// Start is the date or the time the period begins. A date begins at midnight.
+	Start string `json:"start" protobuf:"bytes,1,opt,name=start"`
+	// End is the date or the time the period ends. A date ends at midnight the next day, so that the period includes it.
+	// Defaults to the start date.
+	End string `json:"end,omitempty" protobuf:"bytes,2,opt,name=end"`
+}
+
+// SyncWindowOccurrence is a period of time during which a sync window is active
+type SyncWindowOccurrence struct {
+	// ID is the index of the sync window in the sync windows of the project
+	ID int64 `json:"id" protobuf:"varint,1,opt,name=id"`
+	// Window is the sync window
+	Window *SyncWindow `json:"window" protobuf:"bytes,2,opt,name=window"`
+	// Start is the time the sync window becomes active
+	Start metav1.Time `json:"start" protobuf:"bytes,3,opt,name=start"`
+	// End is the time the sync window becomes inactive
+	End metav1.Time `json:"end" protobuf:"bytes,4,opt,name=end"`
 }
 
 // HasWindows returns true if SyncWindows has one or more SyncWindow
@@ -2964,21 +3358,12 @@ func (w *SyncWindows) active(currentTime time.Time) (*SyncWindows, error) {
 
 	if w.HasWindows() {
 		var active SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.active(currentTime)
+			if err != nil {
+				return nil, err
 			}
-
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-			if nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if isActive {
 				active = append(active, w)
 			}
 		}
@@ -3003,24 +3388,15 @@ func (w *SyncWindows) inactiveAllows(currentTime time.Time) (*SyncWindows, error
 
 	if w.HasWindows() {
 		var inactive SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
 			if w.Kind != "allow" {
 				continue
 			}
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.active(currentTime)
+			if err != nil {
+				return nil, err
 			}
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-
-			if !nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if !isActive {
 				inactive = append(inactive, w)
 			}
 		}
@@ -3031,6 +3407,70 @@ func (w *SyncWindows) inactiveAllows(currentTime time.Time) (*SyncWindows, error
 	return nil, nil
 }
 
+// HasCalendars returns true if one or more of the sync windows refers to a calendar
+func (w *SyncWindows) HasCalendars() bool {
+	if w.HasWindows() {
+		for _, window := range *w {
+			if window.Calendar != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WithCalendars returns a copy of the sync windows in which the periods of the calendar each window refers to are added
+// to the dates of the window. It returns an error if a window refers to a calendar which is not defined.
+func (w *SyncWindows) WithCalendars(calendars map[string][]SyncWindowDateRange) (*SyncWindows, error) {
+	if !w.HasWindows() {
+		return w, nil
+	}
+	windows := make(SyncWindows, len(*w))
+	for i, window := range *w {
+		if window.Calendar == "" {
+			windows[i] = window
+			continue
+		}
+		dates, ok := calendars[window.Calendar]
+		if !ok {
+			return nil, fmt.Errorf("sync window calendar '%s' not found", window.Calendar)
+		}
+		resolved := window.DeepCopy()
+		resolved.Dates = append(resolved.Dates, dates...)
+		windows[i] = resolved
+	}
+	return &windows, nil
+}
+
+// Upcoming returns the periods of time during which the sync windows are active within the given period from the given
+// time, ordered by start time. The overlapping periods of a sync window are merged.
+func (w *SyncWindows) Upcoming(from time.Time, period time.Duration) ([]*SyncWindowOccurrence, error) {
+	from = from.UTC()
+	to := from.Add(period)
+	occurrences := []*SyncWindowOccurrence{}
+	if !w.HasWindows() {
+		return occurrences, nil
+	}
+	for i, window := range *w {
+		periods, err := window.periods(from, to)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range periods {
+			occurrences = append(occurrences, &SyncWindowOccurrence{
+				ID:     int64(i),
+				Window: window,
+				Start:  metav1.NewTime(p[0]),
+				End:    metav1.NewTime(p[1]),
+			})
+		}
+	}
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return occurrences[i].Start.Before(&occurrences[j].Start)
+	})
+	return occurrences, nil
+}
+
 func (w *SyncWindow) scheduleOffsetByTimeZone() time.Duration {
 	loc, err := time.LoadLocation(w.TimeZone)
 	if err != nil {
@@ -3042,9 +3482,9 @@ func (w *SyncWindow) scheduleOffsetByTimeZone() time.Duration {
 }
 
 // AddWindow adds a sync window with the given parameters to the AppProject
-func (spec *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool, timeZone string, andOperator bool, description string, syncOverrun bool) error {
-	if knd == "" || sch == "" || dur == "" {
-		return errors.New("cannot create window: require kind, schedule, duration and one or more of applications, namespaces and clusters")
+func (spec *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool, timeZone string, andOperator bool, description string, syncOverrun bool, dates []SyncWindowDateRange, calendar string) error {
+	if knd == "" || ((sch == "" || dur == "") && len(dates) == 0 && calendar == "") {
+		return errors.New("cannot create window: require kind, either schedule and duration, dates or a calendar, and one or more of applications, namespaces and clusters")
 	}
 
 	window := &SyncWindow{
@@ -3056,6 +3496,8 @@ func (spec *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []
 		UseAndOperator: andOperator,
 		Description:    description,
 		SyncOverrun:    syncOverrun,
+		Dates:          dates,
+		Calendar:       calendar,
 	}
 
 	if len(app) > 0 {
@@ -3373,27 +3815,164 @@ func (w SyncWindow) active(currentTime time.Time) (bool, error) {
 	// first converted to UTC before search
 	currentTime = currentTime.UTC()
 
-	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	schedule, sErr := specParser.Parse(w.Schedule)
-	if sErr != nil {
-		return false, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
+	if w.hasSchedule() {
+		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+		schedule, sErr := specParser.Parse(w.Schedule)
+		if sErr != nil {
+			return false, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
+		}
+		duration, dErr := time.ParseDuration(w.Duration)
+		if dErr != nil {
+			return false, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+		}
+
+		// Offset the nextWindow time to consider the timeZone of the sync window
+		timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
+		nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
+		if nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			return true, nil
+		}
+	}
+
+	loc := w.location()
+	for _, d := range w.Dates {
+		start, end, err := d.period(loc)
+		if err != nil {
+			return false, err
+		}
+		if !currentTime.Before(start) && currentTime.Before(end) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// hasSchedule returns whether the window is active on a cron schedule. A window without dates or calendar always has
+// one.
+func (w *SyncWindow) hasSchedule() bool {
+	return w.Schedule != "" || (len(w.Dates) == 0 && w.Calendar == "")
+}
+
+// location returns the location of the time zone of the window, defaulting to UTC.
+func (w *SyncWindow) location() *time.Location {
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// periods returns the periods of time during which the window is active between the given times, with overlapping
+// periods merged.
+func (w *SyncWindow) periods(from, to time.Time) ([][2]time.Time, error) {
+	var periods [][2]time.Time
+	if w.hasSchedule() {
+		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+		schedule, err := specParser.Parse(w.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+		}
+		duration, err := time.ParseDuration(w.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+		}
+		// The schedule is evaluated in the time zone of the window, like in SyncWindow.active.
+		timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
+		next := from.Add(timeZoneOffsetDuration - duration)
+		for {
+			next = schedule.Next(next)
+			start := next.Add(-timeZoneOffsetDuration)
+			if next.IsZero() || !start.Before(to) {
+				break
+			}
+			periods = append(periods, [2]time.Time{start, start.Add(duration)})
+		}
+	}
+	loc := w.location()
+	for _, d := range w.Dates {
+		start, end, err := d.period(loc)
+		if err != nil {
+			return nil, err
+		}
+		if start.Before(to) && end.After(from) {
+			periods = append(periods, [2]time.Time{start, end})
+		}
+	}
+
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i][0].Before(periods[j][0])
+	})
+	var merged [][2]time.Time
+	for _, p := range periods {
+		if len(merged) > 0 && !p[0].After(merged[len(merged)-1][1]) {
+			if p[1].After(merged[len(merged)-1][1]) {
+				merged[len(merged)-1][1] = p[1]
+			}
+			continue
+		}
+		merged = append(merged, p)
 	}
-	duration, dErr := time.ParseDuration(w.Duration)
-	if dErr != nil {
-		return false, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+	return merged, nil
+}
+
+const (
+	syncWindowDateLayout      = "2006-01-02"
+	syncWindowLocalTimeLayout = "2006-01-02T15:04:05"
+)
+
+// parseSyncWindowTime parses a date, a time in the given location, or an RFC 3339 time. It returns whether the value
+// is a date.
+func parseSyncWindowTime(value string, loc *time.Location) (time.Time, bool, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), false, nil
+	}
+	if t, err := time.ParseInLocation(syncWindowLocalTimeLayout, value, loc); err == nil {
+		return t.UTC(), false, nil
 	}
+	if t, err := time.ParseInLocation(syncWindowDateLayout, value, loc); err == nil {
+		return t, true, nil
+	}
+	return time.Time{}, false, fmt.Errorf("cannot parse date '%s': must be a date (%s), a time (%s) or an RFC 3339 time", value, syncWindowDateLayout, syncWindowLocalTimeLayout)
+}
 
-	// Offset the nextWindow time to consider the timeZone of the sync window
-	timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-	nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
+// Validate checks whether the start and the end of the date range can be parsed, and whether the range ends after it
+// starts.
+func (d SyncWindowDateRange) Validate() error {
+	_, _, err := d.period(time.UTC)
+	return err
+}
 
-	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)), nil
+// period returns the start and the end of the date range in UTC, with dates and times without time zone in the given
+// location.
+func (d SyncWindowDateRange) period(loc *time.Location) (time.Time, time.Time, error) {
+	start, isDate, err := parseSyncWindowTime(d.Start, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	endValue := d.End
+	if endValue == "" {
+		if !isDate {
+			return time.Time{}, time.Time{}, fmt.Errorf("date range starting at '%s' requires an end", d.Start)
+		}
+		endValue = d.Start
+	}
+	end, isDate, err := parseSyncWindowTime(endValue, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if isDate {
+		end = end.AddDate(0, 0, 1)
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("date range end '%s' must be after its start '%s'", endValue, d.Start)
+	}
+	return start.UTC(), end.UTC(), nil
 }
 
 // Update updates a sync window's settings with the given parameter
-func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string, tz string, description string) error {
-	if s == "" && d == "" && len(a) == 0 && len(n) == 0 && len(c) == 0 && description == "" {
-		return errors.New("cannot update: require one or more of schedule, duration, application, namespace, cluster or description")
+func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string, tz string, description string, dates []SyncWindowDateRange, calendar string) error {
+	if s == "" && d == "" && len(a) == 0 && len(n) == 0 && len(c) == 0 && description == "" && len(dates) == 0 && calendar == "" {
+		return errors.New("cannot update: require one or more of schedule, duration, application, namespace, cluster, description, dates or calendar")
 	}
 
 	if s != "" {
@@ -3420,6 +3999,14 @@ func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []stri
 		w.Description = description
 	}
 
+	if len(dates) > 0 {
+		w.Dates = dates
+	}
+
+	if calendar != "" {
+		w.Calendar = calendar
+	}
+
 	if tz == "" {
 		tz = "UTC"
 	}
@@ -3441,14 +4028,21 @@ func (w *SyncWindow) Validate() error {
 	if w.Kind != "allow" && w.Kind != "deny" {
 		return fmt.Errorf("kind '%s' mismatch: can only be allow or deny", w.Kind)
 	}
-	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	_, err := specParser.Parse(w.Schedule)
-	if err != nil {
-		return fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+	if w.hasSchedule() {
+		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+		_, err := specParser.Parse(w.Schedule)
+		if err != nil {
+			return fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+		}
+		_, err = time.ParseDuration(w.Duration)
+		if err != nil {
+			return fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+		}
 	}
-	_, err = time.ParseDuration(w.Duration)
-	if err != nil {
-		return fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+	for _, d := range w.Dates {
+		if err := d.Validate(); err != nil {
+			return err
+		}
 	}
 
 	if len(w.Description) > 255 {
@@ -3470,6 +4064,8 @@ func (w *SyncWindow) HashIdentity() (uint64, error) {
 		Clusters:       w.Clusters,
 		TimeZone:       w.TimeZone,
 		UseAndOperator: w.UseAndOperator,
+		Dates:          w.Dates,
+		Calendar:       w.Calendar,
 		// ManualSync and Description are excluded as they don't affect window identity
 	}
 
@@ -3567,6 +4163,30 @@ type ApplicationDestinationServiceAccount struct {
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
 	// DefaultServiceAccount to be used for impersonation during the sync operation
 	DefaultServiceAccount string `json:"defaultServiceAccount" protobuf:"bytes,3,opt,name=defaultServiceAccount"`
+	// ResourceServiceAccounts are the service accounts to be impersonated instead of the default service account to sync
+	// the resources of specific kinds. The first matching entry is used.
+	ResourceServiceAccounts []ResourceServiceAccount `json:"resourceServiceAccounts,omitempty" protobuf:"bytes,4,rep,name=resourceServiceAccounts"`
+}
+
+// ResourceServiceAccount holds the service account to be impersonated to sync the matching resources
+type ResourceServiceAccount struct {
+	// Group is the API group of the resources. Glob patterns are supported.
+	Group string `json:"group" protobuf:"bytes,1,opt,name=group"`
+	// Kind is the kind of the resources. Glob patterns are supported.
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Namespace is the namespace of the resources. Glob patterns are supported. If no namespace is specified, the
+	// resources of all namespaces and the cluster-scoped resources are matched.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	// ServiceAccount to be used for impersonation to sync the matching resources
+	ServiceAccount string `json:"serviceAccount" protobuf:"bytes,4,opt,name=serviceAccount"`
+}
+
+// Matches returns true if the entry applies to the resource of the given group, kind and namespace
+func (r *ResourceServiceAccount) Matches(group, kind, namespace string) bool {
+	if !globMatch(r.Group, group, false) || !globMatch(r.Kind, kind, false) {
+		return false
+	}
+	return r.Namespace == "" || globMatch(r.Namespace, namespace, false)
 }
 
 // CascadedDeletion indicates if the deletion finalizer is set and controller should delete the application and it's cascaded resources
@@ -728,6 +728,36 @@ func TestAppProject_ValidateSources(t *testing.T) {
 	require.Error(t, err)
 }
 
+// TestAppProject_ValidateManifestPolicies tests for invalid manifest policies
+func TestAppProject_ValidateManifestPolicies(t *testing.T) {
+	p := newTestProject()
+	p.Spec.ManifestPolicies = []ManifestPolicy{{Name: "no-privileged", CEL: "true"}, {Name: "limits", CEL: "true", Enforce: true}}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.ManifestPolicies = []ManifestPolicy{{CEL: "true"}}
+	require.EqualError(t, p.ValidateProject(), "rpc error: code = InvalidArgument desc = manifest policy name is required")
+
+	p.Spec.ManifestPolicies = []ManifestPolicy{{Name: "limits", CEL: "true"}, {Name: "limits", CEL: "false"}}
+	require.EqualError(t, p.ValidateProject(), "rpc error: code = AlreadyExists desc = manifest policy 'limits' already exists")
+
+	p.Spec.ManifestPolicies = []ManifestPolicy{{Name: "limits", CEL: " "}}
+	require.EqualError(t, p.ValidateProject(), "rpc error: code = InvalidArgument desc = manifest policy 'limits' has no CEL expression")
+}
+
+// TestAppProject_ValidateSourceIntegrity tests for invalid source integrity policies
+func TestAppProject_ValidateSourceIntegrity(t *testing.T) {
+	p := newTestProject()
+	x509Policy := &SourceIntegrityGitPolicyX509{Mode: SourceIntegrityGitPolicyGPGModeHead, CARoots: []string{"ca"}, Identities: []string{"*@example.com"}}
+	p.Spec.SourceIntegrity = &SourceIntegrity{Git: &SourceIntegrityGit{Policies: []*SourceIntegrityGitPolicy{{
+		Repos: []SourceIntegrityGitPolicyRepo{{URL: "*"}},
+		X509:  x509Policy,
+	}}}}
+	require.NoError(t, p.ValidateProject())
+
+	x509Policy.Identities = nil
+	require.EqualError(t, p.ValidateProject(), "rpc error: code = InvalidArgument desc = x509 verification of git source integrity policy 0 requires at least one identity")
+}
+
 // TestAppProject_ValidateDestinations tests for an invalid destination
 func TestAppProject_ValidateDestinations(t *testing.T) {
 	p := newTestProject()
@@ -2429,15 +2459,30 @@ func TestAppProjectSpec_AddWindow(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			switch tt.want {
 			case "error":
-				require.Error(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, tt.o, tt.description, false))
+				require.Error(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, tt.o, tt.description, false, nil, ""))
 			case "noError":
-				require.NoError(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, tt.o, tt.description, false))
+				require.NoError(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, tt.o, tt.description, false, nil, ""))
 				require.NoError(t, tt.p.Spec.DeleteWindow(0))
 			}
 		})
 	}
 }
 
+func TestAppProjectSpec_AddWindow_Dates(t *testing.T) {
+	proj := newTestProjectWithSyncWindows()
+	t.Run("Dates", func(t *testing.T) {
+		require.NoError(t, proj.Spec.AddWindow("deny", "", "", []string{"app1"}, nil, nil, false, "", false, "", false, []SyncWindowDateRange{{Start: "2025-12-25"}}, ""))
+		assert.Equal(t, []SyncWindowDateRange{{Start: "2025-12-25"}}, proj.Spec.SyncWindows[len(proj.Spec.SyncWindows)-1].Dates)
+	})
+	t.Run("Calendar", func(t *testing.T) {
+		require.NoError(t, proj.Spec.AddWindow("deny", "", "", []string{"app1"}, nil, nil, false, "", false, "", false, nil, "holidays"))
+		assert.Equal(t, "holidays", proj.Spec.SyncWindows[len(proj.Spec.SyncWindows)-1].Calendar)
+	})
+	t.Run("InvalidDates", func(t *testing.T) {
+		require.Error(t, proj.Spec.AddWindow("deny", "", "", []string{"app2"}, nil, nil, false, "", false, "", false, []SyncWindowDateRange{{Start: "2025-12-26", End: "2025-12-25"}}, ""))
+	})
+}
+
 func TestAppProject_EffectiveSourceIntegrity(t *testing.T) {
 	sourceIntegrity := func(repo string, mode SourceIntegrityGitPolicyGPGMode, keys ...string) *SourceIntegrity {
 		return &SourceIntegrity{
@@ -2499,13 +2544,13 @@ func TestAppProject_EffectiveSourceIntegrity(t *testing.T) {
 
 func TestAppProjectSpecWindowWithDescription(t *testing.T) {
 	proj := newTestProjectWithSyncWindows()
-	require.NoError(t, proj.Spec.AddWindow("allow", "* * * * *", "1h", []string{"app1"}, []string{}, []string{}, false, "error", false, "Ticket AAAAA", false))
+	require.NoError(t, proj.Spec.AddWindow("allow", "* * * * *", "1h", []string{"app1"}, []string{}, []string{}, false, "error", false, "Ticket AAAAA", false, nil, ""))
 	require.Equal(t, "Ticket AAAAA", proj.Spec.SyncWindows[1].Description)
 
-	require.NoError(t, proj.Spec.SyncWindows[1].Update("", "", []string{}, []string{}, []string{}, "", "Ticket BBBBB"))
+	require.NoError(t, proj.Spec.SyncWindows[1].Update("", "", []string{}, []string{}, []string{}, "", "Ticket BBBBB", nil, ""))
 	require.Equal(t, "Ticket BBBBB", proj.Spec.SyncWindows[1].Description)
 
-	require.Error(t, proj.Spec.SyncWindows[1].Update("", "", []string{}, []string{}, []string{}, "", ""))
+	require.Error(t, proj.Spec.SyncWindows[1].Update("", "", []string{}, []string{}, []string{}, "", "", nil, ""))
 }
 
 func TestAppProjectSpec_DeleteWindow(t *testing.T) {
@@ -3282,39 +3327,226 @@ func TestSyncWindow_Active(t *testing.T) {
 	}
 }
 
+func TestSyncWindow_Active_Dates(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		syncWindow     SyncWindow
+		currentTime    time.Time
+		expectedResult bool
+		isErr          bool
+	}{
+		{
+			name:           "Date-active",
+			syncWindow:     SyncWindow{Kind: "deny", Dates: []SyncWindowDateRange{{Start: "2025-12-25"}}},
+			currentTime:    time.Date(2025, 12, 25, 23, 59, 0, 0, time.UTC),
+			expectedResult: true,
+		},
+		{
+			name:           "Date-inactive",
+			syncWindow:     SyncWindow{Kind: "deny", Dates: []SyncWindowDateRange{{Start: "2025-12-25"}}},
+			currentTime:    time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC),
+			expectedResult: false,
+		},
+		{
+			name:           "DateRange-includes-end-date",
+			syncWindow:     SyncWindow{Kind: "deny", Dates: []SyncWindowDateRange{{Start: "2025-12-24", End: "2026-01-01"}}},
+			currentTime:    time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			expectedResult: true,
+		},
+		{
+			name:           "TimeRange-active-in-time-zone",
+			syncWindow:     SyncWindow{Kind: "allow", TimeZone: "Europe/Amsterdam", Dates: []SyncWindowDateRange{{Start: "2026-03-02T18:00:00", End: "2026-03-02T22:00:00"}}},
+			currentTime:    time.Date(2026, 3, 2, 18, 30, 0, 0, amsterdam),
+			expectedResult: true,
+		},
+		{
+			name:           "TimeRange-inactive-in-time-zone",
+			syncWindow:     SyncWindow{Kind: "allow", TimeZone: "Europe/Amsterdam", Dates: []SyncWindowDateRange{{Start: "2026-03-02T18:00:00", End: "2026-03-02T22:00:00"}}},
+			currentTime:    time.Date(2026, 3, 2, 21, 30, 0, 0, time.UTC),
+			expectedResult: false,
+		},
+		{
+			name:           "RFC3339-active",
+			syncWindow:     SyncWindow{Kind: "allow", Dates: []SyncWindowDateRange{{Start: "2026-03-02T18:00:00+01:00", End: "2026-03-02T22:00:00+01:00"}}},
+			currentTime:    time.Date(2026, 3, 2, 20, 30, 0, 0, time.UTC),
+			expectedResult: true,
+		},
+		{
+			name:           "Schedule-or-dates",
+			syncWindow:     SyncWindow{Kind: "deny", Schedule: "0 10 * * *", Duration: "1h", Dates: []SyncWindowDateRange{{Start: "2025-12-25"}}},
+			currentTime:    time.Date(2025, 12, 24, 10, 30, 0, 0, time.UTC),
+			expectedResult: true,
+		},
+		{
+			name:           "Calendar-without-schedule-inactive",
+			syncWindow:     SyncWindow{Kind: "deny", Calendar: "holidays"},
+			currentTime:    time.Date(2025, 12, 25, 10, 30, 0, 0, time.UTC),
+			expectedResult: false,
+		},
+		{
+			name:        "InvalidDate",
+			syncWindow:  SyncWindow{Kind: "deny", Dates: []SyncWindowDateRange{{Start: "25/12/2025"}}},
+			currentTime: time.Date(2025, 12, 25, 10, 30, 0, 0, time.UTC),
+			isErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.syncWindow.active(tt.currentTime)
+			if tt.isErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestSyncWindowDateRange_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		dateRange SyncWindowDateRange
+		err       string
+	}{
+		{name: "Date", dateRange: SyncWindowDateRange{Start: "2025-12-25"}},
+		{name: "DateRange", dateRange: SyncWindowDateRange{Start: "2025-12-25", End: "2025-12-26"}},
+		{name: "TimeRange", dateRange: SyncWindowDateRange{Start: "2025-12-25T10:00:00", End: "2025-12-25T11:00:00Z"}},
+		{name: "SameDate", dateRange: SyncWindowDateRange{Start: "2025-12-25", End: "2025-12-25"}},
+		{
+			name:      "TimeWithoutEnd",
+			dateRange: SyncWindowDateRange{Start: "2025-12-25T10:00:00"},
+			err:       "date range starting at '2025-12-25T10:00:00' requires an end",
+		},
+		{
+			name:      "EndBeforeStart",
+			dateRange: SyncWindowDateRange{Start: "2025-12-26", End: "2025-12-24"},
+			err:       "date range end '2025-12-24' must be after its start '2025-12-26'",
+		},
+		{
+			name:      "InvalidStart",
+			dateRange: SyncWindowDateRange{Start: "tomorrow"},
+			err:       "cannot parse date 'tomorrow': must be a date (2006-01-02), a time (2006-01-02T15:04:05) or an RFC 3339 time",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.dateRange.Validate()
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestSyncWindows_WithCalendars(t *testing.T) {
+	calendars := map[string][]SyncWindowDateRange{
+		"holidays": {{Start: "2025-12-25"}},
+	}
+	t.Run("AddsCalendarDates", func(t *testing.T) {
+		windows := SyncWindows{
+			{Kind: "allow", Schedule: "0 10 * * *", Duration: "1h"},
+			{Kind: "deny", Calendar: "holidays", Dates: []SyncWindowDateRange{{Start: "2026-01-01"}}},
+		}
+		resolved, err := windows.WithCalendars(calendars)
+		require.NoError(t, err)
+		require.Len(t, *resolved, 2)
+		assert.Same(t, windows[0], (*resolved)[0])
+		assert.Equal(t, []SyncWindowDateRange{{Start: "2026-01-01"}, {Start: "2025-12-25"}}, (*resolved)[1].Dates)
+		// The windows are not modified
+		assert.Equal(t, []SyncWindowDateRange{{Start: "2026-01-01"}}, windows[1].Dates)
+
+		active, err := resolved.active(time.Date(2025, 12, 25, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.NotNil(t, active)
+		assert.Equal(t, "deny", (*active)[0].Kind)
+	})
+	t.Run("UnknownCalendar", func(t *testing.T) {
+		windows := SyncWindows{{Kind: "deny", Calendar: "unknown"}}
+		_, err := windows.WithCalendars(calendars)
+		require.EqualError(t, err, "sync window calendar 'unknown' not found")
+	})
+	t.Run("NoWindows", func(t *testing.T) {
+		var windows SyncWindows
+		resolved, err := windows.WithCalendars(calendars)
+		require.NoError(t, err)
+		assert.False(t, resolved.HasWindows())
+	})
+}
+
+func TestSyncWindows_Upcoming(t *testing.T) {
+	from := time.Date(2025, 12, 24, 0, 0, 0, 0, time.UTC)
+	windows := SyncWindows{
+		{Kind: "allow", Schedule: "0 10 * * *", Duration: "2h"},
+		{Kind: "deny", Dates: []SyncWindowDateRange{{Start: "2025-12-25"}, {Start: "2025-12-26"}, {Start: "2026-01-01"}}},
+	}
+	occurrences, err := windows.Upcoming(from, 3*24*time.Hour)
+	require.NoError(t, err)
+
+	type period struct {
+		id         int64
+		start, end time.Time
+	}
+	var periods []period
+	for _, o := range occurrences {
+		periods = append(periods, period{o.ID, o.Start.UTC(), o.End.UTC()})
+	}
+	day := func(d, h int) time.Time {
+		return time.Date(2025, 12, d, h, 0, 0, 0, time.UTC)
+	}
+	assert.Equal(t, []period{
+		{0, day(24, 10), day(24, 12)},
+		{1, day(25, 0), day(27, 0)},
+		{0, day(25, 10), day(25, 12)},
+		{0, day(26, 10), day(26, 12)},
+	}, periods)
+
+	t.Run("InvalidSchedule", func(t *testing.T) {
+		invalid := SyncWindows{{Kind: "allow", Schedule: "* 10 * * 7", Duration: "1h"}}
+		_, err := invalid.Upcoming(from, time.Hour)
+		require.Error(t, err)
+	})
+}
+
 func TestSyncWindow_Update(t *testing.T) {
 	e := SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"app1"}}
 	t.Run("AddApplication", func(t *testing.T) {
-		err := e.Update("", "", []string{"app1", "app2"}, []string{}, []string{}, "", "")
+		err := e.Update("", "", []string{"app1", "app2"}, []string{}, []string{}, "", "", nil, "")
 		require.NoError(t, err)
 		assert.Equal(t, []string{"app1", "app2"}, e.Applications)
 	})
 	t.Run("AddNamespace", func(t *testing.T) {
-		err := e.Update("", "", []string{}, []string{"namespace1"}, []string{}, "", "")
+		err := e.Update("", "", []string{}, []string{"namespace1"}, []string{}, "", "", nil, "")
 		require.NoError(t, err)
 		assert.Equal(t, []string{"namespace1"}, e.Namespaces)
 	})
 	t.Run("AddCluster", func(t *testing.T) {
-		err := e.Update("", "", []string{}, []string{}, []string{"cluster1"}, "", "")
+		err := e.Update("", "", []string{}, []string{}, []string{"cluster1"}, "", "", nil, "")
 		require.NoError(t, err)
 		assert.Equal(t, []string{"cluster1"}, e.Clusters)
 	})
 	t.Run("MissingConfig", func(t *testing.T) {
-		err := e.Update("", "", []string{}, []string{}, []string{}, "", "")
-		require.EqualError(t, err, "cannot update: require one or more of schedule, duration, application, namespace, cluster or description")
+		err := e.Update("", "", []string{}, []string{}, []string{}, "", "", nil, "")
+		require.EqualError(t, err, "cannot update: require one or more of schedule, duration, application, namespace, cluster, description, dates or calendar")
 	})
 	t.Run("ChangeDuration", func(t *testing.T) {
-		err := e.Update("", "10h", []string{}, []string{}, []string{}, "", "")
+		err := e.Update("", "10h", []string{}, []string{}, []string{}, "", "", nil, "")
 		require.NoError(t, err)
 		assert.Equal(t, "10h", e.Duration)
 	})
 	t.Run("ChangeSchedule", func(t *testing.T) {
-		err := e.Update("* 1 0 0 *", "", []string{}, []string{}, []string{}, "", "")
+		err := e.Update("* 1 0 0 *", "", []string{}, []string{}, []string{}, "", "", nil, "")
 		require.NoError(t, err)
 		assert.Equal(t, "* 1 0 0 *", e.Schedule)
 	})
 	t.Run("AddDescription", func(t *testing.T) {
-		err := e.Update("", "", []string{}, []string{}, []string{}, "", "Ticket 123")
+		err := e.Update("", "", []string{}, []string{}, []string{}, "", "Ticket 123", nil, "")
 		require.NoError(t, err)
 		assert.Equal(t, "Ticket 123", e.Description)
 	})
@@ -4909,6 +5141,69 @@ func TestAppProject_ValidateDestinationServiceAccount(t *testing.T) {
 	}
 }
 
+func TestValidateResourceServiceAccounts(t *testing.T) {
+	testData := []struct {
+		resourceServiceAccount ResourceServiceAccount
+		expectedErrMsg         string
+	}{
+		{
+			resourceServiceAccount: ResourceServiceAccount{Group: "apiextensions.k8s.io", Kind: "*", ServiceAccount: "argocd:crd-admin"},
+		},
+		{
+			resourceServiceAccount: ResourceServiceAccount{Kind: "Deployment", Namespace: "team-*", ServiceAccount: "deployer"},
+		},
+		{
+			resourceServiceAccount: ResourceServiceAccount{Group: "apps", ServiceAccount: "deployer"},
+			expectedErrMsg:         "resourceServiceAccount of destination 'https://192.168.99.100:8443/test-ns' has no kind",
+		},
+		{
+			resourceServiceAccount: ResourceServiceAccount{Kind: "[[ech*", ServiceAccount: "deployer"},
+			expectedErrMsg:         "resourceServiceAccount has an invalid format, '[[ech*'",
+		},
+		{
+			resourceServiceAccount: ResourceServiceAccount{Kind: "Deployment", ServiceAccount: " "},
+			expectedErrMsg:         "serviceAccount has an invalid format, ' '",
+		},
+		{
+			resourceServiceAccount: ResourceServiceAccount{Kind: "Deployment", ServiceAccount: "test/sa"},
+			expectedErrMsg:         "serviceAccount has an invalid format, 'test/sa'",
+		},
+	}
+	for _, data := range testData {
+		proj := AppProject{
+			Spec: AppProjectSpec{
+				DestinationServiceAccounts: []ApplicationDestinationServiceAccount{{
+					Server:                  "https://192.168.99.100:8443",
+					Namespace:               "test-ns",
+					DefaultServiceAccount:   "test-sa",
+					ResourceServiceAccounts: []ResourceServiceAccount{data.resourceServiceAccount},
+				}},
+			},
+		}
+		err := proj.ValidateProject()
+		if data.expectedErrMsg == "" {
+			require.NoError(t, err)
+		} else {
+			require.ErrorContains(t, err, data.expectedErrMsg)
+		}
+	}
+}
+
+func TestResourceServiceAccount_Matches(t *testing.T) {
+	crds := ResourceServiceAccount{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}
+	assert.True(t, crds.Matches("apiextensions.k8s.io", "CustomResourceDefinition", ""))
+	assert.False(t, crds.Matches("apps", "Deployment", "default"))
+
+	workloads := ResourceServiceAccount{Group: "apps", Kind: "*", Namespace: "team-*"}
+	assert.True(t, workloads.Matches("apps", "Deployment", "team-a"))
+	assert.False(t, workloads.Matches("apps", "Deployment", "default"))
+	assert.False(t, workloads.Matches("", "ConfigMap", "team-a"))
+
+	core := ResourceServiceAccount{Kind: "ConfigMap"}
+	assert.True(t, core.Matches("", "ConfigMap", "default"))
+	assert.False(t, core.Matches("example.com", "ConfigMap", "default"))
+}
+
 func TestCluster_ParseProxyUrl(t *testing.T) {
 	testData := []struct {
 		url            string
@@ -5233,6 +5528,146 @@ func TestApplicationSpec_GetHydrateToSource_UsesSyncSourceRepo(t *testing.T) {
 	assert.Equal(t, "staging", source.TargetRevision)
 }
 
+func TestApplicationSpec_GetHydrateToSource_OCI(t *testing.T) {
+	spec := ApplicationSpec{
+		SourceHydrator: &SourceHydrator{
+			DrySource: DrySource{
+				RepoURL:        "https://example.com/dry-repo",
+				TargetRevision: "main",
+				Path:           "dry",
+			},
+			SyncSource: SyncSource{
+				TargetBranch: "hydrated",
+				Path:         "sync-path",
+			},
+			HydrateTo: &HydrateTo{OCI: &HydrateToOCI{RepoURL: "oci://registry.example.com/hydrated"}},
+		},
+	}
+
+	source := spec.GetHydrateToSource()
+	assert.Equal(t, "oci://registry.example.com/hydrated", source.RepoURL)
+	assert.Equal(t, "sync-path", source.Path)
+	assert.Equal(t, DefaultHydrateToOCITag, source.TargetRevision)
+	assert.Equal(t, source, spec.SourceHydrator.GetSyncSource())
+	assert.Equal(t, source, spec.GetSource())
+
+	spec.SourceHydrator.HydrateTo.OCI.Tag = "prod"
+	assert.Equal(t, "prod", spec.GetSource().TargetRevision)
+}
+
+func TestApplication_GetHydratedOCIRevision(t *testing.T) {
+	hydrator := SourceHydrator{
+		DrySource:  DrySource{RepoURL: "https://example.com/dry-repo", TargetRevision: "main", Path: "dry"},
+		SyncSource: SyncSource{Path: "sync-path"},
+		HydrateTo:  &HydrateTo{OCI: &HydrateToOCI{RepoURL: "oci://registry.example.com/hydrated"}},
+	}
+	app := &Application{Spec: ApplicationSpec{SourceHydrator: hydrator.DeepCopy()}}
+	assert.Empty(t, app.GetHydratedOCIRevision())
+
+	app.Status.SourceHydrator.LastSuccessfulOperation = &SuccessfulHydrateOperation{
+		DrySHA:         "abc123",
+		HydratedSHA:    "sha256:pushed",
+		SourceHydrator: *hydrator.DeepCopy(),
+	}
+	assert.Equal(t, "latest@sha256:pushed", app.GetHydratedOCIRevision())
+
+	// the artifact was pushed with another tag
+	app.Spec.SourceHydrator.HydrateTo.OCI.Tag = "prod"
+	assert.Empty(t, app.GetHydratedOCIRevision())
+
+	// the hydrated manifests are committed to a branch
+	app.Spec.SourceHydrator.HydrateTo = &HydrateTo{TargetBranch: "staging"}
+	assert.Empty(t, app.GetHydratedOCIRevision())
+}
+
+func TestHydrateTo_DeepEquals(t *testing.T) {
+	oci := &HydrateTo{OCI: &HydrateToOCI{RepoURL: "oci://registry.example.com/hydrated"}}
+	assert.True(t, (*HydrateTo)(nil).DeepEquals(nil))
+	assert.False(t, oci.DeepEquals(nil))
+	assert.True(t, oci.DeepEquals(&HydrateTo{OCI: &HydrateToOCI{RepoURL: "oci://registry.example.com/hydrated"}}))
+	assert.False(t, oci.DeepEquals(&HydrateTo{OCI: &HydrateToOCI{RepoURL: "oci://registry.example.com/hydrated", Tag: "prod"}}))
+	assert.False(t, oci.DeepEquals(&HydrateTo{TargetBranch: "staging"}))
+	assert.True(t, (&HydrateTo{TargetBranch: "staging"}).DeepEquals(&HydrateTo{TargetBranch: "staging"}))
+}
+
+func TestHydratorPullRequest_ValidateBranch(t *testing.T) {
+	require.NoError(t, (&HydratorPullRequest{}).ValidateBranch("main", "env/main", "main"))
+	require.NoError(t, (&HydratorPullRequest{Branch: "hydrated-changes"}).ValidateBranch("main", "env/main", "HEAD"))
+	for _, branch := range []string{"main", "env/main", "dry"} {
+		require.EqualError(t, (&HydratorPullRequest{Branch: branch}).ValidateBranch("main", "env/main", "refs/heads/dry"),
+			fmt.Sprintf("pull request branch %q must differ from the branches of the source hydrator", branch))
+	}
+	for _, branch := range []string{"HEAD", "refs/heads/main", "+main", "-main", "main:other"} {
+		require.EqualError(t, (&HydratorPullRequest{Branch: branch}).ValidateBranch("main"), fmt.Sprintf("pull request branch %q must be a branch name", branch))
+	}
+}
+
+func TestHydratorPullRequest_IsHydratorBranch(t *testing.T) {
+	assert.True(t, (&HydratorPullRequest{}).IsHydratorBranch("main"))
+	assert.True(t, (&HydratorPullRequest{Branch: "argocd-hydrator/staging"}).IsHydratorBranch("main"))
+	assert.False(t, (&HydratorPullRequest{Branch: "hydrated-changes"}).IsHydratorBranch("main"))
+}
+
+func TestHydratorPullRequest_ValidateAPI(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		pullRequest HydratorPullRequest
+		repoURL     string
+		expectedErr string
+	}{
+		{name: "public API of GitHub", pullRequest: HydratorPullRequest{Provider: HydratorPullRequestProviderGitHub}, repoURL: "https://github.com/owner/repo.git"},
+		{name: "public API of GitHub with an SSH URL", pullRequest: HydratorPullRequest{Provider: HydratorPullRequestProviderGitHub}, repoURL: "git@github.com:owner/repo.git"},
+		{name: "GitHub Enterprise", pullRequest: HydratorPullRequest{Provider: HydratorPullRequestProviderGitHub, API: "https://GHE.example.com/api/v3"}, repoURL: "https://ghe.example.com/owner/repo.git"},
+		{name: "public API of Azure DevOps with an SSH URL", pullRequest: HydratorPullRequest{Provider: HydratorPullRequestProviderAzureDevOps}, repoURL: "git@ssh.dev.azure.com:v3/org/project/repo"},
+		{name: "Gitea on the repository host", pullRequest: HydratorPullRequest{Provider: HydratorPullRequestProviderGitea, API: "https://gitea.example.com"}, repoURL: "ssh://git@gitea.example.com:2222/owner/repo.git"},
+		{
+			name:        "API on another host",
+			pullRequest: HydratorPullRequest{Provider: HydratorPullRequestProviderGitHub, API: "https://attacker.example.net/api/v3"},
+			repoURL:     "https://github.com/owner/repo.git",
+			expectedErr: `pull request API "https://attacker.example.net/api/v3" is not hosted by the host of repository "https://github.com/owner/repo.git"`,
+		},
+		{
+			name:        "API on a subdomain of another host",
+			pullRequest: HydratorPullRequest{Provider: HydratorPullRequestProviderGitLab, API: "https://gitlab.com.attacker.example.net"},
+			repoURL:     "https://gitlab.com/group/repo.git",
+			expectedErr: `pull request API "https://gitlab.com.attacker.example.net" is not hosted by the host of repository "https://gitlab.com/group/repo.git"`,
+		},
+		{
+			name:        "public API for a self-hosted repository",
+			pullRequest: HydratorPullRequest{Provider: HydratorPullRequestProviderGitHub},
+			repoURL:     "https://ghe.example.com/owner/repo.git",
+			expectedErr: `pull request API "https://api.github.com" is not hosted by the host of repository "https://ghe.example.com/owner/repo.git"`,
+		},
+		{
+			name:        "API which is not a URL",
+			pullRequest: HydratorPullRequest{Provider: HydratorPullRequestProviderGitea, API: "gitea.example.com"},
+			repoURL:     "https://gitea.example.com/owner/repo.git",
+			expectedErr: `pull request API "gitea.example.com" must be an http or https URL`,
+		},
+		{
+			name:        "missing API",
+			pullRequest: HydratorPullRequest{Provider: HydratorPullRequestProviderBitbucketServer},
+			repoURL:     "https://bitbucket.example.com/scm/project/repo.git",
+			expectedErr: "the API of pull request provider bitbucketServer is required",
+		},
+		{
+			name:        "unsupported provider",
+			pullRequest: HydratorPullRequest{Provider: "unknown"},
+			repoURL:     "https://github.com/owner/repo.git",
+			expectedErr: `unsupported pull request provider "unknown"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pullRequest.ValidateAPI(tc.repoURL)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func TestIgnoreDifferences_Equals(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -157,6 +157,7 @@ func (h *ApplicationSourceHelm) String() string {
 		`APIVersions:` + fmt.Sprintf("%v", h.APIVersions) + `,`,
 		`SkipTests:` + strconv.FormatBool(h.SkipTests) + `,`,
 		`SkipSchemaValidation:` + strconv.FormatBool(h.SkipSchemaValidation) + `,`,
+		`PostRenderer:` + h.PostRenderer.String() + `,`,
 		`}`,
 	}, "")
 }
@@ -192,13 +192,22 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 	if in.DestinationServiceAccounts != nil {
 		in, out := &in.DestinationServiceAccounts, &out.DestinationServiceAccounts
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.SourceIntegrity != nil {
 		in, out := &in.SourceIntegrity, &out.SourceIntegrity
 		*out = new(SourceIntegrity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ManifestPolicies != nil {
+		in, out := &in.ManifestPolicies, &out.ManifestPolicies
+		*out = make([]ManifestPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -288,6 +297,43 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependency) DeepCopyInto(out *ApplicationDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependency.
+func (in *ApplicationDependency) DeepCopy() *ApplicationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependencyNode) DeepCopyInto(out *ApplicationDependencyNode) {
+	*out = *in
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependencyNode.
+func (in *ApplicationDependencyNode) DeepCopy() *ApplicationDependencyNode {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependencyNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
@@ -307,6 +353,11 @@ func (in *ApplicationDestination) DeepCopy() *ApplicationDestination {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestinationServiceAccount) DeepCopyInto(out *ApplicationDestinationServiceAccount) {
 	*out = *in
+	if in.ResourceServiceAccounts != nil {
+		in, out := &in.ResourceServiceAccounts, &out.ResourceServiceAccounts
+		*out = make([]ResourceServiceAccount, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -440,6 +491,16 @@ func (in *ApplicationSetApplicationStatus) DeepCopyInto(out *ApplicationSetAppli
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Gate != nil {
+		in, out := &in.Gate, &out.Gate
+		*out = new(ApplicationSetStepGateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreviousDeploymentID != nil {
+		in, out := &in.PreviousDeploymentID, &out.PreviousDeploymentID
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -723,6 +784,11 @@ func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(ApplicationSetRolloutStepAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -736,6 +802,43 @@ func (in *ApplicationSetRolloutStep) DeepCopy() *ApplicationSetRolloutStep {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutStepAnalysis) DeepCopyInto(out *ApplicationSetRolloutStepAnalysis) {
+	*out = *in
+	if in.Web != nil {
+		in, out := &in.Web, &out.Web
+		*out = new(ApplicationSetRolloutStepWebAnalysis)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutStepAnalysis.
+func (in *ApplicationSetRolloutStepAnalysis) DeepCopy() *ApplicationSetRolloutStepAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutStepAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutStepWebAnalysis) DeepCopyInto(out *ApplicationSetRolloutStepWebAnalysis) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutStepWebAnalysis.
+func (in *ApplicationSetRolloutStepWebAnalysis) DeepCopy() *ApplicationSetRolloutStepWebAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutStepWebAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetRolloutStrategy) DeepCopyInto(out *ApplicationSetRolloutStrategy) {
 	*out = *in
@@ -853,6 +956,30 @@ func (in *ApplicationSetStatus) DeepCopy() *ApplicationSetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetStepGateStatus) DeepCopyInto(out *ApplicationSetStepGateStatus) {
+	*out = *in
+	if in.BakeUntil != nil {
+		in, out := &in.BakeUntil, &out.BakeUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.LastAnalysisTime != nil {
+		in, out := &in.LastAnalysisTime, &out.LastAnalysisTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetStepGateStatus.
+func (in *ApplicationSetStepGateStatus) DeepCopy() *ApplicationSetStepGateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetStepGateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetStrategy) DeepCopyInto(out *ApplicationSetStrategy) {
 	*out = *in
@@ -1147,6 +1274,11 @@ func (in *ApplicationSourceHelm) DeepCopyInto(out *ApplicationSourceHelm) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PostRenderer != nil {
+		in, out := &in.PostRenderer, &out.PostRenderer
+		*out = new(HelmPostRenderer)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1400,6 +1532,11 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1459,6 +1596,11 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		copy(*out, *in)
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.LastResourceDrift != nil {
+		in, out := &in.LastResourceDrift, &out.LastResourceDrift
+		*out = new(ResourceDrift)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1498,6 +1640,83 @@ func (in *ApplicationSummary) DeepCopy() *ApplicationSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSyncRecord) DeepCopyInto(out *ApplicationSyncRecord) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSyncRecord.
+func (in *ApplicationSyncRecord) DeepCopy() *ApplicationSyncRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSyncRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationSyncRecord) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSyncRecordList) DeepCopyInto(out *ApplicationSyncRecordList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApplicationSyncRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSyncRecordList.
+func (in *ApplicationSyncRecordList) DeepCopy() *ApplicationSyncRecordList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSyncRecordList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationSyncRecordList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSyncRecordSpec) DeepCopyInto(out *ApplicationSyncRecordSpec) {
+	*out = *in
+	in.OperationState.DeepCopyInto(&out.OperationState)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSyncRecordSpec.
+func (in *ApplicationSyncRecordSpec) DeepCopy() *ApplicationSyncRecordSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSyncRecordSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationTree) DeepCopyInto(out *ApplicationTree) {
 	*out = *in
@@ -1522,6 +1741,13 @@ func (in *ApplicationTree) DeepCopyInto(out *ApplicationTree) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]ApplicationDependencyNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2311,6 +2537,64 @@ func (in *HelmParameter) DeepCopy() *HelmParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmPostRenderer) DeepCopyInto(out *HelmPostRenderer) {
+	*out = *in
+	if in.Kustomize != nil {
+		in, out := &in.Kustomize, &out.Kustomize
+		*out = new(HelmPostRendererKustomize)
+		**out = **in
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(HelmPostRendererPlugin)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmPostRenderer.
+func (in *HelmPostRenderer) DeepCopy() *HelmPostRenderer {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmPostRenderer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmPostRendererKustomize) DeepCopyInto(out *HelmPostRendererKustomize) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmPostRendererKustomize.
+func (in *HelmPostRendererKustomize) DeepCopy() *HelmPostRendererKustomize {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmPostRendererKustomize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmPostRendererPlugin) DeepCopyInto(out *HelmPostRendererPlugin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmPostRendererPlugin.
+func (in *HelmPostRendererPlugin) DeepCopy() *HelmPostRendererPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmPostRendererPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostInfo) DeepCopyInto(out *HostInfo) {
 	*out = *in
@@ -2381,6 +2665,11 @@ func (in *HydrateOperation) DeepCopy() *HydrateOperation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HydrateTo) DeepCopyInto(out *HydrateTo) {
 	*out = *in
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(HydrateToOCI)
+		**out = **in
+	}
 	return
 }
 
@@ -2394,6 +2683,54 @@ func (in *HydrateTo) DeepCopy() *HydrateTo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydrateToOCI) DeepCopyInto(out *HydrateToOCI) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydrateToOCI.
+func (in *HydrateToOCI) DeepCopy() *HydrateToOCI {
+	if in == nil {
+		return nil
+	}
+	out := new(HydrateToOCI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratorPullRequest) DeepCopyInto(out *HydratorPullRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratorPullRequest.
+func (in *HydratorPullRequest) DeepCopy() *HydratorPullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratorPullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratorPullRequestStatus) DeepCopyInto(out *HydratorPullRequestStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratorPullRequestStatus.
+func (in *HydratorPullRequestStatus) DeepCopy() *HydratorPullRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratorPullRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in IgnoreDifferences) DeepCopyInto(out *IgnoreDifferences) {
 	{
@@ -2765,6 +3102,27 @@ func (in *ManagedNamespaceMetadata) DeepCopy() *ManagedNamespaceMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestPolicy) DeepCopyInto(out *ManifestPolicy) {
+	*out = *in
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]v1.GroupKind, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestPolicy.
+func (in *ManifestPolicy) DeepCopy() *ManifestPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MatrixGenerator) DeepCopyInto(out *MatrixGenerator) {
 	*out = *in
@@ -3756,6 +4114,23 @@ func (in *ResourceDiff) DeepCopy() *ResourceDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDrift) DeepCopyInto(out *ResourceDrift) {
+	*out = *in
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDrift.
+func (in *ResourceDrift) DeepCopy() *ResourceDrift {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDrift)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences) {
 	*out = *in
@@ -3774,6 +4149,11 @@ func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CELExpressions != nil {
+		in, out := &in.CELExpressions, &out.CELExpressions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3966,6 +4346,22 @@ func (in ResourceResults) DeepCopy() ResourceResults {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceServiceAccount) DeepCopyInto(out *ResourceServiceAccount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceServiceAccount.
+func (in *ResourceServiceAccount) DeepCopy() *ResourceServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 	*out = *in
@@ -4448,6 +4844,11 @@ func (in *SourceHydrator) DeepCopyInto(out *SourceHydrator) {
 	if in.HydrateTo != nil {
 		in, out := &in.HydrateTo, &out.HydrateTo
 		*out = new(HydrateTo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydratorPullRequest)
 		**out = **in
 	}
 	return
@@ -4476,6 +4877,11 @@ func (in *SourceHydratorStatus) DeepCopyInto(out *SourceHydratorStatus) {
 		*out = new(HydrateOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydratorPullRequestStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -4497,6 +4903,11 @@ func (in *SourceIntegrity) DeepCopyInto(out *SourceIntegrity) {
 		*out = new(SourceIntegrityGit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(SourceIntegrityOCI)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4594,6 +5005,16 @@ func (in *SourceIntegrityGitPolicy) DeepCopyInto(out *SourceIntegrityGitPolicy)
 		*out = new(SourceIntegrityGitPolicyGPG)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SSH != nil {
+		in, out := &in.SSH, &out.SSH
+		*out = new(SourceIntegrityGitPolicySSH)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.X509 != nil {
+		in, out := &in.X509, &out.X509
+		*out = new(SourceIntegrityGitPolicyX509)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4644,6 +5065,148 @@ func (in *SourceIntegrityGitPolicyRepo) DeepCopy() *SourceIntegrityGitPolicyRepo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityGitPolicySSH) DeepCopyInto(out *SourceIntegrityGitPolicySSH) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityGitPolicySSH.
+func (in *SourceIntegrityGitPolicySSH) DeepCopy() *SourceIntegrityGitPolicySSH {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityGitPolicySSH)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityGitPolicyX509) DeepCopyInto(out *SourceIntegrityGitPolicyX509) {
+	*out = *in
+	if in.CARoots != nil {
+		in, out := &in.CARoots, &out.CARoots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Identities != nil {
+		in, out := &in.Identities, &out.Identities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimestampAuthorities != nil {
+		in, out := &in.TimestampAuthorities, &out.TimestampAuthorities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityGitPolicyX509.
+func (in *SourceIntegrityGitPolicyX509) DeepCopy() *SourceIntegrityGitPolicyX509 {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityGitPolicyX509)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityOCI) DeepCopyInto(out *SourceIntegrityOCI) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]*SourceIntegrityOCIPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SourceIntegrityOCIPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityOCI.
+func (in *SourceIntegrityOCI) DeepCopy() *SourceIntegrityOCI {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityOCI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityOCIPolicy) DeepCopyInto(out *SourceIntegrityOCIPolicy) {
+	*out = *in
+	if in.Repos != nil {
+		in, out := &in.Repos, &out.Repos
+		*out = make([]SourceIntegrityOCIPolicyRepo, len(*in))
+		copy(*out, *in)
+	}
+	if in.Cosign != nil {
+		in, out := &in.Cosign, &out.Cosign
+		*out = new(SourceIntegrityOCIPolicyCosign)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityOCIPolicy.
+func (in *SourceIntegrityOCIPolicy) DeepCopy() *SourceIntegrityOCIPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityOCIPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityOCIPolicyCosign) DeepCopyInto(out *SourceIntegrityOCIPolicyCosign) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityOCIPolicyCosign.
+func (in *SourceIntegrityOCIPolicyCosign) DeepCopy() *SourceIntegrityOCIPolicyCosign {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityOCIPolicyCosign)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityOCIPolicyRepo) DeepCopyInto(out *SourceIntegrityOCIPolicyRepo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityOCIPolicyRepo.
+func (in *SourceIntegrityOCIPolicyRepo) DeepCopy() *SourceIntegrityOCIPolicyRepo {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityOCIPolicyRepo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuccessfulHydrateOperation) DeepCopyInto(out *SuccessfulHydrateOperation) {
 	*out = *in
@@ -4982,6 +5545,11 @@ func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Dates != nil {
+		in, out := &in.Dates, &out.Dates
+		*out = make([]SyncWindowDateRange, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4995,6 +5563,45 @@ func (in *SyncWindow) DeepCopy() *SyncWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowDateRange) DeepCopyInto(out *SyncWindowDateRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowDateRange.
+func (in *SyncWindowDateRange) DeepCopy() *SyncWindowDateRange {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowDateRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowOccurrence) DeepCopyInto(out *SyncWindowOccurrence) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(SyncWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowOccurrence.
+func (in *SyncWindowOccurrence) DeepCopy() *SyncWindowOccurrence {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowOccurrence)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in SyncWindows) DeepCopyInto(out *SyncWindows) {
 	{
@@ -14,6 +14,8 @@ type Interface interface {
 	Applications() ApplicationInformer
 	// ApplicationSets returns a ApplicationSetInformer.
 	ApplicationSets() ApplicationSetInformer
+	// ApplicationSyncRecords returns a ApplicationSyncRecordInformer.
+	ApplicationSyncRecords() ApplicationSyncRecordInformer
 }
 
 type version struct {
@@ -41,3 +43,8 @@ func (v *version) Applications() ApplicationInformer {
 func (v *version) ApplicationSets() ApplicationSetInformer {
 	return &applicationSetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ApplicationSyncRecords returns a ApplicationSyncRecordInformer.
+func (v *version) ApplicationSyncRecords() ApplicationSyncRecordInformer {
+	return &applicationSyncRecordInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
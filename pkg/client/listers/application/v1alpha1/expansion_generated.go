@@ -25,3 +25,11 @@ type ApplicationSetListerExpansion interface{}
 // ApplicationSetNamespaceListerExpansion allows custom methods to be added to
 // ApplicationSetNamespaceLister.
 type ApplicationSetNamespaceListerExpansion interface{}
+
+// ApplicationSyncRecordListerExpansion allows custom methods to be added to
+// ApplicationSyncRecordLister.
+type ApplicationSyncRecordListerExpansion interface{}
+
+// ApplicationSyncRecordNamespaceListerExpansion allows custom methods to be added to
+// ApplicationSyncRecordNamespaceLister.
+type ApplicationSyncRecordNamespaceListerExpansion interface{}
@@ -128,6 +128,93 @@ func (_c *RepoServerServiceClient_GenerateManifest_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GenerateManifestStream provides a mock function for the type RepoServerServiceClient
+func (_mock *RepoServerServiceClient) GenerateManifestStream(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestStreamClient, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateManifestStream")
+	}
+
+	var r0 apiclient.RepoServerService_GenerateManifestStreamClient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.ManifestRequest, ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestStreamClient, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.ManifestRequest, ...grpc.CallOption) apiclient.RepoServerService_GenerateManifestStreamClient); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apiclient.RepoServerService_GenerateManifestStreamClient)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *apiclient.ManifestRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RepoServerServiceClient_GenerateManifestStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateManifestStream'
+type RepoServerServiceClient_GenerateManifestStream_Call struct {
+	*mock.Call
+}
+
+// GenerateManifestStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *apiclient.ManifestRequest
+//   - opts ...grpc.CallOption
+func (_e *RepoServerServiceClient_Expecter) GenerateManifestStream(ctx any, in any, opts ...any) *RepoServerServiceClient_GenerateManifestStream_Call {
+	return &RepoServerServiceClient_GenerateManifestStream_Call{Call: _e.mock.On("GenerateManifestStream",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *RepoServerServiceClient_GenerateManifestStream_Call) Run(run func(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption)) *RepoServerServiceClient_GenerateManifestStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *apiclient.ManifestRequest
+		if args[1] != nil {
+			arg1 = args[1].(*apiclient.ManifestRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *RepoServerServiceClient_GenerateManifestStream_Call) Return(repoServerService_GenerateManifestStreamClient apiclient.RepoServerService_GenerateManifestStreamClient, err error) *RepoServerServiceClient_GenerateManifestStream_Call {
+	_c.Call.Return(repoServerService_GenerateManifestStreamClient, err)
+	return _c
+}
+
+func (_c *RepoServerServiceClient_GenerateManifestStream_Call) RunAndReturn(run func(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestStreamClient, error)) *RepoServerServiceClient_GenerateManifestStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateManifestWithFiles provides a mock function for the type RepoServerServiceClient
 func (_mock *RepoServerServiceClient) GenerateManifestWithFiles(ctx context.Context, opts ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestWithFilesClient, error) {
 	// grpc.CallOption
@@ -2549,165 +2549,166 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2522 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x73, 0xdc, 0x48,
-	0xd5, 0xf3, 0x61, 0x7b, 0xe6, 0xd9, 0xb1, 0xc7, 0x9d, 0xd8, 0x51, 0x26, 0x89, 0xf1, 0x0a, 0x92,
-	0xca, 0x26, 0xbb, 0xe3, 0x4a, 0x52, 0xbb, 0x81, 0xec, 0xb2, 0x5b, 0x5e, 0x27, 0xb1, 0xbd, 0x89,
-	0x13, 0x23, 0x67, 0x17, 0x02, 0x01, 0xaa, 0xad, 0x69, 0x6b, 0xb4, 0xd6, 0x47, 0x47, 0x6a, 0x39,
-	0x4c, 0xaa, 0x38, 0x41, 0x71, 0xa2, 0x28, 0x4e, 0x7b, 0xe0, 0x7f, 0x50, 0xdc, 0xe0, 0x08, 0x17,
-	0xaa, 0xb6, 0xa8, 0xe2, 0x0c, 0x95, 0xbf, 0xc0, 0x81, 0x2b, 0xd5, 0x1f, 0xd2, 0x48, 0x1a, 0xcd,
-	0xd8, 0x9b, 0x71, 0x26, 0xc0, 0xc5, 0x56, 0xb7, 0x5e, 0xbf, 0xf7, 0xf4, 0xfa, 0x7d, 0xbf, 0x81,
-	0xcb, 0x01, 0xa1, 0x7e, 0x48, 0x82, 0x43, 0x12, 0xac, 0x8a, 0x47, 0x9b, 0xf9, 0x41, 0x37, 0xf5,
-	0xd8, 0xa2, 0x81, 0xcf, 0x7c, 0x04, 0xbd, 0x9d, 0xe6, 0x03, 0xcb, 0x66, 0x9d, 0x68, 0xaf, 0x65,
-	0xfa, 0xee, 0x2a, 0x0e, 0x2c, 0x9f, 0x06, 0xfe, 0x17, 0xe2, 0xe1, 0x5d, 0xb3, 0xbd, 0x7a, 0x78,
-	0x73, 0x95, 0x1e, 0x58, 0xab, 0x98, 0xda, 0xe1, 0x2a, 0xa6, 0xd4, 0xb1, 0x4d, 0xcc, 0x6c, 0xdf,
-	0x5b, 0x3d, 0xbc, 0x8e, 0x1d, 0xda, 0xc1, 0xd7, 0x57, 0x2d, 0xe2, 0x91, 0x00, 0x33, 0xd2, 0x96,
-	0x98, 0x9b, 0xe7, 0x2d, 0xdf, 0xb7, 0x1c, 0xb2, 0x2a, 0x56, 0x7b, 0xd1, 0xfe, 0x2a, 0x71, 0x29,
-	0x53, 0x64, 0xf5, 0xbf, 0xcf, 0xc1, 0xfc, 0x36, 0xf6, 0xec, 0x7d, 0x12, 0x32, 0x83, 0x3c, 0x8b,
-	0x48, 0xc8, 0xd0, 0x53, 0xa8, 0x72, 0x66, 0xb4, 0xd2, 0x4a, 0xe9, 0xca, 0xcc, 0x8d, 0xcd, 0x56,
-	0x8f, 0x9b, 0x56, 0xcc, 0x8d, 0x78, 0xf8, 0xa9, 0xd9, 0x6e, 0x1d, 0xde, 0x6c, 0xd1, 0x03, 0xab,
-	0xc5, 0xb9, 0x69, 0xa5, 0xb8, 0x69, 0xc5, 0xdc, 0xb4, 0x8c, 0xe4, 0xb3, 0x0c, 0x81, 0x15, 0x35,
-	0xa1, 0x16, 0x90, 0x43, 0x3b, 0xb4, 0x7d, 0x4f, 0x2b, 0xaf, 0x94, 0xae, 0xd4, 0x8d, 0x64, 0x8d,
-	0x34, 0x98, 0xf6, 0xfc, 0x75, 0x6c, 0x76, 0x88, 0x56, 0x59, 0x29, 0x5d, 0xa9, 0x19, 0xf1, 0x12,
-	0xad, 0xc0, 0x0c, 0xa6, 0xf4, 0x01, 0xde, 0x23, 0xce, 0x7d, 0xd2, 0xd5, 0xaa, 0xe2, 0x60, 0x7a,
-	0x8b, 0x9f, 0xc5, 0x94, 0x3e, 0xc4, 0x2e, 0xd1, 0x26, 0xc5, 0xdb, 0x78, 0x89, 0x2e, 0x40, 0xdd,
-	0xc3, 0x2e, 0x09, 0x29, 0x36, 0x89, 0x56, 0x13, 0xef, 0x7a, 0x1b, 0xe8, 0xe7, 0xb0, 0x90, 0x62,
-	0x7c, 0xd7, 0x8f, 0x02, 0x93, 0x68, 0x20, 0x3e, 0xfd, 0xd1, 0x68, 0x9f, 0xbe, 0x96, 0x47, 0x6b,
-	0xf4, 0x53, 0x42, 0x3f, 0x81, 0x49, 0x71, 0xf3, 0xda, 0xcc, 0x4a, 0xe5, 0x44, 0xa5, 0x2d, 0xd1,
-	0x22, 0x0f, 0xa6, 0xa9, 0x13, 0x59, 0xb6, 0x17, 0x6a, 0xb3, 0x82, 0xc2, 0xe3, 0xd1, 0x28, 0xac,
-	0xfb, 0xde, 0xbe, 0x6d, 0x6d, 0x63, 0x0f, 0x5b, 0xc4, 0x25, 0x1e, 0xdb, 0x11, 0xc8, 0x8d, 0x98,
-	0x08, 0x7a, 0x01, 0x8d, 0x83, 0x28, 0x64, 0xbe, 0x6b, 0xbf, 0x20, 0x8f, 0x28, 0x3f, 0x1b, 0x6a,
-	0xa7, 0x84, 0x34, 0x1f, 0x8e, 0x46, 0xf8, 0x7e, 0x0e, 0xab, 0xd1, 0x47, 0x87, 0x2b, 0xc9, 0x41,
-	0xb4, 0x47, 0x3e, 0x27, 0x81, 0xd0, 0xae, 0x39, 0xa9, 0x24, 0xa9, 0x2d, 0xa9, 0x46, 0xb6, 0x5a,
-	0x85, 0xda, 0xfc, 0x4a, 0x45, 0xaa, 0x51, 0xb2, 0x85, 0xae, 0xc0, 0xfc, 0x21, 0x09, 0xec, 0xfd,
-	0xee, 0xae, 0x6d, 0x79, 0x98, 0x45, 0x01, 0xd1, 0x1a, 0x42, 0x15, 0xf3, 0xdb, 0xc8, 0x85, 0x53,
-	0x1d, 0xe2, 0xb8, 0x5c, 0xe4, 0xeb, 0x01, 0x69, 0x87, 0xda, 0x82, 0x90, 0xef, 0xc6, 0xe8, 0x37,
-	0x28, 0xd0, 0x19, 0x59, 0xec, 0x9c, 0x31, 0xcf, 0x37, 0x94, 0xa5, 0x48, 0x1b, 0x41, 0x92, 0xb1,
-	0xdc, 0x36, 0xba, 0x0c, 0x73, 0x2c, 0xc0, 0xe6, 0x81, 0xed, 0x59, 0xdb, 0x84, 0x75, 0xfc, 0xb6,
-	0x76, 0x5a, 0x48, 0x22, 0xb7, 0x8b, 0x4c, 0x40, 0xc4, 0xc3, 0x7b, 0x0e, 0x69, 0x4b, 0x5d, 0x7c,
-	0xdc, 0xa5, 0x24, 0xd4, 0xce, 0x88, 0xaf, 0xb8, 0xd9, 0x4a, 0x79, 0xa8, 0x9c, 0x83, 0x68, 0xdd,
-	0xed, 0x3b, 0x75, 0xd7, 0x63, 0x41, 0xd7, 0x28, 0x40, 0x87, 0x0e, 0x60, 0x86, 0x7f, 0x47, 0xac,
-	0x0a, 0x8b, 0x42, 0x15, 0xb6, 0x46, 0x93, 0xd1, 0x66, 0x0f, 0xa1, 0x91, 0xc6, 0x8e, 0x5a, 0x80,
-	0x3a, 0x38, 0xdc, 0x8e, 0x1c, 0x66, 0x53, 0x87, 0x48, 0x36, 0x42, 0x6d, 0x49, 0x88, 0xa9, 0xe0,
-	0x0d, 0xba, 0x0f, 0x10, 0x90, 0xfd, 0x18, 0xee, 0xac, 0xf8, 0xf2, 0x6b, 0xc3, 0xbe, 0xdc, 0x48,
-	0xa0, 0xe5, 0x17, 0xa7, 0x8e, 0x73, 0xe2, 0xfc, 0x33, 0x88, 0xc9, 0x94, 0xb5, 0x0b, 0xb3, 0xd6,
-	0x84, 0x8a, 0x15, 0xbc, 0xe1, 0xba, 0xa8, 0x76, 0x85, 0xd3, 0x3a, 0x27, 0xb5, 0x35, 0xb5, 0x85,
-	0x36, 0xe1, 0x1b, 0xd8, 0xf3, 0x7c, 0x26, 0x3e, 0x3f, 0x66, 0x65, 0x43, 0xb9, 0xf7, 0x1d, 0xcc,
-	0x3a, 0xa1, 0xd6, 0x14, 0xa7, 0x8e, 0x02, 0xe3, 0x2a, 0x61, 0x7b, 0x21, 0xc3, 0x8e, 0x23, 0x80,
-	0xb6, 0xee, 0x68, 0xe7, 0xa5, 0x4a, 0x64, 0x77, 0xd1, 0x73, 0x98, 0x0f, 0x05, 0x8b, 0x5b, 0x1e,
-	0x23, 0x56, 0x60, 0xb3, 0xae, 0x76, 0x41, 0xdc, 0xd8, 0xf6, 0x68, 0x37, 0xb6, 0x9b, 0x45, 0x6a,
-	0xe4, 0xa9, 0x34, 0xef, 0xc2, 0xd9, 0x01, 0x5a, 0x85, 0x1a, 0x50, 0x39, 0x20, 0x5d, 0x11, 0x8d,
-	0xea, 0x06, 0x7f, 0x44, 0x67, 0x60, 0xf2, 0x10, 0x3b, 0x11, 0x11, 0xf1, 0xa3, 0x66, 0xc8, 0xc5,
-	0xed, 0xf2, 0xb7, 0x4b, 0xcd, 0x5f, 0x95, 0x60, 0x3e, 0x77, 0x47, 0x05, 0xe7, 0x7f, 0x9c, 0x3e,
-	0x7f, 0x02, 0x16, 0xbb, 0xff, 0x18, 0x07, 0x16, 0x61, 0x29, 0x46, 0xf4, 0xbf, 0x95, 0x40, 0xcb,
-	0x29, 0xcf, 0xf7, 0x6d, 0xd6, 0xb9, 0x67, 0x3b, 0x24, 0x44, 0xb7, 0x60, 0x3a, 0x90, 0x7b, 0x2a,
-	0xc6, 0x9e, 0x1f, 0xa2, 0x73, 0x9b, 0x13, 0x46, 0x0c, 0x8d, 0x3e, 0x82, 0x9a, 0x4b, 0x18, 0x6e,
-	0x63, 0x86, 0x15, 0xef, 0x2b, 0x45, 0x27, 0x39, 0x95, 0x6d, 0x05, 0xb7, 0x39, 0x61, 0x24, 0x67,
-	0xd0, 0x7b, 0x30, 0x69, 0x76, 0x22, 0xef, 0x40, 0x44, 0xd7, 0x99, 0x1b, 0x17, 0x07, 0x1d, 0x5e,
-	0xe7, 0x40, 0x9b, 0x13, 0x86, 0x84, 0xfe, 0x64, 0x0a, 0xaa, 0x14, 0x07, 0x4c, 0xbf, 0x07, 0x67,
-	0x8a, 0x48, 0xf0, 0x90, 0x6e, 0x76, 0x88, 0x79, 0x10, 0x46, 0xae, 0x12, 0x73, 0xb2, 0x46, 0x08,
-	0xaa, 0xa1, 0xfd, 0x42, 0x8a, 0xba, 0x62, 0x88, 0x67, 0xfd, 0x6d, 0x58, 0xe8, 0xa3, 0xc6, 0x2f,
-	0x55, 0xf2, 0xc6, 0x31, 0xcc, 0x2a, 0xd2, 0x7a, 0x04, 0x8b, 0x8f, 0x85, 0x2c, 0x92, 0xb8, 0x36,
-	0x8e, 0x24, 0x45, 0xdf, 0x84, 0xa5, 0x3c, 0xd9, 0x90, 0xfa, 0x5e, 0x48, 0xb8, 0x95, 0x8b, 0x40,
-	0x60, 0x93, 0x76, 0xef, 0xad, 0xe0, 0xa2, 0x66, 0x14, 0xbc, 0xd1, 0xff, 0x5a, 0x86, 0x25, 0x83,
-	0x84, 0xbe, 0x73, 0x48, 0x62, 0x2f, 0x3d, 0x9e, 0x3c, 0xeb, 0x47, 0x50, 0xc1, 0x94, 0x2a, 0x35,
-	0xd9, 0x3a, 0xb1, 0x4c, 0xc6, 0xe0, 0x58, 0xd1, 0x3b, 0xb0, 0x80, 0xdd, 0x3d, 0xdb, 0x8a, 0xfc,
-	0x28, 0x8c, 0x3f, 0x4b, 0x28, 0x55, 0xdd, 0xe8, 0x7f, 0xc1, 0x3d, 0x5d, 0x6c, 0xef, 0x6d, 0xf2,
-	0x33, 0x91, 0xbc, 0x55, 0x8c, 0xf4, 0x56, 0x51, 0x70, 0x9b, 0x2c, 0x0c, 0x6e, 0xba, 0x09, 0x67,
-	0xfb, 0xc4, 0xa9, 0xae, 0x26, 0x9d, 0x59, 0x96, 0x72, 0x99, 0x65, 0x21, 0xc3, 0xe5, 0x01, 0x0c,
-	0xeb, 0xff, 0x2a, 0x43, 0xa3, 0x67, 0x86, 0x0a, 0xfd, 0x05, 0xa8, 0xbb, 0x6a, 0x2f, 0xd4, 0x4a,
-	0xc2, 0xad, 0xf7, 0x36, 0xb2, 0x49, 0x66, 0x39, 0x9f, 0x64, 0x2e, 0xc1, 0x94, 0xac, 0x01, 0x94,
-	0x90, 0xd4, 0x2a, 0xc3, 0x72, 0x35, 0xc7, 0xf2, 0x32, 0x40, 0x98, 0xf8, 0x42, 0x6d, 0x4a, 0xbc,
-	0x4d, 0xed, 0x20, 0x1d, 0x66, 0x65, 0x4a, 0x62, 0x90, 0x30, 0x72, 0x98, 0x36, 0x2d, 0x20, 0x32,
-	0x7b, 0xc2, 0x32, 0x7d, 0xd7, 0xc5, 0x5e, 0x3b, 0xd4, 0x6a, 0x82, 0xe5, 0x64, 0x8d, 0x7e, 0x53,
-	0x82, 0xc5, 0x9c, 0x1b, 0x56, 0x98, 0xea, 0x42, 0x67, 0x7e, 0x70, 0xa2, 0x2e, 0x7f, 0x9d, 0x3b,
-	0x04, 0x89, 0xdf, 0x28, 0x26, 0xab, 0xfb, 0x30, 0xff, 0xc0, 0xe6, 0x02, 0xdf, 0x0f, 0xc7, 0x63,
-	0xe5, 0xef, 0x43, 0x95, 0x13, 0xe3, 0x52, 0xda, 0x0b, 0xb0, 0x67, 0x76, 0x48, 0x7c, 0xb1, 0xc9,
-	0x9a, 0xfb, 0x2f, 0x86, 0xad, 0x50, 0x2b, 0x8b, 0x7d, 0xf1, 0xac, 0xff, 0xa1, 0x2c, 0x39, 0x5d,
-	0xa3, 0x34, 0x7c, 0xf3, 0x45, 0x53, 0x71, 0x1a, 0x57, 0xe9, 0x4f, 0xe3, 0x72, 0x2c, 0x7f, 0x9d,
-	0x34, 0xee, 0x84, 0xe2, 0xb3, 0x1e, 0xc1, 0xf4, 0x1a, 0xa5, 0x9c, 0x11, 0x74, 0x1d, 0xaa, 0x98,
-	0x52, 0x29, 0xf0, 0x5c, 0x28, 0x52, 0x20, 0xfc, 0xbf, 0x62, 0x49, 0x80, 0x36, 0x6f, 0x41, 0x3d,
-	0xd9, 0x3a, 0x8a, 0x6c, 0x3d, 0x4d, 0x76, 0x05, 0x40, 0xd6, 0x29, 0x5b, 0xde, 0xbe, 0xcf, 0xaf,
-	0x94, 0x5b, 0xa6, 0x3a, 0x2a, 0x9e, 0xf5, 0xdb, 0x31, 0x84, 0xe0, 0xed, 0x1d, 0x98, 0xb4, 0x19,
-	0x71, 0x63, 0xe6, 0x96, 0xd2, 0xcc, 0xf5, 0x10, 0x19, 0x12, 0x48, 0xff, 0x73, 0x0d, 0xce, 0xf1,
-	0x1b, 0xdb, 0x15, 0x36, 0xbd, 0x46, 0xe9, 0x1d, 0xc2, 0xb0, 0xed, 0x84, 0xdf, 0x8b, 0x48, 0xd0,
-	0x7d, 0xcd, 0x8a, 0x61, 0xc1, 0x94, 0x34, 0x26, 0xe5, 0xe8, 0x4f, 0xbc, 0x64, 0x55, 0xe8, 0x7b,
-	0x75, 0x6a, 0xe5, 0xf5, 0xd4, 0xa9, 0x45, 0x75, 0x63, 0x75, 0x4c, 0x75, 0xe3, 0xe0, 0xd6, 0x41,
-	0xaa, 0x21, 0x31, 0x95, 0x6d, 0x48, 0x14, 0x44, 0xac, 0xe9, 0xe3, 0x96, 0x63, 0xb5, 0xc2, 0x72,
-	0xcc, 0x2d, 0xb4, 0xe3, 0xba, 0x10, 0xf7, 0x77, 0xd3, 0x1a, 0x38, 0x50, 0xd7, 0x46, 0x29, 0xcc,
-	0xe0, 0xb5, 0x16, 0x66, 0x9f, 0x65, 0x0a, 0x2d, 0xd9, 0xea, 0x78, 0xef, 0x78, 0xdf, 0x34, 0xa4,
-	0xe4, 0xfa, 0xbf, 0xab, 0x1a, 0x7e, 0x29, 0x92, 0x45, 0xea, 0xf7, 0x64, 0x90, 0x64, 0x1f, 0x3c,
-	0x0e, 0xf1, 0x3c, 0x40, 0x39, 0x2d, 0xfe, 0x8c, 0xae, 0x41, 0x95, 0x0b, 0x59, 0x65, 0xf3, 0x67,
-	0xd3, 0xf2, 0xe4, 0x37, 0xb1, 0x46, 0xe9, 0x2e, 0x25, 0xa6, 0x21, 0x80, 0xd0, 0x6d, 0xa8, 0x27,
-	0x8a, 0xaf, 0x2c, 0xeb, 0x42, 0xfa, 0x44, 0x62, 0x27, 0xf1, 0xb1, 0x1e, 0x38, 0x3f, 0xdb, 0xb6,
-	0x03, 0x62, 0x8a, 0x5c, 0x77, 0xb2, 0xff, 0xec, 0x9d, 0xf8, 0x65, 0x72, 0x36, 0x01, 0x47, 0xd7,
-	0x61, 0x4a, 0xf6, 0x86, 0x84, 0x05, 0xcd, 0xdc, 0x38, 0xd7, 0xef, 0x4c, 0xe3, 0x53, 0x0a, 0x50,
-	0xff, 0x63, 0x19, 0xde, 0xea, 0x29, 0x44, 0x6c, 0x4d, 0x71, 0xb9, 0xf1, 0xe6, 0x23, 0xee, 0x65,
-	0x98, 0x13, 0xf5, 0x4d, 0xaf, 0x45, 0x24, 0xbb, 0x95, 0xb9, 0xdd, 0xa2, 0x6a, 0xba, 0x3a, 0x8e,
-	0x6a, 0x5a, 0xff, 0x7d, 0x09, 0x2e, 0xf5, 0x0b, 0x70, 0xbd, 0x83, 0x03, 0x96, 0xe8, 0xd5, 0x38,
-	0x84, 0x18, 0x47, 0xda, 0x72, 0x2f, 0xd2, 0x66, 0x04, 0x5b, 0xc9, 0x0a, 0x56, 0xff, 0x53, 0x19,
-	0x66, 0x52, 0x9a, 0x5b, 0x14, 0xa9, 0x79, 0x5a, 0x2c, 0x0c, 0x46, 0x94, 0xd2, 0x22, 0x1a, 0xd5,
-	0x8d, 0xd4, 0x0e, 0x3a, 0x00, 0xa0, 0x38, 0xc0, 0x2e, 0x61, 0x24, 0xe0, 0x21, 0x84, 0xbb, 0x9a,
-	0xfb, 0xa3, 0xbb, 0xb5, 0x9d, 0x18, 0xa7, 0x91, 0x42, 0xcf, 0xf3, 0x7a, 0x41, 0x3a, 0x54, 0x81,
-	0x43, 0xad, 0xd0, 0x73, 0x98, 0xdb, 0xb7, 0x1d, 0xb2, 0xd3, 0x63, 0x64, 0x4a, 0x30, 0xf2, 0x68,
-	0x74, 0x46, 0xee, 0xa5, 0xf1, 0x1a, 0x39, 0x32, 0xfa, 0x55, 0x68, 0xe4, 0x0d, 0x99, 0x33, 0x69,
-	0xbb, 0xd8, 0x4a, 0xa4, 0xa5, 0x56, 0x3a, 0x82, 0x46, 0xde, 0x70, 0xf5, 0x7f, 0x94, 0x61, 0x31,
-	0x41, 0xb7, 0xe6, 0x79, 0x7e, 0xe4, 0x99, 0xa2, 0xcf, 0x5b, 0x78, 0x17, 0x67, 0x60, 0x92, 0xd9,
-	0xcc, 0x49, 0x32, 0x2e, 0xb1, 0xe0, 0x41, 0x93, 0xf9, 0xbe, 0xc3, 0x6c, 0xaa, 0x2e, 0x38, 0x5e,
-	0xca, 0xbb, 0x7f, 0x16, 0xd9, 0x01, 0x69, 0x0b, 0x4b, 0xa8, 0x19, 0xc9, 0x9a, 0xbf, 0xe3, 0xe9,
-	0x94, 0x28, 0x76, 0xa4, 0x30, 0x93, 0xb5, 0x30, 0x38, 0xdf, 0x71, 0x88, 0xc9, 0xc5, 0x91, 0x2a,
-	0x87, 0x72, 0xbb, 0xa2, 0xcc, 0x62, 0x81, 0xed, 0x59, 0xaa, 0x18, 0x52, 0x2b, 0xce, 0x27, 0x0e,
-	0x02, 0xdc, 0x55, 0x35, 0x90, 0x5c, 0xa0, 0x0f, 0xa1, 0xe2, 0x62, 0xaa, 0x22, 0xec, 0xd5, 0x8c,
-	0x5b, 0x2a, 0x92, 0x40, 0x6b, 0x1b, 0x53, 0x19, 0x82, 0xf8, 0xb1, 0xe6, 0xfb, 0x50, 0x8b, 0x37,
-	0xbe, 0x56, 0x2e, 0xfa, 0x05, 0x9c, 0xca, 0x78, 0x3d, 0xf4, 0x04, 0x96, 0x7a, 0x1a, 0x95, 0x26,
-	0xa8, 0xb2, 0xcf, 0xb7, 0x8e, 0xe4, 0xcc, 0x18, 0x80, 0x40, 0x7f, 0x06, 0x0b, 0x5c, 0x65, 0x84,
-	0xe1, 0x8f, 0xa9, 0xa6, 0xfa, 0x00, 0xea, 0x09, 0xc9, 0x42, 0x9d, 0x69, 0x42, 0xed, 0x30, 0xee,
-	0xbf, 0xcb, 0xa2, 0x2a, 0x59, 0xeb, 0x6b, 0x80, 0xd2, 0xfc, 0xaa, 0xd0, 0x77, 0x2d, 0x9b, 0x8d,
-	0x2f, 0xe6, 0xe3, 0x9c, 0x00, 0x8f, 0x93, 0xf1, 0xaf, 0x2a, 0x30, 0xbf, 0x61, 0x8b, 0xbe, 0xd2,
-	0x98, 0x9c, 0xdc, 0x55, 0x68, 0x84, 0xd1, 0x9e, 0xeb, 0xb7, 0x23, 0x87, 0xa8, 0x6c, 0x44, 0xa5,
-	0x18, 0x7d, 0xfb, 0xc3, 0x9c, 0x1f, 0x17, 0x16, 0xc5, 0xac, 0xa3, 0xfa, 0x00, 0xe2, 0x19, 0x7d,
-	0x08, 0xe7, 0x1e, 0x92, 0xe7, 0xea, 0x7b, 0x36, 0x1c, 0x7f, 0x6f, 0xcf, 0xf6, 0xac, 0x98, 0x88,
-	0xec, 0x90, 0x0c, 0x06, 0x28, 0xca, 0x51, 0xa7, 0x8a, 0x73, 0xd4, 0xa4, 0x97, 0xb0, 0xee, 0xbb,
-	0xae, 0xcd, 0x54, 0x2a, 0x9b, 0xd9, 0x2b, 0x8a, 0x66, 0xb5, 0xb1, 0x44, 0xb3, 0x5f, 0x94, 0xa0,
-	0xd1, 0xbb, 0x52, 0xa5, 0x14, 0xb7, 0xa4, 0xf1, 0x4a, 0x95, 0xb8, 0x94, 0x56, 0x89, 0x3c, 0xe8,
-	0xab, 0xdb, 0xed, 0x6c, 0x26, 0x37, 0xab, 0xc0, 0xe2, 0x86, 0xcd, 0x62, 0x8f, 0x69, 0xff, 0xaf,
-	0xa9, 0x57, 0x81, 0x32, 0x54, 0x8f, 0xa7, 0x0c, 0x93, 0xc7, 0x53, 0x86, 0xa9, 0xb1, 0x28, 0x43,
-	0x0b, 0x96, 0xf2, 0xb7, 0xa0, 0x34, 0xe2, 0x0c, 0x4c, 0x52, 0x31, 0x13, 0x91, 0x2d, 0x1c, 0xb9,
-	0xd0, 0xff, 0x5d, 0x87, 0x8b, 0x9f, 0xd1, 0x36, 0x66, 0x49, 0xbf, 0xf0, 0x9e, 0x1f, 0x88, 0xa1,
-	0xc8, 0x78, 0xae, 0x2f, 0x37, 0xb8, 0x2e, 0x0f, 0x1d, 0x5c, 0x57, 0x86, 0x0c, 0xae, 0xab, 0xc7,
-	0x1a, 0x5c, 0x4f, 0x8e, 0x6d, 0x70, 0xdd, 0x5f, 0xd6, 0x4e, 0x15, 0x96, 0xb5, 0x4f, 0x32, 0xa5,
-	0xdf, 0xb4, 0xb0, 0xd7, 0xef, 0xa4, 0xed, 0x75, 0xe8, 0xed, 0x0c, 0x9d, 0xb8, 0xe5, 0xe6, 0xbd,
-	0xb5, 0x23, 0xe7, 0xbd, 0xf5, 0xfe, 0x79, 0x6f, 0xf1, 0xc8, 0x10, 0x06, 0x8e, 0x0c, 0x2f, 0xc3,
-	0x5c, 0xd8, 0xf5, 0x4c, 0xd2, 0x4e, 0xba, 0xc8, 0x33, 0xf2, 0xb3, 0xb3, 0xbb, 0x19, 0x53, 0x9c,
-	0xcd, 0x99, 0x62, 0xa2, 0xa9, 0xa7, 0x52, 0x9a, 0x5a, 0x64, 0xa0, 0x73, 0x03, 0x3b, 0x0a, 0xb9,
-	0x69, 0xde, 0x7c, 0xe1, 0x34, 0xef, 0x00, 0x1a, 0x31, 0x57, 0xc9, 0x05, 0x34, 0xc4, 0x05, 0x7c,
-	0x7c, 0xfc, 0x0b, 0xd8, 0xcd, 0x61, 0x90, 0xd7, 0xd0, 0x87, 0xb8, 0xc8, 0x23, 0x2c, 0x8c, 0x65,
-	0x74, 0xf8, 0xdf, 0x52, 0xbd, 0x37, 0x7f, 0x5d, 0x82, 0xc5, 0x42, 0x69, 0xbd, 0x99, 0x66, 0xc2,
-	0xe7, 0xb0, 0x3c, 0xe8, 0x66, 0x95, 0xc7, 0xd4, 0x60, 0xda, 0xec, 0x60, 0xcf, 0x12, 0x6d, 0x6f,
-	0xd1, 0xdd, 0x52, 0xcb, 0x61, 0xd5, 0xef, 0x8d, 0x2f, 0x67, 0x61, 0xa1, 0x57, 0x5c, 0xf2, 0xbf,
-	0xb6, 0x49, 0xd0, 0x23, 0x68, 0xc4, 0x23, 0xe7, 0x78, 0x72, 0x82, 0x86, 0x8d, 0x35, 0x9b, 0x17,
-	0x8a, 0x5f, 0x4a, 0xd6, 0xf4, 0x09, 0x64, 0xc2, 0xb9, 0x3c, 0xc2, 0xde, 0x04, 0xf5, 0x5b, 0x43,
-	0x30, 0x27, 0x50, 0x47, 0x91, 0xb8, 0x52, 0x42, 0x4f, 0x60, 0x2e, 0x3b, 0xe7, 0x43, 0x99, 0x6c,
-	0xbb, 0x70, 0xf4, 0xd8, 0xd4, 0x87, 0x81, 0x24, 0xfc, 0x3f, 0xe5, 0x5a, 0x99, 0x19, 0x54, 0x21,
-	0x3d, 0xdb, 0xf1, 0x2a, 0x1a, 0x0a, 0x36, 0xbf, 0x39, 0x14, 0x26, 0xc1, 0xfe, 0x01, 0xd4, 0xe2,
-	0x59, 0x49, 0x56, 0xcc, 0xb9, 0x09, 0x4a, 0xb3, 0x91, 0xc5, 0xb7, 0x1f, 0xea, 0x13, 0xe8, 0x23,
-	0x98, 0xe1, 0x60, 0x8f, 0xd6, 0xb7, 0x1e, 0x63, 0xeb, 0x95, 0xce, 0xd7, 0xe2, 0x59, 0x42, 0xff,
-	0xe1, 0xd4, 0x84, 0xa1, 0x79, 0xba, 0xa0, 0xab, 0xaf, 0x4f, 0xa0, 0x8f, 0x25, 0xfd, 0x1d, 0xf5,
-	0x93, 0xa1, 0xa5, 0x96, 0xfc, 0x85, 0x5a, 0x2b, 0xfe, 0x85, 0x5a, 0xeb, 0xae, 0x4b, 0x59, 0xb7,
-	0x59, 0xd0, 0x76, 0x57, 0x08, 0x9e, 0xc2, 0xa9, 0x0d, 0xc2, 0x7a, 0x5d, 0x32, 0x74, 0xe9, 0x58,
-	0xbd, 0xc4, 0xa6, 0x9e, 0x07, 0xeb, 0x6f, 0xb4, 0xe9, 0x13, 0xe8, 0xcb, 0x12, 0x9c, 0xde, 0x20,
-	0x2c, 0xdf, 0x77, 0x42, 0xef, 0x16, 0x13, 0x19, 0xd0, 0x9f, 0x6a, 0x3e, 0x1c, 0xd5, 0xa6, 0xb3,
-	0x68, 0xf5, 0x09, 0xf4, 0xdb, 0x12, 0xcc, 0x6d, 0x10, 0x7e, 0x6f, 0x09, 0x4f, 0xd7, 0x87, 0xf3,
-	0x54, 0xd0, 0xf2, 0x69, 0x8e, 0xd8, 0xe3, 0x4d, 0x51, 0xd7, 0x27, 0xd0, 0xef, 0x4a, 0x70, 0x36,
-	0x25, 0xab, 0x34, 0xbd, 0x57, 0xe1, 0xed, 0xd3, 0x11, 0x7f, 0x9c, 0x96, 0x42, 0xa9, 0x4f, 0xa0,
-	0x1d, 0xa1, 0x26, 0xbd, 0x8a, 0x12, 0x5d, 0x2c, 0x2c, 0x1d, 0x13, 0xea, 0xcb, 0x83, 0x5e, 0x27,
-	0xaa, 0xf1, 0x29, 0xcc, 0x6c, 0x10, 0x16, 0x57, 0x18, 0x59, 0xe5, 0xcf, 0x55, 0x9d, 0x59, 0xef,
-	0x93, 0x2f, 0x4a, 0x84, 0x12, 0x2f, 0x48, 0x5c, 0xa9, 0x64, 0x36, 0xeb, 0x7e, 0x0a, 0xcb, 0x8d,
-	0xac, 0x12, 0x17, 0xe7, 0xc2, 0xfa, 0x04, 0x7a, 0x06, 0x4b, 0xc5, 0xde, 0x1f, 0xbd, 0x7d, 0xec,
-	0xd8, 0xdf, 0xbc, 0x7a, 0x1c, 0xd0, 0x98, 0xe4, 0x27, 0x6b, 0x7f, 0x79, 0xb9, 0x5c, 0xfa, 0xea,
-	0xe5, 0x72, 0xe9, 0x9f, 0x2f, 0x97, 0x4b, 0x3f, 0xbc, 0x79, 0xc4, 0x8f, 0x58, 0x53, 0xbf, 0x8b,
-	0xc5, 0xd4, 0x36, 0x1d, 0x9b, 0x78, 0x6c, 0x6f, 0x4a, 0xb8, 0x80, 0x9b, 0xff, 0x09, 0x00, 0x00,
-	0xff, 0xff, 0xe0, 0x92, 0xc0, 0x64, 0x36, 0x2b, 0x00, 0x00,
+	// 2533 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x73, 0x1c, 0x47,
+	0x55, 0xfb, 0xa9, 0xdd, 0x27, 0x59, 0x5a, 0x75, 0x2c, 0x79, 0x3c, 0x51, 0x84, 0x32, 0x60, 0x97,
+	0x63, 0x27, 0x2b, 0x6c, 0x57, 0x62, 0x70, 0x42, 0x52, 0x8a, 0x6c, 0x4b, 0x8e, 0x2d, 0x5b, 0x8c,
+	0x9c, 0x04, 0x83, 0x81, 0xea, 0x9d, 0x6d, 0xcd, 0x4e, 0x76, 0xbe, 0x3c, 0xd3, 0x23, 0x23, 0x57,
+	0x71, 0x82, 0xe2, 0x44, 0x51, 0x9c, 0x38, 0xf0, 0x3f, 0x28, 0x6e, 0x70, 0x84, 0x0b, 0x55, 0x29,
+	0xaa, 0x38, 0x43, 0xf9, 0x0f, 0x70, 0xe0, 0xc0, 0x95, 0xea, 0x8f, 0x99, 0x9d, 0x99, 0x9d, 0x5d,
+	0x29, 0x5e, 0x7b, 0x0d, 0x5c, 0xa4, 0xe9, 0x9e, 0xd7, 0xef, 0xbd, 0x79, 0xfd, 0xbe, 0xdf, 0xc2,
+	0xf9, 0x80, 0xf8, 0x5e, 0x48, 0x82, 0x43, 0x12, 0x6c, 0xf0, 0x47, 0x8b, 0x7a, 0xc1, 0x51, 0xea,
+	0xb1, 0xed, 0x07, 0x1e, 0xf5, 0x10, 0x0c, 0x76, 0xd4, 0xbb, 0xa6, 0x45, 0x7b, 0x51, 0xa7, 0x6d,
+	0x78, 0xce, 0x06, 0x0e, 0x4c, 0xcf, 0x0f, 0xbc, 0x2f, 0xf8, 0xc3, 0x3b, 0x46, 0x77, 0xe3, 0xf0,
+	0xea, 0x86, 0xdf, 0x37, 0x37, 0xb0, 0x6f, 0x85, 0x1b, 0xd8, 0xf7, 0x6d, 0xcb, 0xc0, 0xd4, 0xf2,
+	0xdc, 0x8d, 0xc3, 0xcb, 0xd8, 0xf6, 0x7b, 0xf8, 0xf2, 0x86, 0x49, 0x5c, 0x12, 0x60, 0x4a, 0xba,
+	0x02, 0xb3, 0xfa, 0xba, 0xe9, 0x79, 0xa6, 0x4d, 0x36, 0xf8, 0xaa, 0x13, 0x1d, 0x6c, 0x10, 0xc7,
+	0xa7, 0x92, 0xac, 0xf6, 0xb7, 0x05, 0x58, 0xdc, 0xc5, 0xae, 0x75, 0x40, 0x42, 0xaa, 0x93, 0xc7,
+	0x11, 0x09, 0x29, 0x7a, 0x04, 0x55, 0xc6, 0x8c, 0x52, 0x5a, 0x2f, 0x5d, 0x98, 0xbb, 0xb2, 0xd3,
+	0x1e, 0x70, 0xd3, 0x8e, 0xb9, 0xe1, 0x0f, 0x3f, 0x36, 0xba, 0xed, 0xc3, 0xab, 0x6d, 0xbf, 0x6f,
+	0xb6, 0x19, 0x37, 0xed, 0x14, 0x37, 0xed, 0x98, 0x9b, 0xb6, 0x9e, 0x7c, 0x96, 0xce, 0xb1, 0x22,
+	0x15, 0x1a, 0x01, 0x39, 0xb4, 0x42, 0xcb, 0x73, 0x95, 0xf2, 0x7a, 0xe9, 0x42, 0x53, 0x4f, 0xd6,
+	0x48, 0x81, 0x59, 0xd7, 0xdb, 0xc2, 0x46, 0x8f, 0x28, 0x95, 0xf5, 0xd2, 0x85, 0x86, 0x1e, 0x2f,
+	0xd1, 0x3a, 0xcc, 0x61, 0xdf, 0xbf, 0x8b, 0x3b, 0xc4, 0xbe, 0x43, 0x8e, 0x94, 0x2a, 0x3f, 0x98,
+	0xde, 0x62, 0x67, 0xb1, 0xef, 0xdf, 0xc3, 0x0e, 0x51, 0x6a, 0xfc, 0x6d, 0xbc, 0x44, 0xab, 0xd0,
+	0x74, 0xb1, 0x43, 0x42, 0x1f, 0x1b, 0x44, 0x69, 0xf0, 0x77, 0x83, 0x0d, 0xf4, 0x53, 0x58, 0x4a,
+	0x31, 0xbe, 0xef, 0x45, 0x81, 0x41, 0x14, 0xe0, 0x9f, 0x7e, 0x7f, 0xb2, 0x4f, 0xdf, 0xcc, 0xa3,
+	0xd5, 0x87, 0x29, 0xa1, 0x1f, 0x41, 0x8d, 0xdf, 0xbc, 0x32, 0xb7, 0x5e, 0x79, 0xa1, 0xd2, 0x16,
+	0x68, 0x91, 0x0b, 0xb3, 0xbe, 0x1d, 0x99, 0x96, 0x1b, 0x2a, 0xf3, 0x9c, 0xc2, 0x83, 0xc9, 0x28,
+	0x6c, 0x79, 0xee, 0x81, 0x65, 0xee, 0x62, 0x17, 0x9b, 0xc4, 0x21, 0x2e, 0xdd, 0xe3, 0xc8, 0xf5,
+	0x98, 0x08, 0x7a, 0x0a, 0xad, 0x7e, 0x14, 0x52, 0xcf, 0xb1, 0x9e, 0x92, 0xfb, 0x3e, 0x3b, 0x1b,
+	0x2a, 0xa7, 0xb8, 0x34, 0xef, 0x4d, 0x46, 0xf8, 0x4e, 0x0e, 0xab, 0x3e, 0x44, 0x87, 0x29, 0x49,
+	0x3f, 0xea, 0x90, 0xcf, 0x48, 0xc0, 0xb5, 0x6b, 0x41, 0x28, 0x49, 0x6a, 0x4b, 0xa8, 0x91, 0x25,
+	0x57, 0xa1, 0xb2, 0xb8, 0x5e, 0x11, 0x6a, 0x94, 0x6c, 0xa1, 0x0b, 0xb0, 0x78, 0x48, 0x02, 0xeb,
+	0xe0, 0x68, 0xdf, 0x32, 0x5d, 0x4c, 0xa3, 0x80, 0x28, 0x2d, 0xae, 0x8a, 0xf9, 0x6d, 0xe4, 0xc0,
+	0xa9, 0x1e, 0xb1, 0x1d, 0x26, 0xf2, 0xad, 0x80, 0x74, 0x43, 0x65, 0x89, 0xcb, 0x77, 0x7b, 0xf2,
+	0x1b, 0xe4, 0xe8, 0xf4, 0x2c, 0x76, 0xc6, 0x98, 0xeb, 0xe9, 0xd2, 0x52, 0x84, 0x8d, 0x20, 0xc1,
+	0x58, 0x6e, 0x1b, 0x9d, 0x87, 0x05, 0x1a, 0x60, 0xa3, 0x6f, 0xb9, 0xe6, 0x2e, 0xa1, 0x3d, 0xaf,
+	0xab, 0xbc, 0xc6, 0x25, 0x91, 0xdb, 0x45, 0x06, 0x20, 0xe2, 0xe2, 0x8e, 0x4d, 0xba, 0x42, 0x17,
+	0x1f, 0x1c, 0xf9, 0x24, 0x54, 0x4e, 0xf3, 0xaf, 0xb8, 0xda, 0x4e, 0x79, 0xa8, 0x9c, 0x83, 0x68,
+	0xdf, 0x1c, 0x3a, 0x75, 0xd3, 0xa5, 0xc1, 0x91, 0x5e, 0x80, 0x0e, 0xf5, 0x61, 0x8e, 0x7d, 0x47,
+	0xac, 0x0a, 0xcb, 0x5c, 0x15, 0x6e, 0x4f, 0x26, 0xa3, 0x9d, 0x01, 0x42, 0x3d, 0x8d, 0x1d, 0xb5,
+	0x01, 0xf5, 0x70, 0xb8, 0x1b, 0xd9, 0xd4, 0xf2, 0x6d, 0x22, 0xd8, 0x08, 0x95, 0x15, 0x2e, 0xa6,
+	0x82, 0x37, 0xe8, 0x0e, 0x40, 0x40, 0x0e, 0x62, 0xb8, 0x33, 0xfc, 0xcb, 0x2f, 0x8d, 0xfb, 0x72,
+	0x3d, 0x81, 0x16, 0x5f, 0x9c, 0x3a, 0xce, 0x88, 0xb3, 0xcf, 0x20, 0x06, 0x15, 0x3b, 0xdc, 0x16,
+	0x15, 0x85, 0xab, 0x58, 0xc1, 0x1b, 0xa6, 0x8b, 0x72, 0x97, 0x3b, 0xad, 0xb3, 0x42, 0x5b, 0x53,
+	0x5b, 0x68, 0x07, 0xbe, 0x86, 0x5d, 0xd7, 0xa3, 0xfc, 0xf3, 0x63, 0x56, 0xb6, 0xa5, 0x7b, 0xdf,
+	0xc3, 0xb4, 0x17, 0x2a, 0x2a, 0x3f, 0x75, 0x1c, 0x18, 0x53, 0x09, 0xcb, 0x0d, 0x29, 0xb6, 0x6d,
+	0x0e, 0x74, 0xfb, 0x86, 0xf2, 0xba, 0x50, 0x89, 0xec, 0x2e, 0x7a, 0x02, 0x8b, 0x21, 0x67, 0xf1,
+	0xb6, 0x4b, 0x89, 0x19, 0x58, 0xf4, 0x48, 0x59, 0xe5, 0x37, 0xb6, 0x3b, 0xd9, 0x8d, 0xed, 0x67,
+	0x91, 0xea, 0x79, 0x2a, 0xea, 0x4d, 0x38, 0x33, 0x42, 0xab, 0x50, 0x0b, 0x2a, 0x7d, 0x72, 0xc4,
+	0xa3, 0x51, 0x53, 0x67, 0x8f, 0xe8, 0x34, 0xd4, 0x0e, 0xb1, 0x1d, 0x11, 0x1e, 0x3f, 0x1a, 0xba,
+	0x58, 0x5c, 0x2f, 0x7f, 0xab, 0xa4, 0xfe, 0xa2, 0x04, 0x8b, 0xb9, 0x3b, 0x2a, 0x38, 0xff, 0xc3,
+	0xf4, 0xf9, 0x17, 0x60, 0xb1, 0x07, 0x0f, 0x70, 0x60, 0x12, 0x9a, 0x62, 0x44, 0xfb, 0x6b, 0x09,
+	0x94, 0x9c, 0xf2, 0x7c, 0x6e, 0xd1, 0xde, 0x2d, 0xcb, 0x26, 0x21, 0xba, 0x06, 0xb3, 0x81, 0xd8,
+	0x93, 0x31, 0xf6, 0xf5, 0x31, 0x3a, 0xb7, 0x33, 0xa3, 0xc7, 0xd0, 0xe8, 0x43, 0x68, 0x38, 0x84,
+	0xe2, 0x2e, 0xa6, 0x58, 0xf2, 0xbe, 0x5e, 0x74, 0x92, 0x51, 0xd9, 0x95, 0x70, 0x3b, 0x33, 0x7a,
+	0x72, 0x06, 0xbd, 0x0b, 0x35, 0xa3, 0x17, 0xb9, 0x7d, 0x1e, 0x5d, 0xe7, 0xae, 0xbc, 0x31, 0xea,
+	0xf0, 0x16, 0x03, 0xda, 0x99, 0xd1, 0x05, 0xf4, 0xc7, 0x75, 0xa8, 0xfa, 0x38, 0xa0, 0xda, 0x2d,
+	0x38, 0x5d, 0x44, 0x82, 0x85, 0x74, 0xa3, 0x47, 0x8c, 0x7e, 0x18, 0x39, 0x52, 0xcc, 0xc9, 0x1a,
+	0x21, 0xa8, 0x86, 0xd6, 0x53, 0x21, 0xea, 0x8a, 0xce, 0x9f, 0xb5, 0xb7, 0x60, 0x69, 0x88, 0x1a,
+	0xbb, 0x54, 0xc1, 0x1b, 0xc3, 0x30, 0x2f, 0x49, 0x6b, 0x11, 0x2c, 0x3f, 0xe0, 0xb2, 0x48, 0xe2,
+	0xda, 0x34, 0x92, 0x14, 0x6d, 0x07, 0x56, 0xf2, 0x64, 0x43, 0xdf, 0x73, 0x43, 0xc2, 0xac, 0x9c,
+	0x07, 0x02, 0x8b, 0x74, 0x07, 0x6f, 0x39, 0x17, 0x0d, 0xbd, 0xe0, 0x8d, 0xf6, 0x97, 0x32, 0xac,
+	0xe8, 0x24, 0xf4, 0xec, 0x43, 0x12, 0x7b, 0xe9, 0xe9, 0xe4, 0x59, 0x3f, 0x80, 0x0a, 0xf6, 0x7d,
+	0xa5, 0xfc, 0x22, 0x1c, 0x6e, 0x2a, 0x93, 0xd1, 0x19, 0x56, 0xf4, 0x36, 0x2c, 0x61, 0xa7, 0x63,
+	0x99, 0x91, 0x17, 0x85, 0xf1, 0x67, 0x71, 0xa5, 0x6a, 0xea, 0xc3, 0x2f, 0x98, 0xa7, 0x8b, 0xed,
+	0xbd, 0x4b, 0x7e, 0xc2, 0x93, 0xb7, 0x8a, 0x9e, 0xde, 0x2a, 0x0a, 0x6e, 0xb5, 0xc2, 0xe0, 0xa6,
+	0x19, 0x70, 0x66, 0x48, 0x9c, 0xf2, 0x6a, 0xd2, 0x99, 0x65, 0x29, 0x97, 0x59, 0x16, 0x32, 0x5c,
+	0x1e, 0xc1, 0xb0, 0xf6, 0xaf, 0x32, 0xb4, 0x06, 0x66, 0x28, 0xd1, 0xaf, 0x42, 0xd3, 0x91, 0x7b,
+	0xa1, 0x52, 0xe2, 0x6e, 0x7d, 0xb0, 0x91, 0x4d, 0x32, 0xcb, 0xf9, 0x24, 0x73, 0x05, 0xea, 0xa2,
+	0x06, 0x90, 0x42, 0x92, 0xab, 0x0c, 0xcb, 0xd5, 0x1c, 0xcb, 0x6b, 0x00, 0x61, 0xe2, 0x0b, 0x95,
+	0x3a, 0x7f, 0x9b, 0xda, 0x41, 0x1a, 0xcc, 0x8b, 0x94, 0x44, 0x27, 0x61, 0x64, 0x53, 0x65, 0x96,
+	0x43, 0x64, 0xf6, 0xb8, 0x65, 0x7a, 0x8e, 0x83, 0xdd, 0x6e, 0xa8, 0x34, 0x38, 0xcb, 0xc9, 0x1a,
+	0xfd, 0xaa, 0x04, 0xcb, 0x39, 0x37, 0x2c, 0x31, 0x35, 0xb9, 0xce, 0x7c, 0xef, 0x85, 0xba, 0xfc,
+	0x2d, 0xe6, 0x10, 0x04, 0x7e, 0xbd, 0x98, 0xac, 0xe6, 0xc1, 0xe2, 0x5d, 0x8b, 0x09, 0xfc, 0x20,
+	0x9c, 0x8e, 0x95, 0xbf, 0x07, 0x55, 0x46, 0x8c, 0x49, 0xa9, 0x13, 0x60, 0xd7, 0xe8, 0x91, 0xf8,
+	0x62, 0x93, 0x35, 0xf3, 0x5f, 0x14, 0x9b, 0xa1, 0x52, 0xe6, 0xfb, 0xfc, 0x59, 0xfb, 0x7d, 0x59,
+	0x70, 0xba, 0xe9, 0xfb, 0xe1, 0xab, 0x2f, 0x9a, 0x8a, 0xd3, 0xb8, 0xca, 0x70, 0x1a, 0x97, 0x63,
+	0xf9, 0xab, 0xa4, 0x71, 0x2f, 0x28, 0x3e, 0x6b, 0x11, 0xcc, 0x6e, 0xfa, 0x3e, 0x63, 0x04, 0x5d,
+	0x86, 0x2a, 0xf6, 0x7d, 0x21, 0xf0, 0x5c, 0x28, 0x92, 0x20, 0xec, 0xbf, 0x64, 0x89, 0x83, 0xaa,
+	0xd7, 0xa0, 0x99, 0x6c, 0x1d, 0x47, 0xb6, 0x99, 0x26, 0xbb, 0x0e, 0x20, 0xea, 0x94, 0xdb, 0xee,
+	0x81, 0xc7, 0xae, 0x94, 0x59, 0xa6, 0x3c, 0xca, 0x9f, 0xb5, 0xeb, 0x31, 0x04, 0xe7, 0xed, 0x6d,
+	0xa8, 0x59, 0x94, 0x38, 0x31, 0x73, 0x2b, 0x69, 0xe6, 0x06, 0x88, 0x74, 0x01, 0xa4, 0xfd, 0xa9,
+	0x01, 0x67, 0xd9, 0x8d, 0xed, 0x73, 0x9b, 0xde, 0xf4, 0xfd, 0x1b, 0x84, 0x62, 0xcb, 0x0e, 0xbf,
+	0x1b, 0x91, 0xe0, 0xe8, 0x25, 0x2b, 0x86, 0x09, 0x75, 0x61, 0x4c, 0x4a, 0xf9, 0xe5, 0x94, 0xac,
+	0xf5, 0x30, 0x57, 0xa7, 0x56, 0x5e, 0x4e, 0x9d, 0x5a, 0x54, 0x37, 0x56, 0xa7, 0x54, 0x37, 0x8e,
+	0x6e, 0x1d, 0xa4, 0x1a, 0x12, 0xf5, 0x6c, 0x43, 0xa2, 0x20, 0x62, 0xcd, 0x9e, 0xb4, 0x1c, 0x6b,
+	0x14, 0x96, 0x63, 0x4e, 0xa1, 0x1d, 0x37, 0xb9, 0xb8, 0xbf, 0x93, 0xd6, 0xc0, 0x91, 0xba, 0x36,
+	0x49, 0x61, 0x06, 0x2f, 0xb5, 0x30, 0xfb, 0x34, 0x53, 0x68, 0x89, 0x56, 0xc7, 0xbb, 0x27, 0xfb,
+	0xa6, 0x31, 0x25, 0xd7, 0xff, 0x5d, 0xd5, 0xf0, 0x73, 0x9e, 0x2c, 0xfa, 0xde, 0x40, 0x06, 0x49,
+	0xf6, 0xc1, 0xe2, 0x10, 0xcb, 0x03, 0xa4, 0xd3, 0x62, 0xcf, 0xe8, 0x12, 0x54, 0x99, 0x90, 0x65,
+	0x36, 0x7f, 0x26, 0x2d, 0x4f, 0x76, 0x13, 0x9b, 0xbe, 0xbf, 0xef, 0x13, 0x43, 0xe7, 0x40, 0xe8,
+	0x3a, 0x34, 0x13, 0xc5, 0x97, 0x96, 0xb5, 0x9a, 0x3e, 0x91, 0xd8, 0x49, 0x7c, 0x6c, 0x00, 0xce,
+	0xce, 0x76, 0xad, 0x80, 0x18, 0x0c, 0x50, 0xa9, 0x0d, 0x9f, 0xbd, 0x11, 0xbf, 0x4c, 0xce, 0x26,
+	0xe0, 0xe8, 0x32, 0xd4, 0x45, 0x6f, 0x88, 0x5b, 0xd0, 0xdc, 0x95, 0xb3, 0xc3, 0xce, 0x34, 0x3e,
+	0x25, 0x01, 0xb5, 0x3f, 0x94, 0xe1, 0xcd, 0x81, 0x42, 0xc4, 0xd6, 0x14, 0x97, 0x1b, 0xaf, 0x3e,
+	0xe2, 0x9e, 0x87, 0x05, 0x5e, 0xdf, 0x0c, 0x5a, 0x44, 0xa2, 0x5b, 0x99, 0xdb, 0x2d, 0xaa, 0xa6,
+	0xab, 0xd3, 0xa8, 0xa6, 0xb5, 0xdf, 0x95, 0xe0, 0xdc, 0xb0, 0x00, 0xb7, 0x7a, 0x38, 0xa0, 0x89,
+	0x5e, 0x4d, 0x43, 0x88, 0x71, 0xa4, 0x2d, 0x0f, 0x22, 0x6d, 0x46, 0xb0, 0x95, 0xac, 0x60, 0xb5,
+	0x3f, 0x96, 0x61, 0x2e, 0xa5, 0xb9, 0x45, 0x91, 0x9a, 0xa5, 0xc5, 0xdc, 0x60, 0x78, 0x29, 0xcd,
+	0xa3, 0x51, 0x53, 0x4f, 0xed, 0xa0, 0x3e, 0x80, 0x8f, 0x03, 0xec, 0x10, 0x4a, 0x02, 0x16, 0x42,
+	0x98, 0xab, 0xb9, 0x33, 0xb9, 0x5b, 0xdb, 0x8b, 0x71, 0xea, 0x29, 0xf4, 0x2c, 0xaf, 0xe7, 0xa4,
+	0x43, 0x19, 0x38, 0xe4, 0x0a, 0x3d, 0x81, 0x85, 0x03, 0xcb, 0x26, 0x7b, 0x03, 0x46, 0xea, 0xeb,
+	0x95, 0xc9, 0xc3, 0x33, 0x63, 0xe4, 0x56, 0x1a, 0xaf, 0x9e, 0x23, 0xa3, 0x5d, 0x84, 0x56, 0xde,
+	0x90, 0x19, 0x93, 0x96, 0x83, 0xcd, 0x44, 0x5a, 0x72, 0xa5, 0x21, 0x68, 0xe5, 0x0d, 0x57, 0xfb,
+	0x7b, 0x19, 0x96, 0x13, 0x74, 0x9b, 0xae, 0xeb, 0x45, 0xae, 0xc1, 0xfb, 0xbc, 0x85, 0x77, 0x71,
+	0x1a, 0x6a, 0xd4, 0xa2, 0x76, 0x92, 0x71, 0xf1, 0x05, 0x0b, 0x9a, 0xd4, 0xf3, 0x6c, 0x6a, 0xf9,
+	0xf2, 0x82, 0xe3, 0xa5, 0xb8, 0xfb, 0xc7, 0x91, 0x15, 0x90, 0x2e, 0xb7, 0x84, 0x86, 0x9e, 0xac,
+	0xd9, 0x3b, 0x96, 0x4e, 0xf1, 0x62, 0x47, 0x08, 0x33, 0x59, 0x73, 0x83, 0xf3, 0x6c, 0x9b, 0x18,
+	0x4c, 0x1c, 0xa9, 0x72, 0x28, 0xb7, 0xcb, 0xcb, 0x2c, 0x1a, 0x58, 0xae, 0x29, 0x8b, 0x21, 0xb9,
+	0x62, 0x7c, 0xe2, 0x20, 0xc0, 0x47, 0xb2, 0x06, 0x12, 0x0b, 0xf4, 0x01, 0x54, 0x1c, 0xec, 0xcb,
+	0x08, 0x7b, 0x31, 0xe3, 0x96, 0x8a, 0x24, 0xd0, 0xde, 0xc5, 0xbe, 0x08, 0x41, 0xec, 0x98, 0xfa,
+	0x1e, 0x34, 0xe2, 0x8d, 0xaf, 0x94, 0x8b, 0x7e, 0x01, 0xa7, 0x32, 0x5e, 0x0f, 0x3d, 0x84, 0x95,
+	0x81, 0x46, 0xa5, 0x09, 0xca, 0xec, 0xf3, 0xcd, 0x63, 0x39, 0xd3, 0x47, 0x20, 0xd0, 0x1e, 0xc3,
+	0x12, 0x53, 0x19, 0x6e, 0xf8, 0x53, 0xaa, 0xa9, 0xde, 0x87, 0x66, 0x42, 0xb2, 0x50, 0x67, 0x54,
+	0x68, 0x1c, 0xc6, 0xfd, 0x77, 0x51, 0x54, 0x25, 0x6b, 0x6d, 0x13, 0x50, 0x9a, 0x5f, 0x19, 0xfa,
+	0x2e, 0x65, 0xb3, 0xf1, 0xe5, 0x7c, 0x9c, 0xe3, 0xe0, 0x71, 0x32, 0xfe, 0x65, 0x05, 0x16, 0xb7,
+	0x2d, 0xde, 0x57, 0x9a, 0x92, 0x93, 0xbb, 0x08, 0xad, 0x30, 0xea, 0x38, 0x5e, 0x37, 0xb2, 0x89,
+	0xcc, 0x46, 0x64, 0x8a, 0x31, 0xb4, 0x3f, 0xce, 0xf9, 0x31, 0x61, 0xf9, 0x98, 0xf6, 0x64, 0x1f,
+	0x80, 0x3f, 0xa3, 0x0f, 0xe0, 0xec, 0x3d, 0xf2, 0x44, 0x7e, 0xcf, 0xb6, 0xed, 0x75, 0x3a, 0x96,
+	0x6b, 0xc6, 0x44, 0x44, 0x87, 0x64, 0x34, 0x40, 0x51, 0x8e, 0x5a, 0x2f, 0xce, 0x51, 0x93, 0x5e,
+	0xc2, 0x96, 0xe7, 0x38, 0x16, 0x95, 0xa9, 0x6c, 0x66, 0xaf, 0x28, 0x9a, 0x35, 0xa6, 0x12, 0xcd,
+	0x7e, 0x56, 0x82, 0xd6, 0xe0, 0x4a, 0xa5, 0x52, 0x5c, 0x13, 0xc6, 0x2b, 0x54, 0xe2, 0x5c, 0x5a,
+	0x25, 0xf2, 0xa0, 0xcf, 0x6f, 0xb7, 0xf3, 0x99, 0xdc, 0xac, 0x02, 0xcb, 0xdb, 0x16, 0x8d, 0x3d,
+	0xa6, 0xf5, 0xbf, 0xa6, 0x5e, 0x05, 0xca, 0x50, 0x3d, 0x99, 0x32, 0xd4, 0x4e, 0xa6, 0x0c, 0xf5,
+	0xa9, 0x28, 0x43, 0x1b, 0x56, 0xf2, 0xb7, 0x20, 0x35, 0xe2, 0x34, 0xd4, 0x7c, 0x3e, 0x13, 0x11,
+	0x2d, 0x1c, 0xb1, 0xd0, 0xfe, 0xdd, 0x84, 0x37, 0x3e, 0xf5, 0xbb, 0x98, 0x26, 0xfd, 0xc2, 0x5b,
+	0x5e, 0xc0, 0x87, 0x22, 0xd3, 0xb9, 0xbe, 0xdc, 0xe0, 0xba, 0x3c, 0x76, 0x70, 0x5d, 0x19, 0x33,
+	0xb8, 0xae, 0x9e, 0x68, 0x70, 0x5d, 0x9b, 0xda, 0xe0, 0x7a, 0xb8, 0xac, 0xad, 0x17, 0x96, 0xb5,
+	0x0f, 0x33, 0xa5, 0xdf, 0x2c, 0xb7, 0xd7, 0x6f, 0xa7, 0xed, 0x75, 0xec, 0xed, 0x8c, 0x9d, 0xb8,
+	0xe5, 0xe6, 0xbd, 0x8d, 0x63, 0xe7, 0xbd, 0xcd, 0xe1, 0x79, 0x6f, 0xf1, 0xc8, 0x10, 0x46, 0x8e,
+	0x0c, 0xcf, 0xc3, 0x42, 0x78, 0xe4, 0x1a, 0xa4, 0x1b, 0x33, 0xac, 0xcc, 0x89, 0xcf, 0xce, 0xee,
+	0x66, 0x4c, 0x71, 0x3e, 0x67, 0x8a, 0x89, 0xa6, 0x9e, 0x4a, 0x69, 0x6a, 0x91, 0x81, 0x2e, 0x8c,
+	0xec, 0x28, 0xe4, 0xa6, 0x79, 0x8b, 0x85, 0xd3, 0xbc, 0x3e, 0xb4, 0x62, 0xae, 0x92, 0x0b, 0x68,
+	0xf1, 0x0b, 0xf8, 0xe8, 0xe4, 0x17, 0xb0, 0x9f, 0xc3, 0x20, 0xae, 0x61, 0x08, 0x71, 0x91, 0x47,
+	0x58, 0x9a, 0xca, 0xe8, 0xf0, 0xbf, 0xa5, 0x7a, 0x57, 0x7f, 0x59, 0x82, 0xe5, 0x42, 0x69, 0xbd,
+	0x9a, 0x66, 0xc2, 0x67, 0xb0, 0x36, 0xea, 0x66, 0xa5, 0xc7, 0x54, 0x60, 0xd6, 0xe8, 0x61, 0xd7,
+	0xe4, 0x6d, 0x6f, 0xde, 0xdd, 0x92, 0xcb, 0x71, 0xd5, 0xef, 0x95, 0x7f, 0xce, 0xc3, 0xd2, 0xa0,
+	0xb8, 0x64, 0x7f, 0x2d, 0x83, 0xa0, 0xfb, 0xd0, 0x8a, 0x47, 0xce, 0xf1, 0xe4, 0x04, 0x8d, 0x1b,
+	0x6b, 0xaa, 0xab, 0xc5, 0x2f, 0x05, 0x6b, 0xda, 0x0c, 0x32, 0xe0, 0x6c, 0x1e, 0xe1, 0x60, 0x82,
+	0xfa, 0x8d, 0x31, 0x98, 0x13, 0xa8, 0xe3, 0x48, 0x5c, 0x28, 0xa1, 0xcf, 0x61, 0x25, 0x4f, 0x64,
+	0x9f, 0x06, 0x04, 0x3b, 0x13, 0xf1, 0xfe, 0xcd, 0x12, 0x7a, 0x08, 0x0b, 0xd9, 0x01, 0x22, 0xca,
+	0xa4, 0xf1, 0x85, 0x33, 0x4d, 0x55, 0x1b, 0x07, 0x92, 0x08, 0xe6, 0x11, 0x2c, 0xe6, 0x26, 0x60,
+	0x48, 0xcb, 0xb6, 0xd2, 0x8a, 0xa6, 0x8d, 0xea, 0xd7, 0xc7, 0xc2, 0x24, 0xd8, 0xdf, 0x87, 0x46,
+	0x3c, 0x84, 0xc9, 0xca, 0x20, 0x37, 0x9a, 0x51, 0x5b, 0x59, 0x7c, 0x07, 0xa1, 0x36, 0x83, 0x3e,
+	0x84, 0x39, 0x06, 0x76, 0x7f, 0xeb, 0xf6, 0x03, 0x6c, 0x3e, 0xd7, 0xf9, 0x46, 0x3c, 0xa4, 0x18,
+	0x3e, 0x9c, 0x1a, 0x5d, 0xa8, 0xaf, 0x15, 0x8c, 0x0b, 0xb4, 0x19, 0xf4, 0x91, 0xa0, 0xbf, 0x27,
+	0x7f, 0x8b, 0xb4, 0xd2, 0x16, 0x3f, 0x7d, 0x6b, 0xc7, 0x3f, 0x7d, 0x6b, 0xdf, 0x64, 0x3f, 0x7d,
+	0x53, 0x0b, 0xfa, 0xf9, 0x12, 0xc1, 0x23, 0x38, 0xb5, 0x4d, 0xe8, 0xa0, 0xfd, 0x86, 0xce, 0x9d,
+	0xa8, 0x49, 0xa9, 0x6a, 0x79, 0xb0, 0xe1, 0x0e, 0x9e, 0x36, 0x83, 0x7e, 0x53, 0x82, 0xd7, 0xb6,
+	0x09, 0xcd, 0x37, 0xb4, 0xd0, 0x3b, 0xc5, 0x44, 0x46, 0x34, 0xbe, 0xd4, 0x7b, 0x93, 0x3a, 0x8b,
+	0x2c, 0x5a, 0x6d, 0x06, 0xfd, 0xba, 0x04, 0x0b, 0xdb, 0x84, 0xdd, 0x5b, 0xc2, 0xd3, 0xe5, 0xf1,
+	0x3c, 0x15, 0xf4, 0x92, 0xd4, 0x09, 0x9b, 0xc7, 0x29, 0xea, 0xda, 0x0c, 0xfa, 0x6d, 0x09, 0xce,
+	0xa4, 0x64, 0x95, 0xa6, 0xf7, 0x3c, 0xbc, 0x7d, 0x32, 0xe1, 0xaf, 0xde, 0x52, 0x28, 0xb5, 0x19,
+	0xb4, 0xc7, 0xd5, 0x64, 0x50, 0xaa, 0xa2, 0x37, 0x0a, 0x6b, 0xd2, 0x84, 0xfa, 0xda, 0xa8, 0xd7,
+	0x89, 0x6a, 0x7c, 0x02, 0x73, 0xdb, 0x84, 0xc6, 0xa5, 0x4b, 0x56, 0xf9, 0x73, 0xe5, 0xac, 0xba,
+	0x5a, 0xfc, 0x32, 0xe5, 0x20, 0x96, 0x04, 0xae, 0x54, 0x96, 0x9c, 0x75, 0x3f, 0x85, 0x75, 0x8c,
+	0xaa, 0x8d, 0x03, 0x49, 0xb0, 0x3f, 0x86, 0x95, 0xe2, 0xb0, 0x82, 0xde, 0x3a, 0x71, 0x52, 0xa1,
+	0x5e, 0x3c, 0x09, 0x68, 0x4c, 0xf2, 0xe3, 0xcd, 0x3f, 0x3f, 0x5b, 0x2b, 0x7d, 0xf9, 0x6c, 0xad,
+	0xf4, 0x8f, 0x67, 0x6b, 0xa5, 0xef, 0x5f, 0x3d, 0xe6, 0xd7, 0xb1, 0xa9, 0x1f, 0xdc, 0x62, 0xdf,
+	0x32, 0x6c, 0x8b, 0xb8, 0xb4, 0x53, 0xe7, 0x2e, 0xe0, 0xea, 0x7f, 0x06, 0x00, 0x10, 0x2b, 0xa7,
+	0xf6, 0x8f, 0x2b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2726,6 +2727,9 @@ type RepoServerServiceClient interface {
 	GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error)
 	// GenerateManifestWithFiles generates manifest for application using provided tarball of files
 	GenerateManifestWithFiles(ctx context.Context, opts ...grpc.CallOption) (RepoServerService_GenerateManifestWithFilesClient, error)
+	// GenerateManifestStream generates manifest for application in specified repo name and revision, and sends the
+	// manifests in chunks. The first response holds all the fields other than the manifests.
+	GenerateManifestStream(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (RepoServerService_GenerateManifestStreamClient, error)
 	// Returns a bool val if the repository is valid and has proper access
 	TestRepository(ctx context.Context, in *TestRepositoryRequest, opts ...grpc.CallOption) (*TestRepositoryResponse, error)
 	// Returns a valid revision
@@ -2807,6 +2811,38 @@ func (x *repoServerServiceGenerateManifestWithFilesClient) CloseAndRecv() (*Mani
 	return m, nil
 }
 
+func (c *repoServerServiceClient) GenerateManifestStream(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (RepoServerService_GenerateManifestStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RepoServerService_serviceDesc.Streams[1], "/repository.RepoServerService/GenerateManifestStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repoServerServiceGenerateManifestStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RepoServerService_GenerateManifestStreamClient interface {
+	Recv() (*ManifestResponse, error)
+	grpc.ClientStream
+}
+
+type repoServerServiceGenerateManifestStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *repoServerServiceGenerateManifestStreamClient) Recv() (*ManifestResponse, error) {
+	m := new(ManifestResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *repoServerServiceClient) TestRepository(ctx context.Context, in *TestRepositoryRequest, opts ...grpc.CallOption) (*TestRepositoryResponse, error) {
 	out := new(TestRepositoryResponse)
 	err := c.cc.Invoke(ctx, "/repository.RepoServerService/TestRepository", in, out, opts...)
@@ -2939,6 +2975,9 @@ type RepoServerServiceServer interface {
 	GenerateManifest(context.Context, *ManifestRequest) (*ManifestResponse, error)
 	// GenerateManifestWithFiles generates manifest for application using provided tarball of files
 	GenerateManifestWithFiles(RepoServerService_GenerateManifestWithFilesServer) error
+	// GenerateManifestStream generates manifest for application in specified repo name and revision, and sends the
+	// manifests in chunks. The first response holds all the fields other than the manifests.
+	GenerateManifestStream(*ManifestRequest, RepoServerService_GenerateManifestStreamServer) error
 	// Returns a bool val if the repository is valid and has proper access
 	TestRepository(context.Context, *TestRepositoryRequest) (*TestRepositoryResponse, error)
 	// Returns a valid revision
@@ -2979,6 +3018,9 @@ func (*UnimplementedRepoServerServiceServer) GenerateManifest(ctx context.Contex
 func (*UnimplementedRepoServerServiceServer) GenerateManifestWithFiles(srv RepoServerService_GenerateManifestWithFilesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GenerateManifestWithFiles not implemented")
 }
+func (*UnimplementedRepoServerServiceServer) GenerateManifestStream(req *ManifestRequest, srv RepoServerService_GenerateManifestStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateManifestStream not implemented")
+}
 func (*UnimplementedRepoServerServiceServer) TestRepository(ctx context.Context, req *TestRepositoryRequest) (*TestRepositoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestRepository not implemented")
 }
@@ -3070,6 +3112,27 @@ func (x *repoServerServiceGenerateManifestWithFilesServer) Recv() (*ManifestRequ
 	return m, nil
 }
 
+func _RepoServerService_GenerateManifestStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ManifestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepoServerServiceServer).GenerateManifestStream(m, &repoServerServiceGenerateManifestStreamServer{stream})
+}
+
+type RepoServerService_GenerateManifestStreamServer interface {
+	Send(*ManifestResponse) error
+	grpc.ServerStream
+}
+
+type repoServerServiceGenerateManifestStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *repoServerServiceGenerateManifestStreamServer) Send(m *ManifestResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RepoServerService_TestRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TestRepositoryRequest)
 	if err := dec(in); err != nil {
@@ -3393,6 +3456,11 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _RepoServerService_GenerateManifestWithFiles_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "GenerateManifestStream",
+			Handler:       _RepoServerService_GenerateManifestStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "reposerver/repository/repository.proto",
 }
@@ -0,0 +1,55 @@
+package apiclient
+
+import (
+	"errors"
+	"io"
+)
+
+// ManifestChunkSize is the maximum size in bytes of the manifests sent in each response of GenerateManifestStream
+const ManifestChunkSize = 1024 * 1024
+
+// ChunkManifests splits the manifests into chunks whose total size does not exceed maxSize bytes. Each chunk holds at
+// least one manifest, so a manifest larger than maxSize gets a chunk of its own.
+func ChunkManifests(manifests []string, maxSize int) [][]string {
+	var chunks [][]string
+	start, size := 0, 0
+	for i, manifest := range manifests {
+		if i > start && size+len(manifest) > maxSize {
+			chunks = append(chunks, manifests[start:i])
+			start, size = i, 0
+		}
+		size += len(manifest)
+	}
+	if start < len(manifests) {
+		chunks = append(chunks, manifests[start:])
+	}
+	return chunks
+}
+
+// RecvManifests receives the responses of GenerateManifestStream and passes the manifests of each response to
+// onManifests as soon as they are received. It returns the first response without its manifests.
+func RecvManifests(stream RepoServerService_GenerateManifestStreamClient, onManifests func(manifests []string) error) (*ManifestResponse, error) {
+	var res *ManifestResponse
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(chunk.Manifests) > 0 {
+			if err := onManifests(chunk.Manifests); err != nil {
+				return nil, err
+			}
+		}
+		if res == nil {
+			res = chunk
+			res.Manifests = nil
+		}
+	}
+	if res == nil {
+		return nil, errors.New("manifest stream ended without response")
+	}
+	return res, nil
+}
@@ -0,0 +1,51 @@
+package apiclient_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+)
+
+func TestChunkManifests(t *testing.T) {
+	assert.Empty(t, apiclient.ChunkManifests(nil, 10))
+	assert.Equal(t, [][]string{{"aaaa", "bbbb"}, {"cccc"}}, apiclient.ChunkManifests([]string{"aaaa", "bbbb", "cccc"}, 10))
+	// manifests larger than the maximum size get a chunk of their own
+	assert.Equal(t, [][]string{{"aa"}, {"bbbbbbbbbbbb"}, {"cc"}}, apiclient.ChunkManifests([]string{"aa", "bbbbbbbbbbbb", "cc"}, 10))
+}
+
+func TestRecvManifests(t *testing.T) {
+	stream := &mocks.RepoServerService_GenerateManifestStreamClient{}
+	stream.EXPECT().Recv().Return(&apiclient.ManifestResponse{Manifests: []string{"a", "b"}, Revision: "abc", Namespace: "default"}, nil).Once()
+	stream.EXPECT().Recv().Return(&apiclient.ManifestResponse{Manifests: []string{"c"}}, nil).Once()
+	stream.EXPECT().Recv().Return(nil, io.EOF).Once()
+
+	var chunks [][]string
+	res, err := apiclient.RecvManifests(stream, func(manifests []string) error {
+		chunks = append(chunks, manifests)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, chunks)
+	assert.Equal(t, &apiclient.ManifestResponse{Revision: "abc", Namespace: "default"}, res)
+}
+
+func TestRecvManifests_Error(t *testing.T) {
+	stream := &mocks.RepoServerService_GenerateManifestStreamClient{}
+	stream.EXPECT().Recv().Return(&apiclient.ManifestResponse{Manifests: []string{"a"}}, nil).Once()
+	_, err := apiclient.RecvManifests(stream, func(_ []string) error {
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+
+	stream = &mocks.RepoServerService_GenerateManifestStreamClient{}
+	stream.EXPECT().Recv().Return(nil, io.EOF).Once()
+	_, err = apiclient.RecvManifests(stream, func(_ []string) error {
+		return nil
+	})
+	require.Error(t, err)
+}
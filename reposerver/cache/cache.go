@@ -1,7 +1,9 @@
 package cache
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -60,6 +62,9 @@ type CachedManifestResponse struct {
 	FirstFailureTimestamp           int64                       `json:"firstFailureTimestamp"`
 	NumberOfConsecutiveFailures     int                         `json:"numberOfConsecutiveFailures"`
 	NumberOfCachedResponsesReturned int                         `json:"numberOfCachedResponsesReturned"`
+	// ManifestChunks holds the keys of the cache items the manifests are stored in, when they are too large to be
+	// stored along with the rest of the response
+	ManifestChunks []string `json:"manifestChunks,omitempty"`
 }
 
 func NewCache(cache *cacheutil.Cache, repoCacheExpiration time.Duration, revisionCacheExpiration time.Duration, revisionCacheLockTimeout time.Duration) *Cache {
@@ -411,6 +416,16 @@ func (c *Cache) SetNewRevisionManifests(oldKey, newKey manifestKey) error {
 }
 
 func (c *Cache) GetManifests(manifestKey manifestKey, res *CachedManifestResponse) error {
+	return c.getManifests(manifestKey, res, true)
+}
+
+// GetManifestsWithoutChunks gets the cached response like GetManifests, but does not restore the manifests stored in
+// chunks, whose keys are left in res.ManifestChunks, so that they can be read one at a time with GetManifestChunk.
+func (c *Cache) GetManifestsWithoutChunks(manifestKey manifestKey, res *CachedManifestResponse) error {
+	return c.getManifests(manifestKey, res, false)
+}
+
+func (c *Cache) getManifests(manifestKey manifestKey, res *CachedManifestResponse, loadChunks bool) error {
 	err := c.cache.GetItem(manifestKey.String(), res)
 	if err != nil {
 		return err
@@ -439,6 +454,24 @@ func (c *Cache) GetManifests(manifestKey manifestKey, res *CachedManifestRespons
 	// The expected hash matches the actual hash, so remove the hash from the returned value
 	res.CacheEntryHash = ""
 
+	if loadChunks && len(res.ManifestChunks) > 0 {
+		err = c.getManifestChunks(res)
+		if errors.Is(err, ErrCacheMiss) {
+			log.Warnf("Manifest chunk is missing or corrupted, treating as a cache miss: %s", manifestKey.AppName)
+
+			LogDebugManifestCacheKeyFields("deleting manifests cache", "manifest chunk is missing or corrupted", manifestKey)
+
+			err = c.DeleteManifests(manifestKey)
+			if err != nil {
+				return fmt.Errorf("unable to delete manifest after missing chunk: %w", err)
+			}
+			return ErrCacheMiss
+		}
+		if err != nil {
+			return err
+		}
+	}
+
 	if res.ManifestResponse != nil {
 		// cached manifest response might be reused across different revisions, so we need to assume that the revision is the one we are looking for
 		res.ManifestResponse.Revision = manifestKey.Revision
@@ -451,6 +484,9 @@ func (c *Cache) SetManifests(manifestKey manifestKey, res *CachedManifestRespons
 	// Generate and apply the cache entry hash, before writing
 	if res != nil {
 		res = res.shallowCopy()
+		if err := c.setManifestChunks(res); err != nil {
+			return err
+		}
 		hash, err := res.generateCacheEntryHash()
 		if err != nil {
 			return fmt.Errorf("unable to generate hash value: %w", err)
@@ -467,6 +503,94 @@ func (c *Cache) SetManifests(manifestKey manifestKey, res *CachedManifestRespons
 		})
 }
 
+// setManifestChunks stores the manifests of the response in separate cache items if they are larger than
+// apiclient.ManifestChunkSize, and replaces them by the keys of these items. The chunks are addressed by their content,
+// so that the responses of different revisions and applications with the same manifests share them.
+func (c *Cache) setManifestChunks(res *CachedManifestResponse) error {
+	if res.ManifestResponse == nil {
+		return nil
+	}
+	size := 0
+	for _, manifest := range res.ManifestResponse.Manifests {
+		size += len(manifest)
+	}
+	if size <= apiclient.ManifestChunkSize {
+		return nil
+	}
+	chunks := apiclient.ChunkManifests(res.ManifestResponse.Manifests, apiclient.ManifestChunkSize)
+	keys := make([]string, 0, len(chunks))
+	for _, chunk := range chunks {
+		key := manifestChunkKey(chunk)
+		err := c.cache.SetItem(key, chunk, &cacheutil.CacheActionOpts{Expiration: c.repoCacheExpiration})
+		if err != nil {
+			return fmt.Errorf("error storing manifest chunk: %w", err)
+		}
+		keys = append(keys, key)
+	}
+	// the response is shared with the caller, so its manifests are removed from a copy
+	manifestResponse := *res.ManifestResponse
+	manifestResponse.Manifests = nil
+	res.ManifestResponse = &manifestResponse
+	res.ManifestChunks = keys
+	return nil
+}
+
+// getManifestChunks restores the manifests of the response from the cache items they are stored in. It returns
+// ErrCacheMiss if one of the chunks expired or does not match its key.
+func (c *Cache) getManifestChunks(res *CachedManifestResponse) error {
+	if res.ManifestResponse == nil {
+		return ErrCacheMiss
+	}
+	var manifests []string
+	for _, key := range res.ManifestChunks {
+		var chunk []string
+		err := c.cache.GetItem(key, &chunk)
+		if err != nil {
+			return err
+		}
+		if manifestChunkKey(chunk) != key {
+			return ErrCacheMiss
+		}
+		manifests = append(manifests, chunk...)
+	}
+	res.ManifestResponse.Manifests = manifests
+	res.ManifestChunks = nil
+	return nil
+}
+
+// GetManifestChunk gets a chunk of the manifests of a response returned by GetManifestsWithoutChunks. If the chunk
+// expired or does not match its key, the response is deleted, so that the manifests are generated again, and
+// ErrCacheMiss is returned.
+func (c *Cache) GetManifestChunk(manifestKey manifestKey, chunkKey string) ([]string, error) {
+	var chunk []string
+	err := c.cache.GetItem(chunkKey, &chunk)
+	if err == nil && manifestChunkKey(chunk) != chunkKey {
+		err = ErrCacheMiss
+	}
+	if errors.Is(err, ErrCacheMiss) {
+		log.Warnf("Manifest chunk is missing or corrupted, treating as a cache miss: %s", manifestKey.AppName)
+
+		LogDebugManifestCacheKeyFields("deleting manifests cache", "manifest chunk is missing or corrupted", manifestKey)
+
+		if err := c.DeleteManifests(manifestKey); err != nil {
+			return nil, fmt.Errorf("unable to delete manifest after missing chunk: %w", err)
+		}
+		return nil, ErrCacheMiss
+	}
+	if err != nil {
+		return nil, err
+	}
+	return chunk, nil
+}
+
+func manifestChunkKey(manifests []string) string {
+	h := sha256.New()
+	for _, manifest := range manifests {
+		_, _ = fmt.Fprintf(h, "%d:%s", len(manifest), manifest)
+	}
+	return "mfstchunk|" + hex.EncodeToString(h.Sum(nil))
+}
+
 func (c *Cache) DeleteManifests(manifestKey manifestKey) error {
 	return c.cache.SetItem(
 		manifestKey.String(),
@@ -606,6 +730,7 @@ func (cmr *CachedManifestResponse) shallowCopy() *CachedManifestResponse {
 		MostRecentError:                 cmr.MostRecentError,
 		NumberOfCachedResponsesReturned: cmr.NumberOfCachedResponsesReturned,
 		NumberOfConsecutiveFailures:     cmr.NumberOfConsecutiveFailures,
+		ManifestChunks:                  cmr.ManifestChunks,
 	}
 }
 
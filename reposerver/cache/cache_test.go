@@ -3,6 +3,7 @@ package cache
 import (
 	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -151,6 +152,66 @@ func TestCache_GetManifests(t *testing.T) {
 	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 2, ExternalGets: 8})
 }
 
+func TestCache_GetManifests_Chunks(t *testing.T) {
+	fixtures := newFixtures()
+	t.Cleanup(fixtures.mockCache.StopRedisCallback)
+	cache := fixtures.cache
+	key := manifestKey{Revision: "my-revision", AppSource: &v1alpha1.ApplicationSource{}, ClusterInfo: &apiclient.ManifestRequest{}, AppName: "my-app"}
+
+	manifests := []string{strings.Repeat("a", apiclient.ManifestChunkSize/2), strings.Repeat("b", apiclient.ManifestChunkSize/2), "c"}
+	res := &CachedManifestResponse{ManifestResponse: &apiclient.ManifestResponse{Manifests: manifests, SourceType: "my-source-type"}}
+	require.NoError(t, cache.SetManifests(key, res))
+	// the response of the caller is not modified
+	assert.Equal(t, manifests, res.ManifestResponse.Manifests)
+	assert.Empty(t, res.ManifestChunks)
+
+	stored := &CachedManifestResponse{}
+	require.NoError(t, cache.cache.GetItem(key.String(), stored))
+	assert.Empty(t, stored.ManifestResponse.Manifests)
+	require.Len(t, stored.ManifestChunks, 2)
+
+	value := &CachedManifestResponse{}
+	require.NoError(t, cache.GetManifests(key, value))
+	assert.Equal(t, manifests, value.ManifestResponse.Manifests)
+	assert.Equal(t, "my-source-type", value.ManifestResponse.SourceType)
+	assert.Empty(t, value.ManifestChunks)
+
+	t.Run("reads the chunks one at a time", func(t *testing.T) {
+		value := &CachedManifestResponse{}
+		require.NoError(t, cache.GetManifestsWithoutChunks(key, value))
+		assert.Empty(t, value.ManifestResponse.Manifests)
+		assert.Equal(t, "my-revision", value.ManifestResponse.Revision)
+		require.Equal(t, stored.ManifestChunks, value.ManifestChunks)
+		var chunkedManifests []string
+		for _, chunkKey := range value.ManifestChunks {
+			chunk, err := cache.GetManifestChunk(key, chunkKey)
+			require.NoError(t, err)
+			chunkedManifests = append(chunkedManifests, chunk...)
+		}
+		assert.Equal(t, manifests, chunkedManifests)
+	})
+
+	t.Run("expect cache miss because of missing chunk", func(t *testing.T) {
+		require.NoError(t, cache.cache.SetItem(stored.ManifestChunks[1], "", &cacheutil.CacheActionOpts{Delete: true}))
+		err := cache.GetManifests(key, &CachedManifestResponse{})
+		require.ErrorIs(t, err, ErrCacheMiss)
+		// the entry is deleted along with the missing chunk
+		err = cache.cache.GetItem(key.String(), &CachedManifestResponse{})
+		require.ErrorIs(t, err, ErrCacheMiss)
+	})
+
+	t.Run("expect cache miss because of missing chunk read on its own", func(t *testing.T) {
+		require.NoError(t, cache.SetManifests(key, res))
+		value := &CachedManifestResponse{}
+		require.NoError(t, cache.GetManifestsWithoutChunks(key, value))
+		require.NoError(t, cache.cache.SetItem(value.ManifestChunks[1], "", &cacheutil.CacheActionOpts{Delete: true}))
+		_, err := cache.GetManifestChunk(key, value.ManifestChunks[1])
+		require.ErrorIs(t, err, ErrCacheMiss)
+		err = cache.cache.GetItem(key.String(), &CachedManifestResponse{})
+		require.ErrorIs(t, err, ErrCacheMiss)
+	})
+}
+
 func TestCache_GetAppDetails(t *testing.T) {
 	t.Parallel()
 	fixtures := newFixtures()
@@ -303,6 +364,7 @@ func TestCachedManifestResponse_ShallowCopy(t *testing.T) {
 		MostRecentError:                 "error",
 		NumberOfCachedResponsesReturned: 2,
 		NumberOfConsecutiveFailures:     3,
+		ManifestChunks:                  []string{"chunk"},
 	}
 
 	post := pre.shallowCopy()
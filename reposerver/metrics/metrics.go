@@ -11,23 +11,24 @@ import (
 )
 
 type MetricsServer struct {
-	handler                       http.Handler
-	gitFetchFailCounter           *prometheus.CounterVec
-	gitLsRemoteFailCounter        *prometheus.CounterVec
-	gitRequestCounter             *prometheus.CounterVec
-	gitRequestHistogram           *prometheus.HistogramVec
-	repoPendingRequestsGauge      *prometheus.GaugeVec
-	parallelismWaitHistogram      prometheus.Histogram
-	redisRequestCounter           *prometheus.CounterVec
-	redisRequestHistogram         *prometheus.HistogramVec
-	ociExtractFailCounter         *prometheus.CounterVec
-	ociResolveRevisionFailCounter *prometheus.CounterVec
-	ociDigestMetadataCounter      *prometheus.CounterVec
-	ociGetTagsFailCounter         *prometheus.CounterVec
-	ociTestRepoFailCounter        *prometheus.CounterVec
-	ociRequestCounter             *prometheus.CounterVec
-	ociRequestHistogram           *prometheus.HistogramVec
-	PrometheusRegistry            *prometheus.Registry
+	handler                        http.Handler
+	gitFetchFailCounter            *prometheus.CounterVec
+	gitLsRemoteFailCounter         *prometheus.CounterVec
+	gitRequestCounter              *prometheus.CounterVec
+	gitRequestHistogram            *prometheus.HistogramVec
+	repoPendingRequestsGauge       *prometheus.GaugeVec
+	parallelismWaitHistogram       prometheus.Histogram
+	redisRequestCounter            *prometheus.CounterVec
+	redisRequestHistogram          *prometheus.HistogramVec
+	ociExtractFailCounter          *prometheus.CounterVec
+	ociResolveRevisionFailCounter  *prometheus.CounterVec
+	ociDigestMetadataCounter       *prometheus.CounterVec
+	ociGetTagsFailCounter          *prometheus.CounterVec
+	ociTestRepoFailCounter         *prometheus.CounterVec
+	ociCosignSignaturesFailCounter *prometheus.CounterVec
+	ociRequestCounter              *prometheus.CounterVec
+	ociRequestHistogram            *prometheus.HistogramVec
+	PrometheusRegistry             *prometheus.Registry
 }
 
 type GitRequestType string
@@ -162,6 +163,15 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(ociTestRepoFailCounter)
 
+	ociCosignSignaturesFailCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_oci_cosign_signatures_fail_total",
+			Help: "Number of OCI cosign signatures requests failures by repo server",
+		},
+		[]string{"repo", "revision"},
+	)
+	registry.MustRegister(ociCosignSignaturesFailCounter)
+
 	ociRequestCounter := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_oci_request_total",
@@ -182,23 +192,24 @@ func NewMetricsServer() *MetricsServer {
 	registry.MustRegister(ociRequestHistogram)
 
 	return &MetricsServer{
-		handler:                       promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
-		gitFetchFailCounter:           gitFetchFailCounter,
-		gitLsRemoteFailCounter:        gitLsRemoteFailCounter,
-		gitRequestCounter:             gitRequestCounter,
-		gitRequestHistogram:           gitRequestHistogram,
-		repoPendingRequestsGauge:      repoPendingRequestsGauge,
-		parallelismWaitHistogram:      parallelismWaitHistogram,
-		redisRequestCounter:           redisRequestCounter,
-		redisRequestHistogram:         redisRequestHistogram,
-		ociRequestCounter:             ociRequestCounter,
-		ociRequestHistogram:           ociRequestHistogram,
-		ociExtractFailCounter:         ociExtractFailCounter,
-		ociResolveRevisionFailCounter: ociResolveRevisionFailCounter,
-		ociGetTagsFailCounter:         ociGetTagsFailCounter,
-		ociDigestMetadataCounter:      ociDigestMetadataCounter,
-		ociTestRepoFailCounter:        ociTestRepoFailCounter,
-		PrometheusRegistry:            registry,
+		handler:                        promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+		gitFetchFailCounter:            gitFetchFailCounter,
+		gitLsRemoteFailCounter:         gitLsRemoteFailCounter,
+		gitRequestCounter:              gitRequestCounter,
+		gitRequestHistogram:            gitRequestHistogram,
+		repoPendingRequestsGauge:       repoPendingRequestsGauge,
+		parallelismWaitHistogram:       parallelismWaitHistogram,
+		redisRequestCounter:            redisRequestCounter,
+		redisRequestHistogram:          redisRequestHistogram,
+		ociRequestCounter:              ociRequestCounter,
+		ociRequestHistogram:            ociRequestHistogram,
+		ociExtractFailCounter:          ociExtractFailCounter,
+		ociResolveRevisionFailCounter:  ociResolveRevisionFailCounter,
+		ociGetTagsFailCounter:          ociGetTagsFailCounter,
+		ociDigestMetadataCounter:       ociDigestMetadataCounter,
+		ociTestRepoFailCounter:         ociTestRepoFailCounter,
+		ociCosignSignaturesFailCounter: ociCosignSignaturesFailCounter,
+		PrometheusRegistry:             registry,
 	}
 }
 
@@ -280,3 +291,8 @@ func (m *MetricsServer) IncOCIGetTagsFailCounter(repo string) {
 func (m *MetricsServer) IncOCITestRepoFailCounter(repo string) {
 	m.ociTestRepoFailCounter.WithLabelValues(repo).Inc()
 }
+
+// IncOCICosignSignaturesFailCounter increments the OCI failed cosign signatures requests counter
+func (m *MetricsServer) IncOCICosignSignaturesFailCounter(repo string, revision string) {
+	m.ociCosignSignaturesFailCounter.WithLabelValues(repo, revision).Inc()
+}
@@ -9,11 +9,12 @@ import (
 type OCIRequestType string
 
 const (
-	OCIRequestTypeExtract         = "extract"
-	OCIRequestTypeResolveRevision = "resolve-revision"
-	OCIRequestTypeDigestMetadata  = "digest-metadata"
-	OCIRequestTypeGetTags         = "get-tags"
-	OCIRequestTypeTestRepo        = "test-repo"
+	OCIRequestTypeExtract          = "extract"
+	OCIRequestTypeResolveRevision  = "resolve-revision"
+	OCIRequestTypeDigestMetadata   = "digest-metadata"
+	OCIRequestTypeGetTags          = "get-tags"
+	OCIRequestTypeTestRepo         = "test-repo"
+	OCIRequestTypeCosignSignatures = "cosign-signatures"
 )
 
 // NewOCIClientEventHandlers creates event handlers to update OCI repo, related metrics
@@ -34,6 +35,9 @@ func NewOCIClientEventHandlers(metricsServer *MetricsServer) oci.EventHandlers {
 		OnTestRepo: func(repo string) func() {
 			return processMetricFunc(metricsServer, repo, OCIRequestTypeTestRepo)
 		},
+		OnCosignSignatures: func(repo string) func() {
+			return processMetricFunc(metricsServer, repo, OCIRequestTypeCosignSignatures)
+		},
 		OnExtractFail: func(repo string) func(revision string) {
 			return func(revision string) { metricsServer.IncOCIExtractFailCounter(repo, revision) }
 		},
@@ -49,6 +53,9 @@ func NewOCIClientEventHandlers(metricsServer *MetricsServer) oci.EventHandlers {
 		OnTestRepoFail: func(repo string) func() {
 			return func() { metricsServer.IncOCITestRepoFailCounter(repo) }
 		},
+		OnCosignSignaturesFail: func(repo string) func(revision string) {
+			return func(revision string) { metricsServer.IncOCICosignSignaturesFailCounter(repo, revision) }
+		},
 	}
 }
 
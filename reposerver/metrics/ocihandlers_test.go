@@ -28,18 +28,21 @@ func TestOCIClientEventHandlers(t *testing.T) {
 					eventHandlers.OnGetTags("test")()
 					eventHandlers.OnResolveRevision("test")()
 					eventHandlers.OnDigestMetadata("test")()
+					eventHandlers.OnCosignSignatures("test")()
 					eventHandlers.OnExtractFail("test")(revision)
 					eventHandlers.OnTestRepoFail("test")()
 					eventHandlers.OnGetTagsFail("test")()
 					eventHandlers.OnResolveRevisionFail("test")(revision)
 					eventHandlers.OnDigestMetadataFail("test")(revision)
+					eventHandlers.OnCosignSignaturesFail("test")(revision)
 					c := metricsServer.ociRequestCounter
-					assert.Equal(t, 5, testutil.CollectAndCount(c))
+					assert.Equal(t, 6, testutil.CollectAndCount(c))
 					assert.InDelta(t, float64(1), testutil.ToFloat64(c.WithLabelValues("test", OCIRequestTypeExtract)), 0.01)
 					assert.InDelta(t, float64(1), testutil.ToFloat64(c.WithLabelValues("test", OCIRequestTypeResolveRevision)), 0.01)
 					assert.InDelta(t, float64(1), testutil.ToFloat64(c.WithLabelValues("test", OCIRequestTypeDigestMetadata)), 0.01)
 					assert.InDelta(t, float64(1), testutil.ToFloat64(c.WithLabelValues("test", OCIRequestTypeTestRepo)), 0.01)
 					assert.InDelta(t, float64(1), testutil.ToFloat64(c.WithLabelValues("test", OCIRequestTypeTestRepo)), 0.01)
+					assert.InDelta(t, float64(1), testutil.ToFloat64(c.WithLabelValues("test", OCIRequestTypeCosignSignatures)), 0.01)
 
 					c = metricsServer.ociDigestMetadataCounter
 					assert.Equal(t, 1, testutil.CollectAndCount(c))
@@ -60,6 +63,10 @@ func TestOCIClientEventHandlers(t *testing.T) {
 					c = metricsServer.ociResolveRevisionFailCounter
 					assert.Equal(t, 1, testutil.CollectAndCount(c))
 					assert.InDelta(t, float64(1), testutil.ToFloat64(c.WithLabelValues("test", revision)), 0.01)
+
+					c = metricsServer.ociCosignSignaturesFailCounter
+					assert.Equal(t, 1, testutil.CollectAndCount(c))
+					assert.InDelta(t, float64(1), testutil.ToFloat64(c.WithLabelValues("test", revision)), 0.01)
 				})
 			},
 		},
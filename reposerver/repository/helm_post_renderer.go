@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/app/discovery"
+	apppathutil "github.com/argoproj/argo-cd/v3/util/app/path"
+	"github.com/argoproj/argo-cd/v3/util/cmp"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/kustomize"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// helmPostRendererManifestsFile is the name of the file holding the manifests rendered by Helm which are passed to a
+// post-renderer
+const helmPostRendererManifestsFile = "helm-output.yaml"
+
+// newHelmPostRenderer returns the post-renderer of the manifests rendered by Helm for the Helm options of the
+// application, or nil if the manifests are not post-rendered
+func newHelmPostRenderer(ctx context.Context, postRenderer *v1alpha1.HelmPostRenderer, appPath, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest) (helm.PostRenderer, error) {
+	if postRenderer == nil {
+		return nil, nil
+	}
+	switch {
+	case postRenderer.Kustomize != nil && postRenderer.Plugin != nil:
+		return nil, errors.New("helm post-renderer must set either kustomize or plugin, not both")
+	case postRenderer.Kustomize != nil:
+		relAppPath, err := filepath.Rel(repoRoot, appPath)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving application path: %w", err)
+		}
+		componentPath, err := apppathutil.Path(repoRoot, filepath.Join(relAppPath, postRenderer.Kustomize.Path))
+		if err != nil {
+			return nil, fmt.Errorf("error resolving helm post-renderer kustomize path: %w", err)
+		}
+		return newKustomizePostRenderer(componentPath, env, q)
+	case postRenderer.Plugin != nil:
+		if postRenderer.Plugin.Name == "" {
+			return nil, errors.New("helm post-renderer plugin name must not be empty")
+		}
+		return newPluginPostRenderer(ctx, postRenderer.Plugin.Name, env, q), nil
+	}
+	return nil, errors.New("helm post-renderer must set either kustomize or plugin")
+}
+
+// newKustomizePostRenderer returns a post-renderer which applies the Kustomize component in the given directory to the
+// rendered manifests. The manifests are written next to a kustomization which lists them as resources and the
+// component as component, so that the component is loaded from the repository with the usual load restrictions.
+func newKustomizePostRenderer(componentPath string, env *v1alpha1.Env, q *apiclient.ManifestRequest) (helm.PostRenderer, error) {
+	kustomizeBinary, err := settings.GetKustomizeBinaryPath(q.KustomizeOptions, *q.ApplicationSource)
+	if err != nil {
+		return nil, fmt.Errorf("error getting kustomize binary path: %w", err)
+	}
+	kubeVersion, err := parseKubeVersion(q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse kubernetes version %s: %w", q.ApplicationSource.GetKubeVersionOrDefault(q.KubeVersion), err)
+	}
+	return func(manifests string) (string, error) {
+		dir, err := os.MkdirTemp("", "helm-post-renderer")
+		if err != nil {
+			return "", fmt.Errorf("error creating temporary directory: %w", err)
+		}
+		defer func() { _ = os.RemoveAll(dir) }()
+		component, err := filepath.Rel(dir, componentPath)
+		if err != nil {
+			return "", fmt.Errorf("error resolving kustomize component path: %w", err)
+		}
+		kustomization := fmt.Sprintf("apiVersion: kustomize.config.k8s.io/v1beta1\nkind: Kustomization\nresources:\n- %s\ncomponents:\n- %s\n", helmPostRendererManifestsFile, component)
+		if err := os.WriteFile(filepath.Join(dir, "kustomization.yaml"), []byte(kustomization), 0o600); err != nil {
+			return "", fmt.Errorf("error writing kustomization: %w", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, helmPostRendererManifestsFile), []byte(manifests), 0o600); err != nil {
+			return "", fmt.Errorf("error writing rendered manifests: %w", err)
+		}
+
+		var proxy, noProxy string
+		if q.Repo != nil {
+			proxy, noProxy = q.Repo.Proxy, q.Repo.NoProxy
+		}
+		k := kustomize.NewKustomizeApp(dir, dir, git.NopCreds{}, "", kustomizeBinary, proxy, noProxy)
+		objs, _, _, err := k.Build(nil, q.KustomizeOptions, env, &kustomize.BuildOpts{
+			KubeVersion: kubeVersion,
+			APIVersions: q.ApplicationSource.GetAPIVersionsOrDefault(q.ApiVersions),
+		})
+		if err != nil {
+			return "", err
+		}
+		docs := make([]string, 0, len(objs))
+		for _, obj := range objs {
+			doc, err := json.Marshal(obj.Object)
+			if err != nil {
+				return "", err
+			}
+			docs = append(docs, string(doc))
+		}
+		return strings.Join(docs, "\n---\n"), nil
+	}, nil
+}
+
+// newPluginPostRenderer returns a post-renderer which passes the rendered manifests to the generate command of the
+// named config management plugin on stdin
+func newPluginPostRenderer(ctx context.Context, pluginName string, env *v1alpha1.Env, q *apiclient.ManifestRequest) helm.PostRenderer {
+	return func(manifests string) (string, error) {
+		dir, err := os.MkdirTemp("", "helm-post-renderer")
+		if err != nil {
+			return "", fmt.Errorf("error creating temporary directory: %w", err)
+		}
+		defer func() { _ = os.RemoveAll(dir) }()
+		if err := os.WriteFile(filepath.Join(dir, helmPostRendererManifestsFile), []byte(manifests), 0o600); err != nil {
+			return "", fmt.Errorf("error writing rendered manifests: %w", err)
+		}
+
+		envVars, err := getPluginEnvs(env, q)
+		if err != nil {
+			return "", err
+		}
+		conn, cmpClient, err := discovery.DetectConfigManagementPlugin(ctx, dir, dir, pluginName, envVars, nil)
+		if err != nil {
+			return "", err
+		}
+		defer utilio.Close(conn)
+
+		stream, err := cmpClient.GenerateManifest(ctx, grpc_retry.Disable())
+		if err != nil {
+			return "", fmt.Errorf("error getting generateManifestStream: %w", err)
+		}
+		err = cmp.SendRepoStream(ctx, dir, dir, stream, envVars, nil, cmp.WithStdinFile(helmPostRendererManifestsFile))
+		if err != nil {
+			return "", fmt.Errorf("error sending file to cmp-server: %w", err)
+		}
+		res, err := stream.CloseAndRecv()
+		if err != nil {
+			return "", fmt.Errorf("error generating manifests in cmp: %w", err)
+		}
+		return strings.Join(res.Manifests, "\n---\n"), nil
+	}
+}
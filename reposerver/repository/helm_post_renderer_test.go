@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func TestNewHelmPostRenderer(t *testing.T) {
+	t.Parallel()
+
+	repoRoot, err := filepath.Abs("./testdata/helm-post-renderer")
+	require.NoError(t, err)
+	appPath := filepath.Join(repoRoot, "chart")
+	q := &apiclient.ManifestRequest{ApplicationSource: &v1alpha1.ApplicationSource{}}
+
+	t.Run("no post-renderer", func(t *testing.T) {
+		t.Parallel()
+		postRenderer, err := newHelmPostRenderer(t.Context(), nil, appPath, repoRoot, &v1alpha1.Env{}, q)
+		require.NoError(t, err)
+		assert.Nil(t, postRenderer)
+	})
+
+	tests := []struct {
+		name         string
+		postRenderer *v1alpha1.HelmPostRenderer
+		expectedErr  string
+	}{
+		{"kustomize and plugin", &v1alpha1.HelmPostRenderer{
+			Kustomize: &v1alpha1.HelmPostRendererKustomize{Path: "../component"},
+			Plugin:    &v1alpha1.HelmPostRendererPlugin{Name: "my-plugin"},
+		}, "not both"},
+		{"neither kustomize nor plugin", &v1alpha1.HelmPostRenderer{}, "either kustomize or plugin"},
+		{"kustomize path outside of repository", &v1alpha1.HelmPostRenderer{
+			Kustomize: &v1alpha1.HelmPostRendererKustomize{Path: "../../component"},
+		}, "error resolving helm post-renderer kustomize path"},
+		{"missing kustomize path", &v1alpha1.HelmPostRenderer{
+			Kustomize: &v1alpha1.HelmPostRendererKustomize{Path: "../missing"},
+		}, "error resolving helm post-renderer kustomize path"},
+		{"empty plugin name", &v1alpha1.HelmPostRenderer{
+			Plugin: &v1alpha1.HelmPostRendererPlugin{},
+		}, "plugin name must not be empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := newHelmPostRenderer(t.Context(), tt.postRenderer, appPath, repoRoot, &v1alpha1.Env{}, q)
+			assert.ErrorContains(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestKustomizePostRenderer(t *testing.T) {
+	t.Parallel()
+
+	repoRoot, err := filepath.Abs("./testdata/helm-post-renderer")
+	require.NoError(t, err)
+	q := &apiclient.ManifestRequest{
+		ApplicationSource: &v1alpha1.ApplicationSource{},
+		// the fake kustomize binary checks that the component is referenced by the generated kustomization
+		KustomizeOptions: &v1alpha1.KustomizeOptions{BinaryPath: filepath.Join(repoRoot, "kustomize.sh")}, // nolint:staticcheck
+	}
+
+	postRenderer, err := newHelmPostRenderer(t.Context(), &v1alpha1.HelmPostRenderer{
+		Kustomize: &v1alpha1.HelmPostRendererKustomize{Path: "../component"},
+	}, filepath.Join(repoRoot, "chart"), repoRoot, &v1alpha1.Env{}, q)
+	require.NoError(t, err)
+	require.NotNil(t, postRenderer)
+
+	manifests, err := postRenderer(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-map
+data:
+  foo: bar
+`)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"post-rendered-my-map"},"data":{"foo":"bar"}}`, manifests)
+}
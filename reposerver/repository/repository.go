@@ -3,6 +3,8 @@ package repository
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +15,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	gosync "sync"
@@ -60,6 +63,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/app/discovery"
 	apppathutil "github.com/argoproj/argo-cd/v3/util/app/path"
 	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/cache/disk"
 	"github.com/argoproj/argo-cd/v3/util/cmp"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/glob"
@@ -104,6 +108,18 @@ type Service struct {
 	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...git.ClientOpts) (git.Client, error)
 	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client
 	initConstants             RepoServerInitConstants
+	// diskCache stores git worktrees, Helm charts and OCI images on the local disk, nil if disabled
+	diskCache *disk.Cache
+	// helmDependencyCache stores the dependency archives of Helm charts built by `helm dependency build`, nil until
+	// the service is initialized
+	helmDependencyCache *helm.DependencyCache
+	// gitSnapshotsInProgress holds the references of the git snapshots which are being stored in the disk cache
+	gitSnapshotsInProgress gosync.Map
+	// gitSnapshotsSeeded holds the key of the git snapshot copied to the root of each repository which is not
+	// checked out yet
+	gitSnapshotsSeeded gosync.Map
+	// sparseCheckouts merges the directories of the sparse checkouts of the repositories
+	sparseCheckouts *sparseCheckouts
 	// stores cached symlink validation results
 	symlinksState *gocache.Cache
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
@@ -132,6 +148,16 @@ type RepoServerInitConstants struct {
 	EnableBuiltinGitConfig                       bool
 	HelmUserAgent                                string
 	HelmChartCacheExpiration                     time.Duration // Cache expiration for repo
+	// DiskCacheDir is the directory of the disk cache of git worktrees, Helm charts and OCI images, which is disabled if empty
+	DiskCacheDir string
+	// DiskCacheMaxSize is the size of the disk cache above which the least recently used entries are evicted
+	DiskCacheMaxSize int64
+	// SparseCheckout enables partial clones of git repositories, which only check out the directories needed to
+	// generate the manifests of the applications
+	SparseCheckout bool
+	// HelmDependenciesOffline disables downloading the dependencies of Helm charts which are neither vendored in the
+	// charts directory nor cached
+	HelmDependenciesOffline bool
 }
 
 var manifestGenerateLock = sync.NewKeyLock()
@@ -170,10 +196,20 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 		gitRepoInitializer: directoryPermissionInitializer,
 		rootDir:            rootDir,
 		symlinksState:      gocache.New(12*time.Hour, time.Hour),
+		sparseCheckouts:    newSparseCheckouts(),
 	}
 }
 
 func (s *Service) Init() error {
+	if s.initConstants.DiskCacheDir != "" {
+		diskCache, err := disk.NewCache(s.initConstants.DiskCacheDir, s.initConstants.DiskCacheMaxSize)
+		if err != nil {
+			return fmt.Errorf("error initializing disk cache: %w", err)
+		}
+		s.diskCache = diskCache
+	}
+	s.helmDependencyCache = helm.NewDependencyCache(s.chartPaths, s.diskCache)
+
 	_, err := os.Stat(s.rootDir)
 	if os.IsNotExist(err) {
 		return os.MkdirAll(s.rootDir, 0o300)
@@ -319,6 +355,12 @@ type operationSettings struct {
 	noCache         bool
 	noRevisionCache bool
 	allowConcurrent bool
+	// sparseCheckoutPaths are the directories of the git repository needed by the operation, nil if it needs the
+	// whole repository
+	sparseCheckoutPaths []string
+	// gitSnapshotPaths are the directories of the git repository held by the snapshots of the disk cache used by the
+	// operation, nil if the snapshots hold the whole repository
+	gitSnapshotPaths []string
 }
 
 // operationContext contains request values which are generated by runRepoOperation (on demand) by a call to the
@@ -368,13 +410,19 @@ func (s *Service) runRepoOperation(
 	revision = textutils.FirstNonEmpty(revision, source.TargetRevision)
 	unresolvedRevision := revision
 
+	var sparseCheckoutPaths []string
+	var sparseCheckoutGeneration int
+	if s.initConstants.SparseCheckout && len(settings.sparseCheckoutPaths) > 0 && !source.IsOCI() && !source.IsHelm() {
+		sparseCheckoutPaths, sparseCheckoutGeneration = s.sparseCheckouts.merge(git.NormalizeGitURL(repo.Repo), settings.sparseCheckoutPaths)
+	}
+
 	switch {
 	case source.IsOCI():
 		ociClient, revision, err = s.newOCIClientResolveRevision(ctx, repo, revision, settings.noCache || settings.noRevisionCache)
 	case source.IsHelm():
 		helmClient, revision, err = s.newHelmClientResolveRevision(ctx, repo, revision, source.Chart, settings.noCache || settings.noRevisionCache)
 	default:
-		gitClient, revision, err = s.newClientResolveRevision(repo, revision, gitClientOpts, git.WithTagPrefix(source.TagPrefix))
+		gitClient, revision, err = s.newClientResolveRevision(repo, revision, gitClientOpts, git.WithTagPrefix(source.TagPrefix), git.WithSparseCheckout(sparseCheckoutPaths))
 	}
 
 	if err != nil {
@@ -413,6 +461,12 @@ func (s *Service) runRepoOperation(
 			}
 		}
 
+		// Verify the signatures before the artifact is pulled and extracted
+		sourceIntegrityResult, err := sourceintegrity.VerifyOCI(ctx, sourceIntegrity, ociClient, source.RepoURL, revision)
+		if err != nil {
+			return err
+		}
+
 		ociPath, closer, err := ociClient.Extract(ctx, revision)
 		if err != nil {
 			return err
@@ -442,7 +496,7 @@ func (s *Service) runRepoOperation(
 		}
 
 		return operation(ociPath, revision, revision, func() (*operationContext, error) {
-			return &operationContext{appPath, "", nil}, nil
+			return &operationContext{appPath, "", sourceIntegrityResult}, nil
 		})
 	} else if source.IsHelm() {
 		if settings.noCache {
@@ -451,11 +505,23 @@ func (s *Service) runRepoOperation(
 				return err
 			}
 		}
-		helmPassCredentials := false
-		if source.Helm != nil {
-			helmPassCredentials = source.Helm.PassCredentials
+		sourceIntegrityResult, verifiedClient, verifiedDigest, err := s.verifyHelmOCIChart(ctx, sourceIntegrity, repo, source, revision, settings.noCache || settings.noRevisionCache)
+		if err != nil {
+			return err
+		}
+		var chartPath string
+		var closer utilio.Closer
+		if verifiedClient != nil {
+			// Extract the very digest that was verified: pulling the chart again by its version would deploy
+			// whatever the tag points to by then, which may not be the verified artifact.
+			chartPath, closer, err = verifiedClient.Extract(ctx, verifiedDigest)
+		} else {
+			helmPassCredentials := false
+			if source.Helm != nil {
+				helmPassCredentials = source.Helm.PassCredentials
+			}
+			chartPath, closer, err = helmClient.ExtractChart(ctx, source.Chart, revision, helmPassCredentials, s.initConstants.HelmManifestMaxExtractedSize, s.initConstants.DisableHelmManifestMaxExtractedSize)
 		}
-		chartPath, closer, err := helmClient.ExtractChart(ctx, source.Chart, revision, helmPassCredentials, s.initConstants.HelmManifestMaxExtractedSize, s.initConstants.DisableHelmManifestMaxExtractedSize)
 		if err != nil {
 			return err
 		}
@@ -477,16 +543,50 @@ func (s *Service) runRepoOperation(
 			}
 		}
 		return operation(chartPath, revision, revision, func() (*operationContext, error) {
-			return &operationContext{chartPath, "", nil}, nil
+			return &operationContext{chartPath, "", sourceIntegrityResult}, nil
 		})
 	}
-	closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func(clean bool) (goio.Closer, error) {
+	// The snapshots of the disk cache do not include the git metadata, which is needed to verify the signatures of
+	// the revision and to resolve the commit SHAs of multiple sources
+	useGitSnapshots := s.diskCache != nil && !hasMultipleSources && sourceIntegrity == nil
+	if useGitSnapshots && !isGitRepoInitialized(gitClient.Root()) {
+		key, snapshotDir, release, ok := s.getGitSnapshot(repo.Repo, revision, settings.gitSnapshotPaths)
+		if ok {
+			defer release()
+			return s.runGitSnapshotOperation(ctx, gitClient, key, snapshotDir, repo, source, revision, repoRefs, cacheFn, operation, settings)
+		}
+	}
+
+	lockRevision := revision
+	if len(sparseCheckoutPaths) > 0 {
+		// operations only share a sparse checkout which includes the directories needed by all of them
+		lockRevision = fmt.Sprintf("%s|sparse-%d", revision, sparseCheckoutGeneration)
+	}
+	closer, err := s.repoLock.Lock(gitClient.Root(), lockRevision, settings.allowConcurrent, func(clean bool) (goio.Closer, error) {
+		// the checkout replaces the snapshot copied to the root of the repository, if any
+		s.gitSnapshotsSeeded.Delete(gitClient.Root())
 		return s.checkoutRevision(ctx, gitClient, revision, s.initConstants.SubmoduleEnabled, repo.Depth, clean)
 	})
 	if err != nil {
 		return err
 	}
 
+	// a sparse checkout only includes the directories of the snapshot if they are the directories of the source, and
+	// operations which do not allow concurrent processing modify the worktree
+	if useGitSnapshots && (len(sparseCheckoutPaths) == 0 || len(settings.gitSnapshotPaths) > 0) && settings.allowConcurrent {
+		if snapshotStored := s.storeGitSnapshotAsync(ctx, gitClient, repo.Repo, settings.gitSnapshotPaths); snapshotStored != nil {
+			// the worktree must not be checked out at another revision until the snapshot is stored
+			repoCloser := closer
+			closer = utilio.NewCloser(func() error {
+				go func() {
+					<-snapshotStored
+					utilio.Close(repoCloser)
+				}()
+				return nil
+			})
+		}
+	}
+
 	defer utilio.Close(closer)
 
 	if !s.initConstants.AllowOutOfBoundsSymlinks {
@@ -560,6 +660,206 @@ func (s *Service) runRepoOperation(
 	})
 }
 
+func isGitRepoInitialized(root string) bool {
+	_, err := os.Stat(filepath.Join(root, ".git"))
+	return err == nil
+}
+
+// gitSnapshotRef returns the reference of the snapshot of the directories of the repository at the given commit, or of
+// the whole repository if paths is nil
+func gitSnapshotRef(repoURL string, commitSHA string, paths []string) string {
+	ref := fmt.Sprintf("git|%s|%s", git.NormalizeGitURL(repoURL), commitSHA)
+	if paths != nil {
+		ref += "|" + strings.Join(paths, ";")
+	}
+	return ref
+}
+
+// getGitSnapshot returns the key and the directory of the snapshot of the directories of the repository at the given
+// commit from the disk cache
+func (s *Service) getGitSnapshot(repoURL string, commitSHA string, paths []string) (string, string, func(), bool) {
+	key, ok := s.diskCache.Resolve(gitSnapshotRef(repoURL, commitSHA, paths))
+	if !ok {
+		return "", "", nil, false
+	}
+	dir, release, ok := s.diskCache.Get(key)
+	return key, dir, release, ok
+}
+
+// storeGitSnapshotAsync stores a snapshot of the directories of the checked out worktree of the repository in the disk
+// cache in the background, unless the disk cache already has it or it is already being stored. The returned channel is
+// closed once the snapshot is stored, it is nil if no snapshot is stored.
+func (s *Service) storeGitSnapshotAsync(ctx context.Context, gitClient git.Client, repoURL string, paths []string) <-chan struct{} {
+	commitSHA, err := gitClient.CommitSHA(ctx)
+	if err != nil {
+		log.WithField("repo", repoURL).Warnf("Failed to store snapshot of repository in disk cache: %v", err)
+		return nil
+	}
+	ref := gitSnapshotRef(repoURL, commitSHA, paths)
+	if _, ok := s.diskCache.Resolve(ref); ok {
+		return nil
+	}
+	if _, inProgress := s.gitSnapshotsInProgress.LoadOrStore(ref, struct{}{}); inProgress {
+		return nil
+	}
+	stored := make(chan struct{})
+	go func() {
+		defer close(stored)
+		defer s.gitSnapshotsInProgress.Delete(ref)
+		s.storeGitSnapshot(context.WithoutCancel(ctx), gitClient, repoURL, commitSHA, paths)
+	}()
+	return stored
+}
+
+// storeGitSnapshot stores a snapshot of the directories of the checked out worktree of the repository, or of the whole
+// worktree if paths is nil, without the git metadata, in the disk cache. The snapshot is addressed by the hashes of the
+// trees of the directories, so the commits which do not change them share a snapshot. Failures are logged since the
+// snapshot is an optimization only.
+func (s *Service) storeGitSnapshot(ctx context.Context, gitClient git.Client, repoURL string, commitSHA string, paths []string) {
+	err := func() error {
+		key, err := gitSnapshotKey(ctx, gitClient, commitSHA, paths)
+		if err != nil {
+			return err
+		}
+		_, release, err := s.diskCache.Put(key, func(dir string) error {
+			if paths == nil {
+				return files.CopyDir(gitClient.Root(), dir, ".git")
+			}
+			for _, path := range topLevelPaths(paths) {
+				if err := files.CopyDir(filepath.Join(gitClient.Root(), path), filepath.Join(dir, path)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		release()
+		return s.diskCache.Link(gitSnapshotRef(repoURL, commitSHA, paths), key)
+	}()
+	if err != nil {
+		log.WithField("repo", repoURL).Warnf("Failed to store snapshot of repository in disk cache: %v", err)
+	}
+}
+
+// gitSnapshotKey returns the key of the snapshot of the directories of the commit, or of the whole commit if paths is
+// nil, which is derived from the hashes of their trees
+func gitSnapshotKey(ctx context.Context, gitClient git.Client, commitSHA string, paths []string) (string, error) {
+	if paths == nil {
+		treeSHA, err := gitClient.TreeSHA(ctx, commitSHA, "")
+		if err != nil {
+			return "", err
+		}
+		return "git-tree:" + treeSHA, nil
+	}
+	h := sha256.New()
+	for _, path := range topLevelPaths(paths) {
+		treeSHA, err := gitClient.TreeSHA(ctx, commitSHA, path)
+		if err != nil {
+			return "", err
+		}
+		_, _ = fmt.Fprintf(h, "%s\x00%s\n", path, treeSHA)
+	}
+	return "git-trees:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// topLevelPaths returns the sorted paths which are not inside another of the paths
+func topLevelPaths(paths []string) []string {
+	sorted := slices.Clone(paths)
+	slices.Sort(sorted)
+	topLevel := make([]string, 0, len(sorted))
+	for _, path := range sorted {
+		if len(topLevel) > 0 {
+			last := topLevel[len(topLevel)-1]
+			if path == last || strings.HasPrefix(path, last+"/") {
+				continue
+			}
+		}
+		topLevel = append(topLevel, path)
+	}
+	return topLevel
+}
+
+// runGitSnapshotOperation runs the operation on the snapshot of the repository at the given commit, instead of
+// fetching and checking out the commit. The snapshot is copied once to the root of the repository, which is shared by
+// the operations like a checkout, and copied again only if an operation which modifies the worktree ran in between.
+func (s *Service) runGitSnapshotOperation(
+	ctx context.Context,
+	gitClient git.Client,
+	key string,
+	snapshotDir string,
+	repo *v1alpha1.Repository,
+	source *v1alpha1.ApplicationSource,
+	commitSHA string,
+	repoRefs map[string]string,
+	cacheFn func(revision string, refSourceCommitSHAs cache.ResolvedRevisions, firstInvocation bool) (bool, error),
+	operation func(repoRoot, commitSHA, revision string, ctxSrc operationContextSrc) error,
+	settings operationSettings,
+) error {
+	repoRoot := gitClient.Root()
+	closer, err := s.repoLock.Lock(repoRoot, "snapshot|"+key, settings.allowConcurrent, func(clean bool) (goio.Closer, error) {
+		return s.seedGitSnapshot(repoRoot, key, snapshotDir, clean)
+	})
+	if err != nil {
+		return err
+	}
+	defer utilio.Close(closer)
+
+	if !s.initConstants.AllowOutOfBoundsSymlinks {
+		err := s.checkOutOfBoundsSymlinks(repoRoot, commitSHA, settings.noCache)
+		if err != nil {
+			oobError := &apppathutil.OutOfBoundsSymlinkError{}
+			if errors.As(err, &oobError) {
+				log.WithFields(log.Fields{
+					common.SecurityField: common.SecurityHigh,
+					"repo":               repo.Repo,
+					"revision":           commitSHA,
+					"file":               oobError.File,
+				}).Warn("repository contains out-of-bounds symlink")
+				return fmt.Errorf("repository contains out-of-bounds symlinks. file: %s", oobError.File)
+			}
+			return err
+		}
+	}
+
+	// double-check locking
+	if !settings.noCache {
+		if ok, err := cacheFn(commitSHA, repoRefs, false); ok {
+			return err
+		}
+	}
+
+	log.WithFields(log.Fields{"repo": repo.Repo, "revision": commitSHA}).Debug("Using snapshot of repository from disk cache")
+	return operation(repoRoot, commitSHA, commitSHA, func() (*operationContext, error) {
+		appPath, err := apppathutil.Path(repoRoot, source.Path)
+		if err != nil {
+			return nil, err
+		}
+		return &operationContext{appPath, "", nil}, nil
+	})
+}
+
+// seedGitSnapshot copies the snapshot to the root of the repository, unless it was already copied and clean is false.
+// The root of the repository is not a git repository, so the next checkout initializes it from scratch.
+func (s *Service) seedGitSnapshot(repoRoot string, key string, snapshotDir string, clean bool) (goio.Closer, error) {
+	closer := s.gitRepoInitializer(repoRoot)
+	if seeded, ok := s.gitSnapshotsSeeded.Load(repoRoot); ok && seeded == key && !clean {
+		if _, err := os.Stat(repoRoot); err == nil {
+			return closer, nil
+		}
+	}
+	s.gitSnapshotsSeeded.Delete(repoRoot)
+	if err := os.RemoveAll(repoRoot); err != nil {
+		return closer, fmt.Errorf("error cleaning repository for snapshot: %w", err)
+	}
+	if err := files.CopyDir(snapshotDir, repoRoot); err != nil {
+		return closer, fmt.Errorf("error copying repository snapshot: %w", err)
+	}
+	s.gitSnapshotsSeeded.Store(repoRoot, key)
+	return closer, nil
+}
+
 func getRepoSanitizerRegex(rootDir string) *regexp.Regexp {
 	// This regex assumes that the sensitive part of the path (the component immediately after "rootDir") contains no
 	// spaces. This assumption allows us to avoid sanitizing "more info" in "/tmp/_argocd-repo/SENSITIVE more info".
@@ -642,7 +942,20 @@ func (s *Service) checkOutOfBoundsSymlinks(rootPath string, version string, noCa
 	return checker.(func() error)()
 }
 
-func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestRequest) (res *apiclient.ManifestResponse, retErr error) {
+func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+	res, _, err := s.generateManifest(ctx, q, false)
+	return res, err
+}
+
+// manifestChunks reads the manifests of a response one chunk at a time, rather than all at once
+type manifestChunks struct {
+	count int
+	get   func(i int) ([]string, error)
+}
+
+// generateManifest generates the manifests of the request, or gets them from the cache. If lazyChunks is set, the
+// cached manifests which are stored in chunks are not read, and the returned manifestChunks reads them instead.
+func (s *Service) generateManifest(ctx context.Context, q *apiclient.ManifestRequest, lazyChunks bool) (res *apiclient.ManifestResponse, chunks *manifestChunks, retErr error) {
 	// The otelgrpc server handler already created the RPC span as the parent; this names
 	// and annotates the manifest-generation work so it shows up in the reconcile trace.
 	ctx, span := tracer.Start(ctx, "reposerver.GenerateManifest")
@@ -666,17 +979,19 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		res = &apiclient.ManifestResponse{
 			Revision: revision,
 		}
-		return res, err
+		return res, nil, err
 	}
 
 	cacheFn := func(revision string, refSourceCommitSHAs cache.ResolvedRevisions, firstInvocation bool) (bool, error) {
-		ok, resp, err := s.getManifestCacheEntry(revision, q, refSourceCommitSHAs, firstInvocation)
+		ok, resp, respChunks, err := s.getManifestCacheEntry(revision, q, refSourceCommitSHAs, firstInvocation, lazyChunks)
 		res = resp
+		chunks = respChunks
 		return ok, err
 	}
 
 	tarConcluded := false
 	var promise *ManifestResponsePromise
+	var settings operationSettings
 
 	operation := func(repoRoot, commitSHA, revision string, ctxSrc operationContextSrc) error {
 		// do not generate manifests if Path and Chart fields are not set for a source in Multiple Sources
@@ -690,7 +1005,8 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 			return nil
 		}
 
-		promise = s.runManifestGen(ctx, repoRoot, commitSHA, revision, ctxSrc, q)
+		// the errors of a sparse checkout are not cached, since the generation is then retried with a full checkout
+		promise = s.runManifestGen(ctx, repoRoot, commitSHA, revision, ctxSrc, q, len(settings.sparseCheckoutPaths) == 0)
 		// The fist channel to send the message will resume this operation.
 		// The main purpose for using channels here is to be able to unlock
 		// the repository as soon as the lock in not required anymore. In
@@ -707,19 +1023,48 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		return nil
 	}
 
-	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()}
-	err = s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.SourceIntegrity, cacheFn, operation, settings, q.HasMultipleSources, q.RefSources)
+	generate := func() error {
+		res = nil
+		chunks = nil
+		tarConcluded = false
+		err := s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.SourceIntegrity, cacheFn, operation, settings, q.HasMultipleSources, q.RefSources)
+
+		// if the tarDoneCh message is sent it means that the manifest
+		// generation is being managed by the cmp-server. In this case
+		// we have to wait for the responseCh to send the manifest
+		// response.
+		if tarConcluded && res == nil {
+			select {
+			case resp := <-promise.responseCh:
+				res = resp
+			case err := <-promise.errCh:
+				return err
+			}
+		}
+		return err
+	}
 
-	// if the tarDoneCh message is sent it means that the manifest
-	// generation is being managed by the cmp-server. In this case
-	// we have to wait for the responseCh to send the manifest
-	// response.
-	if tarConcluded && res == nil {
-		select {
-		case resp := <-promise.responseCh:
-			res = resp
-		case err := <-promise.errCh:
-			return nil, err
+	settings = operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()}
+	if (s.initConstants.SparseCheckout || s.diskCache != nil) && !s.sparseCheckouts.isFullCheckoutRequired(git.NormalizeGitURL(q.Repo.Repo), q.ApplicationSource.Path) {
+		paths := sparseCheckoutPaths(q.ApplicationSource, q.AnnotationManifestGeneratePaths, q.HasMultipleSources)
+		if s.initConstants.SparseCheckout {
+			settings.sparseCheckoutPaths = paths
+		}
+		if s.diskCache != nil {
+			// the snapshots of the directories of the source are shared by the commits which do not change them
+			settings.gitSnapshotPaths = paths
+		}
+	}
+	err = generate()
+	if err != nil && (len(settings.sparseCheckoutPaths) > 0 || len(settings.gitSnapshotPaths) > 0) && ctx.Err() == nil {
+		// the manifests may refer to files outside of the sparse checkout, such as the bases of a Kustomize overlay or
+		// the local dependencies of a Helm chart in other directories, which are only known once they are generated
+		log.WithFields(log.Fields{"application": q.AppName, "appNamespace": q.Namespace}).
+			Infof("Failed to generate manifests from a sparse checkout, retrying with a full checkout: %v", err)
+		settings.sparseCheckoutPaths = nil
+		settings.gitSnapshotPaths = nil
+		if err = generate(); err == nil {
+			s.sparseCheckouts.requireFullCheckout(git.NormalizeGitURL(q.Repo.Repo), q.ApplicationSource.Path)
 		}
 	}
 
@@ -727,9 +1072,9 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 	// rather than string matching.
 	var globNoMatch *GlobNoMatchError
 	if errors.As(err, &globNoMatch) {
-		return nil, status.Error(codes.NotFound, err.Error())
+		return nil, nil, status.Error(codes.NotFound, err.Error())
 	}
-	return res, err
+	return res, chunks, err
 }
 
 func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_GenerateManifestWithFilesServer) error {
@@ -771,7 +1116,7 @@ func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_G
 			return nil, fmt.Errorf("failed to get app path: %w", err)
 		}
 		return &operationContext{appPath, "", nil}, nil
-	}, req)
+	}, req, true)
 
 	var res *apiclient.ManifestResponse
 	tarConcluded := false
@@ -798,6 +1143,49 @@ func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_G
 	return err
 }
 
+// GenerateManifestStream generates the manifests like GenerateManifest, and sends them in chunks of at most
+// apiclient.ManifestChunkSize bytes, so that the response of very large applications does not need to fit in a single
+// gRPC message. The first response holds all the fields other than the manifests, along with the first chunk.
+// The cached manifests which are stored in chunks are read from the cache one chunk at a time, as they are sent.
+func (s *Service) GenerateManifestStream(q *apiclient.ManifestRequest, stream apiclient.RepoServerService_GenerateManifestStreamServer) error {
+	res, chunks, err := s.generateManifest(stream.Context(), q, true)
+	if err != nil {
+		return err
+	}
+	if chunks == nil {
+		generatedChunks := apiclient.ChunkManifests(res.Manifests, apiclient.ManifestChunkSize)
+		chunks = &manifestChunks{
+			count: len(generatedChunks),
+			get: func(i int) ([]string, error) {
+				return generatedChunks[i], nil
+			},
+		}
+	}
+	first := *res
+	first.Manifests = nil
+	next := &first
+	for i := range chunks.count {
+		manifests, err := chunks.get(i)
+		if err != nil {
+			return fmt.Errorf("error getting manifests: %w", err)
+		}
+		if next == nil {
+			next = &apiclient.ManifestResponse{}
+		}
+		next.Manifests = manifests
+		if err := stream.Send(next); err != nil {
+			return fmt.Errorf("error sending manifests: %w", err)
+		}
+		next = nil
+	}
+	if next != nil {
+		if err := stream.Send(next); err != nil {
+			return fmt.Errorf("error sending manifests: %w", err)
+		}
+	}
+	return nil
+}
+
 type ManifestResponsePromise struct {
 	responseCh <-chan *apiclient.ManifestResponse
 	tarDoneCh  <-chan bool
@@ -823,7 +1211,7 @@ type generateManifestCh struct {
 // - or, the cache does contain a value for this key, but it is an expired manifest generation entry
 // - or, NoCache is true
 // Returns a ManifestResponse, or an error, but not both
-func (s *Service) runManifestGen(ctx context.Context, repoRoot, commitSHA, revision string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest) *ManifestResponsePromise {
+func (s *Service) runManifestGen(ctx context.Context, repoRoot, commitSHA, revision string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest, cacheErrors bool) *ManifestResponsePromise {
 	responseCh := make(chan *apiclient.ManifestResponse)
 	tarDoneCh := make(chan bool)
 	errCh := make(chan error)
@@ -834,7 +1222,7 @@ func (s *Service) runManifestGen(ctx context.Context, repoRoot, commitSHA, revis
 		tarDoneCh:  tarDoneCh,
 		errCh:      errCh,
 	}
-	go s.runManifestGenAsync(ctx, repoRoot, commitSHA, revision, opContextSrc, q, channels)
+	go s.runManifestGenAsync(ctx, repoRoot, commitSHA, revision, opContextSrc, q, cacheErrors, channels)
 	return responsePromise
 }
 
@@ -847,7 +1235,7 @@ type repoRef struct {
 	key string
 }
 
-func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA, revision string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest, ch *generateManifestCh) {
+func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA, revision string, opContextSrc operationContextSrc, q *apiclient.ManifestRequest, cacheErrors bool, ch *generateManifestCh) {
 	defer func() {
 		close(ch.errCh)
 		close(ch.responseCh)
@@ -958,7 +1346,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			}
 		}
 
-		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths))
+		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths), WithHelmDependencyCache(s.helmDependencyCache), WithHelmDependenciesOffline(s.initConstants.HelmDependenciesOffline))
 	}
 	refSourceCommitSHAs := make(map[string]string)
 	if len(repoRefs) > 0 {
@@ -977,7 +1365,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 		})
 
 		// If manifest generation error caching is enabled
-		if s.initConstants.PauseGenerationAfterFailedGenerationAttempts > 0 {
+		if cacheErrors && s.initConstants.PauseGenerationAfterFailedGenerationAttempts > 0 {
 			cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifests error", manifestKey)
 
 			// Retrieve a new copy (if available) of the cached response: this ensures we are updating the latest copy of the cache,
@@ -1039,15 +1427,21 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 // - If the cache is not empty, but the cached value is a manifest generation error AND we have not yet met the failure threshold (e.g. res.NumberOfConsecutiveFailures > 0 && res.NumberOfConsecutiveFailures <  s.initConstants.PauseGenerationAfterFailedGenerationAttempts)
 // - If the cache is not empty, but the cache value is an error AND that generation error has expired
 // and returns true otherwise.
-// If true is returned, either the second or third parameter (but not both) will contain a value from the cache (a ManifestResponse, or error, respectively)
-func (s *Service) getManifestCacheEntry(revision string, q *apiclient.ManifestRequest, refSourceCommitSHAs cache.ResolvedRevisions, firstInvocation bool) (bool, *apiclient.ManifestResponse, error) {
+// If true is returned, either the second or fourth parameter (but not both) will contain a value from the cache (a ManifestResponse, or error, respectively)
+// If lazyChunks is set, the manifests which are stored in chunks are not read, and the third parameter reads them instead.
+func (s *Service) getManifestCacheEntry(revision string, q *apiclient.ManifestRequest, refSourceCommitSHAs cache.ResolvedRevisions, firstInvocation bool, lazyChunks bool) (bool, *apiclient.ManifestResponse, *manifestChunks, error) {
 	cacheKey := cache.NewManifestKey(revision, q.ApplicationSource, q.GetRefSources(), q.GetNamespace(), q.GetTrackingMethod(),
 		q.GetAppLabelKey(), q.GetAppName(), q.GetInstallationID(), q.GetSourceIntegrity(), q, refSourceCommitSHAs,
 	)
 	cache.LogDebugManifestCacheKeyFields("getting manifests cache", "GenerateManifest API call", cacheKey)
 
 	res := cache.CachedManifestResponse{}
-	err := s.cache.GetManifests(cacheKey, &res)
+	var err error
+	if lazyChunks {
+		err = s.cache.GetManifestsWithoutChunks(cacheKey, &res)
+	} else {
+		err = s.cache.GetManifests(cacheKey, &res)
+	}
 	if err == nil {
 		// The cache contains an existing value
 
@@ -1069,7 +1463,7 @@ func (s *Service) getManifestCacheEntry(revision string, q *apiclient.ManifestRe
 							log.Warnf("manifest cache delete error %s/%s: %v", q.ApplicationSource.String(), revision, err)
 						}
 						log.Infof("manifest error cache hit and reset: %s/%s", q.ApplicationSource.String(), revision)
-						return false, nil, nil
+						return false, nil, nil, nil
 					}
 				}
 
@@ -1084,7 +1478,7 @@ func (s *Service) getManifestCacheEntry(revision string, q *apiclient.ManifestRe
 							log.Warnf("manifest cache delete error %s/%s: %v", q.ApplicationSource.String(), revision, err)
 						}
 						log.Infof("manifest error cache hit and reset: %s/%s", q.ApplicationSource.String(), revision)
-						return false, nil, nil
+						return false, nil, nil, nil
 					}
 				}
 
@@ -1106,17 +1500,26 @@ func (s *Service) getManifestCacheEntry(revision string, q *apiclient.ManifestRe
 					}
 				}
 
-				return true, nil, cachedErrorResponse
+				return true, nil, nil, cachedErrorResponse
 			}
 
 			// Otherwise we are not yet in the manifest generation error state, and not enough consecutive errors have
 			// yet occurred to put us in that state.
 			log.Infof("manifest error cache miss: %s/%s", q.ApplicationSource.String(), revision)
-			return false, res.ManifestResponse, nil
+			return false, res.ManifestResponse, nil, nil
 		}
 
 		log.Infof("manifest cache hit: %s/%s", q.ApplicationSource.String(), revision)
-		return true, res.ManifestResponse, nil
+		var chunks *manifestChunks
+		if chunkKeys := res.ManifestChunks; len(chunkKeys) > 0 {
+			chunks = &manifestChunks{
+				count: len(chunkKeys),
+				get: func(i int) ([]string, error) {
+					return s.cache.GetManifestChunk(cacheKey, chunkKeys[i])
+				},
+			}
+		}
+		return true, res.ManifestResponse, chunks, nil
 	}
 
 	if !errors.Is(err, cache.ErrCacheMiss) {
@@ -1125,7 +1528,7 @@ func (s *Service) getManifestCacheEntry(revision string, q *apiclient.ManifestRe
 		log.Infof("manifest cache miss: %s/%s", q.ApplicationSource.String(), revision)
 	}
 
-	return false, nil, nil
+	return false, nil, nil, nil
 }
 
 func getHelmRepos(appPath string, repositories []*v1alpha1.Repository, helmRepoCreds []*v1alpha1.RepoCreds) ([]helm.HelmRepository, error) {
@@ -1237,7 +1640,11 @@ func sanitizeRepoName(repoName string) string {
 // if multiple threads are trying to run it.
 // Multiple goroutines might process same helm app in one repo concurrently when repo server process multiple
 // manifest generation requests of the same commit.
-func runHelmBuild(ctx context.Context, appPath string, h helm.Helm) error {
+// The dependency archives are restored from the dependency cache if they were built before for the same lock file with
+// the same repositories and credentials, and all the repositories are permitted by the project. Otherwise, the
+// dependencies are downloaded unless offline, in which case an error is returned if any dependency must be downloaded
+// from a remote repository.
+func runHelmBuild(ctx context.Context, appPath string, h helm.Helm, helmRepos []helm.HelmRepository, projectSourceRepos []string, dependencyCache *helm.DependencyCache, offline bool) error {
 	manifestGenerateLock.Lock(appPath)
 	defer manifestGenerateLock.Unlock(appPath)
 
@@ -1252,13 +1659,48 @@ func runHelmBuild(ctx context.Context, appPath string, h helm.Helm) error {
 		return err
 	}
 
+	if dependencyCache != nil && helmReposPermitted(helmRepos, projectSourceRepos) {
+		restored, err := dependencyCache.Restore(appPath, helmRepos)
+		if err != nil {
+			log.Warnf("Failed to restore cached dependencies of helm chart %s: %v", appPath, err)
+		} else if restored {
+			return os.WriteFile(markerFile, []byte("marker"), 0o644)
+		}
+	}
+
+	if offline {
+		remoteDependencies, err := helm.RemoteDependencies(appPath)
+		if err != nil {
+			return fmt.Errorf("error getting helm chart dependencies: %w", err)
+		}
+		if len(remoteDependencies) > 0 {
+			return fmt.Errorf("helm chart dependencies %s are neither vendored nor cached, and downloading them is disabled", strings.Join(remoteDependencies, ", "))
+		}
+	}
+
 	err = h.DependencyBuild(ctx)
 	if err != nil {
 		return fmt.Errorf("error building helm chart dependencies: %w", err)
 	}
+	if dependencyCache != nil {
+		if err := dependencyCache.Store(appPath, helmRepos); err != nil {
+			log.Warnf("Failed to cache dependencies of helm chart %s: %v", appPath, err)
+		}
+	}
 	return os.WriteFile(markerFile, []byte("marker"), 0o644)
 }
 
+// helmReposPermitted returns whether all the given Helm repositories are permitted by the source repositories of the
+// project
+func helmReposPermitted(helmRepos []helm.HelmRepository, projectSourceRepos []string) bool {
+	for _, repo := range helmRepos {
+		if !isSourcePermitted(repo.Repo, projectSourceRepos) {
+			return false
+		}
+	}
+	return true
+}
+
 func isSourcePermitted(url string, repos []string) bool {
 	p := v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{SourceRepos: repos}}
 	return p.IsSourcePermitted(v1alpha1.ApplicationSource{RepoURL: url})
@@ -1278,7 +1720,7 @@ func parseKubeVersion(version string) (string, error) {
 	return kubeVersion.String(), nil
 }
 
-func helmTemplate(ctx context.Context, appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths) ([]*unstructured.Unstructured, string, error) {
+func helmTemplate(ctx context.Context, appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths, opt *generateManifestOpt) ([]*unstructured.Unstructured, string, error) {
 	// We use the app name as Helm's release name property, which must not
 	// contain any underscore characters and must not exceed 53 characters.
 	// We are not interested in the fully qualified application name while
@@ -1368,6 +1810,10 @@ func helmTemplate(ctx context.Context, appPath string, repoRoot string, env *v1a
 		templateOpts.SkipCrds = appHelm.SkipCrds
 		templateOpts.SkipSchemaValidation = appHelm.SkipSchemaValidation
 		templateOpts.SkipTests = appHelm.SkipTests
+		templateOpts.PostRenderer, err = newHelmPostRenderer(ctx, appHelm.PostRenderer, appPath, repoRoot, env, q)
+		if err != nil {
+			return nil, "", err
+		}
 	}
 	if templateOpts.Name == "" {
 		templateOpts.Name = q.AppName
@@ -1402,7 +1848,7 @@ func helmTemplate(ctx context.Context, appPath string, repoRoot string, env *v1a
 			return nil, "", err
 		}
 
-		err = runHelmBuild(ctx, appPath, h)
+		err = runHelmBuild(ctx, appPath, h, helmRepos, q.ProjectSourceRepos, opt.helmDependencyCache, opt.helmDependenciesOffline)
 		if err != nil {
 			var reposNotPermitted []string
 			// We do a sanity check here to give a nicer error message in case any of the Helm repositories are not permitted by
@@ -1696,6 +2142,8 @@ type (
 		cmpTarDoneCh                chan<- bool
 		cmpTarExcludedGlobs         []string
 		cmpUseManifestGeneratePaths bool
+		helmDependencyCache         *helm.DependencyCache
+		helmDependenciesOffline     bool
 	}
 )
 
@@ -1732,6 +2180,22 @@ func WithCMPUseManifestGeneratePaths(enabled bool) GenerateManifestOpt {
 	}
 }
 
+// WithHelmDependencyCache defines the cache the dependency archives of Helm charts are restored from, instead of
+// running `helm dependency build`, and stored in once built.
+func WithHelmDependencyCache(dependencyCache *helm.DependencyCache) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.helmDependencyCache = dependencyCache
+	}
+}
+
+// WithHelmDependenciesOffline disables downloading the dependencies of Helm charts which are neither vendored nor
+// cached.
+func WithHelmDependenciesOffline(offline bool) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.helmDependenciesOffline = offline
+	}
+}
+
 // GenerateManifests generates manifests from a path. Overrides are applied as a side effect on the given ApplicationSource.
 func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool, gitCredsStore git.CredsStore, maxCombinedManifestQuantity resource.Quantity, gitRepoPaths utilio.TempPaths, opts ...GenerateManifestOpt) (_ *apiclient.ManifestResponse, retErr error) {
 	ctx, span := tracer.Start(ctx, "reposerver.GenerateManifests")
@@ -1759,7 +2223,7 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeHelm:
 		var command string
-		targetObjs, command, err = helmTemplate(ctx, appPath, repoRoot, env, q, isLocal, gitRepoPaths)
+		targetObjs, command, err = helmTemplate(ctx, appPath, repoRoot, env, q, isLocal, gitRepoPaths, opt)
 		commands = append(commands, command)
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		var kustomizeBinary string
@@ -2455,6 +2919,9 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 	}
 
 	settings := operationSettings{allowConcurrent: q.Source.AllowsConcurrentProcessing(), noCache: q.NoCache, noRevisionCache: q.NoCache || q.NoRevisionCache}
+	if s.initConstants.SparseCheckout {
+		settings.sparseCheckoutPaths = sparseCheckoutPaths(q.Source, "", len(q.RefSources) > 0)
+	}
 	err := s.runRepoOperation(ctx, q.Source.TargetRevision, q.Repo, q.Source, nil, cacheFn, operation, settings, len(q.RefSources) > 0, q.RefSources)
 
 	return res, toUserInputStatusError(err)
@@ -2887,7 +3354,8 @@ func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (
 	}
 	opts = append(opts,
 		git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)),
-		git.WithBuiltinGitConfig(s.initConstants.EnableBuiltinGitConfig))
+		git.WithBuiltinGitConfig(s.initConstants.EnableBuiltinGitConfig),
+		git.WithPartialClone(s.initConstants.SparseCheckout))
 	return s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, repo.NoProxy, opts...)
 }
 
@@ -2920,9 +3388,41 @@ func (s *Service) newOCIClientResolveRevision(ctx context.Context, repo *v1alpha
 	return ociClient, digest, nil
 }
 
+// verifyHelmOCIChart checks the signatures of a Helm chart stored in an OCI registry, if there are source integrity
+// criteria declared for it. The chart version is resolved to the digest of the chart artifact that is being verified.
+// When the chart was verified, the OCI client and the verified digest are returned so that the chart is extracted from
+// that digest rather than from its version tag, which may be moved to another artifact in the meantime.
+func (s *Service) verifyHelmOCIChart(ctx context.Context, sourceIntegrity *v1alpha1.SourceIntegrity, repo *v1alpha1.Repository, source *v1alpha1.ApplicationSource, version string, noRevisionCache bool) (*v1alpha1.SourceIntegrityCheckResult, oci.Client, string, error) {
+	if !sourceintegrity.HasCriteria(sourceIntegrity, *source) {
+		return nil, nil, "", nil
+	}
+
+	chartRepoURL := fmt.Sprintf("oci://%s/%s", strings.TrimSuffix(repo.Repo, "/"), source.Chart)
+	opts := append(s.ociClientStandardOpts(),
+		oci.WithManifestMaxExtractedSize(s.initConstants.HelmManifestMaxExtractedSize),
+		oci.WithDisableManifestMaxExtractedSize(s.initConstants.DisableHelmManifestMaxExtractedSize),
+	)
+	ociClient, err := s.newOCIClient(chartRepoURL, repo.GetOCICreds(), repo.Proxy, repo.NoProxy, s.initConstants.OCIMediaTypes, opts...)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to initialize oci client: %w", err)
+	}
+
+	// By convention, the plus (+) of the SemVer is stored as underscore (_) in the OCI tag
+	digest, err := ociClient.ResolveRevision(ctx, strings.ReplaceAll(version, "+", "_"), noRevisionCache)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("failed to resolve chart version %q: %w", version, err)
+	}
+
+	result, err := sourceintegrity.VerifyOCI(ctx, sourceIntegrity, ociClient, source.RepoURL, digest)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return result, ociClient, digest, nil
+}
+
 func (s *Service) newHelmClientResolveRevision(ctx context.Context, repo *v1alpha1.Repository, revision string, chart string, noRevisionCache bool) (helm.Client, string, error) {
 	enableOCI := repo.EnableOCI || helm.IsHelmOciRepo(repo.Repo)
-	opts := []helm.ClientOpts{helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths)}
+	opts := []helm.ClientOpts{helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths), helm.WithDiskCache(s.diskCache)}
 	if repo.InsecureOCIForceHttp {
 		opts = append(opts, helm.WithPlainHTTP())
 	}
@@ -3610,6 +4110,7 @@ func (s *Service) ociClientStandardOpts() []oci.ClientOpts {
 	return []oci.ClientOpts{
 		oci.WithIndexCache(s.cache),
 		oci.WithImagePaths(s.ociPaths),
+		oci.WithDiskCache(s.diskCache),
 		oci.WithManifestMaxExtractedSize(s.initConstants.OCIManifestMaxExtractedSize),
 		oci.WithDisableManifestMaxExtractedSize(s.initConstants.DisableOCIManifestMaxExtractedSize),
 		oci.WithEventHandlers(metrics.NewOCIClientEventHandlers(s.metricsServer)),
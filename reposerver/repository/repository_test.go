@@ -3,6 +3,8 @@ package repository
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -29,10 +31,12 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/oci"
 
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+	"github.com/argoproj/argo-cd/v3/util/cache/disk"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	appsv1 "k8s.io/api/apps/v1"
@@ -72,7 +76,7 @@ var sourceIntegrityReqStrict = &v1alpha1.SourceIntegrity{
 	},
 }
 
-var LsSignaturesMockOk = func(_ context.Context, _ string, _ bool) (info []git.RevisionSignatureInfo, legacy string, err error) {
+var LsSignaturesMockOk = func(_ context.Context, _ string, _ bool, _ git.SignatureVerifier) (info []git.RevisionSignatureInfo, legacy string, err error) {
 	return []git.RevisionSignatureInfo{
 		{
 			Revision:           "d71589b8001a0bd78bb311cb03c9d129c6f91de1",
@@ -84,7 +88,7 @@ var LsSignaturesMockOk = func(_ context.Context, _ string, _ bool) (info []git.R
 	}, "", nil
 }
 
-var LsSignaturesMockGitError = func(_ context.Context, _ string, _ bool) (info []git.RevisionSignatureInfo, legacy string, err error) {
+var LsSignaturesMockGitError = func(_ context.Context, _ string, _ bool, _ git.SignatureVerifier) (info []git.RevisionSignatureInfo, legacy string, err error) {
 	return []git.RevisionSignatureInfo{{
 		Revision:           "171589b8001a0bd78bb311cb03c9d129c6f91de1",
 		VerificationResult: git.GPGVerificationResultExpiredKey,
@@ -280,6 +284,80 @@ func TestGenerateYamlManifestInDir(t *testing.T) {
 	assert.Len(t, res2.Manifests, 3)
 }
 
+type fakeGenerateManifestStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*apiclient.ManifestResponse
+}
+
+func (s *fakeGenerateManifestStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeGenerateManifestStream) Send(res *apiclient.ManifestResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func TestGenerateManifestStream(t *testing.T) {
+	service := newService(t, "./testdata/concatenated")
+
+	src := v1alpha1.ApplicationSource{Path: "."}
+	q := apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{},
+		ApplicationSource:  &src,
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+	expected, err := service.GenerateManifest(t.Context(), &q)
+	require.NoError(t, err)
+
+	stream := &fakeGenerateManifestStream{ctx: t.Context()}
+	require.NoError(t, service.GenerateManifestStream(&q, stream))
+	require.NotEmpty(t, stream.responses)
+	var manifests []string
+	for _, res := range stream.responses {
+		manifests = append(manifests, res.Manifests...)
+	}
+	assert.Equal(t, expected.Manifests, manifests)
+	assert.Equal(t, expected.Revision, stream.responses[0].Revision)
+	assert.Equal(t, expected.SourceType, stream.responses[0].SourceType)
+}
+
+func TestGenerateManifestStream_CachedChunks(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"a", "b"} {
+		manifest := fmt.Sprintf("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: %s\ndata:\n  key: %s\n", name, strings.Repeat(name, apiclient.ManifestChunkSize*3/4))
+		require.NoError(t, os.WriteFile(filepath.Join(root, name+".yaml"), []byte(manifest), 0o644))
+	}
+	service := newService(t, root)
+
+	src := v1alpha1.ApplicationSource{Path: "."}
+	q := apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{},
+		ApplicationSource:  &src,
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+	// the manifests are generated and cached in chunks
+	expected, err := service.GenerateManifest(t.Context(), &q)
+	require.NoError(t, err)
+	require.Len(t, expected.Manifests, 2)
+	// the manifests can only be streamed from the cache
+	require.NoError(t, os.Remove(filepath.Join(root, "a.yaml")))
+
+	stream := &fakeGenerateManifestStream{ctx: t.Context()}
+	require.NoError(t, service.GenerateManifestStream(&q, stream))
+	// each chunk of the cache is sent in its own response
+	require.Len(t, stream.responses, 2)
+	var manifests []string
+	for _, res := range stream.responses {
+		manifests = append(manifests, res.Manifests...)
+	}
+	assert.Equal(t, expected.Manifests, manifests)
+	assert.Equal(t, expected.Revision, stream.responses[0].Revision)
+}
+
 func Test_GenerateManifest_KustomizeWithVersionOverride(t *testing.T) {
 	t.Parallel()
 
@@ -449,6 +527,112 @@ func TestGenerateManifests_EmptyCache(t *testing.T) {
 	gitMocks.AssertCalled(t, "Fetch", mock.Anything, mock.Anything, mock.Anything)
 }
 
+const gitSnapshotTestManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+`
+
+func newGitSnapshotTestService(t *testing.T, root string, commitSHA string, cf clientFunc) *Service {
+	t.Helper()
+	service, _, _ := newServiceWithOpt(t, func(gitClient *gitmocks.Client, helmClient *helmmocks.Client, ociClient *ocimocks.Client, paths *iomocks.TempPaths) {
+		gitClient.EXPECT().LsRemote(mock.Anything).Return(commitSHA, nil)
+		gitClient.EXPECT().Root().Return(root)
+		paths.EXPECT().GetPath(mock.Anything).Return(root, nil)
+		paths.EXPECT().GetPathIfExists(mock.Anything).Return(root)
+		paths.EXPECT().GetPaths().Return(map[string]string{"fake-nonce": root})
+		cf(gitClient, helmClient, ociClient, paths)
+	}, root)
+	diskCache, err := disk.NewCache(t.TempDir(), 0)
+	require.NoError(t, err)
+	service.diskCache = diskCache
+	return service
+}
+
+func TestGenerateManifests_GitSnapshot(t *testing.T) {
+	commitSHA := "632039659e542ed7de0c170a4fcc1c571b288fc0"
+	repoURL := "https://github.com/argoproj/argocd-example-apps.git"
+	root := t.TempDir()
+	// neither fetch nor checkout are expected since the repository is not cloned yet and its snapshot is cached
+	service := newGitSnapshotTestService(t, root, commitSHA, func(_ *gitmocks.Client, _ *helmmocks.Client, _ *ocimocks.Client, _ *iomocks.TempPaths) {})
+	_, release, err := service.diskCache.Put("git-trees:abc", func(dir string) error {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "app"), 0o755))
+		return os.WriteFile(filepath.Join(dir, "app", "config.yaml"), []byte(gitSnapshotTestManifest), 0o644)
+	})
+	require.NoError(t, err)
+	release()
+	require.NoError(t, service.diskCache.Link(gitSnapshotRef(repoURL, commitSHA, []string{"app"}), "git-trees:abc"))
+
+	generate := func() *apiclient.ManifestResponse {
+		res, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
+			Repo:               &v1alpha1.Repository{Repo: repoURL},
+			ApplicationSource:  &v1alpha1.ApplicationSource{RepoURL: repoURL, Path: "app"},
+			NoCache:            true,
+			ProjectName:        "something",
+			ProjectSourceRepos: []string{"*"},
+		})
+		require.NoError(t, err)
+		return res
+	}
+	res := generate()
+	require.Len(t, res.Manifests, 1)
+	assert.Contains(t, res.Manifests[0], "my-config")
+	assert.Equal(t, commitSHA, res.Revision)
+
+	// the snapshot is copied to the root of the repository only once, so the worktree is not copied again
+	require.NoError(t, os.WriteFile(filepath.Join(root, "app", "other.yaml"), []byte(strings.ReplaceAll(gitSnapshotTestManifest, "my-config", "other-config")), 0o644))
+	res = generate()
+	assert.Len(t, res.Manifests, 2)
+}
+
+func TestGenerateManifests_StoresGitSnapshot(t *testing.T) {
+	commitSHA := "632039659e542ed7de0c170a4fcc1c571b288fc0"
+	repoURL := "https://github.com/argoproj/argocd-example-apps.git"
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "app"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "app", "config.yaml"), []byte(gitSnapshotTestManifest), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "README.md"), []byte("readme"), 0o644))
+	service := newGitSnapshotTestService(t, root, commitSHA, func(gitClient *gitmocks.Client, _ *helmmocks.Client, _ *ocimocks.Client, _ *iomocks.TempPaths) {
+		gitClient.EXPECT().Init().Return(nil)
+		gitClient.EXPECT().IsRevisionPresent(mock.Anything, mock.Anything).Return(false)
+		gitClient.EXPECT().Fetch(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		gitClient.EXPECT().Checkout(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("", nil)
+		gitClient.EXPECT().CommitSHA(mock.Anything).Return(commitSHA, nil)
+		gitClient.EXPECT().TreeSHA(mock.Anything, commitSHA, "app").Return("abc", nil)
+		gitClient.EXPECT().IsAnnotatedTag(mock.Anything, mock.Anything).Return(false)
+		gitClient.EXPECT().VerifyCommitSignature(mock.Anything, mock.Anything).Return("", nil)
+	})
+
+	_, err := service.GenerateManifest(t.Context(), &apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{Repo: repoURL},
+		ApplicationSource:  &v1alpha1.ApplicationSource{RepoURL: repoURL, Path: "app"},
+		NoCache:            true,
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	})
+	require.NoError(t, err)
+
+	// the snapshot of the path of the source is stored in the background
+	var key string
+	require.Eventually(t, func() bool {
+		var ok bool
+		key, ok = service.diskCache.Resolve(gitSnapshotRef(repoURL, commitSHA, []string{"app"}))
+		return ok
+	}, 10*time.Second, 10*time.Millisecond)
+	sum := sha256.Sum256([]byte("app\x00abc\n"))
+	assert.Equal(t, "git-trees:"+hex.EncodeToString(sum[:]), key)
+	dir, release, ok := service.diskCache.Get(key)
+	require.True(t, ok)
+	defer release()
+	assert.FileExists(t, filepath.Join(dir, "app", "config.yaml"))
+	assert.NoFileExists(t, filepath.Join(dir, "README.md"))
+}
+
+func TestTopLevelPaths(t *testing.T) {
+	assert.Equal(t, []string{"apps/a", "apps/b", "base"}, topLevelPaths([]string{"base", "apps/b", "apps/a", "apps/a/overlay", "apps/b"}))
+	assert.Equal(t, []string{"app", "app-other"}, topLevelPaths([]string{"app-other", "app"}))
+}
+
 // Test that when Generate manifest is called with a source that is ref only it does not try to generate manifests or hit the manifest cache
 // but it does resolve and cache the revision
 func TestGenerateManifest_RefOnlyShortCircuit(t *testing.T) {
@@ -1762,6 +1946,8 @@ func TestListApps(t *testing.T) {
 		"helm-with-local-dependency":        "Helm",
 		"simple-chart":                      "Helm",
 		"broken-schema-verification":        "Helm",
+		"helm-post-renderer/chart":          "Helm",
+		"helm-post-renderer/component":      "Kustomize",
 	}
 	assert.Equal(t, expectedApps, res.Apps)
 }
@@ -1939,7 +2125,7 @@ func TestGetRevisionMetadata(t *testing.T) {
 	service, gitClient, _ := newServiceWithMocks(t, "../..")
 	now := time.Now()
 
-	gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(LsSignaturesMockOk)
+	gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(LsSignaturesMockOk)
 	gitClient.EXPECT().RevisionMetadata(mock.Anything, mock.Anything).Return(&git.RevisionMetadata{
 		Message: "test",
 		Author:  "author",
@@ -2031,7 +2217,7 @@ func TestGetSignatureVerificationResult(t *testing.T) {
 	// Commit with signature and verification requested
 	{
 		service, gitClient, _ := newServiceWithMocks(t, "../../manifests/base")
-		gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(LsSignaturesMockOk)
+		gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(LsSignaturesMockOk)
 
 		src := v1alpha1.ApplicationSource{Path: "."}
 		q := apiclient.ManifestRequest{
@@ -2051,7 +2237,7 @@ func TestGetSignatureVerificationResult(t *testing.T) {
 	// Commit with signature and verification not requested
 	{
 		service, gitClient, _ := newServiceWithMocks(t, "../../manifests/base")
-		gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(LsSignaturesMockOk)
+		gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(LsSignaturesMockOk)
 
 		src := v1alpha1.ApplicationSource{Path: "."}
 		q := apiclient.ManifestRequest{
@@ -2066,12 +2252,12 @@ func TestGetSignatureVerificationResult(t *testing.T) {
 		res, err := service.GenerateManifest(t.Context(), &q)
 		require.NoError(t, err)
 		assert.Nil(t, res.SourceIntegrityResult)
-		gitClient.AssertNotCalled(t, "LsSignatures", mock.Anything, mock.Anything, mock.Anything)
+		gitClient.AssertNotCalled(t, "LsSignatures", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	}
 	// Commit without signature and verification requested
 	{
 		service, gitClient, _ := newServiceWithMocks(t, "../../manifests/base")
-		gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(LsSignaturesMockGitError)
+		gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(LsSignaturesMockGitError)
 
 		src := v1alpha1.ApplicationSource{Path: "."}
 		q := apiclient.ManifestRequest{
@@ -2105,7 +2291,7 @@ func TestGetSignatureVerificationResult(t *testing.T) {
 		res, err := service.GenerateManifest(t.Context(), &q)
 		require.NoError(t, err)
 		assert.Nil(t, res.SourceIntegrityResult)
-		gitClient.AssertNotCalled(t, "LsSignatures", mock.Anything, mock.Anything, mock.Anything)
+		gitClient.AssertNotCalled(t, "LsSignatures", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 	}
 }
 
@@ -3207,6 +3393,88 @@ func Test_getHelmDependencyRepos(t *testing.T) {
 	assert.Equal(t, repos[1].Repo, repo2)
 }
 
+// fakeHelm is a helm.Helm whose dependency build writes an archive to the charts directory
+type fakeHelm struct {
+	helm.Helm
+	appPath          string
+	dependencyBuilds int
+}
+
+func (h *fakeHelm) DependencyBuild(_ context.Context) error {
+	h.dependencyBuilds++
+	if err := os.MkdirAll(filepath.Join(h.appPath, "charts"), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(h.appPath, "charts", "redis-1.0.0.tgz"), []byte("archive"), 0o644)
+}
+
+func TestRunHelmBuild(t *testing.T) {
+	chartYAML := `name: chart
+dependencies:
+- name: redis
+  repository: https://charts.example.com
+  version: 1.0.0
+`
+	chartLock := `dependencies:
+- name: redis
+  repository: https://charts.example.com
+  version: 1.0.0
+`
+	newChart := func(t *testing.T) string {
+		t.Helper()
+		appPath := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(appPath, "Chart.yaml"), []byte(chartYAML), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(appPath, "Chart.lock"), []byte(chartLock), 0o644))
+		return appPath
+	}
+	helmRepos := []helm.HelmRepository{{Repo: "https://charts.example.com", Creds: helm.HelmCreds{Username: "user", Password: "secret"}}}
+	projectSourceRepos := []string{"https://charts.example.com"}
+
+	t.Run("cached dependencies", func(t *testing.T) {
+		dependencyCache := helm.NewDependencyCache(utilio.NewRandomizedTempPaths(t.TempDir()), nil)
+
+		appPath := newChart(t)
+		h := &fakeHelm{appPath: appPath}
+		require.NoError(t, runHelmBuild(t.Context(), appPath, h, helmRepos, projectSourceRepos, dependencyCache, false))
+		assert.Equal(t, 1, h.dependencyBuilds)
+
+		// the dependencies of another chart with the same lock file are restored from the cache, even when offline
+		otherAppPath := newChart(t)
+		other := &fakeHelm{appPath: otherAppPath}
+		require.NoError(t, runHelmBuild(t.Context(), otherAppPath, other, helmRepos, projectSourceRepos, dependencyCache, true))
+		assert.Equal(t, 0, other.dependencyBuilds)
+		assert.FileExists(t, filepath.Join(otherAppPath, "charts", "redis-1.0.0.tgz"))
+		assert.FileExists(t, filepath.Join(otherAppPath, helmDepUpMarkerFile))
+
+		// the dependencies are not restored for a project which does not permit the repository
+		notPermittedAppPath := newChart(t)
+		notPermitted := &fakeHelm{appPath: notPermittedAppPath}
+		require.NoError(t, runHelmBuild(t.Context(), notPermittedAppPath, notPermitted, helmRepos, []string{"https://other.example.com"}, dependencyCache, false))
+		assert.Equal(t, 1, notPermitted.dependencyBuilds)
+	})
+
+	t.Run("offline without cached dependencies", func(t *testing.T) {
+		appPath := newChart(t)
+		h := &fakeHelm{appPath: appPath}
+		err := runHelmBuild(t.Context(), appPath, h, helmRepos, projectSourceRepos, helm.NewDependencyCache(utilio.NewRandomizedTempPaths(t.TempDir()), nil), true)
+		require.ErrorContains(t, err, "helm chart dependencies redis:1.0.0 are neither vendored nor cached")
+		assert.Equal(t, 0, h.dependencyBuilds)
+	})
+
+	t.Run("offline with local dependencies", func(t *testing.T) {
+		appPath := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(appPath, "Chart.yaml"), []byte(`name: chart
+dependencies:
+- name: common
+  repository: file://../common
+  version: 1.0.0
+`), 0o644))
+		h := &fakeHelm{appPath: appPath}
+		require.NoError(t, runHelmBuild(t.Context(), appPath, h, nil, nil, nil, true))
+		assert.Equal(t, 1, h.dependencyBuilds)
+	})
+}
+
 func TestResolveRevision(t *testing.T) {
 	expectedRevision := "03b17e0233e64787ffb5fcf65c740cc2a20822ba"
 	service, _, _ := newServiceWithOpt(t, func(gitClient *gitmocks.Client, _ *helmmocks.Client, _ *ocimocks.Client, paths *iomocks.TempPaths) {
@@ -4746,7 +5014,7 @@ func TestErrorGetGitDirectories(t *testing.T) {
 			s, _, _ := newServiceWithOpt(t, func(gitClient *gitmocks.Client, _ *helmmocks.Client, _ *ocimocks.Client, paths *iomocks.TempPaths) {
 				gitClient.EXPECT().Checkout(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("", nil)
 				gitClient.EXPECT().LsRemote(mock.Anything).Return("", errors.New("ah error"))
-				gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything).Return([]git.RevisionSignatureInfo{}, "", errors.New("the thing have exploded"))
+				gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]git.RevisionSignatureInfo{}, "", errors.New("the thing have exploded"))
 				gitClient.EXPECT().Root().Return(root)
 				paths.EXPECT().GetPath(mock.Anything).Return(".", nil)
 				paths.EXPECT().GetPathIfExists(mock.Anything).Return(".")
@@ -6124,6 +6392,75 @@ func TestGenerateManifest_OCISourceSkipsGitClient(t *testing.T) {
 	assert.False(t, gitCalled, "GenerateManifest should not invoke Git for OCI sources")
 }
 
+func TestGenerateManifest_OCISourceIntegrity(t *testing.T) {
+	const digest = "sha256:6fd9a5f2a4b1fbb8cd7b4e0f5d39b94c8c9ca9bd79e0ef6bf4e1b5ac0a4e5a4d"
+	svc, _, _ := newServiceWithOpt(t, func(_ *gitmocks.Client, _ *helmmocks.Client, ociClient *ocimocks.Client, _ *iomocks.TempPaths) {
+		ociClient.EXPECT().ResolveRevision(mock.Anything, "v1", mock.Anything).Return(digest, nil)
+		ociClient.EXPECT().CosignSignatures(mock.Anything, digest).Return([]oci.CosignSignature{}, nil)
+		ociClient.EXPECT().Extract(mock.Anything, digest).Return("./testdata/concatenated", utilio.NopCloser, nil)
+	}, t.TempDir())
+
+	req := &apiclient.ManifestRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "oci://example.com/foo",
+		},
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			TargetRevision: "v1",
+			RepoURL:        "oci://example.com/foo",
+		},
+		SourceIntegrity: &v1alpha1.SourceIntegrity{OCI: &v1alpha1.SourceIntegrityOCI{Policies: []*v1alpha1.SourceIntegrityOCIPolicy{{
+			Repos:  []v1alpha1.SourceIntegrityOCIPolicyRepo{{URL: "oci://example.com/*"}},
+			Cosign: &v1alpha1.SourceIntegrityOCIPolicyCosign{},
+		}}}},
+		ProjectName:        "foo-project",
+		ProjectSourceRepos: []string{"*"},
+	}
+
+	res, err := svc.GenerateManifest(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, &v1alpha1.SourceIntegrityCheckResult{Checks: []v1alpha1.SourceIntegrityCheckResultItem{{
+		Name:     "OCI/COSIGN",
+		Problems: []string{"Failed verifying digest " + digest + ": no cosign signature found"},
+	}}}, res.SourceIntegrityResult)
+}
+
+func TestGenerateManifest_HelmOCISourceIntegrityExtractsVerifiedDigest(t *testing.T) {
+	const digest = "sha256:6fd9a5f2a4b1fbb8cd7b4e0f5d39b94c8c9ca9bd79e0ef6bf4e1b5ac0a4e5a4d"
+	svc, _, _ := newServiceWithOpt(t, func(_ *gitmocks.Client, helmClient *helmmocks.Client, ociClient *ocimocks.Client, paths *iomocks.TempPaths) {
+		helmClient.EXPECT().CleanChartCache("my-chart", "1.1.0").Return(nil).Maybe()
+		paths.EXPECT().GetPaths().Return(map[string]string{"fake-nonce": "."})
+		ociClient.EXPECT().ResolveRevision(mock.Anything, "1.1.0", mock.Anything).Return(digest, nil)
+		ociClient.EXPECT().CosignSignatures(mock.Anything, digest).Return([]oci.CosignSignature{}, nil)
+		// The chart must be extracted from the verified digest, never pulled again by its version
+		ociClient.EXPECT().Extract(mock.Anything, digest).Return("./testdata/my-chart", utilio.NopCloser, nil)
+	}, t.TempDir())
+
+	req := &apiclient.ManifestRequest{
+		Repo: &v1alpha1.Repository{
+			Repo:      "example.com/charts",
+			EnableOCI: true,
+		},
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			RepoURL:        "example.com/charts",
+			Chart:          "my-chart",
+			TargetRevision: "1.1.0",
+		},
+		SourceIntegrity: &v1alpha1.SourceIntegrity{OCI: &v1alpha1.SourceIntegrityOCI{Policies: []*v1alpha1.SourceIntegrityOCIPolicy{{
+			Repos:  []v1alpha1.SourceIntegrityOCIPolicyRepo{{URL: "example.com/*"}},
+			Cosign: &v1alpha1.SourceIntegrityOCIPolicyCosign{},
+		}}}},
+		ProjectName:        "foo-project",
+		ProjectSourceRepos: []string{"*"},
+	}
+
+	res, err := svc.GenerateManifest(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, &v1alpha1.SourceIntegrityCheckResult{Checks: []v1alpha1.SourceIntegrityCheckResultItem{{
+		Name:     "OCI/COSIGN",
+		Problems: []string{"Failed verifying digest " + digest + ": no cosign signature found"},
+	}}}, res.SourceIntegrityResult)
+}
+
 func TestGetHelmRepos_InsecureOCIForceHttpPropagatedFromRepo(t *testing.T) {
 	q := apiclient.ManifestRequest{
 		Repos: []*v1alpha1.Repository{{
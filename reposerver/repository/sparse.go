@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// sparseCheckoutPaths returns the directories of the repository which are needed to generate the manifests of the
+// source: its path, the paths of its manifest-generate-paths annotation and the directories of the local files its
+// Helm and Kustomize options refer to. It returns nil if the whole repository must be checked out, i.e. if one of
+// these paths is the root of the repository or is outside of it, or if the source may refer to other files which are
+// unknown before the checkout.
+func sparseCheckoutPaths(source *v1alpha1.ApplicationSource, manifestGeneratePaths string, hasMultipleSources bool) []string {
+	// referenced sources may share the checkout of the repository, and plugins may read any file of the repository
+	if hasMultipleSources || source.Plugin != nil {
+		return nil
+	}
+	appPath, ok := sparseCheckoutPath("", source.Path)
+	if !ok || appPath == "" {
+		return nil
+	}
+	paths := []string{appPath}
+	addDir := func(dir string) bool {
+		path, ok := sparseCheckoutPath(appPath, dir)
+		if !ok || path == "" {
+			return false
+		}
+		paths = append(paths, path)
+		return true
+	}
+	// the files at the root of the repository are always checked out
+	addFile := func(file string) bool {
+		path, ok := sparseCheckoutPath(appPath, filepath.Dir(file))
+		if ok && path != "" {
+			paths = append(paths, path)
+		}
+		return ok
+	}
+
+	for annotationPath := range strings.SplitSeq(manifestGeneratePaths, ";") {
+		annotationPath = strings.TrimSpace(annotationPath)
+		if annotationPath == "" {
+			continue
+		}
+		// the annotation may list files, which are told apart from directories by their extension
+		if base := filepath.Base(annotationPath); base != "." && base != ".." && filepath.Ext(base) != "" {
+			if !addFile(annotationPath) {
+				return nil
+			}
+		} else if !addDir(annotationPath) {
+			return nil
+		}
+	}
+	if source.Helm != nil {
+		for _, valueFile := range source.Helm.ValueFiles {
+			if strings.Contains(valueFile, "://") {
+				continue
+			}
+			if strings.HasPrefix(valueFile, "$") || !addFile(valueFile) {
+				return nil
+			}
+		}
+		for _, fileParameter := range source.Helm.FileParameters {
+			if strings.Contains(fileParameter.Path, "://") {
+				continue
+			}
+			if !addFile(fileParameter.Path) {
+				return nil
+			}
+		}
+		if postRenderer := source.Helm.PostRenderer; postRenderer != nil && postRenderer.Kustomize != nil && !addDir(postRenderer.Kustomize.Path) {
+			return nil
+		}
+	}
+	if source.Kustomize != nil {
+		for _, component := range source.Kustomize.Components {
+			if strings.Contains(component, "://") {
+				continue
+			}
+			if !addDir(component) {
+				return nil
+			}
+		}
+	}
+	return paths
+}
+
+// sparseCheckoutPath returns the path of the directory relative to the root of the repository, which is empty for the
+// root itself, or false if the directory is outside of the repository. Paths starting with a slash are relative to the
+// root of the repository, other paths to the base directory. Globs are replaced by the directory which contains all
+// their matches.
+func sparseCheckoutPath(base string, path string) (string, bool) {
+	if strings.HasPrefix(path, "/") {
+		path = filepath.Clean(strings.TrimLeft(path, "/"))
+	} else {
+		path = filepath.Join(base, path)
+	}
+	parts := strings.Split(filepath.ToSlash(path), "/")
+	for i, part := range parts {
+		if strings.ContainsAny(part, "*?[{") {
+			parts = parts[:i]
+			break
+		}
+	}
+	path = strings.Join(parts, "/")
+	if path == "." {
+		path = ""
+	}
+	if path == ".." || strings.HasPrefix(path, "../") {
+		return "", false
+	}
+	return path, true
+}
+
+// sparseCheckouts merges the directories of the sparse checkouts of each repository. Directories are only ever added,
+// so that the operations on a repository eventually share the same checkout, rather than alternately checking out
+// different directories.
+type sparseCheckouts struct {
+	lock  sync.Mutex
+	repos map[string]*sparseCheckout
+	// fullCheckouts holds the sources whose manifests could only be generated from the whole repository
+	fullCheckouts map[string]bool
+}
+
+type sparseCheckout struct {
+	paths map[string]bool
+	// generation changes whenever directories are added to the sparse checkout
+	generation int
+}
+
+func newSparseCheckouts() *sparseCheckouts {
+	return &sparseCheckouts{repos: map[string]*sparseCheckout{}, fullCheckouts: map[string]bool{}}
+}
+
+// requireFullCheckout records that the manifests of the source at the given path of the repository refer to files
+// outside of its sparse checkout, such as the bases of a Kustomize overlay or the local dependencies of a Helm chart in
+// other directories, so that they are generated from the whole repository from then on
+func (c *sparseCheckouts) requireFullCheckout(repoURL string, path string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.fullCheckouts[repoURL+"|"+path] = true
+}
+
+// isFullCheckoutRequired returns whether the manifests of the source at the given path of the repository must be
+// generated from the whole repository
+func (c *sparseCheckouts) isFullCheckoutRequired(repoURL string, path string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.fullCheckouts[repoURL+"|"+path]
+}
+
+// merge adds the directories to the sparse checkout of the repository, and returns all the directories of the sparse
+// checkout along with its generation
+func (c *sparseCheckouts) merge(repoURL string, paths []string) ([]string, int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	checkout, ok := c.repos[repoURL]
+	if !ok {
+		checkout = &sparseCheckout{paths: map[string]bool{}}
+		c.repos[repoURL] = checkout
+	}
+	added := false
+	for _, path := range paths {
+		if !checkout.paths[path] {
+			checkout.paths[path] = true
+			added = true
+		}
+	}
+	if added {
+		checkout.generation++
+	}
+	merged := make([]string, 0, len(checkout.paths))
+	for path := range checkout.paths {
+		merged = append(merged, path)
+	}
+	sort.Strings(merged)
+	return merged, checkout.generation
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestSparseCheckoutPaths(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		source             v1alpha1.ApplicationSource
+		annotation         string
+		hasMultipleSources bool
+		expected           []string
+	}{
+		{"app path", v1alpha1.ApplicationSource{Path: "services/helloworld"}, "", false, []string{"services/helloworld"}},
+		{"root path", v1alpha1.ApplicationSource{Path: "."}, "", false, nil},
+		{"empty path", v1alpha1.ApplicationSource{}, "", false, nil},
+		{"path outside of repository", v1alpha1.ApplicationSource{Path: "../helloworld"}, "", false, nil},
+		{"multiple sources", v1alpha1.ApplicationSource{Path: "services/helloworld"}, "", true, nil},
+		{"plugin", v1alpha1.ApplicationSource{Path: "services/helloworld", Plugin: &v1alpha1.ApplicationSourcePlugin{}}, "", false, nil},
+		{"annotation", v1alpha1.ApplicationSource{Path: "services/helloworld"}, ".;../../base; /shared/*-secret.yaml;/config/values.yaml", false, []string{"services/helloworld", "services/helloworld", "base", "shared", "config"}},
+		{"annotation with root", v1alpha1.ApplicationSource{Path: "services/helloworld"}, "../..", false, nil},
+		{"annotation with root file", v1alpha1.ApplicationSource{Path: "services/helloworld"}, "/values.yaml", false, []string{"services/helloworld"}},
+		{"annotation outside of repository", v1alpha1.ApplicationSource{Path: "services/helloworld"}, "../../..", false, nil},
+		{"helm value files", v1alpha1.ApplicationSource{Path: "charts/app", Helm: &v1alpha1.ApplicationSourceHelm{
+			ValueFiles:     []string{"values.yaml", "../../envs/prod/values.yaml", "https://example.com/values.yaml"},
+			FileParameters: []v1alpha1.HelmFileParameter{{Name: "config", Path: "/config/app.json"}},
+		}}, "", false, []string{"charts/app", "charts/app", "envs/prod", "config"}},
+		{"helm value files outside of repository", v1alpha1.ApplicationSource{Path: "charts/app", Helm: &v1alpha1.ApplicationSourceHelm{
+			ValueFiles: []string{"../../../values.yaml"},
+		}}, "", false, nil},
+		{"helm referenced value files", v1alpha1.ApplicationSource{Path: "charts/app", Helm: &v1alpha1.ApplicationSourceHelm{
+			ValueFiles: []string{"$values/values.yaml"},
+		}}, "", false, nil},
+		{"kustomize components", v1alpha1.ApplicationSource{Path: "overlays/prod", Kustomize: &v1alpha1.ApplicationSourceKustomize{
+			Components: []string{"../../components/monitoring"},
+		}}, "", false, []string{"overlays/prod", "components/monitoring"}},
+		{"helm kustomize post-renderer", v1alpha1.ApplicationSource{Path: "charts/app", Helm: &v1alpha1.ApplicationSourceHelm{
+			PostRenderer: &v1alpha1.HelmPostRenderer{Kustomize: &v1alpha1.HelmPostRendererKustomize{Path: "../../post-render"}},
+		}}, "", false, []string{"charts/app", "post-render"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, sparseCheckoutPaths(&tt.source, tt.annotation, tt.hasMultipleSources))
+		})
+	}
+}
+
+func TestSparseCheckouts_Merge(t *testing.T) {
+	t.Parallel()
+
+	checkouts := newSparseCheckouts()
+	paths, generation := checkouts.merge("https://github.com/argoproj/argocd-example-apps", []string{"guestbook"})
+	assert.Equal(t, []string{"guestbook"}, paths)
+	assert.Equal(t, 1, generation)
+
+	paths, generation = checkouts.merge("https://github.com/argoproj/argocd-example-apps", []string{"helm-guestbook", "guestbook"})
+	assert.Equal(t, []string{"guestbook", "helm-guestbook"}, paths)
+	assert.Equal(t, 2, generation)
+
+	// the generation does not change if the directories are already checked out
+	paths, generation = checkouts.merge("https://github.com/argoproj/argocd-example-apps", []string{"guestbook"})
+	assert.Equal(t, []string{"guestbook", "helm-guestbook"}, paths)
+	assert.Equal(t, 2, generation)
+
+	paths, generation = checkouts.merge("https://github.com/argoproj/other", []string{"apps"})
+	assert.Equal(t, []string{"apps"}, paths)
+	assert.Equal(t, 1, generation)
+}
+
+func TestSparseCheckouts_RequireFullCheckout(t *testing.T) {
+	t.Parallel()
+
+	checkouts := newSparseCheckouts()
+	assert.False(t, checkouts.isFullCheckoutRequired("https://github.com/argoproj/argocd-example-apps", "kustomize-guestbook"))
+
+	checkouts.requireFullCheckout("https://github.com/argoproj/argocd-example-apps", "kustomize-guestbook")
+	assert.True(t, checkouts.isFullCheckoutRequired("https://github.com/argoproj/argocd-example-apps", "kustomize-guestbook"))
+	assert.False(t, checkouts.isFullCheckoutRequired("https://github.com/argoproj/argocd-example-apps", "guestbook"))
+	assert.False(t, checkouts.isFullCheckoutRequired("https://github.com/argoproj/other", "kustomize-guestbook"))
+}
@@ -0,0 +1,6 @@
+#!/bin/sh
+# fakes `kustomize build <dir>` by checking that the component is referenced and renaming the rendered config map
+set -e
+component=$(tail -n 1 "$2/kustomization.yaml" | sed 's/^- //')
+test -f "$2/$component/kustomization.yaml"
+sed 's/name: my-map/name: post-rendered-my-map/' "$2/helm-output.yaml"
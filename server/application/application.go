@@ -960,6 +960,33 @@ func (s *Server) ListResourceEvents(ctx context.Context, q *application.Applicat
 	return serverevents.K8sEventListToAPIEventList(list), nil
 }
 
+// ListSyncRecords returns the sync records of an application, from the oldest to the most recent sync
+func (s *Server) ListSyncRecords(ctx context.Context, q *application.ApplicationSyncRecordsQuery) (*v1alpha1.ApplicationSyncRecordList, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	records, err := argo.ListSyncRecords(ctx, s.appclientset.ArgoprojV1alpha1().ApplicationSyncRecords(a.Namespace), a.Name)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1alpha1.ApplicationSyncRecordList{Items: make([]v1alpha1.ApplicationSyncRecord, 0, len(records))}
+	for _, record := range records {
+		startedAt := record.Spec.OperationState.StartedAt
+		if q.Since != nil && startedAt.Before(q.Since) {
+			continue
+		}
+		if q.Until != nil && q.Until.Before(&startedAt) {
+			continue
+		}
+		if q.GetInitiator() != "" && !record.IsInitiatedBy(q.GetInitiator()) {
+			continue
+		}
+		list.Items = append(list.Items, record)
+	}
+	return list, nil
+}
+
 // validateAndUpdateApp validates and updates the application. currentProject is the name of the project the app
 // currently is under. If not specified, we assume that the app is under the project specified in the app spec.
 func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *v1alpha1.Application, merge bool, validate bool, action string, currentProject string) (*v1alpha1.Application, error) {
@@ -1395,6 +1422,14 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 		return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(managedByURLConditions))
 	}
 
+	dependencyConditions, err := argo.ValidateDependencies(app, appNs, s.appLister)
+	if err != nil {
+		return fmt.Errorf("error validating dependencies: %w", err)
+	}
+	if len(dependencyConditions) > 0 {
+		return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(dependencyConditions))
+	}
+
 	app.Spec = *argo.NormalizeApplicationSpec(&app.Spec)
 	return nil
 }
@@ -2090,7 +2125,11 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 
 	s.inferResourcesStatusHealth(a)
 
-	canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(true, nil)
+	windows, err := s.settingsMgr.ResolveSyncWindowCalendars(proj.Spec.SyncWindows.Matches(a))
+	if err != nil {
+		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
+	}
+	canSync, err := windows.CanSync(true, nil)
 	if err != nil {
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
 	}
@@ -2884,7 +2923,10 @@ func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.A
 		return nil, err
 	}
 
-	windows := proj.Spec.SyncWindows.Matches(a)
+	windows, err := s.settingsMgr.ResolveSyncWindowCalendars(proj.Spec.SyncWindows.Matches(a))
+	if err != nil {
+		return nil, fmt.Errorf("invalid sync windows: %w", err)
+	}
 	sync, err := windows.CanSync(true, nil)
 	if err != nil {
 		return nil, fmt.Errorf("invalid sync windows: %w", err)
@@ -860,6 +860,17 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
 	})
 
+	t.Run("ListSyncRecords", func(t *testing.T) {
+		_, err := appServer.ListSyncRecords(adminCtx, &application.ApplicationSyncRecordsQuery{Name: new("test")})
+		require.NoError(t, err)
+		_, err = appServer.ListSyncRecords(noRoleCtx, &application.ApplicationSyncRecordsQuery{Name: new("test")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ListSyncRecords(adminCtx, &application.ApplicationSyncRecordsQuery{Name: new("doest-not-exist")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.ListSyncRecords(adminCtx, &application.ApplicationSyncRecordsQuery{Name: new("doest-not-exist"), Project: new("test")})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
+	})
+
 	t.Run("UpdateSpec", func(t *testing.T) {
 		_, err := appServer.UpdateSpec(adminCtx, &application.ApplicationUpdateSpecRequest{Name: new("test"), Spec: &v1alpha1.ApplicationSpec{
 			Destination: v1alpha1.ApplicationDestination{Namespace: "default", Server: "https://cluster-api.example.com"},
@@ -5238,3 +5249,59 @@ func TestGetUnstructuredLiveResourceOrAppWithImpersonation(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "system:serviceaccount:"+test.FakeDestNamespace+":test-sa", config.Impersonate.UserName)
 }
+
+func TestListSyncRecords(t *testing.T) {
+	testApp := newTestApp()
+	now := time.Now()
+	newRecord := func(name, appName string, startedAt time.Time, initiatedBy v1alpha1.OperationInitiator) *v1alpha1.ApplicationSyncRecord {
+		return &v1alpha1.ApplicationSyncRecord{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				Labels:    map[string]string{common.LabelKeySyncRecordApplication: appName},
+			},
+			Spec: v1alpha1.ApplicationSyncRecordSpec{
+				Application: appName,
+				OperationState: v1alpha1.OperationState{
+					Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}, InitiatedBy: initiatedBy},
+					Phase:     synccommon.OperationSucceeded,
+					StartedAt: metav1.NewTime(startedAt),
+				},
+			},
+		}
+	}
+	appServer := newTestAppServer(t, testApp,
+		newRecord("test-app-3", testApp.Name, now.Add(-time.Hour), v1alpha1.OperationInitiator{Username: "admin"}),
+		newRecord("test-app-1", testApp.Name, now.Add(-3*time.Hour), v1alpha1.OperationInitiator{Automated: true}),
+		newRecord("test-app-2", testApp.Name, now.Add(-2*time.Hour), v1alpha1.OperationInitiator{Username: "alice"}),
+		newRecord("other-app-1", "other-app", now.Add(-time.Hour), v1alpha1.OperationInitiator{Username: "admin"}),
+	)
+
+	listRecords := func(t *testing.T, q *application.ApplicationSyncRecordsQuery) []string {
+		t.Helper()
+		q.Name = new(testApp.Name)
+		list, err := appServer.ListSyncRecords(t.Context(), q)
+		require.NoError(t, err)
+		names := []string{}
+		for _, record := range list.Items {
+			names = append(names, record.Name)
+		}
+		return names
+	}
+
+	t.Run("All", func(t *testing.T) {
+		assert.Equal(t, []string{"test-app-1", "test-app-2", "test-app-3"}, listRecords(t, &application.ApplicationSyncRecordsQuery{}))
+	})
+	t.Run("TimeRange", func(t *testing.T) {
+		since := metav1.NewTime(now.Add(-150 * time.Minute))
+		until := metav1.NewTime(now.Add(-90 * time.Minute))
+		assert.Equal(t, []string{"test-app-2", "test-app-3"}, listRecords(t, &application.ApplicationSyncRecordsQuery{Since: &since}))
+		assert.Equal(t, []string{"test-app-1", "test-app-2"}, listRecords(t, &application.ApplicationSyncRecordsQuery{Until: &until}))
+		assert.Equal(t, []string{"test-app-2"}, listRecords(t, &application.ApplicationSyncRecordsQuery{Since: &since, Until: &until}))
+	})
+	t.Run("Initiator", func(t *testing.T) {
+		assert.Equal(t, []string{"test-app-3"}, listRecords(t, &application.ApplicationSyncRecordsQuery{Initiator: new("admin")}))
+		assert.Equal(t, []string{"test-app-1"}, listRecords(t, &application.ApplicationSyncRecordsQuery{Initiator: new(v1alpha1.SyncRecordInitiatorAutomated)}))
+		assert.Empty(t, listRecords(t, &application.ApplicationSyncRecordsQuery{Initiator: new("bob")}))
+	})
+}
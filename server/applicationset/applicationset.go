@@ -3,6 +3,7 @@ package applicationset
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -20,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -28,6 +30,7 @@ import (
 
 	appsettemplate "github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	"github.com/argoproj/argo-cd/v3/applicationset/progressivesync"
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	appsetstatus "github.com/argoproj/argo-cd/v3/applicationset/status"
 	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
@@ -444,6 +447,75 @@ func (s *Server) Delete(ctx context.Context, q *applicationset.ApplicationSetDel
 	return &applicationset.ApplicationSetResponse{}, nil
 }
 
+// PauseRollout pauses the RollingSync rollout of an ApplicationSet
+func (s *Server) PauseRollout(ctx context.Context, q *applicationset.ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error) {
+	appset, err := s.getRollingSyncAppSetEnforceRBAC(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return s.patchRolloutAnnotations(ctx, appset, map[string]any{argocommon.AnnotationApplicationSetRolloutPaused: "true"}, "paused ApplicationSet rollout")
+}
+
+// ResumeRollout resumes the paused RollingSync rollout of an ApplicationSet
+func (s *Server) ResumeRollout(ctx context.Context, q *applicationset.ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error) {
+	appset, err := s.getRollingSyncAppSetEnforceRBAC(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	return s.patchRolloutAnnotations(ctx, appset, map[string]any{argocommon.AnnotationApplicationSetRolloutPaused: nil}, "resumed ApplicationSet rollout")
+}
+
+// PromoteRollout promotes the RollingSync rollout of an ApplicationSet past a step, without waiting for its Applications
+func (s *Server) PromoteRollout(ctx context.Context, q *applicationset.ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error) {
+	appset, err := s.getRollingSyncAppSetEnforceRBAC(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	step := int(q.GetStep())
+	if step == 0 {
+		step = progressivesync.GetRolloutStep(appset)
+		if step == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "the rollout of ApplicationSet %s has completed, there is no step to promote", appset.QualifiedName())
+		}
+	}
+	if steps := len(appset.Spec.Strategy.RollingSync.Steps); step < 0 || step > steps {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid step %d, ApplicationSet %s has %d steps", step, appset.QualifiedName(), steps)
+	}
+	return s.patchRolloutAnnotations(ctx, appset, map[string]any{argocommon.AnnotationApplicationSetRolloutPromotedStep: strconv.Itoa(step)}, fmt.Sprintf("promoted ApplicationSet rollout past step %d", step))
+}
+
+// getRollingSyncAppSetEnforceRBAC gets the ApplicationSet of the rollout request, verifies that the user is allowed to
+// update it, and that it uses the RollingSync strategy
+func (s *Server) getRollingSyncAppSetEnforceRBAC(ctx context.Context, q *applicationset.ApplicationSetRolloutRequest) (*v1alpha1.ApplicationSet, error) {
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+	appset, err := s.getAppSetEnforceRBAC(ctx, rbac.ActionUpdate, namespace, q.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !progressivesync.RollingSyncStrategyEnabled(appset) {
+		return nil, status.Errorf(codes.FailedPrecondition, "ApplicationSet %s does not use the RollingSync strategy", appset.QualifiedName())
+	}
+	return appset, nil
+}
+
+func (s *Server) patchRolloutAnnotations(ctx context.Context, appset *v1alpha1.ApplicationSet, annotations map[string]any, action string) (*v1alpha1.ApplicationSet, error) {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling ApplicationSet rollout patch: %w", err)
+	}
+	res, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(appset.Namespace).Patch(ctx, appset.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error patching ApplicationSet: %w", err)
+	}
+	s.logAppSetEvent(ctx, res, argo.EventReasonResourceUpdated, action)
+	return res, nil
+}
+
 func (s *Server) ResourceTree(ctx context.Context, q *applicationset.ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error) {
 	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
 
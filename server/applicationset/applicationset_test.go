@@ -608,6 +608,88 @@ func TestUpdateAppSet(t *testing.T) {
 	})
 }
 
+func TestRolloutAppSet(t *testing.T) {
+	rollingSyncAppSet := func(annotations map[string]string) *appsv1.ApplicationSet {
+		return newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Name = "AppSet1"
+			appset.Annotations = annotations
+			appset.Spec.Strategy = &appsv1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &appsv1.ApplicationSetRolloutStrategy{
+					Steps: []appsv1.ApplicationSetRolloutStep{
+						{MatchExpressions: []appsv1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"dev"}}}},
+						{MatchExpressions: []appsv1.ApplicationMatchExpression{{Key: "env", Operator: "In", Values: []string{"prod"}}}},
+					},
+				},
+			}
+			appset.Status.ApplicationStatus = []appsv1.ApplicationSetApplicationStatus{
+				{Application: "app-dev", Status: appsv1.ProgressiveSyncHealthy, Step: "1"},
+				{Application: "app-prod", Status: appsv1.ProgressiveSyncWaiting, Step: "2"},
+			}
+		})
+	}
+
+	t.Run("Pause rollout", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, rollingSyncAppSet(map[string]string{"annotation-key": "annotation-value"}))
+
+		res, err := appSetServer.PauseRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			"annotation-key": "annotation-value",
+			common.AnnotationApplicationSetRolloutPaused: "true",
+		}, res.Annotations)
+	})
+
+	t.Run("Resume rollout", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, rollingSyncAppSet(map[string]string{common.AnnotationApplicationSetRolloutPaused: "true"}))
+
+		res, err := appSetServer.ResumeRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1", AppsetNamespace: testNamespace})
+		require.NoError(t, err)
+		assert.NotContains(t, res.Annotations, common.AnnotationApplicationSetRolloutPaused)
+	})
+
+	t.Run("Promote current step", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, rollingSyncAppSet(nil))
+
+		res, err := appSetServer.PromoteRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1"})
+		require.NoError(t, err)
+		assert.Equal(t, "2", res.Annotations[common.AnnotationApplicationSetRolloutPromotedStep])
+	})
+
+	t.Run("Promote given step", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, rollingSyncAppSet(nil))
+
+		res, err := appSetServer.PromoteRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1", Step: 1})
+		require.NoError(t, err)
+		assert.Equal(t, "1", res.Annotations[common.AnnotationApplicationSetRolloutPromotedStep])
+	})
+
+	t.Run("Promote invalid step", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, rollingSyncAppSet(nil))
+
+		_, err := appSetServer.PromoteRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1", Step: 3})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = invalid step 3, ApplicationSet default/AppSet1 has 2 steps")
+	})
+
+	t.Run("Promote completed rollout", func(t *testing.T) {
+		appSet := rollingSyncAppSet(nil)
+		appSet.Status.ApplicationStatus[1].Status = appsv1.ProgressiveSyncHealthy
+		appSetServer := newTestAppSetServer(t, appSet)
+
+		_, err := appSetServer.PromoteRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1"})
+		assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = the rollout of ApplicationSet default/AppSet1 has completed, there is no step to promote")
+	})
+
+	t.Run("Pause rollout without RollingSync strategy", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Name = "AppSet1"
+		}))
+
+		_, err := appSetServer.PauseRollout(t.Context(), &applicationset.ApplicationSetRolloutRequest{Name: "AppSet1"})
+		assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = ApplicationSet default/AppSet1 does not use the RollingSync strategy")
+	})
+}
+
 func TestUpsertAppSet(t *testing.T) {
 	name := "test"
 	ns := "external-namespace"
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	"github.com/argoproj/pkg/v2/sync"
@@ -33,14 +34,18 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
+	"github.com/argoproj/argo-cd/v3/util/manifestpolicy"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/session"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	"github.com/argoproj/argo-cd/v3/util/sourceintegrity"
 )
 
 const (
 	// JWTTokenSubFormat format of the JWT token subject that Argo CD vends out.
 	JWTTokenSubFormat = "proj:%s:%s"
+	// defaultUpcomingSyncWindowsDays is the number of days upcoming sync windows are listed for by default
+	defaultUpcomingSyncWindowsDays = 7
 )
 
 // Server provides a Project service
@@ -78,6 +83,14 @@ func validateProject(proj *v1alpha1.AppProject) error {
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "policy syntax error: %s", err.Error())
 	}
+	err = manifestpolicy.Validate(proj.Spec.ManifestPolicies)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	err = sourceintegrity.Validate(proj.Spec.SourceIntegrity)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 	return nil
 }
 
@@ -534,7 +547,11 @@ func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindows
 
 	res := &project.SyncWindowsResponse{}
 
-	windows, err := proj.Spec.SyncWindows.Active()
+	projWindows, err := s.settingsMgr.ResolveSyncWindowCalendars(&proj.Spec.SyncWindows)
+	if err != nil {
+		return nil, err
+	}
+	windows, err := projWindows.Active()
 	if err != nil {
 		return nil, err
 	}
@@ -547,6 +564,34 @@ func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindows
 	return res, nil
 }
 
+// ListUpcomingSyncWindows returns the periods in which the sync windows of the project are active in the coming days
+func (s *Server) ListUpcomingSyncWindows(ctx context.Context, q *project.UpcomingSyncWindowsQuery) (*project.UpcomingSyncWindowsResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, q.Name); err != nil {
+		return nil, err
+	}
+	if q.Days < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "days must not be negative: %d", q.Days)
+	}
+	days := q.Days
+	if days == 0 {
+		days = defaultUpcomingSyncWindowsDays
+	}
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	windows, err := s.settingsMgr.ResolveSyncWindowCalendars(&proj.Spec.SyncWindows)
+	if err != nil {
+		return nil, err
+	}
+	occurrences, err := windows.Upcoming(time.Now(), time.Duration(days)*24*time.Hour)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sync windows: %v", err)
+	}
+	return &project.UpcomingSyncWindowsResponse{Occurrences: occurrences}, nil
+}
+
 func (s *Server) NormalizeProjs() error {
 	projList, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(context.Background(), metav1.ListOptions{})
 	if err != nil {
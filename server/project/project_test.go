@@ -167,6 +167,41 @@ func TestProjectServer(t *testing.T) {
 		assert.Equal(t, status.Error(codes.PermissionDenied, "permission denied: repositories, update, https://github.com/argoproj/argo-cd.git"), err)
 	})
 
+	t.Run("TestManifestPoliciesUpdateInvalid", func(t *testing.T) {
+		enforcer.SetDefaultRole("role:projects")
+		_ = enforcer.SetBuiltinPolicy("p, role:projects, projects, update, *, allow")
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		updatedProj := existingProj.DeepCopy()
+		updatedProj.Spec.ManifestPolicies = []v1alpha1.ManifestPolicy{{Name: "invalid", CEL: `object.kind ==`}}
+
+		_, err := projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: updatedProj})
+
+		statusCode, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, statusCode.Code())
+		assert.Contains(t, statusCode.Message(), "invalid manifest policy 'invalid'")
+	})
+
+	t.Run("TestSourceIntegrityUpdateInvalid", func(t *testing.T) {
+		enforcer.SetDefaultRole("role:projects")
+		_ = enforcer.SetBuiltinPolicy("p, role:projects, projects, update, *, allow")
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(&existingProj, &existingApp), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		updatedProj := existingProj.DeepCopy()
+		updatedProj.Spec.SourceIntegrity = &v1alpha1.SourceIntegrity{Git: &v1alpha1.SourceIntegrityGit{Policies: []*v1alpha1.SourceIntegrityGitPolicy{{
+			Repos: []v1alpha1.SourceIntegrityGitPolicyRepo{{URL: "*"}},
+			SSH:   &v1alpha1.SourceIntegrityGitPolicySSH{Mode: v1alpha1.SourceIntegrityGitPolicyGPGModeHead, Keys: []string{"not a key"}},
+		}}}}
+
+		_, err := projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: updatedProj})
+
+		statusCode, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, statusCode.Code())
+		assert.Contains(t, statusCode.Message(), "invalid ssh key 0 of git source integrity policy 0")
+	})
+
 	t.Run("TestClusterResourceWhitelistUpdateDenied", func(t *testing.T) {
 		enforcer.SetDefaultRole("role:projects")
 		_ = enforcer.SetBuiltinPolicy("p, role:projects, projects, update, *, allow")
@@ -714,6 +749,36 @@ p, role:admin, projects, update, *, allow`)
 		assert.Len(t, res.Windows, 1)
 	})
 
+	t.Run("TestListUpcomingSyncWindows", func(t *testing.T) {
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		projectWithSyncWindows := existingProj.DeepCopy()
+		projectWithSyncWindows.Spec.SyncWindows = v1alpha1.SyncWindows{
+			{Kind: "allow", Schedule: "0 10 * * *", Duration: "1h"},
+			{Kind: "deny", Dates: []v1alpha1.SyncWindowDateRange{{Start: "2000-01-01"}}},
+		}
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		res, err := projectServer.ListUpcomingSyncWindows(ctx, &project.UpcomingSyncWindowsQuery{Name: projectWithSyncWindows.Name, Days: 2})
+		require.NoError(t, err)
+		require.NotEmpty(t, res.Occurrences)
+		for _, occurrence := range res.Occurrences {
+			assert.Equal(t, int64(0), occurrence.ID)
+		}
+
+		_, err = projectServer.ListUpcomingSyncWindows(ctx, &project.UpcomingSyncWindowsQuery{Name: projectWithSyncWindows.Name, Days: -1})
+		require.EqualError(t, err, "rpc error: code = InvalidArgument desc = days must not be negative: -1")
+	})
+
+	t.Run("TestListUpcomingSyncWindowsUnknownCalendar", func(t *testing.T) {
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		projectWithSyncWindows := existingProj.DeepCopy()
+		projectWithSyncWindows.Spec.SyncWindows = v1alpha1.SyncWindows{{Kind: "deny", Calendar: "holidays"}}
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		_, err := projectServer.ListUpcomingSyncWindows(ctx, &project.UpcomingSyncWindowsQuery{Name: projectWithSyncWindows.Name})
+		require.EqualError(t, err, "sync window calendar 'holidays' not found")
+	})
+
 	t.Run("TestGetSyncWindowsStateCannotGetProjectDetails", func(t *testing.T) {
 		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
 		projectWithSyncWindows := existingProj.DeepCopy()
@@ -172,7 +172,7 @@ export const ApplicationCreatePanel = (props: {
             delete data.spec.destination.server;
         }
 
-        if (data.spec.sourceHydrator && !data.spec.sourceHydrator.hydrateTo?.targetBranch) {
+        if (data.spec.sourceHydrator && !data.spec.sourceHydrator.hydrateTo?.targetBranch && !data.spec.sourceHydrator.hydrateTo?.oci) {
             delete data.spec.sourceHydrator.hydrateTo;
         }
 
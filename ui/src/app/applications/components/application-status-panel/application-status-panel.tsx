@@ -259,6 +259,19 @@ export const ApplicationStatusPanel = ({application, showDiff, showOperation, sh
                     {application.status.sourceHydrator.currentOperation.message && (
                         <div className='application-status-panel__item-name'>{application.status.sourceHydrator.currentOperation.message}</div>
                     )}
+                    {application.status.sourceHydrator.pullRequest && (
+                        <div className='application-status-panel__item-name'>
+                            Pull request{' '}
+                            {application.status.sourceHydrator.pullRequest.url ? (
+                                <a href={application.status.sourceHydrator.pullRequest.url} target='_blank' rel='noopener noreferrer'>
+                                    #{application.status.sourceHydrator.pullRequest.number}
+                                </a>
+                            ) : (
+                                `#${application.status.sourceHydrator.pullRequest.number}`
+                            )}{' '}
+                            {application.status.sourceHydrator.pullRequest.state.toLowerCase()}
+                        </div>
+                    )}
                     <div className='application-status-panel__item-name'>
                         {application.status.sourceHydrator.currentOperation.drySHA && (
                             <RevisionMetadataPanel
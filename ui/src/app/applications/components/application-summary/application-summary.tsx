@@ -62,6 +62,7 @@ function processPath(path: string) {
 
 export interface ApplicationSummaryProps {
     app: models.Application;
+    tree?: models.ApplicationTree;
     updateApp: (app: models.Application, query: {validate?: boolean}) => Promise<any>;
 }
 
@@ -81,7 +82,7 @@ export const ApplicationSummary = (props: ApplicationSummaryProps) => {
 
     const updateAppSource = async (input: models.Application, query: {validate?: boolean}) => {
         const hydrateTo = input.spec.sourceHydrator?.hydrateTo;
-        if (hydrateTo && !hydrateTo.targetBranch?.trim() && input.spec.sourceHydrator) {
+        if (hydrateTo && !hydrateTo.targetBranch?.trim() && !hydrateTo.oci && input.spec.sourceHydrator) {
             delete input.spec.sourceHydrator.hydrateTo;
         }
         return updateApp(input, query);
@@ -275,6 +276,25 @@ export const ApplicationSummary = (props: ApplicationSummaryProps) => {
         }
     ];
 
+    if ((app.spec.dependsOn || []).length > 0) {
+        attributes.push({
+            title: 'DEPENDS ON',
+            view: (
+                <div>
+                    {((props.tree && props.tree.dependencies) || []).map(dependency => (
+                        <div key={`${dependency.namespace}/${dependency.name}`}>
+                            <Link to={`/applications/${dependency.namespace}/${dependency.name}`}>
+                                {dependency.namespace}/{dependency.name}
+                            </Link>{' '}
+                            <ComparisonStatusIcon status={dependency.syncStatus} /> {dependency.syncStatus} <HealthStatusIcon state={{status: dependency.health, message: ''}} />{' '}
+                            {dependency.health}
+                        </div>
+                    ))}
+                </div>
+            )
+        });
+    }
+
     const urls = app.status.summary.externalURLs || [];
     if (urls.length > 0) {
         attributes.push({
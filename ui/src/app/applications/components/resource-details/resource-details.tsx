@@ -188,7 +188,7 @@ export const ResourceDetails = (props: ResourceDetailsProps) => {
             {
                 title: 'SUMMARY',
                 key: 'summary',
-                content: <ApplicationSummary app={application} updateApp={(app, query: {validate?: boolean}) => updateApp(app, query)} />
+                content: <ApplicationSummary app={application} tree={tree} updateApp={(app, query: {validate?: boolean}) => updateApp(app, query)} />
             },
             {
                 title: application.spec.sources === undefined ? 'PARAMETERS' : 'SOURCES',
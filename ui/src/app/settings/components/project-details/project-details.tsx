@@ -285,6 +285,10 @@ export const ProjectDetails: React.FC<RouteComponentProps<{name: string}> & {obj
                                             </div>
                                             <div className='columns small-8-elements'>
                                                 {window.kind}:{window.schedule}:{window.duration}:{window.timeZone}
+                                                {(window.dates || []).map(date => (
+                                                    <div key={date.start}>{date.end ? `${date.start}/${date.end}` : date.start}</div>
+                                                ))}
+                                                {window.calendar && <div>calendar:{window.calendar}</div>}
                                             </div>
                                             <div className='columns small-8-elements'>{(window.applications || ['-']).join(',')}</div>
                                             <div className='columns small-8-elements'>{(window.namespaces || ['-']).join(',')}</div>
@@ -218,6 +218,14 @@ export interface ApplicationDestinationServiceAccount {
     server: string;
     namespace: string;
     defaultServiceAccount: string;
+    resourceServiceAccounts?: ResourceServiceAccount[];
+}
+
+export interface ResourceServiceAccount {
+    group: string;
+    kind: string;
+    namespace?: string;
+    serviceAccount: string;
 }
 
 export interface OrphanedResource {
@@ -259,6 +267,7 @@ export interface SourceHydrator {
     drySource: DrySource;
     syncSource: SyncSource;
     hydrateTo?: HydrateTo;
+    pullRequest?: HydratorPullRequest;
 }
 
 export interface DrySource {
@@ -278,7 +287,20 @@ export interface SyncSource {
 }
 
 export interface HydrateTo {
-    targetBranch: string;
+    targetBranch?: string;
+    oci?: HydrateToOCI;
+}
+
+export interface HydrateToOCI {
+    repoURL: string;
+    tag?: string;
+}
+
+export interface HydratorPullRequest {
+    provider: string;
+    api?: string;
+    repository?: string;
+    branch?: string;
 }
 
 export interface ApplicationSourceHelm {
@@ -359,6 +381,12 @@ export interface ApplicationSpec {
     ignoreDifferences?: ResourceIgnoreDifferences[];
     info?: Info[];
     revisionHistoryLimit?: number;
+    dependsOn?: ApplicationDependency[];
+}
+
+export interface ApplicationDependency {
+    name: string;
+    namespace?: string;
 }
 
 export interface ResourceIgnoreDifferences {
@@ -368,6 +396,7 @@ export interface ResourceIgnoreDifferences {
     namespace: string;
     jsonPointers: string[];
     jqPathExpressions: string[];
+    celExpressions?: string[];
 }
 
 /**
@@ -488,6 +517,15 @@ export interface AbstractApplicationTree {
 export interface ApplicationTree extends AbstractApplicationTree {
     orphanedNodes: ResourceNode[];
     hosts: Node[];
+    dependencies?: ApplicationDependencyNode[];
+}
+
+export interface ApplicationDependencyNode {
+    name: string;
+    namespace: string;
+    dependsOn?: ApplicationDependency[];
+    syncStatus: SyncStatusCode;
+    health: HealthStatusCode;
 }
 
 export interface ApplicationSetTree extends AbstractApplicationTree {
@@ -539,11 +577,33 @@ export interface ApplicationStatus {
     operationState?: OperationState;
     summary?: ApplicationSummary;
     sourceHydrator?: SourceHydratorStatus;
+    lastResourceDrift?: ResourceDrift;
+}
+
+export interface ResourceDrift {
+    group?: string;
+    kind: string;
+    namespace?: string;
+    name: string;
+    manager: string;
+    operation?: string;
+    diff?: string;
+    detectedAt: models.Time;
 }
 
 export interface SourceHydratorStatus {
     lastSuccessfulOperation?: SuccessfulHydrateOperation;
     currentOperation?: HydrateOperation;
+    pullRequest?: HydratorPullRequestStatus;
+}
+
+export interface HydratorPullRequestStatus {
+    url?: string;
+    number: number;
+    branch: string;
+    targetBranch: string;
+    state: 'Opened' | 'Updated';
+    drySHA?: string;
 }
 
 export interface HydrateOperation {
@@ -891,6 +951,15 @@ export interface ProjectSpec {
     signatureKeys: ProjectSignatureKey[];
     orphanedResources?: {warn?: boolean; ignore: OrphanedResource[]};
     syncWindows?: SyncWindows;
+    manifestPolicies?: ManifestPolicy[];
+}
+
+export interface ManifestPolicy {
+    name: string;
+    kinds?: GroupKind[];
+    cel: string;
+    message?: string;
+    enforce?: boolean;
 }
 
 export type SyncWindows = SyncWindow[];
@@ -907,6 +976,13 @@ export interface SyncWindow {
     andOperator: boolean;
     description: string;
     syncOverrun: boolean;
+    dates?: SyncWindowDateRange[];
+    calendar?: string;
+}
+
+export interface SyncWindowDateRange {
+    start: string;
+    end?: string;
 }
 
 export interface Project {
@@ -1186,7 +1262,7 @@ export interface ApplicationSet extends AbstractApplication {
         conditions?: ApplicationSetCondition[];
         applicationStatus?: Array<{
             application: string;
-            status: 'Waiting' | 'Pending' | 'Progressing' | 'Healthy';
+            status: 'Waiting' | 'Pending' | 'Progressing' | 'Healthy' | 'RollingBack' | 'RolledBack';
             message?: string;
             lastTransitionTime?: string;
             step?: string;
@@ -437,6 +437,38 @@ func ValidateManagedByURL(app *argoappv1.Application) []argoappv1.ApplicationCon
 	return conditions
 }
 
+// ValidateDependencies validates that the applications which the application depends on exist in the same project as
+// the application, so that the dependencies do not disclose the status of applications of other projects. The
+// dependencies with no namespace default to the given application namespace.
+func ValidateDependencies(app *argoappv1.Application, appNamespace string, appLister applicationsv1.ApplicationLister) ([]argoappv1.ApplicationCondition, error) {
+	conditions := []argoappv1.ApplicationCondition{}
+	for _, dependency := range app.Spec.DependsOn {
+		if dependency.Namespace == "" {
+			dependency.Namespace = appNamespace
+		}
+		if dependency.Name == "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "dependency name is required",
+			})
+			continue
+		}
+		depApp, err := appLister.Applications(dependency.Namespace).Get(dependency.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting application %s: %w", dependency.QualifiedName(), err)
+		}
+		// Applications which do not exist and applications of other projects are reported the same way, so that the
+		// existence of the applications of other projects is not disclosed either
+		if depApp == nil || err != nil || depApp.Spec.GetProject() != app.Spec.GetProject() {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("dependency %s is not an application of project %s", dependency.QualifiedName(), app.Spec.GetProject()),
+			})
+		}
+	}
+	return conditions, nil
+}
+
 func validateRepo(ctx context.Context,
 	app *argoappv1.Application,
 	db db.ArgoDB,
@@ -640,12 +672,69 @@ func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.Appl
 			Message: "spec.sourceHydrator.syncSource.targetBranch is required",
 		})
 	}
-	if hydrator.HydrateTo != nil && hydrator.HydrateTo.TargetBranch == "" {
+	if hydrator.HydrateTo != nil && hydrator.HydrateTo.TargetBranch == "" && hydrator.HydrateTo.OCI == nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: "when spec.sourceHydrator.hydrateTo is set, spec.sourceHydrator.hydrateTo.targetBranch is required",
+			Message: "when spec.sourceHydrator.hydrateTo is set, spec.sourceHydrator.hydrateTo.targetBranch is required unless spec.sourceHydrator.hydrateTo.oci is set",
 		})
 	}
+	if hydrator.HydrateTo.IsOCI() {
+		if hydrator.HydrateTo.TargetBranch != "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.sourceHydrator.hydrateTo.targetBranch and spec.sourceHydrator.hydrateTo.oci are mutually exclusive",
+			})
+		}
+		if !strings.HasPrefix(hydrator.HydrateTo.OCI.RepoURL, "oci://") {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.sourceHydrator.hydrateTo.oci.repoURL must be an oci:// repository URL",
+			})
+		}
+	}
+	if hydrator.PullRequest != nil {
+		if hydrator.HydrateTo.IsOCI() {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.sourceHydrator.pullRequest and spec.sourceHydrator.hydrateTo.oci are mutually exclusive",
+			})
+		}
+		switch hydrator.PullRequest.Provider {
+		case argoappv1.HydratorPullRequestProviderGitea, argoappv1.HydratorPullRequestProviderBitbucketServer:
+			if hydrator.PullRequest.API == "" {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("spec.sourceHydrator.pullRequest.api is required for provider %s", hydrator.PullRequest.Provider),
+				})
+				break
+			}
+			fallthrough
+		case argoappv1.HydratorPullRequestProviderGitHub, argoappv1.HydratorPullRequestProviderGitLab,
+			argoappv1.HydratorPullRequestProviderBitbucketCloud, argoappv1.HydratorPullRequestProviderAzureDevOps:
+			// The pull requests are opened with the write credentials of the repository
+			if err := hydrator.PullRequest.ValidateAPI(hydrator.GetSyncSource().RepoURL); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("spec.sourceHydrator.pullRequest.api is not permitted: %v", err),
+				})
+			}
+		default:
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.sourceHydrator.pullRequest.provider %q is not supported", hydrator.PullRequest.Provider),
+			})
+		}
+		targetBranch := hydrator.SyncSource.TargetBranch
+		if hydrator.HydrateTo != nil && hydrator.HydrateTo.TargetBranch != "" {
+			targetBranch = hydrator.HydrateTo.TargetBranch
+		}
+		if err := hydrator.PullRequest.ValidateBranch(targetBranch, hydrator.SyncSource.TargetBranch, hydrator.DrySource.TargetRevision); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.sourceHydrator.pullRequest.branch is invalid: %v", err),
+			})
+		}
+	}
 	return conditions
 }
 
@@ -1198,6 +1198,114 @@ func TestValidatePermissions_SourceHydratorSyncSourceRepo(t *testing.T) {
 		require.Len(t, conditions, 1)
 		assert.Contains(t, conditions[0].Message, "spec.sourceHydrator.hydrateTo.targetBranch is required")
 	})
+
+	t.Run("HydrateTo OCI requires an OCI repoURL", func(t *testing.T) {
+		t.Parallel()
+		spec := argoappv1.ApplicationSpec{
+			SourceHydrator: &argoappv1.SourceHydrator{
+				DrySource: argoappv1.DrySource{
+					RepoURL:        "https://example.com/dry-repo",
+					TargetRevision: "main",
+					Path:           "dry",
+				},
+				SyncSource: argoappv1.SyncSource{
+					TargetBranch: "main",
+					Path:         "hydrated",
+				},
+				HydrateTo: &argoappv1.HydrateTo{
+					TargetBranch: "staging",
+					OCI:          &argoappv1.HydrateToOCI{RepoURL: "https://example.com/hydrated"},
+				},
+			},
+		}
+		proj := argoappv1.AppProject{}
+		db := &dbmocks.ArgoDB{}
+
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 2)
+		assert.Contains(t, conditions[0].Message, "spec.sourceHydrator.hydrateTo.targetBranch and spec.sourceHydrator.hydrateTo.oci are mutually exclusive")
+		assert.Contains(t, conditions[1].Message, "spec.sourceHydrator.hydrateTo.oci.repoURL must be an oci:// repository URL")
+	})
+
+	t.Run("PullRequest requires a supported provider, an API hosted by the repository host and a separate branch", func(t *testing.T) {
+		t.Parallel()
+		for _, pr := range []struct {
+			pullRequest *argoappv1.HydratorPullRequest
+			message     string
+		}{
+			{pullRequest: &argoappv1.HydratorPullRequest{Provider: "unknown"}, message: `spec.sourceHydrator.pullRequest.provider "unknown" is not supported`},
+			{pullRequest: &argoappv1.HydratorPullRequest{Provider: argoappv1.HydratorPullRequestProviderGitea}, message: "spec.sourceHydrator.pullRequest.api is required for provider gitea"},
+			{pullRequest: &argoappv1.HydratorPullRequest{Provider: argoappv1.HydratorPullRequestProviderGitea, API: "https://attacker.example.net"}, message: `spec.sourceHydrator.pullRequest.api is not permitted: pull request API "https://attacker.example.net" is not hosted by the host of repository "https://example.com/dry-repo"`},
+			{pullRequest: &argoappv1.HydratorPullRequest{Provider: argoappv1.HydratorPullRequestProviderGitea, API: "https://example.com", Branch: "main"}, message: `spec.sourceHydrator.pullRequest.branch is invalid: pull request branch "main" must differ from the branches of the source hydrator`},
+			{pullRequest: &argoappv1.HydratorPullRequest{Provider: argoappv1.HydratorPullRequestProviderGitea, API: "https://example.com", Branch: "refs/heads/review"}, message: `spec.sourceHydrator.pullRequest.branch is invalid: pull request branch "refs/heads/review" must be a branch name`},
+			{pullRequest: &argoappv1.HydratorPullRequest{Provider: argoappv1.HydratorPullRequestProviderGitHub}, message: `spec.sourceHydrator.pullRequest.api is not permitted: pull request API "https://api.github.com" is not hosted by the host of repository "https://example.com/dry-repo"`},
+		} {
+			spec := argoappv1.ApplicationSpec{
+				SourceHydrator: &argoappv1.SourceHydrator{
+					DrySource: argoappv1.DrySource{
+						RepoURL:        "https://example.com/dry-repo",
+						TargetRevision: "main",
+						Path:           "dry",
+					},
+					SyncSource: argoappv1.SyncSource{
+						TargetBranch: "main",
+						Path:         "hydrated",
+					},
+					PullRequest: pr.pullRequest,
+				},
+			}
+			proj := argoappv1.AppProject{}
+			db := &dbmocks.ArgoDB{}
+
+			conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+			require.NoError(t, err)
+			require.Len(t, conditions, 1)
+			assert.Equal(t, pr.message, conditions[0].Message)
+		}
+	})
+
+	t.Run("HydrateTo OCI sync source is not permitted", func(t *testing.T) {
+		t.Parallel()
+		spec := argoappv1.ApplicationSpec{
+			SourceHydrator: &argoappv1.SourceHydrator{
+				DrySource: argoappv1.DrySource{
+					RepoURL:        "https://example.com/dry-repo",
+					TargetRevision: "main",
+					Path:           "dry",
+				},
+				SyncSource: argoappv1.SyncSource{
+					TargetBranch: "main",
+					Path:         "hydrated",
+				},
+				HydrateTo: &argoappv1.HydrateTo{
+					OCI: &argoappv1.HydrateToOCI{RepoURL: "oci://registry.example.com/hydrated"},
+				},
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "default",
+			},
+		}
+		proj := argoappv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{{
+					Server:    "*",
+					Namespace: "*",
+				}},
+				SourceRepos: []string{"https://example.com/dry-repo"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, spec.Destination.Server).Return(cluster, nil).Maybe()
+
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "sync source repo oci://registry.example.com/hydrated is not permitted in project")
+	})
 }
 
 func TestSetAppOperations(t *testing.T) {
@@ -2562,3 +2670,49 @@ func Test_GetRefSourcesAndSyncedRefSources_SingleSourceWithRef(t *testing.T) {
 	}
 	assert.Equal(t, expected, synced)
 }
+
+func TestValidateDependencies(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(namespace, name, project string) *argoappv1.Application {
+		return &argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       argoappv1.ApplicationSpec{Project: project},
+		}
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, app := range []*argoappv1.Application{
+		newApp("argocd", "database", "my-proj"),
+		newApp("apps", "cache", "my-proj"),
+		newApp("argocd", "other", "other-proj"),
+	} {
+		require.NoError(t, indexer.Add(app))
+	}
+	appLister := applisters.NewApplicationLister(indexer)
+
+	tests := []struct {
+		name      string
+		dependsOn []argoappv1.ApplicationDependency
+		messages  []string
+	}{
+		{"same project", []argoappv1.ApplicationDependency{{Name: "database"}, {Name: "cache", Namespace: "apps"}}, nil},
+		{"missing name", []argoappv1.ApplicationDependency{{Namespace: "apps"}}, []string{"dependency name is required"}},
+		{"unknown application", []argoappv1.ApplicationDependency{{Name: "missing"}}, []string{"dependency argocd/missing is not an application of project my-proj"}},
+		{"other project", []argoappv1.ApplicationDependency{{Name: "other"}}, []string{"dependency argocd/other is not an application of project my-proj"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			app := newApp("argocd", "frontend", "my-proj")
+			app.Spec.DependsOn = tt.dependsOn
+			conditions, err := ValidateDependencies(app, "argocd", appLister)
+			require.NoError(t, err)
+			var messages []string
+			for _, condition := range conditions {
+				assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, condition.Type)
+				messages = append(messages, condition.Message)
+			}
+			assert.Equal(t, tt.messages, messages)
+		})
+	}
+}
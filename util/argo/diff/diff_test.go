@@ -128,6 +128,24 @@ func TestStateDiff(t *testing.T) {
 			expectedNormalizedReplicas: 1,
 			expectedPredictedReplicas:  1,
 		},
+		{
+			name: "will normalize replica field if configured with cel expression",
+			params: func() *diffConfigParams {
+				params := defaultDiffConfigParams()
+				params.ignores = []v1alpha1.ResourceIgnoreDifferences{
+					{
+						Group:          "*",
+						Kind:           "*",
+						CELExpressions: []string{`live.spec.replicas != target.spec.replicas ? ["/spec/replicas"] : []`},
+					},
+				}
+				return params
+			},
+			desiredState:               testutil.YamlToUnstructured(testdata.DesiredDeploymentYaml),
+			liveState:                  testutil.YamlToUnstructured(testdata.LiveDeploymentWithManagedReplicaYaml),
+			expectedNormalizedReplicas: 1,
+			expectedPredictedReplicas:  1,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -15,7 +15,7 @@ func Normalize(lives, configs []*unstructured.Unstructured, diffConfig DiffConfi
 	if err != nil {
 		return nil, err
 	}
-	diffNormalizer, err := newDiffNormalizer(diffConfig.Ignores(), diffConfig.Overrides(), diffConfig.IgnoreNormalizerOpts())
+	diffNormalizer, err := newDiffNormalizer(diffConfig.Ignores(), diffConfig.Overrides(), diffConfig.IgnoreNormalizerOpts(), result.Lives, result.Targets)
 	if err != nil {
 		return nil, err
 	}
@@ -40,17 +40,23 @@ func Normalize(lives, configs []*unstructured.Unstructured, diffConfig DiffConfi
 }
 
 // newDiffNormalizer creates normalizer that uses Argo CD and application settings to normalize the resource prior to diffing.
-func newDiffNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides map[string]v1alpha1.ResourceOverride, opts normalizers.IgnoreNormalizerOpts) (diff.Normalizer, error) {
+// The lives and targets are the resources which are diffed, they are used to evaluate the CEL expressions of the ignored
+// differences.
+func newDiffNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides map[string]v1alpha1.ResourceOverride, opts normalizers.IgnoreNormalizerOpts, lives, targets []*unstructured.Unstructured) (diff.Normalizer, error) {
 	ignoreNormalizer, err := normalizers.NewIgnoreNormalizer(ignore, overrides, opts)
 	if err != nil {
 		return nil, err
 	}
+	celNormalizer, err := normalizers.NewCELNormalizer(ignore, lives, targets)
+	if err != nil {
+		return nil, err
+	}
 	knownTypesNorm, err := normalizers.NewKnownTypesNormalizer(overrides)
 	if err != nil {
 		return nil, err
 	}
 
-	return &composableNormalizer{normalizers: []diff.Normalizer{ignoreNormalizer, knownTypesNorm}}, nil
+	return &composableNormalizer{normalizers: []diff.Normalizer{ignoreNormalizer, celNormalizer, knownTypesNorm}}, nil
 }
 
 type composableNormalizer struct {
@@ -0,0 +1,211 @@
+package normalizers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/manifestpolicy"
+)
+
+const (
+	// celCostLimit bounds the cost of the evaluation of a CEL expression of ignored differences against one resource
+	celCostLimit = 1000000
+	// celProgramCacheSize is the maximum number of compiled CEL expressions of ignored differences to cache
+	celProgramCacheSize = 1000
+
+	celVarLive    = "live"
+	celVarTarget  = "target"
+	celVarRelated = "related"
+)
+
+var (
+	getCELEnv = sync.OnceValues(func() (*cel.Env, error) {
+		return cel.NewEnv(
+			cel.Variable(celVarLive, cel.DynType),
+			cel.Variable(celVarTarget, cel.DynType),
+			cel.Variable(celVarRelated, cel.ListType(cel.DynType)),
+		)
+	})
+
+	// celProgramCache holds the compiled programs, since a normalizer is created on every reconciliation
+	celProgramCache = manifestpolicy.NewProgramCache(celProgramCacheSize, compileCELExpression)
+)
+
+type celExpression struct {
+	baseNormalizerPatch
+	expression string
+	program    cel.Program
+}
+
+// compileCELExpression compiles the expression, which must return the list of the JSON pointers of the fields to ignore
+func compileCELExpression(expression string) (cel.Program, error) {
+	env, err := getCELEnv()
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("error compiling CEL expression '%s': %w", expression, issues.Err())
+	}
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.DynType) && !cel.ListType(cel.StringType).IsAssignableType(outputType) {
+		return nil, fmt.Errorf("CEL expression '%s' must return a list of JSON pointers, got %s", expression, outputType)
+	}
+	program, err := env.Program(ast, cel.CostLimit(celCostLimit))
+	if err != nil {
+		return nil, fmt.Errorf("error creating program of CEL expression '%s': %w", expression, err)
+	}
+	return program, nil
+}
+
+type celNormalizer struct {
+	// pointers holds the JSON pointers of the fields to remove by resource
+	pointers map[kube.ResourceKey][]string
+}
+
+// NewCELNormalizer creates a diff normalizer which removes the fields returned by the CEL expressions of the ignored
+// differences. Each expression is evaluated once per matching resource, when the normalizer is created, with the
+// following variables:
+//   - live: the live state of the resource, or null if the resource does not exist
+//   - target: the target state of the resource, or null if the resource is not part of the target state
+//   - related: the live state of all the resources of the application
+//
+// Since the expressions see both states of the resource, the same fields are removed from the live and target states.
+// The lives and targets slices are pairs of the live and target states of the resources.
+func NewCELNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, lives, targets []*unstructured.Unstructured) (diff.Normalizer, error) {
+	var expressions []celExpression
+	for i := range ignore {
+		for _, expression := range ignore[i].CELExpressions {
+			program, err := celProgramCache.Get(expression)
+			if err != nil {
+				return nil, err
+			}
+			expressions = append(expressions, celExpression{
+				baseNormalizerPatch: baseNormalizerPatch{
+					groupKind: schema.GroupKind{Group: ignore[i].Group, Kind: ignore[i].Kind},
+					name:      ignore[i].Name,
+					namespace: ignore[i].Namespace,
+				},
+				expression: expression,
+				program:    program,
+			})
+		}
+	}
+	n := &celNormalizer{pointers: map[kube.ResourceKey][]string{}}
+	if len(expressions) == 0 {
+		return n, nil
+	}
+	if len(lives) != len(targets) {
+		return nil, errors.New("live and target arrays have mismatched lengths")
+	}
+
+	related := make([]any, 0, len(lives))
+	for _, live := range lives {
+		if live != nil {
+			related = append(related, live.Object)
+		}
+	}
+	for i := range targets {
+		obj := targets[i]
+		if obj == nil {
+			obj = lives[i]
+		}
+		if obj == nil {
+			continue
+		}
+		vars := map[string]any{celVarLive: types.NullValue, celVarTarget: types.NullValue, celVarRelated: related}
+		if lives[i] != nil {
+			vars[celVarLive] = lives[i].Object
+		}
+		if targets[i] != nil {
+			vars[celVarTarget] = targets[i].Object
+		}
+		var pointers []string
+		for _, expression := range expressions {
+			if !expression.Matches(obj) {
+				continue
+			}
+			res, err := evalCELExpression(expression.program, vars)
+			if err != nil {
+				gvk := obj.GroupVersionKind()
+				log.WithFields(log.Fields{
+					"group":     gvk.Group,
+					"kind":      gvk.Kind,
+					"name":      obj.GetName(),
+					"namespace": obj.GetNamespace(),
+				}).Warnf("Failed to evaluate CEL expression '%s': %v", expression.expression, err)
+				continue
+			}
+			pointers = append(pointers, res...)
+		}
+		if len(pointers) == 0 {
+			continue
+		}
+		// the target may have no namespace yet, so the pointers are registered under the keys of both states
+		for _, un := range []*unstructured.Unstructured{targets[i], lives[i]} {
+			if un != nil {
+				n.pointers[kube.GetResourceKey(un)] = pointers
+			}
+		}
+	}
+	return n, nil
+}
+
+func evalCELExpression(program cel.Program, vars map[string]any) ([]string, error) {
+	out, _, err := program.Eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	pointers, err := out.ConvertToNative(reflect.TypeOf([]string{}))
+	if err != nil {
+		return nil, fmt.Errorf("expression must return a list of JSON pointers: %w", err)
+	}
+	return pointers.([]string), nil
+}
+
+// Normalize removes the fields returned by the CEL expressions for the resource
+func (n *celNormalizer) Normalize(un *unstructured.Unstructured) error {
+	if un == nil {
+		return errors.New("invalid argument: unstructured is nil")
+	}
+	pointers := n.pointers[kube.GetResourceKey(un)]
+	if len(pointers) == 0 {
+		return nil
+	}
+
+	docData, err := json.Marshal(un)
+	if err != nil {
+		return err
+	}
+	for _, pointer := range pointers {
+		patchData, err := json.Marshal([]map[string]string{{"op": "remove", "path": pointer}})
+		if err != nil {
+			return err
+		}
+		patch, err := jsonpatch.DecodePatch(patchData)
+		if err != nil {
+			return err
+		}
+		patchedDocData, err := patch.Apply(docData)
+		if err != nil {
+			if shouldLogError(err) {
+				log.Debugf("Failed to remove field '%s' returned by CEL expression: %v", pointer, err)
+			}
+			continue
+		}
+		docData = patchedDocData
+	}
+	return json.Unmarshal(docData, un)
+}
@@ -0,0 +1,139 @@
+package normalizers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+const hpaIgnoreExpression = `related.exists(r, r.kind == "HorizontalPodAutoscaler" && r.spec.scaleTargetRef.name == target.metadata.name) ? ["/spec/replicas"] : []`
+
+func newHPA(target string) *unstructured.Unstructured {
+	return test.YamlToUnstructured(`
+apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+  name: nginx
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: ` + target + `
+`)
+}
+
+func hasReplicas(t *testing.T, un *unstructured.Unstructured) bool {
+	t.Helper()
+	_, has, err := unstructured.NestedInt64(un.Object, "spec", "replicas")
+	require.NoError(t, err)
+	return has
+}
+
+func TestCELNormalizer(t *testing.T) {
+	t.Parallel()
+	ignore := []v1alpha1.ResourceIgnoreDifferences{{
+		Group:          "apps",
+		Kind:           "Deployment",
+		CELExpressions: []string{hpaIgnoreExpression},
+	}}
+
+	t.Run("Matched", func(t *testing.T) {
+		t.Parallel()
+		live, target := test.NewDeployment(), test.NewDeployment()
+		require.NoError(t, unstructured.SetNestedField(live.Object, int64(5), "spec", "replicas"))
+		hpa := newHPA(live.GetName())
+
+		normalizer, err := NewCELNormalizer(ignore, []*unstructured.Unstructured{live, hpa}, []*unstructured.Unstructured{target, hpa})
+		require.NoError(t, err)
+
+		require.NoError(t, normalizer.Normalize(live))
+		require.NoError(t, normalizer.Normalize(target))
+		assert.False(t, hasReplicas(t, live))
+		assert.False(t, hasReplicas(t, target))
+
+		// the HPA does not match the group and kind of the ignored differences
+		require.NoError(t, normalizer.Normalize(hpa))
+		assert.Equal(t, newHPA(live.GetName()), hpa)
+	})
+	t.Run("NotMatched", func(t *testing.T) {
+		t.Parallel()
+		live, target := test.NewDeployment(), test.NewDeployment()
+		hpa := newHPA("other-deployment")
+
+		normalizer, err := NewCELNormalizer(ignore, []*unstructured.Unstructured{live, hpa}, []*unstructured.Unstructured{target, hpa})
+		require.NoError(t, err)
+
+		require.NoError(t, normalizer.Normalize(live))
+		require.NoError(t, normalizer.Normalize(target))
+		assert.True(t, hasReplicas(t, live))
+		assert.True(t, hasReplicas(t, target))
+	})
+	t.Run("MissingLive", func(t *testing.T) {
+		t.Parallel()
+		target := test.NewDeployment()
+		normalizer, err := NewCELNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+			Group:          "apps",
+			Kind:           "Deployment",
+			CELExpressions: []string{`live == null ? ["/spec/replicas"] : []`},
+		}}, []*unstructured.Unstructured{nil}, []*unstructured.Unstructured{target})
+		require.NoError(t, err)
+
+		require.NoError(t, normalizer.Normalize(target))
+		assert.False(t, hasReplicas(t, target))
+	})
+	t.Run("EvaluationError", func(t *testing.T) {
+		t.Parallel()
+		live, target := test.NewDeployment(), test.NewDeployment()
+		normalizer, err := NewCELNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+			Group:          "apps",
+			Kind:           "Deployment",
+			CELExpressions: []string{`live.status.replicas > 0 ? ["/spec/replicas"] : []`, `["/spec/selector", "/spec/missing"]`},
+		}}, []*unstructured.Unstructured{live}, []*unstructured.Unstructured{target})
+		require.NoError(t, err)
+
+		// the failed expression is skipped
+		require.NoError(t, normalizer.Normalize(live))
+		assert.True(t, hasReplicas(t, live))
+		_, has, err := unstructured.NestedMap(live.Object, "spec", "selector")
+		require.NoError(t, err)
+		assert.False(t, has)
+	})
+	t.Run("NoExpressions", func(t *testing.T) {
+		t.Parallel()
+		normalizer, err := NewCELNormalizer([]v1alpha1.ResourceIgnoreDifferences{{Kind: "Deployment", JSONPointers: []string{"/spec"}}}, nil, nil)
+		require.NoError(t, err)
+		deployment := test.NewDeployment()
+		require.NoError(t, normalizer.Normalize(deployment))
+		assert.Equal(t, test.NewDeployment(), deployment)
+		require.Error(t, normalizer.Normalize(nil))
+	})
+}
+
+func TestCELNormalizer_InvalidExpression(t *testing.T) {
+	t.Parallel()
+	for name, expression := range map[string]string{
+		"Syntax":     `["/spec/replicas"`,
+		"ReturnType": `live != null`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewCELNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+				Kind:           "Deployment",
+				CELExpressions: []string{expression},
+			}}, nil, nil)
+			require.ErrorContains(t, err, expression)
+
+			// the compilation error is cached with the programs
+			_, cachedErr := NewCELNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
+				Kind:           "Deployment",
+				CELExpressions: []string{expression},
+			}}, nil, nil)
+			assert.Same(t, err, cachedErr)
+		})
+	}
+}
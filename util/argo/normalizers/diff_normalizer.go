@@ -28,6 +28,7 @@ type normalizerPatch interface {
 	GetGroupKind() schema.GroupKind
 	GetNamespace() string
 	GetName() string
+	Matches(un *unstructured.Unstructured) bool
 	// Apply(un *unstructured.Unstructured) (error)
 	Apply(data []byte) ([]byte, error)
 }
@@ -50,6 +51,15 @@ func (np *baseNormalizerPatch) GetName() string {
 	return np.name
 }
 
+// Matches returns true if the patch applies to the given resource
+func (np *baseNormalizerPatch) Matches(un *unstructured.Unstructured) bool {
+	groupKind := un.GroupVersionKind().GroupKind()
+	return glob.Match(np.groupKind.Group, groupKind.Group) &&
+		glob.Match(np.groupKind.Kind, groupKind.Kind) &&
+		(np.name == "" || np.name == un.GetName()) &&
+		(np.namespace == "" || np.namespace == un.GetNamespace())
+}
+
 type jsonPatchNormalizerPatch struct {
 	baseNormalizerPatch
 	patch *jsonpatch.Patch
@@ -188,12 +198,7 @@ func (n *ignoreNormalizer) Normalize(un *unstructured.Unstructured) error {
 	}
 	matched := make([]normalizerPatch, 0)
 	for _, patch := range n.patches {
-		groupKind := un.GroupVersionKind().GroupKind()
-
-		if glob.Match(patch.GetGroupKind().Group, groupKind.Group) &&
-			glob.Match(patch.GetGroupKind().Kind, groupKind.Kind) &&
-			(patch.GetName() == "" || patch.GetName() == un.GetName()) &&
-			(patch.GetNamespace() == "" || patch.GetNamespace() == un.GetNamespace()) {
+		if patch.Matches(un) {
 			matched = append(matched, patch)
 		}
 	}
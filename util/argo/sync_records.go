@@ -0,0 +1,62 @@
+package argo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/typed/application/v1alpha1"
+)
+
+// NewSyncRecord returns the sync record of the completed operation of the application. The record is owned by the
+// application, so that it is deleted along with it.
+func NewSyncRecord(app *argoappv1.Application, state *argoappv1.OperationState) *argoappv1.ApplicationSyncRecord {
+	record := &argoappv1.ApplicationSyncRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: app.Name + "-",
+			Namespace:    app.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(app, argoappv1.ApplicationSchemaGroupVersionKind),
+			},
+		},
+		Spec: argoappv1.ApplicationSyncRecordSpec{
+			Application:    app.Name,
+			Project:        app.Spec.GetProject(),
+			OperationState: *state.DeepCopy(),
+		},
+	}
+	// application names may be longer than label values, in which case the records are filtered by their spec
+	if len(validation.IsValidLabelValue(app.Name)) == 0 {
+		record.Labels = map[string]string{common.LabelKeySyncRecordApplication: app.Name}
+	}
+	return record
+}
+
+// ListSyncRecords returns the sync records of the application, from the oldest to the most recent sync
+func ListSyncRecords(ctx context.Context, recordIf v1alpha1.ApplicationSyncRecordInterface, appName string) ([]argoappv1.ApplicationSyncRecord, error) {
+	opts := metav1.ListOptions{}
+	if len(validation.IsValidLabelValue(appName)) == 0 {
+		opts.LabelSelector = labels.SelectorFromSet(map[string]string{common.LabelKeySyncRecordApplication: appName}).String()
+	}
+	list, err := recordIf.List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error listing sync records of application %s: %w", appName, err)
+	}
+	records := make([]argoappv1.ApplicationSyncRecord, 0, len(list.Items))
+	for _, record := range list.Items {
+		if record.Spec.Application == appName {
+			records = append(records, record)
+		}
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		startedAt := records[i].Spec.OperationState.StartedAt
+		return startedAt.Before(&records[j].Spec.OperationState.StartedAt)
+	})
+	return records, nil
+}
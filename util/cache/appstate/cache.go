@@ -2,11 +2,15 @@ package appstate
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"time"
 
 	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
@@ -129,3 +133,50 @@ func (c *Cache) GetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	err := c.GetItem(clusterInfoKey(server), &res)
 	return err
 }
+
+func clusterCacheSnapshotKey(server string) string {
+	return "cluster|cache-snapshot|" + server
+}
+
+// SetClusterCacheSnapshot stores the encoded snapshot of the cache of a cluster, or deletes it if data is nil. The
+// default cache expiration applies if expiration is 0.
+func (c *Cache) SetClusterCacheSnapshot(server string, data []byte, expiration time.Duration) error {
+	return c.SetItem(clusterCacheSnapshotKey(server), data, expiration, data == nil)
+}
+
+func (c *Cache) GetClusterCacheSnapshot(server string, res *[]byte) error {
+	return c.GetItem(clusterCacheSnapshotKey(server), res)
+}
+
+// serverSideDiffResultKey returns the key of the result of the server-side dry run of the target manifest by the
+// field manager against the given resourceVersion of the live resource
+func serverSideDiffResultKey(server string, target *unstructured.Unstructured, liveResourceVersion, manager string) (string, error) {
+	data, err := json.Marshal(target)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling target manifest: %w", err)
+	}
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s|%s|", liveResourceVersion, manager)
+	_, _ = h.Write(data)
+	return "cluster|ssd-result|" + server + "|" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// GetServerSideDiffResult returns the predicted live state returned by the server-side dry run of the target manifest
+// by the field manager, if the live resource did not change since then
+func (c *Cache) GetServerSideDiffResult(server string, target *unstructured.Unstructured, liveResourceVersion, manager string, res *string) error {
+	key, err := serverSideDiffResultKey(server, target, liveResourceVersion, manager)
+	if err != nil {
+		return err
+	}
+	return c.GetItem(key, res)
+}
+
+// SetServerSideDiffResult stores the predicted live state returned by the server-side dry run of the target manifest
+// by the field manager against the given resourceVersion of the live resource
+func (c *Cache) SetServerSideDiffResult(server string, target *unstructured.Unstructured, liveResourceVersion, manager string, result string) error {
+	key, err := serverSideDiffResultKey(server, target, liveResourceVersion, manager)
+	if err != nil {
+		return err
+	}
+	return c.SetItem(key, result, c.appStateCacheExpiration, false)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	. "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
@@ -80,6 +81,39 @@ func TestCache_GetClusterInfo(t *testing.T) {
 	assert.Equal(t, &ClusterInfo{ServerVersion: "0.24.0"}, res)
 }
 
+func TestCache_GetServerSideDiffResult(t *testing.T) {
+	t.Parallel()
+	cache := newFixtures().Cache
+	target := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "my-config", "namespace": "default"},
+		"data":       map[string]any{"foo": "bar"},
+	}}
+	// cache miss
+	var res string
+	err := cache.GetServerSideDiffResult("http://kind-cluster", target, "1", "argocd-controller", &res)
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetServerSideDiffResult("http://kind-cluster", target, "1", "argocd-controller", `{"kind":"ConfigMap"}`)
+	require.NoError(t, err)
+	// cache miss on another cluster, live resource version, manager or target manifest
+	err = cache.GetServerSideDiffResult("http://minikube", target, "1", "argocd-controller", &res)
+	assert.Equal(t, ErrCacheMiss, err)
+	err = cache.GetServerSideDiffResult("http://kind-cluster", target, "2", "argocd-controller", &res)
+	assert.Equal(t, ErrCacheMiss, err)
+	err = cache.GetServerSideDiffResult("http://kind-cluster", target, "1", "kubectl", &res)
+	assert.Equal(t, ErrCacheMiss, err)
+	changed := target.DeepCopy()
+	changed.Object["data"] = map[string]any{"foo": "baz"}
+	err = cache.GetServerSideDiffResult("http://kind-cluster", changed, "1", "argocd-controller", &res)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache hit
+	err = cache.GetServerSideDiffResult("http://kind-cluster", target, "1", "argocd-controller", &res)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"kind":"ConfigMap"}`, res)
+}
+
 func TestAddCacheFlagsToCmd(t *testing.T) {
 	t.Parallel()
 	cache, err := AddCacheFlagsToCmd(&cobra.Command{})()
@@ -0,0 +1,330 @@
+package disk
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// The content of each entry is stored in the objects directory, in a directory named after the hash of its key, next
+// to a file holding the key. The key file is written last and removed first, so an entry without key file is
+// incomplete.
+const (
+	objectsDir = "objects"
+	refsDir    = "refs"
+	tmpDir     = "tmp"
+)
+
+// Cache is a content-addressed store of directories on the local disk. Each entry is addressed by a key derived from
+// its content, e.g. the digest of an OCI image or the hash of a git tree, and is never modified once stored. Refs map
+// mutable names, e.g. a commit SHA of a repository, to the keys of entries.
+//
+// The least recently used entries are evicted once the total size of the entries exceeds the maximum size. Entries
+// which are in use are not evicted until they are released. The entries and refs are kept on disk, so they survive a
+// restart of the process.
+type Cache struct {
+	root    string
+	maxSize int64
+
+	lock    sync.Mutex
+	entries map[string]*entry
+	refs    map[string]string
+	lru     *list.List
+	size    int64
+}
+
+type entry struct {
+	key     string
+	dir     string
+	size    int64
+	users   int
+	element *list.Element
+}
+
+// NewCache creates a cache stored in the given directory and loads the entries and refs stored by a previous process.
+// A maxSize of 0 or less disables the eviction of entries.
+func NewCache(root string, maxSize int64) (*Cache, error) {
+	c := &Cache{
+		root:    root,
+		maxSize: maxSize,
+		entries: map[string]*entry{},
+		refs:    map[string]string{},
+		lru:     list.New(),
+	}
+	// entries which were being stored when the previous process stopped are incomplete
+	if err := os.RemoveAll(filepath.Join(root, tmpDir)); err != nil {
+		return nil, fmt.Errorf("error removing temporary directory: %w", err)
+	}
+	for _, dir := range []string{objectsDir, refsDir, tmpDir} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0o700); err != nil {
+			return nil, fmt.Errorf("error creating cache directory: %w", err)
+		}
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	c.lock.Lock()
+	c.evict()
+	c.lock.Unlock()
+	return c, nil
+}
+
+func hash(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+func (c *Cache) entryDir(key string) string {
+	return filepath.Join(c.root, objectsDir, hash(key))
+}
+
+func (c *Cache) refPath(ref string) string {
+	return filepath.Join(c.root, refsDir, hash(ref))
+}
+
+// load restores the entries ordered by the time they were last used, and the refs to the restored entries
+func (c *Cache) load() error {
+	objects, err := os.ReadDir(filepath.Join(c.root, objectsDir))
+	if err != nil {
+		return fmt.Errorf("error reading cache entries: %w", err)
+	}
+	type loadedEntry struct {
+		*entry
+		usedAt time.Time
+	}
+	loaded := make([]loadedEntry, 0, len(objects))
+	for _, object := range objects {
+		dir := filepath.Join(c.root, objectsDir, object.Name())
+		if !object.IsDir() {
+			// remove the keys of the entries which were not completely removed
+			if _, err := os.Stat(strings.TrimSuffix(dir, ".key")); errors.Is(err, fs.ErrNotExist) {
+				_ = os.Remove(dir)
+			}
+			continue
+		}
+		key, err := os.ReadFile(dir + ".key")
+		if err != nil {
+			// the entry was not completely stored or its key was lost
+			_ = os.RemoveAll(dir)
+			continue
+		}
+		info, err := os.Stat(dir)
+		if err != nil {
+			return fmt.Errorf("error reading cache entry: %w", err)
+		}
+		size, err := dirSize(dir)
+		if err != nil {
+			return fmt.Errorf("error computing size of cache entry: %w", err)
+		}
+		loaded = append(loaded, loadedEntry{entry: &entry{key: string(key), dir: dir, size: size}, usedAt: info.ModTime()})
+	}
+	sort.Slice(loaded, func(i, j int) bool {
+		return loaded[i].usedAt.After(loaded[j].usedAt)
+	})
+	for _, e := range loaded {
+		e.element = c.lru.PushBack(e.entry)
+		c.entries[e.key] = e.entry
+		c.size += e.size
+	}
+
+	refs, err := os.ReadDir(filepath.Join(c.root, refsDir))
+	if err != nil {
+		return fmt.Errorf("error reading cache refs: %w", err)
+	}
+	for _, ref := range refs {
+		path := filepath.Join(c.root, refsDir, ref.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("error reading cache ref: %w", err)
+		}
+		name, key, ok := splitRef(string(data))
+		if _, exists := c.entries[key]; !ok || !exists {
+			_ = os.Remove(path)
+			continue
+		}
+		c.refs[name] = key
+	}
+	return nil
+}
+
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// Get returns the directory of the entry with the given key, and a function which must be called once the directory
+// is no longer used. The directory must not be modified.
+func (c *Cache) Get(key string) (string, func(), bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return "", nil, false
+	}
+	return e.dir, c.use(e), true
+}
+
+// use marks the entry as the most recently used one and prevents its eviction until the returned function is called
+func (c *Cache) use(e *entry) func() {
+	e.users++
+	c.lru.MoveToFront(e.element)
+	now := time.Now()
+	if err := os.Chtimes(e.dir, now, now); err != nil {
+		log.Warnf("Failed to update last use of cache entry %s: %v", e.key, err)
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.lock.Lock()
+			defer c.lock.Unlock()
+			e.users--
+			c.evict()
+		})
+	}
+}
+
+// Put stores the entry with the given key, unless it is already stored, and returns it like Get. The content of the
+// entry is written by the given function into an empty directory.
+func (c *Cache) Put(key string, write func(dir string) error) (string, func(), error) {
+	if dir, release, ok := c.Get(key); ok {
+		return dir, release, nil
+	}
+	tmp, err := os.MkdirTemp(filepath.Join(c.root, tmpDir), "")
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+	if err := write(tmp); err != nil {
+		return "", nil, err
+	}
+	size, err := dirSize(tmp)
+	if err != nil {
+		return "", nil, fmt.Errorf("error computing size of cache entry: %w", err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	// the entry might have been stored concurrently
+	if e, ok := c.entries[key]; ok {
+		return e.dir, c.use(e), nil
+	}
+	dir := c.entryDir(key)
+	if err := os.Rename(tmp, dir); err != nil {
+		return "", nil, fmt.Errorf("error storing cache entry: %w", err)
+	}
+	if err := os.WriteFile(dir+".key", []byte(key), 0o600); err != nil {
+		_ = os.RemoveAll(dir)
+		return "", nil, fmt.Errorf("error storing cache entry key: %w", err)
+	}
+	e := &entry{key: key, dir: dir, size: size}
+	e.element = c.lru.PushFront(e)
+	c.entries[key] = e
+	c.size += size
+	release := c.use(e)
+	c.evict()
+	return dir, release, nil
+}
+
+// Link makes the given ref resolve to the entry with the given key
+func (c *Cache) Link(ref string, key string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, ok := c.entries[key]; !ok {
+		return fmt.Errorf("cache entry %s does not exist", key)
+	}
+	if c.refs[ref] == key {
+		return nil
+	}
+	if err := os.WriteFile(c.refPath(ref), []byte(joinRef(ref, key)), 0o600); err != nil {
+		return fmt.Errorf("error storing cache ref: %w", err)
+	}
+	c.refs[ref] = key
+	return nil
+}
+
+// Resolve returns the key of the entry the given ref resolves to
+func (c *Cache) Resolve(ref string) (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	key, ok := c.refs[ref]
+	return key, ok
+}
+
+// Size returns the total size of the entries
+func (c *Cache) Size() int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.size
+}
+
+// evict removes the least recently used entries which are not in use until the total size of the entries does not
+// exceed the maximum size. The caller must hold the lock.
+func (c *Cache) evict() {
+	if c.maxSize <= 0 {
+		return
+	}
+	for element := c.lru.Back(); element != nil && c.size > c.maxSize; {
+		e := element.Value.(*entry)
+		element = element.Prev()
+		if e.users > 0 {
+			continue
+		}
+		if err := c.remove(e); err != nil {
+			log.Warnf("Failed to evict cache entry %s: %v", e.key, err)
+		}
+	}
+}
+
+func (c *Cache) remove(e *entry) error {
+	// remove the key first, so an entry without key is discarded on the next start if the removal fails halfway
+	if err := os.Remove(e.dir + ".key"); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	c.lru.Remove(e.element)
+	delete(c.entries, e.key)
+	c.size -= e.size
+	for ref, key := range c.refs {
+		if key == e.key {
+			delete(c.refs, ref)
+			_ = os.Remove(c.refPath(ref))
+		}
+	}
+	return os.RemoveAll(e.dir)
+}
+
+// refs are stored as "<ref>\n<key>"; keys never contain a newline
+func joinRef(ref, key string) string {
+	return ref + "\n" + key
+}
+
+func splitRef(data string) (string, string, bool) {
+	i := strings.LastIndexByte(data, '\n')
+	if i < 0 {
+		return "", "", false
+	}
+	return data[:i], data[i+1:], true
+}
@@ -0,0 +1,158 @@
+package disk
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(name string, size int) func(dir string) error {
+	return func(dir string) error {
+		return os.WriteFile(filepath.Join(dir, name), make([]byte, size), 0o600)
+	}
+}
+
+func putEntry(t *testing.T, c *Cache, key string, size int) {
+	t.Helper()
+	_, release, err := c.Put(key, writeFile("data", size))
+	require.NoError(t, err)
+	release()
+}
+
+func TestCache_PutGet(t *testing.T) {
+	c, err := NewCache(t.TempDir(), 0)
+	require.NoError(t, err)
+
+	_, _, ok := c.Get("sha256:abc")
+	assert.False(t, ok)
+
+	dir, release, err := c.Put("sha256:abc", writeFile("data", 10))
+	require.NoError(t, err)
+	release()
+	data, err := os.ReadFile(filepath.Join(dir, "data"))
+	require.NoError(t, err)
+	assert.Len(t, data, 10)
+
+	got, release, ok := c.Get("sha256:abc")
+	require.True(t, ok)
+	release()
+	assert.Equal(t, dir, got)
+	assert.Equal(t, int64(10), c.Size())
+
+	// the content of an existing entry is not written again
+	_, release, err = c.Put("sha256:abc", func(_ string) error {
+		t.Fatal("unexpected write of existing entry")
+		return nil
+	})
+	require.NoError(t, err)
+	release()
+}
+
+func TestCache_PutError(t *testing.T) {
+	c, err := NewCache(t.TempDir(), 0)
+	require.NoError(t, err)
+
+	_, _, err = c.Put("sha256:abc", func(_ string) error {
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+	_, _, ok := c.Get("sha256:abc")
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), c.Size())
+}
+
+func TestCache_Refs(t *testing.T) {
+	c, err := NewCache(t.TempDir(), 0)
+	require.NoError(t, err)
+
+	require.Error(t, c.Link("repo|main", "sha256:abc"))
+
+	putEntry(t, c, "sha256:abc", 10)
+	require.NoError(t, c.Link("repo|main", "sha256:abc"))
+	key, ok := c.Resolve("repo|main")
+	require.True(t, ok)
+	assert.Equal(t, "sha256:abc", key)
+
+	_, ok = c.Resolve("repo|other")
+	assert.False(t, ok)
+}
+
+func TestCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c, err := NewCache(t.TempDir(), 25)
+	require.NoError(t, err)
+
+	putEntry(t, c, "a", 10)
+	require.NoError(t, c.Link("ref-a", "a"))
+	putEntry(t, c, "b", 10)
+	// a becomes more recently used than b
+	_, release, ok := c.Get("a")
+	require.True(t, ok)
+	release()
+
+	putEntry(t, c, "c", 10)
+
+	_, _, ok = c.Get("b")
+	assert.False(t, ok)
+	_, release, ok = c.Get("a")
+	require.True(t, ok)
+	release()
+	_, release, ok = c.Get("c")
+	require.True(t, ok)
+	release()
+	assert.Equal(t, int64(20), c.Size())
+	_, ok = c.Resolve("ref-a")
+	assert.True(t, ok)
+
+	// evicting an entry removes its refs
+	putEntry(t, c, "d", 10)
+	putEntry(t, c, "e", 10)
+	_, ok = c.Resolve("ref-a")
+	assert.False(t, ok)
+}
+
+func TestCache_DoesNotEvictEntriesInUse(t *testing.T) {
+	c, err := NewCache(t.TempDir(), 15)
+	require.NoError(t, err)
+
+	dir, release, err := c.Put("a", writeFile("data", 10))
+	require.NoError(t, err)
+	putEntry(t, c, "b", 10)
+
+	_, _, ok := c.Get("b")
+	assert.False(t, ok, "b is evicted since a is in use")
+	_, err = os.Stat(dir)
+	require.NoError(t, err)
+
+	release()
+	putEntry(t, c, "c", 10)
+	_, _, ok = c.Get("a")
+	assert.False(t, ok, "a is evicted once released")
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCache_Restore(t *testing.T) {
+	root := t.TempDir()
+	c, err := NewCache(root, 0)
+	require.NoError(t, err)
+	putEntry(t, c, "a", 10)
+	require.NoError(t, c.Link("ref-a", "a"))
+	// an entry which was not completely stored
+	require.NoError(t, os.MkdirAll(filepath.Join(root, objectsDir, "incomplete"), 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, tmpDir, "partial"), 0o700))
+
+	c, err = NewCache(root, 0)
+	require.NoError(t, err)
+	key, ok := c.Resolve("ref-a")
+	require.True(t, ok)
+	dir, release, ok := c.Get(key)
+	require.True(t, ok)
+	release()
+	assert.FileExists(t, filepath.Join(dir, "data"))
+	assert.Equal(t, int64(10), c.Size())
+	assert.NoDirExists(t, filepath.Join(root, objectsDir, "incomplete"))
+	assert.NoDirExists(t, filepath.Join(root, tmpDir, "partial"))
+}
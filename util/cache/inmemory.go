@@ -18,6 +18,7 @@ func NewInMemoryCache(expiration time.Duration) *InMemoryCache {
 
 func init() {
 	gob.Register([]any{})
+	gob.Register(map[string]any{})
 }
 
 // compile-time validation of adherence of the CacheClient contract
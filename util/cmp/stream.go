@@ -66,6 +66,7 @@ type SenderOption func(*senderOption)
 type senderOption struct {
 	chunkSize   int
 	tarDoneChan chan<- bool
+	stdinFile   string
 }
 
 func newSenderOption(opts ...SenderOption) *senderOption {
@@ -84,6 +85,14 @@ func WithTarDoneChan(ch chan<- bool) SenderOption {
 	}
 }
 
+// WithStdinFile makes the cmp-server pass the content of the given file, relative to the root path, to the generate
+// command on stdin
+func WithStdinFile(path string) SenderOption {
+	return func(opt *senderOption) {
+		opt.stdinFile = path
+	}
+}
+
 // SendRepoStream will compress the files under the given rootPath and send
 // them using the plugin stream sender.
 func SendRepoStream(ctx context.Context, appPath, rootPath string, sender StreamSender, env []string, excludedGlobs []string, opts ...SenderOption) error {
@@ -135,6 +144,9 @@ func GetCompressedRepoAndMetadata(rootPath string, appPath string, env []string,
 	}
 	// send metadata first
 	mr := appMetadataRequest(filepath.Base(appPath), appRelPath, env, checksum, fi.Size())
+	if opt != nil && opt.stdinFile != "" {
+		mr.GetMetadata().StdinFile = opt.stdinFile
+	}
 	return tgz, mr, err
 }
 
@@ -29,6 +29,7 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
@@ -138,6 +139,9 @@ type Client interface {
 	LsFiles(ctx context.Context, path string, enableNewGitFileGlobbing bool) ([]string, error)
 	LsLargeFiles(ctx context.Context) ([]string, error)
 	CommitSHA(ctx context.Context) (string, error)
+	// TreeSHA returns the SHA of the tree of the directory at the given path of the revision, which identifies the
+	// content of the directory. The path is relative to the root of the repository, which is the empty path.
+	TreeSHA(ctx context.Context, revision string, path string) (string, error)
 	RevisionMetadata(ctx context.Context, revision string) (*RevisionMetadata, error)
 	// Deprecated: To be removed in the next major version when Signature verification is replaced with Source Integrity.
 	VerifyCommitSignature(ctx context.Context, revision string) (string, error)
@@ -147,7 +151,8 @@ type Client interface {
 	// If revision is an annotated tag or a semantic constraint matching an annotated tag, its signature is reported as well
 	// If deep==true, list the commits backwards in history until a signed "seal commit" or repo init commit. The listing includes those seal commits.
 	// If deep==false, examines the revision only. Checking the annotated tag signature if the revision is an annotated tag, commit signature otherwise.
-	LsSignatures(ctx context.Context, revision string, deep bool) ([]RevisionSignatureInfo, string, error)
+	// Signatures in SSH and X.509 formats are verified by the verifier. When it is nil, such revisions are reported unsigned.
+	LsSignatures(ctx context.Context, revision string, deep bool, verifier SignatureVerifier) ([]RevisionSignatureInfo, string, error)
 	ChangedFiles(ctx context.Context, revision string, targetRevision string) ([]string, error)
 	IsRevisionPresent(ctx context.Context, revision string) bool
 	// SetAuthor sets the author name and email in the git configuration.
@@ -202,6 +207,10 @@ type nativeGitClient struct {
 	// tagPrefix filters git tags to only those with this prefix when resolving semver constraints.
 	// The prefix is stripped before comparison and re-added to the resolved tag name.
 	tagPrefix string
+	// partialClone enables partial clones, which fetch the content of the files lazily on checkout
+	partialClone bool
+	// sparseCheckoutPaths are the directories checked out by a partial clone, all directories are checked out if empty
+	sparseCheckoutPaths []string
 }
 
 type runOpts struct {
@@ -273,6 +282,23 @@ func WithTagPrefix(prefix string) ClientOpts {
 	}
 }
 
+// WithPartialClone enables partial clones of the repository: fetches omit the content of the files, which is fetched
+// on demand when the files are checked out. The sparse checkout set by WithSparseCheckout is only applied to partial
+// clones.
+func WithPartialClone(enable bool) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.partialClone = enable
+	}
+}
+
+// WithSparseCheckout sets the directories of the repository which are checked out, in addition to the files at the
+// root of the repository. All directories are checked out if paths is empty.
+func WithSparseCheckout(paths []string) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.sparseCheckoutPaths = paths
+	}
+}
+
 func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...ClientOpts) (Client, error) {
 	r := regexp.MustCompile(`([/:])`)
 	normalizedGitURL := NormalizeGitURL(rawRepoURL)
@@ -581,6 +607,9 @@ func (m *nativeGitClient) fetch(ctx context.Context, revision string, depth int6
 	} else {
 		args = append(args, "--tags")
 	}
+	if m.partialClone {
+		args = append(args, "--filter=blob:none")
+	}
 	args = append(args, "--force", "--prune")
 	return m.runCredentialedCmd(ctx, args...)
 }
@@ -771,7 +800,15 @@ func (m *nativeGitClient) Checkout(ctx context.Context, revision string, submodu
 	if revision == "" || revision == "HEAD" {
 		revision = "origin/HEAD"
 	}
-	if out, err := m.runCmd(ctx, "checkout", "--force", revision); err != nil {
+	if m.partialClone {
+		// the content of the checked out files is fetched from origin, which requires the credentials
+		if err := m.sparseCheckout(ctx); err != nil {
+			return "", fmt.Errorf("failed to set sparse checkout: %w", err)
+		}
+		if err := m.runCredentialedCmd(ctx, "checkout", "--force", revision); err != nil {
+			return "", fmt.Errorf("failed to checkout %s: %w", revision, err)
+		}
+	} else if out, err := m.runCmd(ctx, "checkout", "--force", revision); err != nil {
 		return out, fmt.Errorf("failed to checkout %s: %w", revision, err)
 	}
 	// We must populate LFS content by using lfs checkout, if we have at least
@@ -807,6 +844,80 @@ func (m *nativeGitClient) Checkout(ctx context.Context, revision string, submodu
 	return "", nil
 }
 
+// sparseCheckout sets the directories checked out by the next checkout. The patterns are written directly rather than
+// with `git sparse-checkout`, which enables the worktreeConfig extension that go-git cannot open.
+func (m *nativeGitClient) sparseCheckout(ctx context.Context) error {
+	patternsPath := filepath.Join(m.root, ".git", "info", "sparse-checkout")
+	patterns, cone := sparseCheckoutPatterns(m.sparseCheckoutPaths)
+	if !cone {
+		if _, err := os.Stat(patternsPath); os.IsNotExist(err) {
+			// the repository was never checked out sparsely
+			return nil
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(patternsPath), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(patternsPath, []byte(patterns), 0o644); err != nil {
+		return err
+	}
+	if _, err := m.config(ctx, "core.sparseCheckout", "true"); err != nil {
+		return err
+	}
+	_, err := m.config(ctx, "core.sparseCheckoutCone", strconv.FormatBool(cone))
+	return err
+}
+
+// sparseCheckoutPatterns returns the cone mode patterns which include the files at the root of the repository and all
+// the files of the given directories. If the directories include the root of the repository, it returns a non-cone
+// pattern including all files, since disabling the sparse checkout does not restore the files excluded previously.
+func sparseCheckoutPatterns(paths []string) (string, bool) {
+	dirs := map[string]bool{}
+	for _, path := range paths {
+		path = strings.Trim(filepath.ToSlash(filepath.Clean(path)), "/")
+		if path == "" || path == "." {
+			return "/*\n", false
+		}
+		dirs[path] = true
+	}
+	if len(dirs) == 0 {
+		return "/*\n", false
+	}
+	// the directories within other directories are already included
+	for dir := range dirs {
+		for parent := filepath.Dir(dir); parent != "."; parent = filepath.Dir(parent) {
+			if dirs[parent] {
+				delete(dirs, dir)
+				break
+			}
+		}
+	}
+	// only the files directly in the parents of the directories are included
+	parents := map[string]bool{}
+	for dir := range dirs {
+		for parent := filepath.Dir(dir); parent != "."; parent = filepath.Dir(parent) {
+			parents[parent] = true
+		}
+	}
+	all := make([]string, 0, len(dirs)+len(parents))
+	for dir := range dirs {
+		all = append(all, dir)
+	}
+	for parent := range parents {
+		all = append(all, parent)
+	}
+	sort.Strings(all)
+	var patterns strings.Builder
+	patterns.WriteString("/*\n!/*/\n")
+	for _, dir := range all {
+		patterns.WriteString("/" + dir + "/\n")
+		if parents[dir] {
+			patterns.WriteString("!/" + dir + "/*/\n")
+		}
+	}
+	return patterns.String(), true
+}
+
 func (m *nativeGitClient) getRefs() ([]*plumbing.Reference, error) {
 	myLockUUID, err := uuid.NewRandom()
 	myLockId := ""
@@ -1013,6 +1124,15 @@ func (m *nativeGitClient) CommitSHA(ctx context.Context) (string, error) {
 	return strings.TrimSpace(out), nil
 }
 
+// TreeSHA returns the SHA of the tree of the directory at the given path of the revision
+func (m *nativeGitClient) TreeSHA(ctx context.Context, revision string, path string) (string, error) {
+	out, err := m.runCmd(ctx, "rev-parse", revision+":"+path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
 // RevisionMetadata returns the meta-data for the commit
 func (m *nativeGitClient) RevisionMetadata(ctx context.Context, revision string) (*RevisionMetadata, error) {
 	out, err := m.runCmd(ctx, "show", "-s", "--format=%an <%ae>%n%at%n%B", revision)
@@ -1159,15 +1279,27 @@ func (m *nativeGitClient) VerifyCommitSignature(ctx context.Context, revision st
 
 type (
 	GPGVerificationResult string
+	SignatureFormat       string
+	// SignatureVerifier verifies signatures made in other formats than OpenPGP, those are verified by GnuPG. It is given
+	// the armored signature with the signed payload, and it reports the verification result and the signing key ID.
+	SignatureVerifier     func(format SignatureFormat, signature string, payload []byte) (GPGVerificationResult, string)
 	RevisionSignatureInfo struct {
 		Revision           string
 		VerificationResult GPGVerificationResult
 		SignatureKeyID     string
 		Date               string
 		AuthorIdentity     string
+		// SignatureFormat is empty for unsigned revisions
+		SignatureFormat SignatureFormat
 	}
 )
 
+const (
+	SignatureFormatOpenPGP SignatureFormat = "openpgp"
+	SignatureFormatSSH     SignatureFormat = "ssh"
+	SignatureFormatX509    SignatureFormat = "x509"
+)
+
 const (
 	GPGVerificationResultGood             GPGVerificationResult = "signed"                         // All good
 	GPGVerificationResultBad              GPGVerificationResult = "bad signature"                  // Not able to cryptographically verify signature
@@ -1228,7 +1360,7 @@ func gpgVerificationFromGitRevParse(oneLetter string) (GPGVerificationResult, er
 
 var gpgKeyIdRegexp = regexp.MustCompile("[0-9a-zA-Z]{16}")
 
-func (m *nativeGitClient) tagSignature(ctx context.Context, tagRevision string) (*RevisionSignatureInfo, error) {
+func (m *nativeGitClient) tagSignature(ctx context.Context, tagRevision string, verifier SignatureVerifier) (*RevisionSignatureInfo, error) {
 	// Unlike for commits, there is no elegant way to slurp all signature info for tag. So this extracts details needed
 	// for RevisionSignatureInfo from 2 different git invocations.
 	cmd := m.cmdWithGPG(ctx, "git", "for-each-ref", "refs/tags/"+tagRevision, `--format=%(taggerdate),%(taggername) "%(taggeremail)"`)
@@ -1244,16 +1376,32 @@ func (m *nativeGitClient) tagSignature(ctx context.Context, tagRevision string)
 		return nil, fmt.Errorf("failed to parse tag %q for revisions %q", tagOut, tagRevision)
 	}
 
-	cmd = m.cmdWithGPG(ctx, "git", "verify-tag", tagRevision, "--raw")
-	tagGpgOut, err := m.runCmdOutput(cmd, runOpts{
-		CaptureStderr:    true, // The structured --raw output is printed to stderr only
-		SkipErrorLogging: true, // Unsigned returns rc=1
-	})
-	status, keyId, err := evaluateGpgSignStatus(err, tagGpgOut)
+	// GnuPG is not capable of verifying other signature formats, so those are not passed to `git verify-tag`
+	repo, err := git.PlainOpen(m.root)
+	if err != nil {
+		return nil, err
+	}
+	tagRef, err := repo.Reference(plumbing.NewTagReferenceName(tagRevision), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed resolving git tag %q: %w", tagRevision, err)
+	}
+	format, status, keyId, err := verifyObjectSignature(repo, tagRef.Hash(), verifier)
 	if err != nil {
-		return nil, fmt.Errorf("gpg failed verifying git tag %q: %s", tagRevision, err.Error())
+		return nil, fmt.Errorf("failed verifying git tag %q: %w", tagRevision, err)
 	}
-	info, err := newRevisionSignatureInfo(tagRevision, status, keyId, tagInfo[0], tagInfo[1])
+
+	if format == SignatureFormatOpenPGP {
+		cmd = m.cmdWithGPG(ctx, "git", "verify-tag", tagRevision, "--raw")
+		tagGpgOut, err := m.runCmdOutput(cmd, runOpts{
+			CaptureStderr:    true, // The structured --raw output is printed to stderr only
+			SkipErrorLogging: true, // Unsigned returns rc=1
+		})
+		status, keyId, err = evaluateGpgSignStatus(err, tagGpgOut)
+		if err != nil {
+			return nil, fmt.Errorf("gpg failed verifying git tag %q: %s", tagRevision, err.Error())
+		}
+	}
+	info, err := newRevisionSignatureInfo(tagRevision, format, status, keyId, tagInfo[0], tagInfo[1])
 	if err != nil {
 		return nil, fmt.Errorf("failed building revision gpg signature info for tag %q: %s", tagRevision, err.Error())
 	}
@@ -1294,7 +1442,7 @@ func evaluateGpgSignStatus(cmdErr error, tagGpgOut string) (result GPGVerificati
 	return "", "", fmt.Errorf("unexpected `git verify-tag --raw` output: %q", tagGpgOut)
 }
 
-func (m *nativeGitClient) LsSignatures(ctx context.Context, unresolvedRevision string, deep bool) ([]RevisionSignatureInfo, string, error) {
+func (m *nativeGitClient) LsSignatures(ctx context.Context, unresolvedRevision string, deep bool, verifier SignatureVerifier) ([]RevisionSignatureInfo, string, error) {
 	legacyVerification := ""
 
 	// Resolve eventual semantic tag constraint before annotated tag detection
@@ -1316,7 +1464,7 @@ func (m *nativeGitClient) LsSignatures(ctx context.Context, unresolvedRevision s
 
 	var signatures []RevisionSignatureInfo
 	if m.IsAnnotatedTag(ctx, unresolvedRevision) {
-		signature, err := m.tagSignature(ctx, unresolvedRevision)
+		signature, err := m.tagSignature(ctx, unresolvedRevision, verifier)
 		if err != nil {
 			return nil, "", err
 		}
@@ -1328,7 +1476,12 @@ func (m *nativeGitClient) LsSignatures(ctx context.Context, unresolvedRevision s
 		}
 	}
 
-	commitSignaturesRawOut, err := m.listRawSignatures(ctx, deep)
+	repo, err := git.PlainOpen(m.root)
+	if err != nil {
+		return nil, "", err
+	}
+
+	commitSignaturesRawOut, err := m.listRawSignatures(ctx, repo, deep, verifier)
 	if err != nil {
 		return nil, "", err
 	}
@@ -1350,11 +1503,11 @@ func (m *nativeGitClient) LsSignatures(ctx context.Context, unresolvedRevision s
 		}
 
 		revision := r[0]
-		result, err := gpgVerificationFromGitRevParse(r[1])
+		format, result, keyID, err := resolveCommitSignature(repo, revision, r[1], r[2], verifier)
 		if err != nil {
 			return nil, "", err
 		}
-		signatureInfo, err := newRevisionSignatureInfo(revision, result, r[2], r[3], r[4])
+		signatureInfo, err := newRevisionSignatureInfo(revision, format, result, keyID, r[3], r[4])
 		if err != nil {
 			return nil, "", fmt.Errorf("failed building revision gpg signature info for %q at %q: %s", unresolvedRevision, revision, err.Error())
 		}
@@ -1364,8 +1517,107 @@ func (m *nativeGitClient) LsSignatures(ctx context.Context, unresolvedRevision s
 	return signatures, legacyVerification, nil
 }
 
+// resolveCommitSignature determines the signature verification result of a commit listed by git. The commits signed in
+// other formats than OpenPGP are verified by the verifier, as git reports them unsigned, or verified by a program that
+// is not configured.
+func resolveCommitSignature(repo *git.Repository, revision string, gitResult string, gitKeyID string, verifier SignatureVerifier) (SignatureFormat, GPGVerificationResult, string, error) {
+	result, err := gpgVerificationFromGitRevParse(gitResult)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if verifier != nil {
+		format, verifiedResult, keyID, err := verifyObjectSignature(repo, plumbing.NewHash(revision), verifier)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed verifying commit %q: %w", revision, err)
+		}
+		if format != SignatureFormatOpenPGP {
+			return format, verifiedResult, keyID, nil
+		}
+	}
+
+	if result == GPGVerificationResultUnsigned {
+		return "", result, gitKeyID, nil
+	}
+	return SignatureFormatOpenPGP, result, gitKeyID, nil
+}
+
+// verifyObjectSignature finds the format of a commit or tag object signature, and verifies it using the verifier when
+// it is not an OpenPGP one. The verification result is empty for OpenPGP signatures, and for all formats if the verifier
+// is nil. Unsigned objects have an empty format.
+func verifyObjectSignature(repo *git.Repository, hash plumbing.Hash, verifier SignatureVerifier) (SignatureFormat, GPGVerificationResult, string, error) {
+	obj, err := repo.Storer.EncodedObject(plumbing.AnyObject, hash)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	var signature string
+	payload := &plumbing.MemoryObject{}
+	switch obj.Type() {
+	case plumbing.CommitObject:
+		commit, err := object.DecodeCommit(repo.Storer, obj)
+		if err != nil {
+			return "", "", "", err
+		}
+		signature = commit.PGPSignature
+		err = commit.EncodeWithoutSignature(payload)
+		if err != nil {
+			return "", "", "", err
+		}
+	case plumbing.TagObject:
+		tag, err := object.DecodeTag(repo.Storer, obj)
+		if err != nil {
+			return "", "", "", err
+		}
+		signature = tag.PGPSignature
+		err = tag.EncodeWithoutSignature(payload)
+		if err != nil {
+			return "", "", "", err
+		}
+	default:
+		return "", "", "", fmt.Errorf("unexpected object type %q", obj.Type())
+	}
+
+	format := signatureFormat(signature)
+	switch {
+	case format == "":
+		return "", GPGVerificationResultUnsigned, "", nil
+	case format == SignatureFormatOpenPGP:
+		return format, "", "", nil
+	case verifier == nil:
+		// Reported the same way git does when it is not configured to verify the format
+		return format, GPGVerificationResultUnsigned, "", nil
+	}
+
+	reader, err := payload.Reader()
+	if err != nil {
+		return "", "", "", err
+	}
+	defer func() { _ = reader.Close() }()
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", "", "", err
+	}
+	result, keyID := verifier(format, signature, data)
+	return format, result, keyID, nil
+}
+
+// signatureFormat determines the format of an armored signature the same way git does
+func signatureFormat(signature string) SignatureFormat {
+	switch {
+	case signature == "":
+		return ""
+	case strings.HasPrefix(signature, "-----BEGIN SSH SIGNATURE-----"):
+		return SignatureFormatSSH
+	case strings.HasPrefix(signature, "-----BEGIN SIGNED MESSAGE-----"):
+		return SignatureFormatX509
+	default:
+		return SignatureFormatOpenPGP
+	}
+}
+
 // newRevisionSignatureInfo builds valid RevisionSignatureInfo
-func newRevisionSignatureInfo(revision string, verificationResult GPGVerificationResult, signatureKeyID string, date string, authorIdentity string) (*RevisionSignatureInfo, error) {
+func newRevisionSignatureInfo(revision string, format SignatureFormat, verificationResult GPGVerificationResult, signatureKeyID string, date string, authorIdentity string) (*RevisionSignatureInfo, error) {
 	if revision == "" {
 		return nil, errors.New("no revision specified")
 	}
@@ -1375,12 +1627,13 @@ func newRevisionSignatureInfo(revision string, verificationResult GPGVerificatio
 	if authorIdentity == "" {
 		return nil, errors.New("no author specified")
 	}
-	// Unsigned have no key ID, other states must have key ID
+	// Unsigned have no key ID, other states must have key ID. The key ID of other signature formats is not always known.
 	if verificationResult == GPGVerificationResultUnsigned {
 		if signatureKeyID != "" {
 			return nil, fmt.Errorf("a gpg signing key id %q specified for unsigned commit", signatureKeyID)
 		}
-	} else {
+		format = ""
+	} else if format == SignatureFormatOpenPGP {
 		if !gpgKeyIdRegexp.MatchString(signatureKeyID) {
 			return nil, fmt.Errorf("invalid gpg signing key %q", signatureKeyID)
 		}
@@ -1392,10 +1645,11 @@ func newRevisionSignatureInfo(revision string, verificationResult GPGVerificatio
 		SignatureKeyID:     signatureKeyID,
 		Date:               date,
 		AuthorIdentity:     authorIdentity,
+		SignatureFormat:    format,
 	}, nil
 }
 
-func (m *nativeGitClient) listRawSignatures(ctx context.Context, deep bool) (string, error) {
+func (m *nativeGitClient) listRawSignatures(ctx context.Context, repo *git.Repository, deep bool, verifier SignatureVerifier) (string, error) {
 	revisionSha, err := m.CommitSHA(ctx)
 	if err != nil {
 		return "", err
@@ -1422,7 +1676,7 @@ func (m *nativeGitClient) listRawSignatures(ctx context.Context, deep bool) (str
 			return "", err
 		}
 
-		commitFilterArgs, err = m.getSealRevListFilter(ctx, revisionSha, sealCommitsRawOut)
+		commitFilterArgs, err = m.getSealRevListFilter(ctx, repo, revisionSha, sealCommitsRawOut, verifier)
 		if err != nil {
 			return "", err
 		}
@@ -1441,12 +1695,20 @@ func (m *nativeGitClient) listRawSignatures(ctx context.Context, deep bool) (str
 }
 
 // getSealRevListFilter create arguments for `git rev-list` to search the history all the way until the seal commits found.
-func (m *nativeGitClient) getSealRevListFilter(ctx context.Context, revision string, sealCommitsRawOut string) ([]string, error) {
+func (m *nativeGitClient) getSealRevListFilter(ctx context.Context, repo *git.Repository, revision string, sealCommitsRawOut string, verifier SignatureVerifier) ([]string, error) {
 	// Keep only seal commits with a valid signature
 	var sealCommits []string
 	for line := range strings.SplitSeq(sealCommitsRawOut, "\n") {
-		if strings.HasPrefix(line, "G,") {
-			sealCommits = append(sealCommits, line[2:])
+		gitResult, sha, found := strings.Cut(line, ",")
+		if !found {
+			continue
+		}
+		_, result, _, err := resolveCommitSignature(repo, sha, gitResult, "", verifier)
+		if err != nil {
+			return nil, err
+		}
+		if result == GPGVerificationResultGood {
+			sealCommits = append(sealCommits, sha)
 		}
 	}
 	sealCommitsLen := len(sealCommits)
@@ -373,6 +373,62 @@ func Test_nativeGitClient_Fetch_Prune(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_nativeGitClient_PartialCloneSparseCheckout(t *testing.T) {
+	ctx := t.Context()
+	tempDir := t.TempDir()
+	require.NoError(t, runCmd(ctx, tempDir, "git", "init"))
+	// partial clones require the server to support filters
+	require.NoError(t, runCmd(ctx, tempDir, "git", "config", "uploadpack.allowFilter", "true"))
+	for _, file := range []string{"README.md", "apps/foo/config.yaml", "apps/bar/config.yaml", "base/config.yaml"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, filepath.Dir(file)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, file), []byte(file), 0o644))
+	}
+	require.NoError(t, runCmd(ctx, tempDir, "git", "add", "."))
+	require.NoError(t, runCmd(ctx, tempDir, "git", "commit", "-m", "Initial commit"))
+	commitSHA, err := outputCmd(ctx, tempDir, "git", "rev-parse", "HEAD")
+	require.NoError(t, err)
+	revision := strings.TrimSpace(string(commitSHA))
+
+	root := t.TempDir()
+	checkout := func(paths []string) {
+		t.Helper()
+		client, err := NewClientExt("file://"+tempDir, root, NopCreds{}, true, false, "", "", WithPartialClone(true), WithSparseCheckout(paths))
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+		require.NoError(t, client.Fetch(ctx, "", 0))
+		_, err = client.Checkout(ctx, revision, false, true)
+		require.NoError(t, err)
+	}
+
+	checkout([]string{"apps/foo", "base"})
+	assert.FileExists(t, filepath.Join(root, "README.md"))
+	assert.FileExists(t, filepath.Join(root, "apps/foo/config.yaml"))
+	assert.FileExists(t, filepath.Join(root, "base/config.yaml"))
+	assert.NoFileExists(t, filepath.Join(root, "apps/bar/config.yaml"))
+
+	// the content of the files outside of the sparse checkout is not fetched
+	out, err := outputCmd(ctx, root, "git", "rev-list", "--objects", "--missing=print", revision)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "?")
+
+	checkout(nil)
+	assert.FileExists(t, filepath.Join(root, "apps/bar/config.yaml"))
+}
+
+func Test_sparseCheckoutPatterns(t *testing.T) {
+	patterns, cone := sparseCheckoutPatterns(nil)
+	assert.False(t, cone)
+	assert.Equal(t, "/*\n", patterns)
+
+	patterns, cone = sparseCheckoutPatterns([]string{"apps/foo", "/base/", "apps/foo/bar", "."})
+	assert.False(t, cone)
+	assert.Equal(t, "/*\n", patterns)
+
+	patterns, cone = sparseCheckoutPatterns([]string{"apps/foo", "/base/", "apps/foo/bar", "apps/bar/baz"})
+	assert.True(t, cone)
+	assert.Equal(t, "/*\n!/*/\n/apps/\n!/apps/*/\n/apps/bar/\n!/apps/bar/*/\n/apps/bar/baz/\n/apps/foo/\n/base/\n", patterns)
+}
+
 func Test_IsAnnotatedTag(t *testing.T) {
 	tempDir := t.TempDir()
 	ctx := t.Context()
@@ -914,6 +970,47 @@ func Test_IsRevisionPresent(t *testing.T) {
 	assert.False(t, revisionPresent)
 }
 
+func Test_nativeGitClient_TreeSHA(t *testing.T) {
+	tempDir := t.TempDir()
+	ctx := t.Context()
+
+	client, err := NewClientExt("file://"+tempDir, tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+	require.NoError(t, client.Init())
+	require.NoError(t, runCmd(ctx, client.Root(), "git", "config", "user.name", "test"))
+	require.NoError(t, runCmd(ctx, client.Root(), "git", "config", "user.email", "test@example.com"))
+
+	require.NoError(t, os.MkdirAll(path.Join(client.Root(), "app"), 0o755))
+	require.NoError(t, os.WriteFile(path.Join(client.Root(), "app", "config.yaml"), []byte("a: b"), 0o644))
+	require.NoError(t, runCmd(ctx, client.Root(), "git", "add", "."))
+	require.NoError(t, runCmd(ctx, client.Root(), "git", "commit", "-m", "Add app"))
+	first, err := client.CommitSHA(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path.Join(client.Root(), "README"), []byte("Hello."), 0o644))
+	require.NoError(t, runCmd(ctx, client.Root(), "git", "add", "."))
+	require.NoError(t, runCmd(ctx, client.Root(), "git", "commit", "-m", "Add README"))
+	second, err := client.CommitSHA(ctx)
+	require.NoError(t, err)
+
+	// the tree of the directory does not change with the files outside of it
+	firstApp, err := client.TreeSHA(ctx, first, "app")
+	require.NoError(t, err)
+	secondApp, err := client.TreeSHA(ctx, second, "app")
+	require.NoError(t, err)
+	assert.Equal(t, firstApp, secondApp)
+
+	firstRoot, err := client.TreeSHA(ctx, first, "")
+	require.NoError(t, err)
+	secondRoot, err := client.TreeSHA(ctx, second, "")
+	require.NoError(t, err)
+	assert.NotEqual(t, firstRoot, secondRoot)
+	assert.NotEqual(t, firstApp, firstRoot)
+
+	_, err = client.TreeSHA(ctx, second, "missing")
+	require.Error(t, err)
+}
+
 func Test_nativeGitClient_RevisionMetadata(t *testing.T) {
 	tempDir := t.TempDir()
 	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
@@ -2123,7 +2220,7 @@ func Test_LsSignatures_Error(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		signatures, legacy, err := client.LsSignatures(t.Context(), tt.revision, tt.deep)
+		signatures, legacy, err := client.LsSignatures(t.Context(), tt.revision, tt.deep, nil)
 		require.ErrorContains(t, err, tt.expectedMsg)
 		assert.Nil(t, signatures)
 		assert.Empty(t, legacy)
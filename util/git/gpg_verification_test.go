@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -124,7 +125,7 @@ func (g *gpgReadyRepo) commitSHA() string {
 }
 
 func (g *gpgReadyRepo) assertSignedAs(revision string, expectedSign ...string) {
-	info, _, err := g.git.LsSignatures(context.Background(), revision, true)
+	info, _, err := g.git.LsSignatures(context.Background(), revision, true, nil)
 	require.NoError(g.t, err)
 
 	var actualSign []string
@@ -209,7 +210,7 @@ func Test_LsSignatures_UnsignedSealedCommitDoesNotStopHistorySearch(t *testing.T
 	require.NoError(t, repo.cmd("commit", "--allow-empty", "--no-edit", "--message=signed", "--gpg-sign="+trustedKeyID))
 	signedSha := repo.commitSHA()
 
-	info, _, err := repo.git.LsSignatures(t.Context(), signedSha, true)
+	info, _, err := repo.git.LsSignatures(t.Context(), signedSha, true, nil)
 	require.NoError(t, err)
 
 	assert.Len(t, info, 4)
@@ -240,7 +241,7 @@ func Test_SignedTag(t *testing.T) {
 	require.NoError(t, repo.cmd("tag", "--message=signed tag", "--local-user="+tagKeyId, "2.0", "HEAD"))
 	require.NoError(t, repo.cmd("tag", "--message=unsigned tag", "dev", "HEAD"))
 
-	info, legacy, err := repo.git.LsSignatures(t.Context(), "1.0", false)
+	info, legacy, err := repo.git.LsSignatures(t.Context(), "1.0", false, nil)
 	require.NoError(t, err)
 	require.Len(t, info, 1)
 	assert.Equal(t, "1.0", info[0].Revision)
@@ -251,7 +252,7 @@ func Test_SignedTag(t *testing.T) {
 	assert.Contains(t, legacy, tagKeyId)
 	assert.Contains(t, legacy, `gpg: Good signature from "tag gpg User <tag gpg@example.com>" [ultimate]`)
 
-	info, legacy, err = repo.git.LsSignatures(t.Context(), "2.0", false)
+	info, legacy, err = repo.git.LsSignatures(t.Context(), "2.0", false, nil)
 	require.NoError(t, err)
 	require.Len(t, info, 1)
 	assert.Equal(t, "2.0", info[0].Revision)
@@ -262,7 +263,7 @@ func Test_SignedTag(t *testing.T) {
 	assert.Contains(t, legacy, tagKeyId)
 	assert.Contains(t, legacy, `gpg: Good signature from "tag gpg User <tag gpg@example.com>" [ultimate]`)
 
-	info, legacy, err = repo.git.LsSignatures(t.Context(), "dev", false)
+	info, legacy, err = repo.git.LsSignatures(t.Context(), "dev", false, nil)
 	require.NoError(t, err)
 	require.Len(t, info, 1)
 	assert.Equal(t, "dev", info[0].Revision)
@@ -326,3 +327,94 @@ func Test_parseGpgSignStatus(t *testing.T) {
 		assert.Equal(t, tt.expKeyID, keyId)
 	}
 }
+
+func (g *gpgReadyRepo) generateSSHKey(name string) (keyPath string) {
+	g.t.Helper()
+	keyPath = filepath.Join(g.t.TempDir(), name)
+	out, err := exec.CommandContext(g.t.Context(), "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-C", name, "-f", keyPath).CombinedOutput()
+	require.NoError(g.t, err, "ssh key generation failed: %s", out)
+	return keyPath
+}
+
+// sshCmd runs git with signing configured to use the SSH key
+func (g *gpgReadyRepo) sshCmd(keyPath string, args ...string) error {
+	return g.cmd(append([]string{"-c", "gpg.format=ssh", "-c", "user.signingkey=" + keyPath}, args...)...)
+}
+
+// fakeSSHVerifier trusts all SSH signatures unless the signed commit or tag message mentions it is untrusted
+func fakeSSHVerifier(format SignatureFormat, signature string, payload []byte) (GPGVerificationResult, string) {
+	if format != SignatureFormatSSH || !strings.HasPrefix(signature, "-----BEGIN SSH SIGNATURE-----") {
+		return GPGVerificationResultBad, ""
+	}
+	if strings.Contains(string(payload), "untrusted") {
+		return GPGVerificationResultUntrusted, "SHA256:untrusted"
+	}
+	return GPGVerificationResultGood, "SHA256:trusted"
+}
+
+func Test_LsSignatures_SSH(t *testing.T) {
+	repo := newGPGReadyRepo(t)
+	gpgKeyID := repo.generateGPGKey("gpg")
+	sshKey := repo.generateSSHKey("ssh")
+
+	require.NoError(t, repo.cmd("commit", "--allow-empty", "--no-edit", "--message=gpg", "--gpg-sign="+gpgKeyID))
+	require.NoError(t, repo.sshCmd(sshKey, "commit", "--allow-empty", "--no-edit", "--message=ssh", "--gpg-sign"))
+	require.NoError(t, repo.cmd("commit", "--allow-empty", "--no-edit", "--message=unsigned"))
+	require.NoError(t, repo.sshCmd(sshKey, "commit", "--allow-empty", "--no-edit", "--message=ssh", "--gpg-sign"))
+	tip := repo.commitSHA()
+
+	t.Run("without verifier", func(t *testing.T) {
+		info, _, err := repo.git.LsSignatures(t.Context(), tip, true, nil)
+		require.NoError(t, err)
+		var actual []string
+		for _, record := range info {
+			actual = append(actual, string(record.SignatureFormat)+":"+string(record.VerificationResult)+"="+record.SignatureKeyID)
+		}
+		assert.Equal(t, []string{":unsigned=", ":unsigned=", ":unsigned=", "openpgp:signed=" + gpgKeyID}, actual)
+	})
+
+	t.Run("with verifier", func(t *testing.T) {
+		info, _, err := repo.git.LsSignatures(t.Context(), tip, true, fakeSSHVerifier)
+		require.NoError(t, err)
+		var actual []string
+		for _, record := range info {
+			actual = append(actual, string(record.SignatureFormat)+":"+string(record.VerificationResult)+"="+record.SignatureKeyID)
+		}
+		assert.Equal(t, []string{"ssh:signed=SHA256:trusted", ":unsigned=", "ssh:signed=SHA256:trusted", "openpgp:signed=" + gpgKeyID}, actual)
+	})
+
+	t.Run("sealed", func(t *testing.T) {
+		require.NoError(t, repo.sshCmd(sshKey, "commit", "--allow-empty", "--no-edit", "--message=seal", "--gpg-sign", "--trailer=ArgoCD-gpg-seal: XXX"))
+		sealSha := repo.commitSHA()
+		require.NoError(t, repo.sshCmd(sshKey, "commit", "--allow-empty", "--no-edit", "--message=untrusted seal", "--gpg-sign", "--trailer=ArgoCD-gpg-seal: XXX"))
+		untrustedSealSha := repo.commitSHA()
+
+		info, _, err := repo.git.LsSignatures(t.Context(), untrustedSealSha, true, fakeSSHVerifier)
+		require.NoError(t, err)
+		require.Len(t, info, 2)
+		assert.Equal(t, untrustedSealSha, info[0].Revision)
+		assert.Equal(t, GPGVerificationResultUntrusted, info[0].VerificationResult)
+		assert.Equal(t, sealSha, info[1].Revision)
+		assert.Equal(t, GPGVerificationResultGood, info[1].VerificationResult)
+	})
+
+	t.Run("tag", func(t *testing.T) {
+		require.NoError(t, repo.sshCmd(sshKey, "tag", "--sign", "--message=signed tag", "1.0", "HEAD"))
+
+		info, legacy, err := repo.git.LsSignatures(t.Context(), "1.0", false, fakeSSHVerifier)
+		require.NoError(t, err)
+		require.Len(t, info, 1)
+		assert.Equal(t, "1.0", info[0].Revision)
+		assert.Equal(t, SignatureFormatSSH, info[0].SignatureFormat)
+		assert.Equal(t, GPGVerificationResultGood, info[0].VerificationResult)
+		assert.Equal(t, "SHA256:trusted", info[0].SignatureKeyID)
+		assert.Equal(t, `Test User "<test@example.com>"`, info[0].AuthorIdentity)
+		assert.NotContains(t, legacy, "Good signature")
+
+		info, _, err = repo.git.LsSignatures(t.Context(), "1.0", false, nil)
+		require.NoError(t, err)
+		require.Len(t, info, 1)
+		assert.Equal(t, GPGVerificationResultUnsigned, info[0].VerificationResult)
+		assert.Empty(t, info[0].SignatureFormat)
+	})
+}
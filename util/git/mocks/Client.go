@@ -1152,8 +1152,8 @@ func (_c *Client_LsRemote_Call) RunAndReturn(run func(revision string) (string,
 }
 
 // LsSignatures provides a mock function for the type Client
-func (_mock *Client) LsSignatures(ctx context.Context, revision string, deep bool) ([]git.RevisionSignatureInfo, string, error) {
-	ret := _mock.Called(ctx, revision, deep)
+func (_mock *Client) LsSignatures(ctx context.Context, revision string, deep bool, verifier git.SignatureVerifier) ([]git.RevisionSignatureInfo, string, error) {
+	ret := _mock.Called(ctx, revision, deep, verifier)
 
 	if len(ret) == 0 {
 		panic("no return value specified for LsSignatures")
@@ -1162,23 +1162,23 @@ func (_mock *Client) LsSignatures(ctx context.Context, revision string, deep boo
 	var r0 []git.RevisionSignatureInfo
 	var r1 string
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) ([]git.RevisionSignatureInfo, string, error)); ok {
-		return returnFunc(ctx, revision, deep)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool, git.SignatureVerifier) ([]git.RevisionSignatureInfo, string, error)); ok {
+		return returnFunc(ctx, revision, deep, verifier)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) []git.RevisionSignatureInfo); ok {
-		r0 = returnFunc(ctx, revision, deep)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool, git.SignatureVerifier) []git.RevisionSignatureInfo); ok {
+		r0 = returnFunc(ctx, revision, deep, verifier)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]git.RevisionSignatureInfo)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool) string); ok {
-		r1 = returnFunc(ctx, revision, deep)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool, git.SignatureVerifier) string); ok {
+		r1 = returnFunc(ctx, revision, deep, verifier)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, string, bool) error); ok {
-		r2 = returnFunc(ctx, revision, deep)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string, bool, git.SignatureVerifier) error); ok {
+		r2 = returnFunc(ctx, revision, deep, verifier)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -1194,11 +1194,12 @@ type Client_LsSignatures_Call struct {
 //   - ctx context.Context
 //   - revision string
 //   - deep bool
-func (_e *Client_Expecter) LsSignatures(ctx any, revision any, deep any) *Client_LsSignatures_Call {
-	return &Client_LsSignatures_Call{Call: _e.mock.On("LsSignatures", ctx, revision, deep)}
+//   - verifier git.SignatureVerifier
+func (_e *Client_Expecter) LsSignatures(ctx any, revision any, deep any, verifier any) *Client_LsSignatures_Call {
+	return &Client_LsSignatures_Call{Call: _e.mock.On("LsSignatures", ctx, revision, deep, verifier)}
 }
 
-func (_c *Client_LsSignatures_Call) Run(run func(ctx context.Context, revision string, deep bool)) *Client_LsSignatures_Call {
+func (_c *Client_LsSignatures_Call) Run(run func(ctx context.Context, revision string, deep bool, verifier git.SignatureVerifier)) *Client_LsSignatures_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1212,10 +1213,15 @@ func (_c *Client_LsSignatures_Call) Run(run func(ctx context.Context, revision s
 		if args[2] != nil {
 			arg2 = args[2].(bool)
 		}
+		var arg3 git.SignatureVerifier
+		if args[3] != nil {
+			arg3 = args[3].(git.SignatureVerifier)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -1226,7 +1232,7 @@ func (_c *Client_LsSignatures_Call) Return(revisionSignatureInfos []git.Revision
 	return _c
 }
 
-func (_c *Client_LsSignatures_Call) RunAndReturn(run func(ctx context.Context, revision string, deep bool) ([]git.RevisionSignatureInfo, string, error)) *Client_LsSignatures_Call {
+func (_c *Client_LsSignatures_Call) RunAndReturn(run func(ctx context.Context, revision string, deep bool, verifier git.SignatureVerifier) ([]git.RevisionSignatureInfo, string, error)) *Client_LsSignatures_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1576,6 +1582,78 @@ func (_c *Client_Submodule_Call) RunAndReturn(run func(ctx context.Context) erro
 	return _c
 }
 
+// TreeSHA provides a mock function for the type Client
+func (_mock *Client) TreeSHA(ctx context.Context, revision string, path string) (string, error) {
+	ret := _mock.Called(ctx, revision, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TreeSHA")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return returnFunc(ctx, revision, path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = returnFunc(ctx, revision, path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, revision, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_TreeSHA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TreeSHA'
+type Client_TreeSHA_Call struct {
+	*mock.Call
+}
+
+// TreeSHA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revision string
+//   - path string
+func (_e *Client_Expecter) TreeSHA(ctx any, revision any, path any) *Client_TreeSHA_Call {
+	return &Client_TreeSHA_Call{Call: _e.mock.On("TreeSHA", ctx, revision, path)}
+}
+
+func (_c *Client_TreeSHA_Call) Run(run func(ctx context.Context, revision string, path string)) *Client_TreeSHA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_TreeSHA_Call) Return(s string, err error) *Client_TreeSHA_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_TreeSHA_Call) RunAndReturn(run func(ctx context.Context, revision string, path string) (string, error)) *Client_TreeSHA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyCommitSignature provides a mock function for the type Client
 func (_mock *Client) VerifyCommitSignature(ctx context.Context, revision string) (string, error) {
 	ret := _mock.Called(ctx, revision)
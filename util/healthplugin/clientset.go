@@ -0,0 +1,84 @@
+package healthplugin
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path/filepath"
+	"sync"
+	"time"
+
+	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/env"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// MaxGRPCMessageSize contains max grpc message size
+var MaxGRPCMessageSize = env.ParseNumFromEnv(common.EnvGRPCMaxSizeMB, 100, 0, math.MaxInt32) * 1024 * 1024
+
+// Clientset represents health plugin api clients
+type Clientset interface {
+	NewHealthPluginClient(ctx context.Context, plugin string) (utilio.Closer, HealthPluginServiceClient, error)
+}
+
+// clientSet keeps one connection to each health plugin, which is shared by all the health assessments, as the health
+// of every resource assessed by a plugin is assessed each time its application is compared
+type clientSet struct {
+	sockFilePath string
+	lock         sync.Mutex
+	conns        map[string]*grpc.ClientConn
+}
+
+// NewHealthPluginClient returns a client of the health plugin using the connection to the plugin, which is established
+// on first use and re-established by gRPC when it is lost. The returned closer does not close the shared connection.
+func (c *clientSet) NewHealthPluginClient(_ context.Context, plugin string) (utilio.Closer, HealthPluginServiceClient, error) {
+	if plugin == "" || filepath.Base(plugin) != plugin {
+		return nil, nil, fmt.Errorf("invalid health plugin name %q", plugin)
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	conn, ok := c.conns[plugin]
+	if !ok {
+		var err error
+		conn, err = NewConnection(filepath.Join(c.sockFilePath, plugin+".sock"))
+		if err != nil {
+			return nil, nil, err
+		}
+		c.conns[plugin] = conn
+	}
+	return utilio.NopCloser, NewHealthPluginServiceClient(conn), nil
+}
+
+// NewConnection returns a connection to the health plugin listening on the unix socket at the given address. The
+// connection is established lazily, and the socket is dialed again whenever the connection is lost.
+func NewConnection(address string) (*grpc.ClientConn, error) {
+	retryOpts := []grpc_retry.CallOption{
+		grpc_retry.WithMax(3),
+		grpc_retry.WithBackoff(grpc_retry.BackoffLinear(100 * time.Millisecond)),
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(grpc_retry.UnaryClientInterceptor(retryOpts...)),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(MaxGRPCMessageSize), grpc.MaxCallSendMsgSize(MaxGRPCMessageSize)),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
+
+	conn, err := grpc.NewClient("unix://"+address, dialOpts...)
+	if err != nil {
+		log.Errorf("Unable to create client of health plugin with address %s", address)
+		return nil, err
+	}
+	return conn, nil
+}
+
+// NewHealthPluginClientSet creates new instance of health plugin Clientset, connecting to the plugins which listen on
+// the <plugin name>.sock unix sockets of the given directory
+func NewHealthPluginClientSet(sockFilePath string) Clientset {
+	return &clientSet{sockFilePath: sockFilePath, conns: make(map[string]*grpc.ClientConn)}
+}
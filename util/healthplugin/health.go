@@ -0,0 +1,68 @@
+package healthplugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/env"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/lua"
+)
+
+// timeout is the maximum duration of the health assessment of a resource by a health plugin. It is kept short, as the
+// resources are assessed one after the other when their application is compared.
+var timeout = env.ParseDurationFromEnv(common.EnvHealthPluginTimeout, 2*time.Second, 0, math.MaxInt64)
+
+// GetHealthPlugin returns the name of the health plugin configured in the resource overrides for the given group version
+// kind, or an empty string if the health of the resource is not assessed by a plugin. As for health Lua scripts, an
+// override of the exact group kind takes precedence over the wildcard overrides.
+func GetHealthPlugin(overrides map[string]appv1.ResourceOverride, gvk schema.GroupVersionKind) string {
+	key := lua.GetConfigMapKey(gvk)
+	if override, ok := overrides[key]; ok && override.HealthPlugin != "" {
+		return override.HealthPlugin
+	}
+	for pattern, override := range overrides {
+		if override.HealthPlugin != "" && glob.Match(pattern, key) {
+			return override.HealthPlugin
+		}
+	}
+	return ""
+}
+
+// GetResourceHealth assesses the health of the resource with the given health plugin. The children are the nodes of
+// the resource tree of the resource.
+func GetResourceHealth(ctx context.Context, clientset Clientset, plugin string, obj *unstructured.Unstructured, children []*appv1.ResourceNode) (*health.HealthStatus, error) {
+	manifest, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling resource: %w", err)
+	}
+
+	closer, client, err := clientset.NewHealthPluginClient(ctx, plugin)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to health plugin %s: %w", plugin, err)
+	}
+	defer utilio.Close(closer)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	res, err := client.GetHealth(ctx, &HealthRequest{Resource: string(manifest), Children: children})
+	if err != nil {
+		return nil, fmt.Errorf("error getting health from health plugin %s: %w", plugin, err)
+	}
+	status := health.HealthStatusCode(res.Status)
+	switch status {
+	case health.HealthStatusUnknown, health.HealthStatusProgressing, health.HealthStatusSuspended, health.HealthStatusHealthy, health.HealthStatusDegraded, health.HealthStatusMissing:
+		return &health.HealthStatus{Status: status, Message: res.Message}, nil
+	}
+	return nil, fmt.Errorf("health plugin %s returned invalid health status %q", plugin, res.Status)
+}
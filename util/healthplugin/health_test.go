@@ -0,0 +1,116 @@
+package healthplugin
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeHealthPlugin struct {
+	UnimplementedHealthPluginServiceServer
+	requests []*HealthRequest
+	response *HealthResponse
+}
+
+func (p *fakeHealthPlugin) GetHealth(_ context.Context, req *HealthRequest) (*HealthResponse, error) {
+	p.requests = append(p.requests, req)
+	return p.response, nil
+}
+
+// startHealthPlugin serves the plugin on the <name>.sock unix socket of a temporary directory, which is returned
+func startHealthPlugin(t *testing.T, name string, plugin *fakeHealthPlugin) string {
+	t.Helper()
+	dir := t.TempDir()
+	listener, err := net.Listen("unix", filepath.Join(dir, name+".sock"))
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	RegisterHealthPluginServiceServer(server, plugin)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	return dir
+}
+
+func testResource() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.com/v1",
+		"kind":       "MyKind",
+		"metadata":   map[string]any{"name": "my-resource", "namespace": "default"},
+		"status":     map[string]any{"phase": "Running"},
+	}}
+}
+
+func TestGetHealthPlugin(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "MyKind"}
+
+	assert.Empty(t, GetHealthPlugin(nil, gvk))
+	assert.Empty(t, GetHealthPlugin(map[string]appv1.ResourceOverride{"example.com/MyKind": {HealthLua: "return {}"}}, gvk))
+	assert.Equal(t, "my-plugin", GetHealthPlugin(map[string]appv1.ResourceOverride{"example.com/MyKind": {HealthPlugin: "my-plugin"}}, gvk))
+	assert.Equal(t, "wildcard-plugin", GetHealthPlugin(map[string]appv1.ResourceOverride{"*.com/*": {HealthPlugin: "wildcard-plugin"}}, gvk))
+	assert.Equal(t, "my-plugin", GetHealthPlugin(map[string]appv1.ResourceOverride{
+		"example.com/MyKind": {HealthPlugin: "my-plugin"},
+		"*.com/*":            {HealthPlugin: "wildcard-plugin"},
+	}, gvk))
+	assert.Empty(t, GetHealthPlugin(map[string]appv1.ResourceOverride{"other.io/*": {HealthPlugin: "other-plugin"}}, gvk))
+}
+
+func TestGetResourceHealth(t *testing.T) {
+	t.Run("Healthy", func(t *testing.T) {
+		plugin := &fakeHealthPlugin{response: &HealthResponse{Status: "Healthy", Message: "all replicas are ready"}}
+		clientset := NewHealthPluginClientSet(startHealthPlugin(t, "my-plugin", plugin))
+		children := []*appv1.ResourceNode{{ResourceRef: appv1.ResourceRef{Kind: "Pod", Namespace: "default", Name: "my-resource-0"}}}
+
+		healthStatus, err := GetResourceHealth(t.Context(), clientset, "my-plugin", testResource(), children)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy, Message: "all replicas are ready"}, healthStatus)
+
+		require.Len(t, plugin.requests, 1)
+		var sent unstructured.Unstructured
+		require.NoError(t, json.Unmarshal([]byte(plugin.requests[0].Resource), &sent.Object))
+		assert.Equal(t, testResource(), &sent)
+		require.Len(t, plugin.requests[0].Children, 1)
+		assert.Equal(t, "my-resource-0", plugin.requests[0].Children[0].Name)
+	})
+	t.Run("SharedConnection", func(t *testing.T) {
+		plugin := &fakeHealthPlugin{response: &HealthResponse{Status: "Healthy"}}
+		clientset := NewHealthPluginClientSet(startHealthPlugin(t, "my-plugin", plugin))
+
+		for range 2 {
+			_, err := GetResourceHealth(t.Context(), clientset, "my-plugin", testResource(), nil)
+			require.NoError(t, err)
+		}
+		assert.Len(t, plugin.requests, 2)
+		assert.Len(t, clientset.(*clientSet).conns, 1)
+	})
+	t.Run("InvalidStatus", func(t *testing.T) {
+		plugin := &fakeHealthPlugin{response: &HealthResponse{Status: "Fine"}}
+		clientset := NewHealthPluginClientSet(startHealthPlugin(t, "my-plugin", plugin))
+
+		_, err := GetResourceHealth(t.Context(), clientset, "my-plugin", testResource(), nil)
+		require.EqualError(t, err, `health plugin my-plugin returned invalid health status "Fine"`)
+	})
+	t.Run("UnknownPlugin", func(t *testing.T) {
+		clientset := NewHealthPluginClientSet(t.TempDir())
+
+		_, err := GetResourceHealth(t.Context(), clientset, "my-plugin", testResource(), nil)
+		require.ErrorContains(t, err, "error getting health from health plugin my-plugin")
+	})
+	t.Run("InvalidPluginName", func(t *testing.T) {
+		clientset := NewHealthPluginClientSet(t.TempDir())
+
+		_, err := GetResourceHealth(t.Context(), clientset, "../my-plugin", testResource(), nil)
+		require.ErrorContains(t, err, `invalid health plugin name "../my-plugin"`)
+	})
+}
@@ -3,8 +3,10 @@ package helm
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -29,6 +31,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/cache"
+	"github.com/argoproj/argo-cd/v3/util/cache/disk"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
 	"github.com/argoproj/argo-cd/v3/util/proxy"
@@ -80,6 +83,14 @@ func WithChartPaths(chartPaths utilio.TempPaths) ClientOpts {
 	}
 }
 
+// WithDiskCache sets the disk cache the downloaded chart archives are stored in, and restored from when they are not
+// downloaded yet.
+func WithDiskCache(diskCache *disk.Cache) ClientOpts {
+	return func(c *nativeHelmChart) {
+		c.diskCache = diskCache
+	}
+}
+
 // WithUserAgent sets a custom User-Agent string for HTTP requests.
 // If not set, a default User-Agent will be generated automatically.
 func WithUserAgent(userAgent string) ClientOpts {
@@ -126,6 +137,7 @@ var _ Client = &nativeHelmChart{}
 
 type nativeHelmChart struct {
 	chartCachePaths          utilio.TempPaths
+	diskCache                *disk.Cache
 	repoURL                  string
 	creds                    Creds
 	repoLock                 sync.KeyLock
@@ -218,6 +230,10 @@ func (c *nativeHelmChart) ExtractChart(ctx context.Context, chart string, versio
 		return "", nil, fmt.Errorf("error checking existence of cached chart path: %w", err)
 	}
 
+	if !exists && c.diskCache != nil {
+		exists = c.restoreChartFromDiskCache(chart, version, cachedChartPath)
+	}
+
 	// if chart tar exists, check if it is expired based on cache expiration duration configuration.
 	if exists && c.helmChartCacheExpiration > 0 {
 		info, err := os.Stat(cachedChartPath)
@@ -295,6 +311,10 @@ func (c *nativeHelmChart) ExtractChart(ctx context.Context, chart string, versio
 		if err != nil {
 			return "", nil, fmt.Errorf("error renaming file from %s to %s: %w", chartFilePath, cachedChartPath, err)
 		}
+
+		if c.diskCache != nil {
+			c.storeChartInDiskCache(chart, version, cachedChartPath)
+		}
 	}
 
 	err = untarChart(ctx, tempDir, cachedChartPath, manifestMaxExtractedSize, disableManifestMaxExtractedSize)
@@ -464,6 +484,68 @@ func normalizeChartName(chart string) string {
 	return nc
 }
 
+// diskCacheChartFile is the name of the chart archive in the disk cache entries
+const diskCacheChartFile = "chart.tgz"
+
+func (c *nativeHelmChart) diskCacheRef(chart string, version string) string {
+	return fmt.Sprintf("helm|%s|%s|%s", c.repoURL, chart, version)
+}
+
+// restoreChartFromDiskCache copies the archive of the chart from the disk cache to the given path, and returns whether
+// it was found. The archive keeps the time it was downloaded, so that the chart cache expiration still applies.
+func (c *nativeHelmChart) restoreChartFromDiskCache(chart string, version string, cachedChartPath string) bool {
+	key, ok := c.diskCache.Resolve(c.diskCacheRef(chart, version))
+	if !ok {
+		return false
+	}
+	dir, release, ok := c.diskCache.Get(key)
+	if !ok {
+		return false
+	}
+	defer release()
+	if err := files.CopyFile(filepath.Join(dir, diskCacheChartFile), cachedChartPath); err != nil {
+		log.Warnf("Failed to restore chart %s:%s from disk cache: %v", chart, version, err)
+		_ = os.Remove(cachedChartPath)
+		return false
+	}
+	return true
+}
+
+// storeChartInDiskCache stores the downloaded archive of the chart in the disk cache, addressed by its digest
+func (c *nativeHelmChart) storeChartInDiskCache(chart string, version string, cachedChartPath string) {
+	err := func() error {
+		digest, err := fileDigest(cachedChartPath)
+		if err != nil {
+			return err
+		}
+		key := "helm-chart:" + digest
+		_, release, err := c.diskCache.Put(key, func(dir string) error {
+			return files.CopyFile(cachedChartPath, filepath.Join(dir, diskCacheChartFile))
+		})
+		if err != nil {
+			return err
+		}
+		release()
+		return c.diskCache.Link(c.diskCacheRef(chart, version), key)
+	}()
+	if err != nil {
+		log.Warnf("Failed to store chart %s:%s in disk cache: %v", chart, version, err)
+	}
+}
+
+func fileDigest(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
 func (c *nativeHelmChart) getCachedChartPath(chart string, version string) (string, error) {
 	keyData, err := json.Marshal(map[string]string{"url": c.repoURL, "chart": chart, "version": version})
 	if err != nil {
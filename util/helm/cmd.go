@@ -394,8 +394,13 @@ type TemplateOpts struct {
 	SkipCrds             bool
 	SkipSchemaValidation bool
 	SkipTests            bool
+	// PostRenderer, if set, post-processes the rendered manifests (Helm's --post-renderer)
+	PostRenderer PostRenderer
 }
 
+// PostRenderer receives the manifests rendered by helm template and returns the post-rendered manifests
+type PostRenderer func(manifests string) (string, error)
+
 func cleanSetParameters(val string) string {
 	// `{}` equal helm list parameters format, so don't escape `,`.
 	if strings.HasPrefix(val, `{`) && strings.HasSuffix(val, `}`) {
@@ -478,6 +483,12 @@ func (c *Cmd) template(chartPath string, opts *TemplateOpts) (string, string, er
 		}
 		return "", command, errors.New(msg)
 	}
+	if opts.PostRenderer != nil {
+		out, err = opts.PostRenderer(out)
+		if err != nil {
+			return "", command, fmt.Errorf("failed to post-render manifests: %w", err)
+		}
+	}
 	return out, command, nil
 }
 
@@ -0,0 +1,327 @@
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/argoproj/pkg/v2/sync"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/util/cache/disk"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+// chartDependencies holds the dependencies declared in Chart.yaml or requirements.yaml, or locked in Chart.lock or
+// requirements.lock
+type chartDependencies struct {
+	Dependencies []chartDependency `json:"dependencies"`
+}
+
+type chartDependency struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	Repository string `json:"repository"`
+}
+
+// DependencyCache stores the dependency archives downloaded by `helm dependency build`, addressed by the digest of the
+// lock file of the chart and of the repositories and credentials the dependencies are downloaded with, so that the
+// dependencies are not downloaded again for each chart with the same lock file, while the archives downloaded with
+// some credentials are never restored for a chart built with other credentials.
+// The dependencies of charts which depend on local (file://) charts are not cached, since the content of local charts
+// is not pinned by the lock file.
+type DependencyCache struct {
+	paths     utilio.TempPaths
+	diskCache *disk.Cache
+	lock      sync.KeyLock
+}
+
+// NewDependencyCache returns a dependency cache which stores the archives in the given chart cache paths, and in the
+// disk cache if it is not nil
+func NewDependencyCache(paths utilio.TempPaths, diskCache *disk.Cache) *DependencyCache {
+	return &DependencyCache{paths: paths, diskCache: diskCache, lock: sync.NewKeyLock()}
+}
+
+// Restore copies the cached dependency archives of the chart in the given directory, downloaded from the given
+// repositories, into its charts directory, and returns whether they were found. The archives are not restored if the
+// lock file of the chart is out of sync with its declared dependencies, so that `helm dependency build` reports it.
+func (c *DependencyCache) Restore(chartPath string, repos []HelmRepository) (bool, error) {
+	digest, ok, err := cacheDigest(chartPath, repos)
+	if err != nil || !ok {
+		return false, err
+	}
+	if err := checkLockInSync(chartPath); err != nil {
+		return false, err
+	}
+	cachePath, err := c.getCachePath(digest)
+	if err != nil {
+		return false, err
+	}
+	c.lock.RLock(cachePath)
+	defer c.lock.RUnlock(cachePath)
+
+	exists, err := fileExist(cachePath)
+	if err != nil {
+		return false, err
+	}
+	if !exists && c.diskCache != nil {
+		dir, release, ok := c.diskCache.Get(diskCacheDependenciesKey(digest))
+		if ok {
+			defer release()
+			cachePath, exists = dir, true
+		}
+	}
+	if !exists {
+		return false, nil
+	}
+	if err := copyArchives(cachePath, filepath.Join(chartPath, "charts")); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Store stores the dependency archives in the charts directory of the chart in the given directory, which must have
+// been built by `helm dependency build` with the given repositories
+func (c *DependencyCache) Store(chartPath string, repos []HelmRepository) error {
+	digest, ok, err := cacheDigest(chartPath, repos)
+	if err != nil || !ok {
+		return err
+	}
+	cachePath, err := c.getCachePath(digest)
+	if err != nil {
+		return err
+	}
+	c.lock.Lock(cachePath)
+	defer c.lock.Unlock(cachePath)
+
+	exists, err := fileExist(cachePath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// the archives are copied to a temporary directory first, so that a partially stored entry is never restored
+		tmp, err := os.MkdirTemp(filepath.Dir(cachePath), "helm-dependencies")
+		if err != nil {
+			return fmt.Errorf("error creating temporary directory: %w", err)
+		}
+		defer func() { _ = os.RemoveAll(tmp) }()
+		if err := copyArchives(filepath.Join(chartPath, "charts"), tmp); err != nil {
+			return err
+		}
+		if err := os.Rename(tmp, cachePath); err != nil {
+			return fmt.Errorf("error renaming %s to %s: %w", tmp, cachePath, err)
+		}
+	}
+	if c.diskCache != nil {
+		_, release, err := c.diskCache.Put(diskCacheDependenciesKey(digest), func(dir string) error {
+			return copyArchives(cachePath, dir)
+		})
+		if err != nil {
+			log.Warnf("Failed to store dependencies of chart %s in disk cache: %v", chartPath, err)
+		} else {
+			release()
+		}
+	}
+	return nil
+}
+
+func (c *DependencyCache) getCachePath(digest string) (string, error) {
+	keyData, err := json.Marshal(map[string]string{"helmDependencies": digest})
+	if err != nil {
+		return "", fmt.Errorf("error marshaling cache key data: %w", err)
+	}
+	return c.paths.GetPath(string(keyData))
+}
+
+func diskCacheDependenciesKey(digest string) string {
+	return "helm-dependencies:" + digest
+}
+
+// cacheDigest returns the digest of the lock file of the chart in the given directory and of the given repositories
+// and their credentials, and whether the dependencies of the chart can be cached
+func cacheDigest(chartPath string, repos []HelmRepository) (string, bool, error) {
+	lockFile, err := findFile(chartPath, "Chart.lock", "requirements.lock")
+	if err != nil || lockFile == "" {
+		return "", false, err
+	}
+	deps, err := loadDependencies(lockFile)
+	if err != nil {
+		return "", false, err
+	}
+	for _, dep := range deps.Dependencies {
+		if isLocalDependency(dep.Repository) {
+			return "", false, nil
+		}
+	}
+	lockDigest, err := fileDigest(lockFile)
+	if err != nil {
+		return "", false, fmt.Errorf("error computing digest of %s: %w", lockFile, err)
+	}
+	h := sha256.New()
+	h.Write([]byte(lockDigest))
+	repos = slices.Clone(repos)
+	slices.SortFunc(repos, func(a, b HelmRepository) int {
+		return strings.Compare(a.Repo+"|"+a.Name, b.Repo+"|"+b.Name)
+	})
+	for _, repo := range repos {
+		data, err := json.Marshal([]any{repo.Name, repo.Repo, repo.EnableOci, repo.InsecureOCIForceHttp, credentialsIdentity(repo.Creds)})
+		if err != nil {
+			return "", false, fmt.Errorf("error marshaling repository %s: %w", repo.Repo, err)
+		}
+		h.Write(data)
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), true, nil
+}
+
+// credentialsIdentity returns the static parts of the given credentials which identify them. The password of Azure
+// workload identity credentials is a short-lived token and is therefore not part of their identity.
+func credentialsIdentity(creds Creds) []any {
+	switch c := creds.(type) {
+	case nil:
+		return nil
+	case HelmCreds:
+		return []any{"helm", c.Username, c.Password, c.CertData, c.KeyData, c.InsecureSkipVerify}
+	case AzureWorkloadIdentityCreds:
+		return []any{"azure-workload-identity", c.repoURL, c.CertData, c.KeyData, c.InsecureSkipVerify}
+	default:
+		return []any{fmt.Sprintf("%T", creds), creds.GetUsername(), creds.GetCertData(), creds.GetKeyData(), creds.GetInsecureSkipVerify()}
+	}
+}
+
+// checkLockInSync returns an error if the lock file of the chart in the given directory does not lock the dependencies
+// declared by the chart, like Helm does before building the dependencies
+func checkLockInSync(chartPath string) error {
+	lockFile, err := findFile(chartPath, "Chart.lock", "requirements.lock")
+	if err != nil || lockFile == "" {
+		return err
+	}
+	locked, err := loadDependencies(lockFile)
+	if err != nil {
+		return err
+	}
+	declared, err := loadDeclaredDependencies(chartPath)
+	if err != nil {
+		return err
+	}
+	outOfSync := fmt.Errorf("%s is out of sync with the declared dependencies", filepath.Base(lockFile))
+	if len(declared.Dependencies) != len(locked.Dependencies) {
+		return outOfSync
+	}
+	for _, dep := range declared.Dependencies {
+		i := slices.IndexFunc(locked.Dependencies, func(lockedDep chartDependency) bool {
+			return lockedDep.Name == dep.Name && lockedDep.Repository == dep.Repository
+		})
+		if i < 0 {
+			return outOfSync
+		}
+		constraint, err := semver.NewConstraint(dep.Version)
+		if err != nil {
+			return fmt.Errorf("invalid version %q of dependency %s: %w", dep.Version, dep.Name, err)
+		}
+		version, err := semver.NewVersion(locked.Dependencies[i].Version)
+		if err != nil || !constraint.Check(version) {
+			return outOfSync
+		}
+	}
+	return nil
+}
+
+// RemoteDependencies returns the dependencies of the chart in the given directory which `helm dependency build`
+// downloads from a remote repository, as <name>:<version>. The dependencies are read from the lock file of the chart,
+// or from the declared dependencies if the chart has no lock file.
+func RemoteDependencies(chartPath string) ([]string, error) {
+	depsFile, err := findFile(chartPath, "Chart.lock", "requirements.lock")
+	if err != nil {
+		return nil, err
+	}
+	var deps *chartDependencies
+	if depsFile != "" {
+		deps, err = loadDependencies(depsFile)
+	} else {
+		deps, err = loadDeclaredDependencies(chartPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var remote []string
+	for _, dep := range deps.Dependencies {
+		if !isLocalDependency(dep.Repository) {
+			remote = append(remote, dep.Name+":"+dep.Version)
+		}
+	}
+	return remote, nil
+}
+
+func isLocalDependency(repository string) bool {
+	return strings.HasPrefix(repository, "file://")
+}
+
+// findFile returns the path of the first of the given files which exists in the given directory, or an empty string
+// if none exists
+func findFile(dir string, names ...string) (string, error) {
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		exists, err := fileExist(path)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return path, nil
+		}
+	}
+	return "", nil
+}
+
+// loadDeclaredDependencies returns the dependencies declared by the chart in the given directory
+func loadDeclaredDependencies(chartPath string) (*chartDependencies, error) {
+	// charts with apiVersion v1 declare their dependencies in requirements.yaml
+	depsFile, err := findFile(chartPath, "requirements.yaml", "Chart.yaml")
+	if err != nil {
+		return nil, err
+	}
+	if depsFile == "" {
+		return nil, fmt.Errorf("no Chart.yaml found in %s", chartPath)
+	}
+	return loadDependencies(depsFile)
+}
+
+func loadDependencies(path string) (*chartDependencies, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", path, err)
+	}
+	deps := &chartDependencies{}
+	if err := yaml.Unmarshal(data, deps); err != nil {
+		return nil, fmt.Errorf("error unmarshalling %s: %w", path, err)
+	}
+	return deps, nil
+}
+
+// copyArchives copies the chart archives in the source directory into the destination directory
+func copyArchives(srcDir string, dstDir string) error {
+	archives, err := filepath.Glob(filepath.Join(srcDir, "*.tgz"))
+	if err != nil {
+		return err
+	}
+	if len(archives) == 0 {
+		return errors.New("no dependency archives found in " + srcDir)
+	}
+	if err := os.MkdirAll(dstDir, 0o755); err != nil {
+		return fmt.Errorf("error creating directory %s: %w", dstDir, err)
+	}
+	for _, archive := range archives {
+		if err := files.CopyFile(archive, filepath.Join(dstDir, filepath.Base(archive))); err != nil {
+			return fmt.Errorf("error copying %s: %w", archive, err)
+		}
+	}
+	return nil
+}
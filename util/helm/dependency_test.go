@@ -0,0 +1,187 @@
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/cache/disk"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const remoteChart = `name: chart
+dependencies:
+- name: redis
+  repository: https://charts.example.com
+  version: ~1.0.0
+`
+
+const remoteChartLock = `dependencies:
+- name: redis
+  repository: https://charts.example.com
+  version: 1.0.0
+digest: sha256:0d3e0b1ee2b4c3bf8f1a5b4dc1d6e2c6d8d5e1e6c0c0f3d0e0a7f4b1a1f1e1e1
+generated: "2024-01-01T00:00:00Z"
+`
+
+// newChart creates a chart with the given files in a temporary directory
+func newChart(t *testing.T, chartFiles map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range chartFiles {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	return dir
+}
+
+func TestDependencyCache(t *testing.T) {
+	t.Parallel()
+
+	repos := []HelmRepository{{Repo: "https://charts.example.com", Creds: HelmCreds{Username: "user", Password: "secret"}}}
+
+	t.Run("restore stored dependencies", func(t *testing.T) {
+		t.Parallel()
+		cache := NewDependencyCache(utilio.NewRandomizedTempPaths(t.TempDir()), nil)
+
+		chart := newChart(t, map[string]string{"Chart.yaml": remoteChart, "Chart.lock": remoteChartLock})
+		restored, err := cache.Restore(chart, repos)
+		require.NoError(t, err)
+		assert.False(t, restored)
+
+		require.NoError(t, os.MkdirAll(filepath.Join(chart, "charts"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(chart, "charts", "redis-1.0.0.tgz"), []byte("archive"), 0o644))
+		require.NoError(t, cache.Store(chart, repos))
+
+		other := newChart(t, map[string]string{"Chart.yaml": remoteChart, "Chart.lock": remoteChartLock})
+		restored, err = cache.Restore(other, repos)
+		require.NoError(t, err)
+		assert.True(t, restored)
+		data, err := os.ReadFile(filepath.Join(other, "charts", "redis-1.0.0.tgz"))
+		require.NoError(t, err)
+		assert.Equal(t, "archive", string(data))
+
+		changed := newChart(t, map[string]string{"Chart.yaml": remoteChart, "Chart.lock": remoteChartLock + "# changed\n"})
+		restored, err = cache.Restore(changed, repos)
+		require.NoError(t, err)
+		assert.False(t, restored)
+
+		// the archives are not restored for a chart whose dependencies are downloaded with other credentials
+		otherCreds := []HelmRepository{{Repo: "https://charts.example.com", Creds: HelmCreds{Username: "other", Password: "secret"}}}
+		restored, err = cache.Restore(newChart(t, map[string]string{"Chart.yaml": remoteChart, "Chart.lock": remoteChartLock}), otherCreds)
+		require.NoError(t, err)
+		assert.False(t, restored)
+	})
+
+	t.Run("lock file out of sync", func(t *testing.T) {
+		t.Parallel()
+		cache := NewDependencyCache(utilio.NewRandomizedTempPaths(t.TempDir()), nil)
+		chart := newChart(t, map[string]string{"Chart.yaml": remoteChart, "Chart.lock": remoteChartLock, "charts/redis-1.0.0.tgz": "archive"})
+		require.NoError(t, cache.Store(chart, repos))
+
+		for name, chartYAML := range map[string]string{
+			"version": `name: chart
+dependencies:
+- name: redis
+  repository: https://charts.example.com
+  version: ~2.0.0
+`,
+			"repository": `name: chart
+dependencies:
+- name: redis
+  repository: https://other.example.com
+  version: ~1.0.0
+`,
+			"added dependency": remoteChart + `- name: postgresql
+  repository: https://charts.example.com
+  version: 1.0.0
+`,
+		} {
+			restored, err := cache.Restore(newChart(t, map[string]string{"Chart.yaml": chartYAML, "Chart.lock": remoteChartLock}), repos)
+			require.ErrorContains(t, err, "Chart.lock is out of sync", name)
+			assert.False(t, restored, name)
+		}
+	})
+
+	t.Run("restore dependencies from disk cache", func(t *testing.T) {
+		t.Parallel()
+		diskCache, err := disk.NewCache(t.TempDir(), 1024*1024)
+		require.NoError(t, err)
+
+		chart := newChart(t, map[string]string{"Chart.yaml": remoteChart, "Chart.lock": remoteChartLock, "charts/redis-1.0.0.tgz": "archive"})
+		require.NoError(t, NewDependencyCache(utilio.NewRandomizedTempPaths(t.TempDir()), diskCache).Store(chart, repos))
+
+		// a new cache with empty chart cache paths, like after a restart of the repo server
+		other := newChart(t, map[string]string{"Chart.yaml": remoteChart, "Chart.lock": remoteChartLock})
+		restored, err := NewDependencyCache(utilio.NewRandomizedTempPaths(t.TempDir()), diskCache).Restore(other, repos)
+		require.NoError(t, err)
+		assert.True(t, restored)
+		assert.FileExists(t, filepath.Join(other, "charts", "redis-1.0.0.tgz"))
+	})
+
+	t.Run("local dependencies are not cached", func(t *testing.T) {
+		t.Parallel()
+		cache := NewDependencyCache(utilio.NewRandomizedTempPaths(t.TempDir()), nil)
+		chartLock := `dependencies:
+- name: common
+  repository: file://../common
+  version: 1.0.0
+`
+		chart := newChart(t, map[string]string{"Chart.lock": chartLock, "charts/common-1.0.0.tgz": "archive"})
+		require.NoError(t, cache.Store(chart, nil))
+
+		restored, err := cache.Restore(newChart(t, map[string]string{"Chart.lock": chartLock}), nil)
+		require.NoError(t, err)
+		assert.False(t, restored)
+	})
+
+	t.Run("charts without lock file are not cached", func(t *testing.T) {
+		t.Parallel()
+		cache := NewDependencyCache(utilio.NewRandomizedTempPaths(t.TempDir()), nil)
+		chart := newChart(t, map[string]string{"Chart.yaml": "name: chart", "charts/redis-1.0.0.tgz": "archive"})
+		require.NoError(t, cache.Store(chart, nil))
+
+		restored, err := cache.Restore(chart, nil)
+		require.NoError(t, err)
+		assert.False(t, restored)
+	})
+}
+
+func TestRemoteDependencies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		chartFiles map[string]string
+		expected   []string
+	}{
+		{"lock file", map[string]string{"Chart.lock": remoteChartLock}, []string{"redis:1.0.0"}},
+		{"declared dependencies", map[string]string{"Chart.yaml": `name: chart
+dependencies:
+- name: redis
+  repository: oci://registry.example.com/charts
+  version: ~1.0.0
+- name: common
+  repository: file://../common
+  version: 1.0.0
+`}, []string{"redis:~1.0.0"}},
+		{"requirements", map[string]string{"Chart.yaml": "name: chart", "requirements.yaml": `dependencies:
+- name: redis
+  repository: "@stable"
+  version: 1.0.0
+`}, []string{"redis:1.0.0"}},
+		{"no dependencies", map[string]string{"Chart.yaml": "name: chart"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dependencies, err := RemoteDependencies(newChart(t, tt.chartFiles))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, dependencies)
+		})
+	}
+}
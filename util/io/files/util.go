@@ -3,10 +3,12 @@ package files
 import (
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -92,3 +94,72 @@ func Inbound(candidate, baseDir string) bool {
 	}
 	return strings.HasPrefix(target, filepath.Clean(baseDir)+string(os.PathSeparator))
 }
+
+// CopyDir copies the content of the srcDir directory into the dstDir
+// directory, which is created if it does not exist. Symlinks are
+// copied as symlinks, without being followed. The top-level entries
+// of srcDir with a name in exclusions are not copied.
+func CopyDir(srcDir, dstDir string, exclusions ...string) error {
+	return filepath.WalkDir(srcDir, func(srcPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(srcDir, srcPath)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && relPath != "." && slices.Contains(exclusions, relPath) {
+			return filepath.SkipDir
+		}
+		if !d.IsDir() && slices.Contains(exclusions, relPath) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		dstPath := filepath.Join(dstDir, relPath)
+		switch {
+		case d.IsDir():
+			return os.MkdirAll(dstPath, info.Mode().Perm()|0o700)
+		case IsSymlink(info):
+			target, err := os.Readlink(srcPath)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(target, dstPath)
+		case info.Mode().IsRegular():
+			return CopyFile(srcPath, dstPath)
+		default:
+			// sockets, devices and pipes are not copied
+			return nil
+		}
+	})
+}
+
+// CopyFile copies the srcPath file to dstPath, keeping its
+// permissions and modification time. An existing dstPath file is
+// overwritten.
+func CopyFile(srcPath, dstPath string) error {
+	info, err := os.Stat(srcPath)
+	if err != nil {
+		return err
+	}
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.OpenFile(dstPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	return os.Chtimes(dstPath, info.ModTime(), info.ModTime())
+}
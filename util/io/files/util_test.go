@@ -1,9 +1,13 @@
 package files_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/argoproj/argo-cd/v3/util/io/files"
 )
@@ -145,3 +149,32 @@ func TestInbound(t *testing.T) {
 		})
 	}
 }
+
+func TestCopyDir(t *testing.T) {
+	t.Parallel()
+
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "app", "base"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "app", "base", "kustomization.yaml"), []byte("resources: []"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "run.sh"), []byte("#!/bin/sh"), 0o755))
+	require.NoError(t, os.Symlink("base", filepath.Join(src, "app", "current")))
+	require.NoError(t, os.MkdirAll(filepath.Join(src, ".git"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, ".git", "HEAD"), []byte("ref: refs/heads/main"), 0o644))
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(filepath.Join(src, "run.sh"), modTime, modTime))
+
+	dst := filepath.Join(t.TempDir(), "copy")
+	require.NoError(t, files.CopyDir(src, dst, ".git"))
+
+	data, err := os.ReadFile(filepath.Join(dst, "app", "base", "kustomization.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "resources: []", string(data))
+	info, err := os.Stat(filepath.Join(dst, "run.sh"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+	assert.True(t, modTime.Equal(info.ModTime()))
+	target, err := os.Readlink(filepath.Join(dst, "app", "current"))
+	require.NoError(t, err)
+	assert.Equal(t, "base", target)
+	assert.NoDirExists(t, filepath.Join(dst, ".git"))
+}
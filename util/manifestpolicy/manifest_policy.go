@@ -0,0 +1,230 @@
+package manifestpolicy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/golang/groupcache/lru"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+const (
+	// costLimit bounds the cost of the evaluation of a policy against one manifest
+	costLimit = 1000000
+	// programCacheSize is the maximum number of compiled policy expressions to cache
+	programCacheSize = 1000
+	// maxReportedManifests is the maximum number of manifests listed in the message of a violation, which is reported as
+	// an application condition
+	maxReportedManifests = 5
+
+	varObject      = "object"
+	varApplication = "application"
+)
+
+var (
+	getEnv = sync.OnceValues(func() (*cel.Env, error) {
+		return cel.NewEnv(
+			cel.Variable(varObject, cel.DynType),
+			cel.Variable(varApplication, cel.MapType(cel.StringType, cel.StringType)),
+		)
+	})
+
+	// programCache holds the compiled policy programs, since the policies are evaluated on every reconciliation
+	programCache = NewProgramCache(programCacheSize, compileProgram)
+)
+
+// ProgramCache is a LRU cache of the programs compiled from CEL expressions, or of the compilation errors, by
+// expression. It is safe for concurrent use.
+type ProgramCache struct {
+	compile func(expression string) (cel.Program, error)
+	cache   *lru.Cache
+	lock    sync.Mutex
+}
+
+// compiledProgram is the result of the compilation of an expression
+type compiledProgram struct {
+	program cel.Program
+	err     error
+}
+
+// NewProgramCache returns a cache of at most size programs, compiled by the given function
+func NewProgramCache(size int, compile func(expression string) (cel.Program, error)) *ProgramCache {
+	return &ProgramCache{compile: compile, cache: lru.New(size)}
+}
+
+// Get returns the program of the CEL expression, compiling it if it is not cached
+func (c *ProgramCache) Get(expression string) (cel.Program, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if compiled, ok := c.cache.Get(expression); ok {
+		return compiled.(*compiledProgram).program, compiled.(*compiledProgram).err
+	}
+
+	program, err := c.compile(expression)
+	c.cache.Add(expression, &compiledProgram{program: program, err: err})
+	return program, err
+}
+
+// Violation is a policy which is not satisfied by some manifests. It is reported as a single application condition,
+// whatever the number of manifests violating the policy.
+type Violation struct {
+	Policy *v1alpha1.ManifestPolicy
+	// Manifests are the violations of the policy by each manifest, in the order of the manifests
+	Manifests []ManifestViolation
+}
+
+// ManifestViolation is a manifest which does not satisfy a policy
+type ManifestViolation struct {
+	Manifest *unstructured.Unstructured
+	// Message explains the violation
+	Message string
+}
+
+// key returns the group, kind, namespace and name of the manifest
+func (v ManifestViolation) key() string {
+	gvk := v.Manifest.GroupVersionKind()
+	name := v.Manifest.GetName()
+	if v.Manifest.GetNamespace() != "" {
+		name = v.Manifest.GetNamespace() + "/" + name
+	}
+	return fmt.Sprintf("%s/%s %s", gvk.Group, gvk.Kind, name)
+}
+
+// Error returns the message of the application condition of the violation. It lists the first maxReportedManifests
+// manifests violating the policy, with the message of each manifest unless all the messages are the same.
+func (v Violation) Error() string {
+	if len(v.Manifests) == 1 {
+		return fmt.Sprintf("manifest policy '%s' violated by %s: %s", v.Policy.Name, v.Manifests[0].key(), v.Manifests[0].Message)
+	}
+	sameMessage := true
+	for _, m := range v.Manifests[1:] {
+		if m.Message != v.Manifests[0].Message {
+			sameMessage = false
+			break
+		}
+	}
+	reported := make([]string, 0, maxReportedManifests+1)
+	for _, m := range v.Manifests[:min(len(v.Manifests), maxReportedManifests)] {
+		if sameMessage {
+			reported = append(reported, m.key())
+		} else {
+			reported = append(reported, fmt.Sprintf("%s (%s)", m.key(), m.Message))
+		}
+	}
+	if len(v.Manifests) > maxReportedManifests {
+		reported = append(reported, fmt.Sprintf("and %d more", len(v.Manifests)-maxReportedManifests))
+	}
+	message := fmt.Sprintf("manifest policy '%s' violated by %d resources: %s", v.Policy.Name, len(v.Manifests), strings.Join(reported, ", "))
+	if sameMessage {
+		message += ": " + v.Manifests[0].Message
+	}
+	return message
+}
+
+// compile returns the program of the CEL expression of a policy, which must return a boolean
+func compile(expression string) (cel.Program, error) {
+	return programCache.Get(expression)
+}
+
+func compileProgram(expression string) (cel.Program, error) {
+	env, err := getEnv()
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("error compiling CEL expression: %w", issues.Err())
+	}
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("CEL expression must return a boolean, got %s", outputType)
+	}
+	program, err := env.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, fmt.Errorf("error creating program of CEL expression: %w", err)
+	}
+	return program, nil
+}
+
+// Validate returns an error if the expression of a policy is invalid
+func Validate(policies []v1alpha1.ManifestPolicy) error {
+	var errs []error
+	for _, policy := range policies {
+		if _, err := compile(policy.CEL); err != nil {
+			errs = append(errs, fmt.Errorf("invalid manifest policy '%s': %w", policy.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// matches returns true if the policy applies to the manifest
+func matches(policy *v1alpha1.ManifestPolicy, manifest *unstructured.Unstructured) bool {
+	if len(policy.Kinds) == 0 {
+		return true
+	}
+	gvk := manifest.GroupVersionKind()
+	for _, kind := range policy.Kinds {
+		if glob.Match(kind.Group, gvk.Group) && glob.Match(kind.Kind, gvk.Kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate returns the violations of the policies by the manifests of the application, one per violated policy. A
+// policy which cannot be compiled or evaluated is reported as violated, so that an invalid enforced policy does not let
+// the manifests through.
+func Evaluate(policies []v1alpha1.ManifestPolicy, app *v1alpha1.Application, manifests []*unstructured.Unstructured) []Violation {
+	var violations []Violation
+	appVar := map[string]string{
+		"name":      app.Name,
+		"namespace": app.Namespace,
+		"project":   app.Spec.GetProject(),
+	}
+	for i := range policies {
+		policy := &policies[i]
+		program, compileErr := compile(policy.CEL)
+		violation := Violation{Policy: policy}
+		for _, manifest := range manifests {
+			if manifest == nil || !matches(policy, manifest) {
+				continue
+			}
+			if compileErr != nil {
+				violation.Manifests = append(violation.Manifests, ManifestViolation{Manifest: manifest, Message: compileErr.Error()})
+				continue
+			}
+			if message := evaluate(program, policy, manifest, appVar); message != "" {
+				violation.Manifests = append(violation.Manifests, ManifestViolation{Manifest: manifest, Message: message})
+			}
+		}
+		if len(violation.Manifests) > 0 {
+			violations = append(violations, violation)
+		}
+	}
+	return violations
+}
+
+// evaluate returns the message of the violation of the policy by the manifest, or an empty string if the manifest
+// satisfies the policy
+func evaluate(program cel.Program, policy *v1alpha1.ManifestPolicy, manifest *unstructured.Unstructured, appVar map[string]string) string {
+	out, _, err := program.Eval(map[string]any{varObject: manifest.Object, varApplication: appVar})
+	if err != nil {
+		return "error evaluating CEL expression: " + err.Error()
+	}
+	if out == types.True {
+		return ""
+	}
+	if out != types.False {
+		return fmt.Sprintf("CEL expression must return a boolean, got %s", out.Type().TypeName())
+	}
+	if policy.Message != "" {
+		return policy.Message
+	}
+	return "failed expression: " + policy.CEL
+}
@@ -0,0 +1,150 @@
+package manifestpolicy
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newApp() *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec:       v1alpha1.ApplicationSpec{Project: "team-a"},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	deployment := test.NewDeployment()
+	configMap := test.NewConfigMap()
+	manifests := []*unstructured.Unstructured{deployment, configMap, nil}
+
+	t.Run("Satisfied", func(t *testing.T) {
+		violations := Evaluate([]v1alpha1.ManifestPolicy{{
+			Name: "labels",
+			CEL:  `has(object.metadata.name) && application.project == "team-a"`,
+		}}, newApp(), manifests)
+		assert.Empty(t, violations)
+	})
+	t.Run("Violated", func(t *testing.T) {
+		policies := []v1alpha1.ManifestPolicy{{
+			Name:    "approved-registry",
+			Kinds:   []metav1.GroupKind{{Group: "apps", Kind: "*"}},
+			CEL:     `object.spec.template.spec.containers.all(c, c.image.startsWith("registry.example.com/"))`,
+			Message: "images must be pulled from registry.example.com",
+			Enforce: true,
+		}, {
+			Name: "no-configmaps",
+			CEL:  `object.kind != "ConfigMap"`,
+		}}
+		violations := Evaluate(policies, newApp(), manifests)
+		require.Len(t, violations, 2)
+		assert.Same(t, &policies[0], violations[0].Policy)
+		require.Len(t, violations[0].Manifests, 1)
+		assert.Same(t, deployment, violations[0].Manifests[0].Manifest)
+		assert.Equal(t, "manifest policy 'approved-registry' violated by apps/Deployment nginx-deployment: images must be pulled from registry.example.com", violations[0].Error())
+		assert.Same(t, &policies[1], violations[1].Policy)
+		require.Len(t, violations[1].Manifests, 1)
+		assert.Equal(t, `failed expression: object.kind != "ConfigMap"`, violations[1].Manifests[0].Message)
+	})
+	t.Run("NoMatchingKind", func(t *testing.T) {
+		violations := Evaluate([]v1alpha1.ManifestPolicy{{
+			Name:  "no-services",
+			Kinds: []metav1.GroupKind{{Kind: "Service"}},
+			CEL:   `false`,
+		}}, newApp(), manifests)
+		assert.Empty(t, violations)
+	})
+	t.Run("InvalidExpression", func(t *testing.T) {
+		violations := Evaluate([]v1alpha1.ManifestPolicy{{
+			Name:  "invalid",
+			Kinds: []metav1.GroupKind{{Kind: "ConfigMap"}},
+			CEL:   `object.kind ==`,
+		}}, newApp(), manifests)
+		require.Len(t, violations, 1)
+		require.Len(t, violations[0].Manifests, 1)
+		assert.Contains(t, violations[0].Manifests[0].Message, "error compiling CEL expression")
+	})
+	t.Run("EvaluationError", func(t *testing.T) {
+		violations := Evaluate([]v1alpha1.ManifestPolicy{{
+			Name:  "missing-field",
+			Kinds: []metav1.GroupKind{{Kind: "ConfigMap"}},
+			CEL:   `object.spec.replicas > 1`,
+		}}, newApp(), manifests)
+		require.Len(t, violations, 1)
+		require.Len(t, violations[0].Manifests, 1)
+		assert.Contains(t, violations[0].Manifests[0].Message, "error evaluating CEL expression")
+	})
+	t.Run("AggregatedByPolicy", func(t *testing.T) {
+		var configMaps []*unstructured.Unstructured
+		for _, name := range []string{"cm-1", "cm-2", "cm-3", "cm-4", "cm-5", "cm-6", "cm-7"} {
+			configMap := test.NewConfigMap()
+			configMap.SetName(name)
+			configMaps = append(configMaps, configMap)
+		}
+		violations := Evaluate([]v1alpha1.ManifestPolicy{{
+			Name:    "no-configmaps",
+			CEL:     `object.kind != "ConfigMap"`,
+			Message: "config maps are not allowed",
+		}}, newApp(), configMaps)
+		require.Len(t, violations, 1)
+		assert.Len(t, violations[0].Manifests, 7)
+		assert.Equal(t, "manifest policy 'no-configmaps' violated by 7 resources: /ConfigMap cm-1, /ConfigMap cm-2, /ConfigMap cm-3, /ConfigMap cm-4, /ConfigMap cm-5, and 2 more: config maps are not allowed", violations[0].Error())
+	})
+	t.Run("AggregatedWithDifferentMessages", func(t *testing.T) {
+		violations := Evaluate([]v1alpha1.ManifestPolicy{{
+			Name: "invalid-replicas",
+			CEL:  `object.spec.replicas > 3`,
+		}}, newApp(), manifests)
+		require.Len(t, violations, 1)
+		require.Len(t, violations[0].Manifests, 2)
+		assert.Equal(t, "manifest policy 'invalid-replicas' violated by 2 resources: apps/Deployment nginx-deployment (failed expression: object.spec.replicas > 3), /ConfigMap my-configmap ("+violations[0].Manifests[1].Message+")", violations[0].Error())
+	})
+}
+
+func TestCompile(t *testing.T) {
+	program, err := compile(`object.kind != "Pod"`)
+	require.NoError(t, err)
+	cached, err := compile(`object.kind != "Pod"`)
+	require.NoError(t, err)
+	assert.Same(t, program, cached)
+
+	_, err = compile(`object.kind ==`)
+	require.Error(t, err)
+	_, cachedErr := compile(`object.kind ==`)
+	assert.Same(t, err, cachedErr)
+}
+
+func TestProgramCache(t *testing.T) {
+	compiled := map[string]int{}
+	cache := NewProgramCache(1, func(expression string) (cel.Program, error) {
+		compiled[expression]++
+		return compileProgram(expression)
+	})
+
+	program, err := cache.Get(`object.kind != "Pod"`)
+	require.NoError(t, err)
+	cached, err := cache.Get(`object.kind != "Pod"`)
+	require.NoError(t, err)
+	assert.Same(t, program, cached)
+	assert.Equal(t, 1, compiled[`object.kind != "Pod"`])
+
+	// the least recently used program is evicted once the cache is full
+	_, err = cache.Get(`object.kind != "Service"`)
+	require.NoError(t, err)
+	_, err = cache.Get(`object.kind != "Pod"`)
+	require.NoError(t, err)
+	assert.Equal(t, 2, compiled[`object.kind != "Pod"`])
+}
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate([]v1alpha1.ManifestPolicy{{Name: "valid", CEL: `object.kind != "Pod"`}}))
+	require.ErrorContains(t, Validate([]v1alpha1.ManifestPolicy{{Name: "syntax", CEL: `object.kind ==`}}), "invalid manifest policy 'syntax'")
+	require.ErrorContains(t, Validate([]v1alpha1.ManifestPolicy{{Name: "type", CEL: `"Pod"`}}), "CEL expression must return a boolean, got string")
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	securejoin "github.com/cyphar/filepath-securejoin"
+	godigest "github.com/opencontainers/go-digest"
 	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"oras.land/oras-go/v2/content/oci"
 
@@ -29,12 +30,15 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-cd/v3/util/cache"
+	"github.com/argoproj/argo-cd/v3/util/cache/disk"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
 	"github.com/argoproj/argo-cd/v3/util/proxy"
 
 	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/errdef"
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
 )
@@ -47,6 +51,11 @@ var (
 const (
 	helmOCIConfigType = "application/vnd.cncf.helm.config.v1+json"
 	helmOCILayerType  = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+
+	cosignSimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	cosignSignatureAnnotation    = "dev.cosignproject.cosign/signature"
+	// cosignMaxPayloadSize guards against fetching unreasonably large blobs posing as signature payloads.
+	cosignMaxPayloadSize = 1024 * 1024
 )
 
 var _ Client = &nativeOCIClient{}
@@ -79,6 +88,19 @@ type Client interface {
 
 	// GetTags retrieves the list of tags for the repository.
 	GetTags(ctx context.Context, noCache bool) ([]string, error)
+
+	// CosignSignatures retrieves the cosign signatures attached to the specified digest. The signatures are looked up
+	// under the `<algorithm>-<hex>.sig` tag of the same repository, following the cosign convention. An empty list is
+	// returned when there are no signatures.
+	CosignSignatures(ctx context.Context, digest string) ([]CosignSignature, error)
+}
+
+// CosignSignature is a signature of an OCI artifact made by cosign.
+type CosignSignature struct {
+	// Payload is the signed simple signing document, referencing the signed digest.
+	Payload []byte
+	// Signature is the base64 encoded signature of the Payload.
+	Signature string
 }
 
 type Creds struct {
@@ -105,6 +127,13 @@ func WithImagePaths(repoCachePaths utilio.TempPaths) ClientOpts {
 	}
 }
 
+// WithDiskCache sets the disk cache the pulled images are stored in, and restored from when they are not pulled yet.
+func WithDiskCache(diskCache *disk.Cache) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.diskCache = diskCache
+	}
+}
+
 func WithManifestMaxExtractedSize(manifestMaxExtractedSize int64) ClientOpts {
 	return func(c *nativeOCIClient) {
 		c.manifestMaxExtractedSize = manifestMaxExtractedSize
@@ -123,6 +152,36 @@ func NewClient(repoURL string, creds Creds, proxy, noProxy string, layerMediaTyp
 
 func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, proxyURL, noProxy string, layerMediaTypes []string, opts ...ClientOpts) (Client, error) {
 	ociRepo := strings.TrimPrefix(repoURL, "oci://")
+	repo, err := newRemoteRepository(ociRepo, creds, proxyURL, noProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse oci repo url: %w", err)
+	}
+
+	reg, err := remote.NewRegistry(parsed.Host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup registry config: %w", err)
+	}
+	reg.PlainHTTP = repo.PlainHTTP
+	reg.Client = repo.Client
+	return newClientWithLock(ociRepo, repoLock, repo, func(ctx context.Context, last string) ([]string, error) {
+		var t []string
+
+		err := repo.Tags(ctx, last, func(tags []string) error {
+			t = append(t, tags...)
+			return nil
+		})
+
+		return t, err
+	}, reg.Ping, layerMediaTypes, opts...), nil
+}
+
+// newRemoteRepository returns a client for the remote repository, authenticated with the given credentials.
+func newRemoteRepository(ociRepo string, creds Creds, proxyURL, noProxy string) (*remote.Repository, error) {
 	repo, err := remote.NewRepository(ociRepo)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize repository: %w", err)
@@ -159,28 +218,7 @@ func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, proxy
 			Password: creds.Password,
 		}),
 	}
-
-	parsed, err := url.Parse(repoURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse oci repo url: %w", err)
-	}
-
-	reg, err := remote.NewRegistry(parsed.Host)
-	if err != nil {
-		return nil, fmt.Errorf("failed to setup registry config: %w", err)
-	}
-	reg.PlainHTTP = repo.PlainHTTP
-	reg.Client = repo.Client
-	return newClientWithLock(ociRepo, repoLock, repo, func(ctx context.Context, last string) ([]string, error) {
-		var t []string
-
-		err := repo.Tags(ctx, last, func(tags []string) error {
-			t = append(t, tags...)
-			return nil
-		})
-
-		return t, err
-	}, reg.Ping, layerMediaTypes, opts...), nil
+	return repo, nil
 }
 
 func newClientWithLock(repoURL string, repoLock sync.KeyLock, repo oras.ReadOnlyTarget, tagsFunc func(context.Context, string) ([]string, error), pingFunc func(ctx context.Context) error, layerMediaTypes []string, opts ...ClientOpts) Client {
@@ -199,16 +237,18 @@ func newClientWithLock(repoURL string, repoLock sync.KeyLock, repo oras.ReadOnly
 }
 
 type EventHandlers struct {
-	OnExtract             func(repo string) func()
-	OnResolveRevision     func(repo string) func()
-	OnDigestMetadata      func(repo string) func()
-	OnTestRepo            func(repo string) func()
-	OnGetTags             func(repo string) func()
-	OnExtractFail         func(repo string) func(revision string)
-	OnResolveRevisionFail func(repo string) func(revision string)
-	OnDigestMetadataFail  func(repo string) func(revision string)
-	OnTestRepoFail        func(repo string) func()
-	OnGetTagsFail         func(repo string) func()
+	OnExtract              func(repo string) func()
+	OnResolveRevision      func(repo string) func()
+	OnDigestMetadata       func(repo string) func()
+	OnTestRepo             func(repo string) func()
+	OnGetTags              func(repo string) func()
+	OnCosignSignatures     func(repo string) func()
+	OnExtractFail          func(repo string) func(revision string)
+	OnResolveRevisionFail  func(repo string) func(revision string)
+	OnDigestMetadataFail   func(repo string) func(revision string)
+	OnTestRepoFail         func(repo string) func()
+	OnGetTagsFail          func(repo string) func()
+	OnCosignSignaturesFail func(repo string) func(revision string)
 }
 
 // nativeOCIClient implements Client interface using oras-go
@@ -221,6 +261,7 @@ type nativeOCIClient struct {
 	repoLock                        sync.KeyLock
 	tagsCache                       tagsCache
 	repoCachePaths                  utilio.TempPaths
+	diskCache                       *disk.Cache
 	allowedMediaTypes               []string
 	manifestMaxExtractedSize        int64
 	disableManifestMaxExtractedSize bool
@@ -268,49 +309,29 @@ func (c *nativeOCIClient) extract(ctx context.Context, digest string) (string, u
 
 	var isHelmChart bool
 
+	if !exists && c.diskCache != nil {
+		exists = c.restoreImageFromDiskCache(ctx, digest, cachedPath)
+	}
+
 	if !exists {
 		ociManifest, err := getOCIManifest(ctx, digest, c.repo)
 		if err != nil {
 			return "", nil, err
 		}
 
-		// Add a guard to defend against a ridiculous amount of layers. No idea what a good amount is, but normally we
-		// shouldn't expect more than 2-3 in most real world use cases.
-		if len(ociManifest.Layers) > 10 {
-			return "", nil, fmt.Errorf("expected no more than 10 oci layers, got %d", len(ociManifest.Layers))
-		}
-
-		isHelmChart = ociManifest.Config.MediaType == helmOCIConfigType
-
-		contentLayers := 0
-
-		// Strictly speaking we only allow for a single content layer. There are images which contains extra layers, such
-		// as provenance/attestation layers. Pending a better story to do this natively, we will skip such layers for now.
-		for _, layer := range ociManifest.Layers {
-			// For Helm charts, only look for the specific Helm chart content layer
-			if isHelmChart {
-				if isHelmOCI(layer.MediaType) {
-					if !slices.Contains(c.allowedMediaTypes, layer.MediaType) {
-						return "", nil, fmt.Errorf("oci layer media type %s is not in the list of allowed media types", layer.MediaType)
-					}
-					contentLayers++
-				}
-			} else if isContentLayer(layer.MediaType) {
-				if !slices.Contains(c.allowedMediaTypes, layer.MediaType) {
-					return "", nil, fmt.Errorf("oci layer media type %s is not in the list of allowed media types", layer.MediaType)
-				}
-				contentLayers++
-			}
-		}
-
-		if contentLayers != 1 {
-			return "", nil, fmt.Errorf("expected only a single oci content layer, got %d", contentLayers)
+		isHelmChart, err = c.validateLayers(ociManifest)
+		if err != nil {
+			return "", nil, err
 		}
 
 		err = saveCompressedImageToPath(ctx, digest, c.repo, cachedPath)
 		if err != nil {
 			return "", nil, fmt.Errorf("could not save oci digest %s: %w", digest, err)
 		}
+
+		if c.diskCache != nil {
+			c.storeImageInDiskCache(digest, cachedPath)
+		}
 	}
 
 	maxSize := c.manifestMaxExtractedSize
@@ -336,6 +357,90 @@ func (c *nativeOCIClient) extract(ctx context.Context, digest string) (string, u
 	}), nil
 }
 
+// validateLayers checks that the image has a single content layer of an allowed media type, and returns whether the
+// image is a Helm chart
+func (c *nativeOCIClient) validateLayers(ociManifest *imagev1.Manifest) (bool, error) {
+	// Add a guard to defend against a ridiculous amount of layers. No idea what a good amount is, but normally we
+	// shouldn't expect more than 2-3 in most real world use cases.
+	if len(ociManifest.Layers) > 10 {
+		return false, fmt.Errorf("expected no more than 10 oci layers, got %d", len(ociManifest.Layers))
+	}
+
+	isHelmChart := ociManifest.Config.MediaType == helmOCIConfigType
+
+	contentLayers := 0
+
+	// Strictly speaking we only allow for a single content layer. There are images which contains extra layers, such
+	// as provenance/attestation layers. Pending a better story to do this natively, we will skip such layers for now.
+	for _, layer := range ociManifest.Layers {
+		// For Helm charts, only look for the specific Helm chart content layer
+		if isHelmChart {
+			if isHelmOCI(layer.MediaType) {
+				if !slices.Contains(c.allowedMediaTypes, layer.MediaType) {
+					return false, fmt.Errorf("oci layer media type %s is not in the list of allowed media types", layer.MediaType)
+				}
+				contentLayers++
+			}
+		} else if isContentLayer(layer.MediaType) {
+			if !slices.Contains(c.allowedMediaTypes, layer.MediaType) {
+				return false, fmt.Errorf("oci layer media type %s is not in the list of allowed media types", layer.MediaType)
+			}
+			contentLayers++
+		}
+	}
+
+	if contentLayers != 1 {
+		return false, fmt.Errorf("expected only a single oci content layer, got %d", contentLayers)
+	}
+	return isHelmChart, nil
+}
+
+// diskCacheImageFile is the name of the image archive in the disk cache entries
+const diskCacheImageFile = "image.tar"
+
+func diskCacheImageKey(digest string) string {
+	return "oci-image:" + digest
+}
+
+// restoreImageFromDiskCache copies the archive of the image from the disk cache to the given path, and returns whether
+// it was found. The layers of the restored image are validated again, since the allowed media types might have changed.
+func (c *nativeOCIClient) restoreImageFromDiskCache(ctx context.Context, digest string, cachedPath string) bool {
+	dir, release, ok := c.diskCache.Get(diskCacheImageKey(digest))
+	if !ok {
+		return false
+	}
+	defer release()
+	err := func() error {
+		if err := files.CopyFile(filepath.Join(dir, diskCacheImageFile), cachedPath); err != nil {
+			return err
+		}
+		ociManifest, err := getOCIManifestFromCache(ctx, cachedPath, digest)
+		if err != nil {
+			return err
+		}
+		_, err = c.validateLayers(ociManifest)
+		return err
+	}()
+	if err != nil {
+		log.Warnf("Failed to restore oci digest %s from disk cache: %v", digest, err)
+		_ = os.RemoveAll(cachedPath)
+		return false
+	}
+	return true
+}
+
+// storeImageInDiskCache stores the archive of the image in the disk cache, addressed by its digest
+func (c *nativeOCIClient) storeImageInDiskCache(digest string, cachedPath string) {
+	_, release, err := c.diskCache.Put(diskCacheImageKey(digest), func(dir string) error {
+		return files.CopyFile(cachedPath, filepath.Join(dir, diskCacheImageFile))
+	})
+	if err != nil {
+		log.Warnf("Failed to store oci digest %s in disk cache: %v", digest, err)
+		return
+	}
+	release()
+}
+
 func (c *nativeOCIClient) getCachedPath(version string) (string, error) {
 	keyData, err := json.Marshal(map[string]string{"url": c.repoURL, "version": version})
 	if err != nil {
@@ -459,6 +564,56 @@ func (c *nativeOCIClient) getTags(ctx context.Context, noCache bool) ([]string,
 	return tags, nil
 }
 
+// CosignSignatures retrieves the cosign signatures of the given digest from the remote repository.
+func (c *nativeOCIClient) CosignSignatures(ctx context.Context, digest string) ([]CosignSignature, error) {
+	inc := c.OnCosignSignatures(c.repoURL)
+	defer inc()
+	// Currently doesn't do anything in regard to measuring spans, but keep it consistent with OnCosignSignatures()
+	fail := c.OnCosignSignaturesFail(c.repoURL)
+	signatures, err := c.cosignSignatures(ctx, digest)
+	if err != nil {
+		fail(digest)
+	}
+	return signatures, err
+}
+
+func (c *nativeOCIClient) cosignSignatures(ctx context.Context, digest string) ([]CosignSignature, error) {
+	d, err := godigest.Parse(digest)
+	if err != nil {
+		return nil, fmt.Errorf("cannot look up signatures of invalid digest %s: %w", digest, err)
+	}
+
+	signatureTag := fmt.Sprintf("%s-%s.sig", d.Algorithm(), d.Encoded())
+	signatureManifest, err := getOCIManifest(ctx, signatureTag, c.repo)
+	if err != nil {
+		if errors.Is(err, errdef.ErrNotFound) {
+			return []CosignSignature{}, nil
+		}
+		return nil, err
+	}
+
+	signatures := []CosignSignature{}
+	for _, layer := range signatureManifest.Layers {
+		if layer.MediaType != cosignSimpleSigningMediaType {
+			continue
+		}
+		signature, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+		if layer.Size > cosignMaxPayloadSize {
+			return nil, fmt.Errorf("cosign signature payload %s is too large: %d bytes", layer.Digest, layer.Size)
+		}
+		payload, err := content.FetchAll(ctx, c.repo, layer)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching cosign signature payload %s: %w", layer.Digest, err)
+		}
+		signatures = append(signatures, CosignSignature{Payload: payload, Signature: signature})
+	}
+
+	return signatures, nil
+}
+
 // resolveDigest resolves a digest from a tag.
 func (c *nativeOCIClient) resolveDigest(ctx context.Context, revision string) (string, error) {
 	descriptor, err := c.repo.Resolve(ctx, revision)
@@ -631,6 +631,58 @@ func Test_nativeOCIClient_Extract(t *testing.T) {
 	}
 }
 
+func Test_nativeOCIClient_CosignSignatures(t *testing.T) {
+	store := memory.New()
+	layerBlob := createGzippedTarWithContent(t, "foo.yaml", "some content")
+	signedDigest := generateManifest(t, store, layerConf{content.NewDescriptorFromBytes("application/vnd.oci.image.layer.v1.tar+gzip", layerBlob), layerBlob})
+	unsignedDigest := digest.FromBytes([]byte("unsigned")).String()
+
+	payload := []byte(`{"critical":{"image":{"docker-manifest-digest":"` + signedDigest + `"}}}`)
+	signatureDesc := content.NewDescriptorFromBytes(cosignSimpleSigningMediaType, payload)
+	signatureDesc.Annotations = map[string]string{cosignSignatureAnnotation: "c2lnbmF0dXJl"}
+	unannotatedPayload := []byte(`{"critical":{}}`)
+	unannotatedDesc := content.NewDescriptorFromBytes(cosignSimpleSigningMediaType, unannotatedPayload)
+	otherBlob := []byte("attestation")
+	otherDesc := content.NewDescriptorFromBytes("application/vnd.dsse.envelope.v1+json", otherBlob)
+	otherDesc.Annotations = map[string]string{cosignSignatureAnnotation: "b3RoZXI="}
+	configBlob := []byte("{}")
+	configDesc := content.NewDescriptorFromBytes(imagev1.MediaTypeImageConfig, configBlob)
+	signatureManifestBlob, err := json.Marshal(imagev1.Manifest{
+		Config:    configDesc,
+		Layers:    []imagev1.Descriptor{signatureDesc, unannotatedDesc, otherDesc},
+		Versioned: specs.Versioned{SchemaVersion: 2},
+	})
+	require.NoError(t, err)
+	signatureManifestDesc := content.NewDescriptorFromBytes(imagev1.MediaTypeImageManifest, signatureManifestBlob)
+	require.NoError(t, store.Push(t.Context(), signatureDesc, bytes.NewReader(payload)))
+	require.NoError(t, store.Push(t.Context(), unannotatedDesc, bytes.NewReader(unannotatedPayload)))
+	require.NoError(t, store.Push(t.Context(), otherDesc, bytes.NewReader(otherBlob)))
+	require.NoError(t, store.Push(t.Context(), configDesc, bytes.NewReader(configBlob)))
+	require.NoError(t, store.Push(t.Context(), signatureManifestDesc, bytes.NewReader(signatureManifestBlob)))
+	require.NoError(t, store.Tag(t.Context(), signatureManifestDesc, "sha256-"+digest.Digest(signedDigest).Encoded()+".sig"))
+
+	c := newClientWithLock("registry.example.com/org/app", globalLock, store, nil, func(_ context.Context) error {
+		return nil
+	}, nil, WithEventHandlers(fakeEventHandlers(t, "registry.example.com/org/app")))
+
+	t.Run("signed digest", func(t *testing.T) {
+		signatures, err := c.CosignSignatures(t.Context(), signedDigest)
+		require.NoError(t, err)
+		assert.Equal(t, []CosignSignature{{Payload: payload, Signature: "c2lnbmF0dXJl"}}, signatures)
+	})
+
+	t.Run("unsigned digest", func(t *testing.T) {
+		signatures, err := c.CosignSignatures(t.Context(), unsignedDigest)
+		require.NoError(t, err)
+		assert.Empty(t, signatures)
+	})
+
+	t.Run("invalid digest", func(t *testing.T) {
+		_, err := c.CosignSignatures(t.Context(), "1.0.0")
+		require.ErrorContains(t, err, "cannot look up signatures of invalid digest 1.0.0")
+	})
+}
+
 func Test_nativeOCIClient_ResolveRevision(t *testing.T) {
 	store := memory.New()
 	data := []byte("")
@@ -859,6 +911,9 @@ func fakeEventHandlers(t *testing.T, repoURL string) EventHandlers {
 		OnDigestMetadata:  func(repo string) func() { return func() { require.Equal(t, repoURL, repo) } },
 		OnTestRepo:        func(repo string) func() { return func() { require.Equal(t, repoURL, repo) } },
 		OnGetTags:         func(repo string) func() { return func() { require.Equal(t, repoURL, repo) } },
+		OnCosignSignatures: func(repo string) func() {
+			return func() { require.Equal(t, repoURL, repo) }
+		},
 		OnGetTagsFail: func(repo string) func() {
 			return func() { require.Equal(t, repoURL, repo) }
 		},
@@ -874,5 +929,8 @@ func fakeEventHandlers(t *testing.T, repoURL string) EventHandlers {
 		OnDigestMetadataFail: func(repo string) func(revision string) {
 			return func(_ string) { require.Equal(t, repoURL, repo) }
 		},
+		OnCosignSignaturesFail: func(repo string) func(revision string) {
+			return func(_ string) { require.Equal(t, repoURL, repo) }
+		},
 	}
 }
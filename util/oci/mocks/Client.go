@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/oci"
 	"github.com/opencontainers/image-spec/specs-go/v1"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -90,6 +91,74 @@ func (_c *Client_CleanCache_Call) RunAndReturn(run func(revision string) error)
 	return _c
 }
 
+// CosignSignatures provides a mock function for the type Client
+func (_mock *Client) CosignSignatures(ctx context.Context, digest string) ([]oci.CosignSignature, error) {
+	ret := _mock.Called(ctx, digest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CosignSignatures")
+	}
+
+	var r0 []oci.CosignSignature
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]oci.CosignSignature, error)); ok {
+		return returnFunc(ctx, digest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []oci.CosignSignature); ok {
+		r0 = returnFunc(ctx, digest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]oci.CosignSignature)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, digest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_CosignSignatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CosignSignatures'
+type Client_CosignSignatures_Call struct {
+	*mock.Call
+}
+
+// CosignSignatures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - digest string
+func (_e *Client_Expecter) CosignSignatures(ctx any, digest any) *Client_CosignSignatures_Call {
+	return &Client_CosignSignatures_Call{Call: _e.mock.On("CosignSignatures", ctx, digest)}
+}
+
+func (_c *Client_CosignSignatures_Call) Run(run func(ctx context.Context, digest string)) *Client_CosignSignatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_CosignSignatures_Call) Return(cosignSignatures []oci.CosignSignature, err error) *Client_CosignSignatures_Call {
+	_c.Call.Return(cosignSignatures, err)
+	return _c
+}
+
+func (_c *Client_CosignSignatures_Call) RunAndReturn(run func(ctx context.Context, digest string) ([]oci.CosignSignature, error)) *Client_CosignSignatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DigestMetadata provides a mock function for the type Client
 func (_mock *Client) DigestMetadata(ctx context.Context, digest string) (*v1.Manifest, error) {
 	ret := _mock.Called(ctx, digest)
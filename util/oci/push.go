@@ -0,0 +1,113 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+	"oras.land/oras-go/v2/errdef"
+
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+// contentLayerTitle is the title of the layer holding the content of the directories pushed as artifacts.
+const contentLayerTitle = "content.tar.gz"
+
+// Pusher is an interface that provides methods for pushing artifacts to an OCI (Open Container Initiative) registry.
+type Pusher interface {
+	// ResolveTag resolves a tag to the digest of the artifact it references. It returns an empty digest if the tag does
+	// not exist.
+	ResolveTag(ctx context.Context, tag string) (string, error)
+
+	// Tag tags the artifact identified by the specified digest.
+	Tag(ctx context.Context, digest string, tag string) error
+
+	// PushDirectory packs the contents of a directory as the single tar+gzip layer of an artifact, and pushes the
+	// artifact with the given tags and manifest annotations. It returns the digest of the pushed artifact.
+	PushDirectory(ctx context.Context, dirPath string, artifactType string, tags []string, annotations map[string]string) (string, error)
+}
+
+var _ Pusher = &nativeOCIPusher{}
+
+// nativeOCIPusher implements Pusher interface using oras-go
+type nativeOCIPusher struct {
+	repoURL string
+	repo    oras.Target
+}
+
+// NewPusher returns a Pusher for the given repository URL, authenticated with the given credentials.
+func NewPusher(repoURL string, creds Creds, proxyURL, noProxy string) (Pusher, error) {
+	ociRepo := strings.TrimPrefix(repoURL, "oci://")
+	repo, err := newRemoteRepository(ociRepo, creds, proxyURL, noProxy)
+	if err != nil {
+		return nil, err
+	}
+	return &nativeOCIPusher{repoURL: ociRepo, repo: repo}, nil
+}
+
+func (p *nativeOCIPusher) ResolveTag(ctx context.Context, tag string) (string, error) {
+	desc, err := p.repo.Resolve(ctx, tag)
+	if err != nil {
+		if errors.Is(err, errdef.ErrNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("cannot resolve tag %s of repository %s: %w", tag, p.repoURL, err)
+	}
+	return desc.Digest.String(), nil
+}
+
+func (p *nativeOCIPusher) Tag(ctx context.Context, digest string, tag string) error {
+	desc, err := p.repo.Resolve(ctx, digest)
+	if err != nil {
+		return fmt.Errorf("cannot resolve digest %s of repository %s: %w", digest, p.repoURL, err)
+	}
+	if err = p.repo.Tag(ctx, desc, tag); err != nil {
+		return fmt.Errorf("cannot tag digest %s of repository %s with %s: %w", digest, p.repoURL, tag, err)
+	}
+	return nil
+}
+
+func (p *nativeOCIPusher) PushDirectory(ctx context.Context, dirPath string, artifactType string, tags []string, annotations map[string]string) (string, error) {
+	if len(tags) == 0 {
+		return "", errors.New("at least one tag is required")
+	}
+
+	var layerContent bytes.Buffer
+	if _, err := files.Tgz(dirPath, nil, nil, &layerContent); err != nil {
+		return "", fmt.Errorf("failed to compress %s: %w", dirPath, err)
+	}
+
+	// The artifact is packed in memory, and then copied to the repository.
+	store := memory.New()
+	layer := content.NewDescriptorFromBytes(imagev1.MediaTypeImageLayerGzip, layerContent.Bytes())
+	layer.Annotations = map[string]string{imagev1.AnnotationTitle: contentLayerTitle}
+	if err := store.Push(ctx, layer, bytes.NewReader(layerContent.Bytes())); err != nil {
+		return "", fmt.Errorf("failed to store layer: %w", err)
+	}
+	manifest, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, artifactType, oras.PackManifestOptions{
+		Layers:              []imagev1.Descriptor{layer},
+		ManifestAnnotations: annotations,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to pack manifest: %w", err)
+	}
+	if err = store.Tag(ctx, manifest, tags[0]); err != nil {
+		return "", fmt.Errorf("failed to tag manifest: %w", err)
+	}
+
+	if _, err = oras.Copy(ctx, store, tags[0], p.repo, tags[0], oras.DefaultCopyOptions); err != nil {
+		return "", fmt.Errorf("failed to push artifact to repository %s: %w", p.repoURL, err)
+	}
+	for _, tag := range tags[1:] {
+		if err = p.repo.Tag(ctx, manifest, tag); err != nil {
+			return "", fmt.Errorf("failed to tag artifact %s of repository %s with %s: %w", manifest.Digest, p.repoURL, tag, err)
+		}
+	}
+	return manifest.Digest.String(), nil
+}
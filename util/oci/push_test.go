@@ -0,0 +1,86 @@
+package oci
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content/memory"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+func Test_nativeOCIPusher_PushDirectory(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "guestbook"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "guestbook", "manifest.yaml"), []byte("kind: ConfigMap\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "hydrator.metadata"), []byte("{}\n"), 0o644))
+
+	store := memory.New()
+	pusher := &nativeOCIPusher{repoURL: "example.com/hydrated", repo: store}
+
+	t.Run("requires a tag", func(t *testing.T) {
+		_, err := pusher.PushDirectory(t.Context(), dir, "application/vnd.example", nil, nil)
+		require.EqualError(t, err, "at least one tag is required")
+	})
+
+	digest, err := pusher.PushDirectory(t.Context(), dir, "application/vnd.example", []string{"abc123", "latest"}, map[string]string{"example.com/key": "value"})
+	require.NoError(t, err)
+	require.NotEmpty(t, digest)
+	// Unlike registries, the memory store only resolves tags, so the digest is also a tag.
+	desc, err := store.Resolve(t.Context(), "abc123")
+	require.NoError(t, err)
+	require.NoError(t, store.Tag(t.Context(), desc, digest))
+
+	t.Run("tags the artifact", func(t *testing.T) {
+		for _, tag := range []string{"abc123", "latest"} {
+			resolved, err := pusher.ResolveTag(t.Context(), tag)
+			require.NoError(t, err)
+			assert.Equal(t, digest, resolved)
+		}
+	})
+
+	t.Run("resolves a missing tag to an empty digest", func(t *testing.T) {
+		resolved, err := pusher.ResolveTag(t.Context(), "missing")
+		require.NoError(t, err)
+		assert.Empty(t, resolved)
+	})
+
+	t.Run("tags an existing artifact", func(t *testing.T) {
+		require.NoError(t, pusher.Tag(t.Context(), digest, "stable"))
+		resolved, err := pusher.ResolveTag(t.Context(), "stable")
+		require.NoError(t, err)
+		assert.Equal(t, digest, resolved)
+	})
+
+	t.Run("sets the artifact type and annotations", func(t *testing.T) {
+		manifest, err := getOCIManifest(t.Context(), digest, store)
+		require.NoError(t, err)
+		assert.Equal(t, "application/vnd.example", manifest.ArtifactType)
+		assert.Equal(t, "value", manifest.Annotations["example.com/key"])
+		require.Len(t, manifest.Layers, 1)
+		assert.Equal(t, imagev1.MediaTypeImageLayerGzip, manifest.Layers[0].MediaType)
+	})
+
+	t.Run("can be extracted", func(t *testing.T) {
+		cacheDir := utilio.NewRandomizedTempPaths(t.TempDir())
+		c := newClientWithLock("example.com/hydrated", globalLock, store, nil, func(_ context.Context) error {
+			return nil
+		}, []string{imagev1.MediaTypeImageLayerGzip},
+			WithImagePaths(cacheDir),
+			WithManifestMaxExtractedSize(1024*1024),
+			WithEventHandlers(fakeEventHandlers(t, "example.com/hydrated")))
+		path, closer, err := c.Extract(t.Context(), digest)
+		require.NoError(t, err)
+		defer utilio.Close(closer)
+
+		manifest, err := os.ReadFile(filepath.Join(path, "guestbook", "manifest.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "kind: ConfigMap\n", string(manifest))
+		assert.FileExists(t, filepath.Join(path, "hydrator.metadata"))
+	})
+}
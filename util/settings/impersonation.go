@@ -14,9 +14,48 @@ const (
 	serviceAccountDisallowedCharSet = "!*[]{}\\/"
 )
 
+// ServiceAccountsToImpersonate holds the fully qualified service accounts to be impersonated for the sync operation
+type ServiceAccountsToImpersonate struct {
+	// Default is the service account to be impersonated for the resources which match no resource service account
+	Default   string
+	resources []resourceServiceAccountToImpersonate
+}
+
+type resourceServiceAccountToImpersonate struct {
+	v1alpha1.ResourceServiceAccount
+	qualified string
+}
+
+// ForResource returns the service account to be impersonated to sync the resource of the given group, kind and
+// namespace
+func (s *ServiceAccountsToImpersonate) ForResource(group, kind, namespace string) string {
+	for _, item := range s.resources {
+		if item.Matches(group, kind, namespace) {
+			return item.qualified
+		}
+	}
+	return s.Default
+}
+
+// HasResourceServiceAccounts returns true if some resources are synced with another service account than the default one
+func (s *ServiceAccountsToImpersonate) HasResourceServiceAccounts() bool {
+	return len(s.resources) > 0
+}
+
 // DeriveServiceAccountToImpersonate determines the service account to be used for impersonation for the sync operation.
 // The returned service account will be fully qualified including namespace and the service account name in the format system:serviceaccount:<namespace>:<service_account>
 func DeriveServiceAccountToImpersonate(project *v1alpha1.AppProject, application *v1alpha1.Application, destCluster *v1alpha1.Cluster) (string, error) {
+	serviceAccounts, err := DeriveServiceAccountsToImpersonate(project, application, destCluster)
+	if err != nil {
+		return "", err
+	}
+	return serviceAccounts.Default, nil
+}
+
+// DeriveServiceAccountsToImpersonate determines the default service account and the service accounts of specific
+// resources to be used for impersonation for the sync operation, from the first destination service account matching
+// the destination of the application. The default service account is empty if there is no match.
+func DeriveServiceAccountsToImpersonate(project *v1alpha1.AppProject, application *v1alpha1.Application, destCluster *v1alpha1.Cluster) (*ServiceAccountsToImpersonate, error) {
 	// spec.Destination.Namespace is optional. If not specified, use the Application's
 	// namespace
 	serviceAccountNamespace := application.Spec.Destination.Namespace
@@ -24,27 +63,45 @@ func DeriveServiceAccountToImpersonate(project *v1alpha1.AppProject, application
 		serviceAccountNamespace = application.Namespace
 	}
 	// Loop through the destinationServiceAccounts and see if there is any destination that is a candidate.
-	// if so, return the service account specified for that destination.
+	// if so, return the service accounts specified for that destination.
 	for _, item := range project.Spec.DestinationServiceAccounts {
 		dstServerMatched, err := glob.MatchWithError(item.Server, destCluster.Server)
 		if err != nil {
-			return "", fmt.Errorf("invalid glob pattern for destination server: %w", err)
+			return nil, fmt.Errorf("invalid glob pattern for destination server: %w", err)
 		}
 		dstNamespaceMatched, err := glob.MatchWithError(item.Namespace, application.Spec.Destination.Namespace)
 		if err != nil {
-			return "", fmt.Errorf("invalid glob pattern for destination namespace: %w", err)
+			return nil, fmt.Errorf("invalid glob pattern for destination namespace: %w", err)
 		}
 		if dstServerMatched && dstNamespaceMatched {
-			if strings.Trim(item.DefaultServiceAccount, " ") == "" || strings.ContainsAny(item.DefaultServiceAccount, serviceAccountDisallowedCharSet) {
-				return "", fmt.Errorf("default service account contains invalid chars '%s'", item.DefaultServiceAccount)
-			} else if strings.Contains(item.DefaultServiceAccount, ":") {
-				// service account is specified along with its namespace.
-				return "system:serviceaccount:" + item.DefaultServiceAccount, nil
+			defaultServiceAccount, err := qualifyServiceAccount(item.DefaultServiceAccount, serviceAccountNamespace)
+			if err != nil {
+				return nil, fmt.Errorf("default service account contains invalid chars '%s'", item.DefaultServiceAccount)
 			}
-			// service account needs to be prefixed with a namespace
-			return fmt.Sprintf("system:serviceaccount:%s:%s", serviceAccountNamespace, item.DefaultServiceAccount), nil
+			serviceAccounts := &ServiceAccountsToImpersonate{Default: defaultServiceAccount}
+			for _, resourceItem := range item.ResourceServiceAccounts {
+				serviceAccount, err := qualifyServiceAccount(resourceItem.ServiceAccount, serviceAccountNamespace)
+				if err != nil {
+					return nil, fmt.Errorf("service account of %s/%s resources contains invalid chars '%s'", resourceItem.Group, resourceItem.Kind, resourceItem.ServiceAccount)
+				}
+				serviceAccounts.resources = append(serviceAccounts.resources, resourceServiceAccountToImpersonate{ResourceServiceAccount: resourceItem, qualified: serviceAccount})
+			}
+			return serviceAccounts, nil
 		}
 	}
-	// if there is no match found in the AppProject.Spec.DestinationServiceAccounts, return empty string
-	return "", nil
+	// if there is no match found in the AppProject.Spec.DestinationServiceAccounts, return an empty default service account
+	return &ServiceAccountsToImpersonate{}, nil
+}
+
+// qualifyServiceAccount returns the fully qualified user name of the service account, which is prefixed with the given
+// namespace unless it is specified along with its namespace
+func qualifyServiceAccount(serviceAccount string, namespace string) (string, error) {
+	if strings.Trim(serviceAccount, " ") == "" || strings.ContainsAny(serviceAccount, serviceAccountDisallowedCharSet) {
+		return "", fmt.Errorf("service account contains invalid chars '%s'", serviceAccount)
+	} else if strings.Contains(serviceAccount, ":") {
+		// service account is specified along with its namespace.
+		return "system:serviceaccount:" + serviceAccount, nil
+	}
+	// service account needs to be prefixed with a namespace
+	return fmt.Sprintf("system:serviceaccount:%s:%s", namespace, serviceAccount), nil
 }
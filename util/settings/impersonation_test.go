@@ -278,3 +278,74 @@ func TestDeriveServiceAccountToImpersonate(t *testing.T) {
 		assert.Equal(t, "system:serviceaccount:dest-ns:first-sa", user)
 	})
 }
+
+func TestDeriveServiceAccountsToImpersonate(t *testing.T) {
+	t.Parallel()
+	app := &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{
+			Destination: v1alpha1.ApplicationDestination{
+				Server:    "https://cluster-api.example.com",
+				Namespace: "dest-ns",
+			},
+		},
+	}
+	cluster := &v1alpha1.Cluster{Server: "https://cluster-api.example.com"}
+
+	t.Run("ResourceServiceAccounts", func(t *testing.T) {
+		t.Parallel()
+		project := &v1alpha1.AppProject{
+			Spec: v1alpha1.AppProjectSpec{
+				DestinationServiceAccounts: []v1alpha1.ApplicationDestinationServiceAccount{{
+					Server:                "https://cluster-api.example.com",
+					Namespace:             "dest-ns",
+					DefaultServiceAccount: "restricted-sa",
+					ResourceServiceAccounts: []v1alpha1.ResourceServiceAccount{
+						{Group: "apiextensions.k8s.io", Kind: "*", ServiceAccount: "argocd:crd-admin"},
+						{Group: "*", Kind: "*", Namespace: "kube-system", ServiceAccount: "system-sa"},
+					},
+				}},
+			},
+		}
+
+		serviceAccounts, err := DeriveServiceAccountsToImpersonate(project, app, cluster)
+		require.NoError(t, err)
+		assert.True(t, serviceAccounts.HasResourceServiceAccounts())
+		assert.Equal(t, "system:serviceaccount:dest-ns:restricted-sa", serviceAccounts.Default)
+		assert.Equal(t, "system:serviceaccount:argocd:crd-admin", serviceAccounts.ForResource("apiextensions.k8s.io", "CustomResourceDefinition", ""))
+		assert.Equal(t, "system:serviceaccount:dest-ns:system-sa", serviceAccounts.ForResource("", "ConfigMap", "kube-system"))
+		assert.Equal(t, "system:serviceaccount:dest-ns:restricted-sa", serviceAccounts.ForResource("apps", "Deployment", "dest-ns"))
+	})
+
+	t.Run("InvalidResourceServiceAccount", func(t *testing.T) {
+		t.Parallel()
+		project := &v1alpha1.AppProject{
+			Spec: v1alpha1.AppProjectSpec{
+				DestinationServiceAccounts: []v1alpha1.ApplicationDestinationServiceAccount{{
+					Server:                  "*",
+					Namespace:               "*",
+					DefaultServiceAccount:   "test-sa",
+					ResourceServiceAccounts: []v1alpha1.ResourceServiceAccount{{Kind: "*", ServiceAccount: "test/sa"}},
+				}},
+			},
+		}
+
+		_, err := DeriveServiceAccountsToImpersonate(project, app, cluster)
+		require.EqualError(t, err, "service account of /* resources contains invalid chars 'test/sa'")
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		t.Parallel()
+		project := &v1alpha1.AppProject{
+			Spec: v1alpha1.AppProjectSpec{
+				DestinationServiceAccounts: []v1alpha1.ApplicationDestinationServiceAccount{
+					{Server: "https://other-cluster.example.com", Namespace: "*", DefaultServiceAccount: "test-sa"},
+				},
+			},
+		}
+
+		serviceAccounts, err := DeriveServiceAccountsToImpersonate(project, app, cluster)
+		require.NoError(t, err)
+		assert.False(t, serviceAccounts.HasResourceServiceAccounts())
+		assert.Empty(t, serviceAccounts.ForResource("apps", "Deployment", "dest-ns"))
+	})
+}
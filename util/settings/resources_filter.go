@@ -15,6 +15,8 @@ type ResourcesFilter struct {
 	ResourceExclusions []FilteredResource
 	// ResourceInclusions holds the only api groups, kinds per cluster that Argo CD will watch
 	ResourceInclusions []FilteredResource
+	// MetadataOnlyResources holds the api groups, kinds per cluster that Argo CD watches through the metadata-only API
+	MetadataOnlyResources []FilteredResource
 }
 
 func (rf *ResourcesFilter) getExcludedResources() []FilteredResource {
@@ -82,3 +84,9 @@ func (rf *ResourcesFilter) IsExcludedResource(apiGroup, kind, cluster string) bo
 	// if no inclusion rules defined for cluster, default is allow
 	return false
 }
+
+// IsMetadataOnlyResource returns true if the resources of the given api group and kind are watched through the
+// metadata-only API in the given cluster
+func (rf *ResourcesFilter) IsMetadataOnlyResource(apiGroup, kind, cluster string) bool {
+	return rf.checkResourcePresence(apiGroup, kind, cluster, rf.MetadataOnlyResources)
+}
@@ -64,3 +64,19 @@ func TestResourceInclusionsExclusionMultiCluster(t *testing.T) {
 	assert.True(t, filter.IsExcludedResource("whitelisted-resource", "", "cluster-two"))
 	assert.False(t, filter.IsExcludedResource("whitelisted-resource", "", "cluster-three"))
 }
+
+func TestIsMetadataOnlyResource(t *testing.T) {
+	t.Parallel()
+	filter := ResourcesFilter{
+		MetadataOnlyResources: []FilteredResource{
+			{APIGroups: []string{""}, Kinds: []string{"ConfigMap", "Secret"}, Clusters: []string{"*"}},
+			{APIGroups: []string{"*.example.com"}, Kinds: []string{"*"}, Clusters: []string{"cluster-one"}},
+		},
+	}
+
+	assert.True(t, filter.IsMetadataOnlyResource("", "ConfigMap", "cluster-one"))
+	assert.False(t, filter.IsMetadataOnlyResource("", "Pod", "cluster-one"))
+	assert.True(t, filter.IsMetadataOnlyResource("events.example.com", "Event", "cluster-one"))
+	assert.False(t, filter.IsMetadataOnlyResource("events.example.com", "Event", "cluster-two"))
+	assert.False(t, (&ResourcesFilter{}).IsMetadataOnlyResource("", "ConfigMap", "cluster-one"))
+}
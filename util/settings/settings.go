@@ -483,6 +483,8 @@ const (
 	resourceExclusionsKey = "resource.exclusions"
 	// resourceInclusions is the key to the list of explicitly watched resources
 	resourceInclusionsKey = "resource.inclusions"
+	// resourceMetadataOnlyKey is the key to the list of resources watched through the metadata-only API
+	resourceMetadataOnlyKey = "resource.metadataOnly"
 	// resourceIgnoreResourceUpdatesEnabledKey is the key to a boolean determining whether the resourceIgnoreUpdates feature is enabled
 	resourceIgnoreResourceUpdatesEnabledKey = "resource.ignoreResourceUpdatesEnabled"
 	// resourceSensitiveAnnotationsKey is the key to list of annotations to mask in secret resource
@@ -890,6 +892,15 @@ func (mgr *SettingsManager) GetResourcesFilter() (*ResourcesFilter, error) {
 		}
 		rf.ResourceExclusions = excludedResources
 	}
+
+	if value, ok := argoCDCM.Data[resourceMetadataOnlyKey]; ok {
+		metadataOnlyResources := make([]FilteredResource, 0)
+		err := yaml.Unmarshal([]byte(value), &metadataOnlyResources)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshalling metadata-only resources %w", err)
+		}
+		rf.MetadataOnlyResources = metadataOnlyResources
+	}
 	return rf, nil
 }
 
@@ -1177,6 +1188,8 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 				return err
 			}
 			overrideVal.UseOpenLibs = useOpenLibs
+		case "healthPlugin":
+			overrideVal.HealthPlugin = v
 		case "actions":
 			overrideVal.Actions = v
 		case "ignoreDifferences":
@@ -151,15 +151,17 @@ func TestGetExtensionConfigs(t *testing.T) {
 
 func TestGetResourceFilter(t *testing.T) {
 	data := map[string]string{
-		"resource.exclusions": "\n  - apiGroups: [\"group1\"]\n    kinds: [\"kind1\"]\n    clusters: [\"cluster1\"]\n",
-		"resource.inclusions": "\n  - apiGroups: [\"group2\"]\n    kinds: [\"kind2\"]\n    clusters: [\"cluster2\"]\n",
+		"resource.exclusions":   "\n  - apiGroups: [\"group1\"]\n    kinds: [\"kind1\"]\n    clusters: [\"cluster1\"]\n",
+		"resource.inclusions":   "\n  - apiGroups: [\"group2\"]\n    kinds: [\"kind2\"]\n    clusters: [\"cluster2\"]\n",
+		"resource.metadataOnly": "\n  - apiGroups: [\"\"]\n    kinds: [\"ConfigMap\"]\n    clusters: [\"*\"]\n",
 	}
 	_, settingsManager := fixtures(t.Context(), data)
 	filter, err := settingsManager.GetResourcesFilter()
 	require.NoError(t, err)
 	assert.Equal(t, &ResourcesFilter{
-		ResourceExclusions: []FilteredResource{{APIGroups: []string{"group1"}, Kinds: []string{"kind1"}, Clusters: []string{"cluster1"}}},
-		ResourceInclusions: []FilteredResource{{APIGroups: []string{"group2"}, Kinds: []string{"kind2"}, Clusters: []string{"cluster2"}}},
+		ResourceExclusions:    []FilteredResource{{APIGroups: []string{"group1"}, Kinds: []string{"kind1"}, Clusters: []string{"cluster1"}}},
+		ResourceInclusions:    []FilteredResource{{APIGroups: []string{"group2"}, Kinds: []string{"kind2"}, Clusters: []string{"cluster2"}}},
+		MetadataOnlyResources: []FilteredResource{{APIGroups: []string{""}, Kinds: []string{"ConfigMap"}, Clusters: []string{"*"}}},
 	}, filter)
 }
 
@@ -508,6 +510,7 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 			"resource.customizations.actions.Deployment":                         "bar",
 			"resource.customizations.health.iam-manager.k8s.io_Iamrole":          "bar",
 			"resource.customizations.health.Iamrole":                             "bar",
+			"resource.customizations.healthPlugin.iam-manager.k8s.io_Iamrole":    "iam-health",
 			"resource.customizations.ignoreDifferences.iam-manager.k8s.io_Iamrole": `jsonPointers:
         - bar`,
 			"resource.customizations.ignoreDifferences.apps_Deployment": `jqPathExpressions:
@@ -541,6 +544,7 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 		assert.Equal(t, "bar", overrides["Deployment"].Actions)
 		assert.Equal(t, "bar", overrides["iam-manager.k8s.io/Iamrole"].HealthLua)
 		assert.Equal(t, "bar", overrides["Iamrole"].HealthLua)
+		assert.Equal(t, "iam-health", overrides["iam-manager.k8s.io/Iamrole"].HealthPlugin)
 		assert.Len(t, overrides["iam-manager.k8s.io/Iamrole"].IgnoreDifferences.JSONPointers, 1)
 		assert.Len(t, overrides["apps/Deployment"].IgnoreDifferences.JQPathExpressions, 1)
 		assert.Equal(t, "bar", overrides["apps/Deployment"].IgnoreDifferences.JQPathExpressions[0])
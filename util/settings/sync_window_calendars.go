@@ -0,0 +1,207 @@
+package settings
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// syncWindowCalendarKeyPrefix is the prefix of the keys of the sync window calendars, e.g. syncWindows.calendar.holidays
+const syncWindowCalendarKeyPrefix = "syncWindows.calendar."
+
+const (
+	iCalDateLayout     = "20060102"
+	iCalDateTimeLayout = "20060102T150405"
+)
+
+// GetSyncWindowCalendars loads the sync window calendars from argocd-cm ConfigMap. A calendar is either an iCalendar
+// (RFC 5545) file, or a list of dates, one per line, where a line is either a date, or a start and an end separated by
+// a slash (e.g. 2025-12-24/2026-01-01).
+func (mgr *SettingsManager) GetSyncWindowCalendars() (map[string][]v1alpha1.SyncWindowDateRange, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	calendars := map[string][]v1alpha1.SyncWindowDateRange{}
+	for k, v := range argoCDCM.Data {
+		name, ok := strings.CutPrefix(k, syncWindowCalendarKeyPrefix)
+		if !ok || name == "" {
+			continue
+		}
+		dates, err := parseSyncWindowCalendar(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sync window calendar %q: %w", name, err)
+		}
+		calendars[name] = dates
+	}
+	return calendars, nil
+}
+
+// ResolveSyncWindowCalendars returns the sync windows with the periods of the calendars they refer to. The calendars are
+// only loaded if one of the windows refers to a calendar.
+func (mgr *SettingsManager) ResolveSyncWindowCalendars(windows *v1alpha1.SyncWindows) (*v1alpha1.SyncWindows, error) {
+	if !windows.HasCalendars() {
+		return windows, nil
+	}
+	calendars, err := mgr.GetSyncWindowCalendars()
+	if err != nil {
+		return nil, fmt.Errorf("error getting sync window calendars: %w", err)
+	}
+	return windows.WithCalendars(calendars)
+}
+
+// parseSyncWindowCalendar parses an iCalendar file or a list of dates into date ranges
+func parseSyncWindowCalendar(data string) ([]v1alpha1.SyncWindowDateRange, error) {
+	var dates []v1alpha1.SyncWindowDateRange
+	var err error
+	if strings.HasPrefix(strings.TrimSpace(data), "BEGIN:VCALENDAR") {
+		dates, err = parseICalendar(data)
+	} else {
+		dates, err = parseDateList(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range dates {
+		if err := d.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return dates, nil
+}
+
+func parseDateList(data string) ([]v1alpha1.SyncWindowDateRange, error) {
+	var dates []v1alpha1.SyncWindowDateRange
+	scanner := bufio.NewScanner(strings.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		line = strings.TrimSpace(strings.TrimPrefix(line, "- "))
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		start, end, _ := strings.Cut(line, "/")
+		dates = append(dates, v1alpha1.SyncWindowDateRange{Start: strings.TrimSpace(start), End: strings.TrimSpace(end)})
+	}
+	return dates, scanner.Err()
+}
+
+// iCalProperty is a property of an iCalendar component, e.g. DTSTART;TZID=Europe/Paris:20251224T090000
+type iCalProperty struct {
+	value  string
+	params map[string]string
+}
+
+func parseICalendar(data string) ([]v1alpha1.SyncWindowDateRange, error) {
+	// Unfold the content lines, which are split into multiple lines starting with a space or a tab.
+	data = strings.ReplaceAll(data, "\r\n", "\n")
+	data = strings.ReplaceAll(data, "\n ", "")
+	data = strings.ReplaceAll(data, "\n\t", "")
+
+	var dates []v1alpha1.SyncWindowDateRange
+	var event map[string]iCalProperty
+	for _, line := range strings.Split(data, "\n") {
+		nameAndParams, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		params := strings.Split(nameAndParams, ";")
+		name := strings.ToUpper(params[0])
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			event = map[string]iCalProperty{}
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if event == nil {
+				continue
+			}
+			d, ok, err := iCalEventDateRange(event)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				dates = append(dates, d)
+			}
+			event = nil
+		case event != nil:
+			property := iCalProperty{value: strings.TrimSpace(value), params: map[string]string{}}
+			for _, param := range params[1:] {
+				k, v, _ := strings.Cut(param, "=")
+				property.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+			}
+			event[name] = property
+		}
+	}
+	return dates, nil
+}
+
+// iCalEventDateRange returns the date range of an event, and false if the event has no duration.
+func iCalEventDateRange(event map[string]iCalProperty) (v1alpha1.SyncWindowDateRange, bool, error) {
+	if _, ok := event["RRULE"]; ok {
+		return v1alpha1.SyncWindowDateRange{}, false, errors.New("recurring events are not supported")
+	}
+	dtStart, ok := event["DTSTART"]
+	if !ok {
+		return v1alpha1.SyncWindowDateRange{}, false, errors.New("event without DTSTART")
+	}
+	start, isDate, err := iCalTime(dtStart)
+	if err != nil {
+		return v1alpha1.SyncWindowDateRange{}, false, err
+	}
+	dtEnd, ok := event["DTEND"]
+	if !ok {
+		if _, ok := event["DURATION"]; ok {
+			return v1alpha1.SyncWindowDateRange{}, false, errors.New("events with a DURATION are not supported")
+		}
+		// An all-day event without end lasts one day, and an event starting at a time without end has no duration.
+		return v1alpha1.SyncWindowDateRange{Start: start}, isDate, nil
+	}
+	end, isEndDate, err := iCalTime(dtEnd)
+	if err != nil {
+		return v1alpha1.SyncWindowDateRange{}, false, err
+	}
+	if isEndDate {
+		// The end date of an event is exclusive, while the end date of a date range is inclusive.
+		t, err := time.Parse("2006-01-02", end)
+		if err != nil {
+			return v1alpha1.SyncWindowDateRange{}, false, err
+		}
+		end = t.AddDate(0, 0, -1).Format("2006-01-02")
+		if end < start {
+			end = start
+		}
+	}
+	return v1alpha1.SyncWindowDateRange{Start: start, End: end}, true, nil
+}
+
+// iCalTime converts an iCalendar date or date-time to a date, a time without time zone or an RFC 3339 time, and
+// returns whether it is a date.
+func iCalTime(property iCalProperty) (string, bool, error) {
+	if strings.EqualFold(property.params["VALUE"], "DATE") || len(property.value) == len(iCalDateLayout) {
+		t, err := time.Parse(iCalDateLayout, property.value)
+		if err != nil {
+			return "", false, fmt.Errorf("cannot parse date '%s': %w", property.value, err)
+		}
+		return t.Format("2006-01-02"), true, nil
+	}
+	value, utc := strings.CutSuffix(property.value, "Z")
+	loc := time.UTC
+	if tzid := property.params["TZID"]; tzid != "" && !utc {
+		var err error
+		loc, err = time.LoadLocation(tzid)
+		if err != nil {
+			return "", false, fmt.Errorf("cannot load time zone '%s': %w", tzid, err)
+		}
+	}
+	t, err := time.ParseInLocation(iCalDateTimeLayout, value, loc)
+	if err != nil {
+		return "", false, fmt.Errorf("cannot parse date-time '%s': %w", property.value, err)
+	}
+	if !utc && property.params["TZID"] == "" {
+		// A floating time is in the time zone of the sync window.
+		return t.Format("2006-01-02T15:04:05"), false, nil
+	}
+	return t.Format(time.RFC3339), false, nil
+}
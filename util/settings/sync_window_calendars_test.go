@@ -0,0 +1,139 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestGetSyncWindowCalendars(t *testing.T) {
+	t.Run("NoCalendars", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), nil)
+		calendars, err := settingsManager.GetSyncWindowCalendars()
+		require.NoError(t, err)
+		assert.Empty(t, calendars)
+	})
+	t.Run("DateList", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"syncWindows.calendar.holidays": `
+# Christmas
+2025-12-25/2025-12-26
+- 2026-01-01
+2026-03-02T18:00:00/2026-03-02T22:00:00
+`,
+			"syncWindows.calendar.": "2026-01-01",
+			"other":                 "2026-01-01",
+		})
+		calendars, err := settingsManager.GetSyncWindowCalendars()
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]v1alpha1.SyncWindowDateRange{
+			"holidays": {
+				{Start: "2025-12-25", End: "2025-12-26"},
+				{Start: "2026-01-01"},
+				{Start: "2026-03-02T18:00:00", End: "2026-03-02T22:00:00"},
+			},
+		}, calendars)
+	})
+	t.Run("InvalidDateList", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"syncWindows.calendar.holidays": "2025-12-26/2025-12-25",
+		})
+		_, err := settingsManager.GetSyncWindowCalendars()
+		require.ErrorContains(t, err, `failed to parse sync window calendar "holidays"`)
+	})
+}
+
+func TestParseSyncWindowCalendar_ICalendar(t *testing.T) {
+	t.Run("Events", func(t *testing.T) {
+		dates, err := parseSyncWindowCalendar(`BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Example//Calendar//EN
+BEGIN:VEVENT
+SUMMARY:Christmas
+DTSTART;VALUE=DATE:20251225
+DTEND;VALUE=DATE:20251227
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:New Year
+DTSTART;VALUE=DATE:20260101
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Release
+DTSTART;TZID=Europe/Paris:20260302T180000
+DTEND;TZID=Europe/Paris:20260302T2
+ 20000
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Maintenance
+DTSTART:20260303T010000Z
+DTEND:20260303T020000Z
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Standup
+DTSTART:20260304T090000
+DTEND:20260304T091500
+END:VEVENT
+BEGIN:VEVENT
+SUMMARY:Reminder
+DTSTART:20260305T090000
+END:VEVENT
+END:VCALENDAR
+`)
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.SyncWindowDateRange{
+			{Start: "2025-12-25", End: "2025-12-26"},
+			{Start: "2026-01-01"},
+			{Start: "2026-03-02T18:00:00+01:00", End: "2026-03-02T22:00:00+01:00"},
+			{Start: "2026-03-03T01:00:00Z", End: "2026-03-03T02:00:00Z"},
+			{Start: "2026-03-04T09:00:00", End: "2026-03-04T09:15:00"},
+		}, dates)
+	})
+	t.Run("RecurringEvent", func(t *testing.T) {
+		_, err := parseSyncWindowCalendar(`BEGIN:VCALENDAR
+BEGIN:VEVENT
+DTSTART;VALUE=DATE:20251225
+RRULE:FREQ=YEARLY
+END:VEVENT
+END:VCALENDAR`)
+		require.EqualError(t, err, "recurring events are not supported")
+	})
+	t.Run("EventWithDuration", func(t *testing.T) {
+		_, err := parseSyncWindowCalendar(`BEGIN:VCALENDAR
+BEGIN:VEVENT
+DTSTART:20251225T100000Z
+DURATION:PT1H
+END:VEVENT
+END:VCALENDAR`)
+		require.EqualError(t, err, "events with a DURATION are not supported")
+	})
+	t.Run("UnknownTimeZone", func(t *testing.T) {
+		_, err := parseSyncWindowCalendar(`BEGIN:VCALENDAR
+BEGIN:VEVENT
+DTSTART;TZID=Nowhere/Town:20251225T100000
+DTEND;TZID=Nowhere/Town:20251225T110000
+END:VEVENT
+END:VCALENDAR`)
+		require.ErrorContains(t, err, "cannot load time zone 'Nowhere/Town'")
+	})
+}
+
+func TestResolveSyncWindowCalendars(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), map[string]string{
+		"syncWindows.calendar.holidays": "2025-12-25",
+	})
+	t.Run("WithoutCalendars", func(t *testing.T) {
+		windows := &v1alpha1.SyncWindows{{Kind: "allow", Schedule: "0 10 * * *", Duration: "1h"}}
+		resolved, err := settingsManager.ResolveSyncWindowCalendars(windows)
+		require.NoError(t, err)
+		assert.Same(t, windows, resolved)
+	})
+	t.Run("WithCalendars", func(t *testing.T) {
+		windows := &v1alpha1.SyncWindows{{Kind: "deny", Calendar: "holidays"}}
+		resolved, err := settingsManager.ResolveSyncWindowCalendars(windows)
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.SyncWindowDateRange{{Start: "2025-12-25"}}, (*resolved)[0].Dates)
+	})
+}
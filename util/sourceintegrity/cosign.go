@@ -0,0 +1,190 @@
+package sourceintegrity
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+)
+
+type ociFunc func(ctx context.Context, ociClient oci.Client, digest string) (*v1alpha1.SourceIntegrityCheckResult, error)
+
+// cosignSimpleSigning is the subset of the cosign simple signing payload relevant for the verification.
+type cosignSimpleSigning struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// VerifyOCI makes sure the OCI artifact satisfies the criteria declared.
+// It returns nil in case there were no relevant criteria, a check result if there were.
+// The repoURL is the source repository URL the policies are matched against, and the digest is the resolved artifact
+// digest - its signatures are being verified.
+func VerifyOCI(ctx context.Context, si *v1alpha1.SourceIntegrity, ociClient oci.Client, repoURL string, digest string) (*v1alpha1.SourceIntegrityCheckResult, error) {
+	check := lookupOCI(si, repoURL)
+	if check != nil {
+		return check(ctx, ociClient, digest)
+	}
+	return nil, nil
+}
+
+func lookupOCI(si *v1alpha1.SourceIntegrity, repoURL string) ociFunc {
+	if si == nil || si.OCI == nil {
+		return nil
+	}
+
+	var policies []*v1alpha1.SourceIntegrityOCIPolicy
+	for _, p := range si.OCI.Policies {
+		urlGlobs := make([]string, 0, len(p.Repos))
+		for _, r := range p.Repos {
+			urlGlobs = append(urlGlobs, r.URL)
+		}
+		if includesRepo(urlGlobs, repoURL) {
+			policies = append(policies, p)
+		}
+	}
+
+	nPolicies := len(policies)
+	if nPolicies == 0 {
+		log.Infof("No oci source integrity policies found for repo URL: %s", repoURL)
+		return nil
+	}
+	if nPolicies > 1 {
+		// Multiple matching policies is an error, and it has to fail the same way the git ones do.
+		msg := fmt.Sprintf("multiple (%d) oci source integrity policies found for repo URL: %s", nPolicies, repoURL)
+		log.Warn(msg)
+		return func(_ context.Context, _ oci.Client, _ string) (*v1alpha1.SourceIntegrityCheckResult, error) {
+			return nil, errors.New(msg)
+		}
+	}
+
+	policy := policies[0]
+	if policy.Cosign != nil {
+		return func(ctx context.Context, ociClient oci.Client, digest string) (*v1alpha1.SourceIntegrityCheckResult, error) {
+			return verifyCosign(ctx, policy.Cosign, ociClient, digest)
+		}
+	}
+
+	log.Warnf("No verification configured for SourceIntegrity policy for %+v", policy.Repos)
+	return nil
+}
+
+func verifyCosign(ctx context.Context, c *v1alpha1.SourceIntegrityOCIPolicyCosign, ociClient oci.Client, digest string) (*v1alpha1.SourceIntegrityCheckResult, error) {
+	const checkName = "OCI/COSIGN"
+
+	var keys []crypto.PublicKey
+	for _, k := range c.Keys {
+		key, err := parseCosignPublicKey(k)
+		if err != nil {
+			log.Error(err.Error())
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	signatures, err := ociClient.CosignSignatures(ctx, digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve cosign signatures for digest %s: %w", digest, err)
+	}
+
+	return &v1alpha1.SourceIntegrityCheckResult{Checks: []v1alpha1.SourceIntegrityCheckResultItem{{
+		Name:     checkName,
+		Problems: describeCosignProblems(keys, digest, signatures),
+	}}}, nil
+}
+
+// describeCosignProblems reports why none of the signatures satisfies the policy. No problems are reported when at
+// least one of the signatures is made by a trusted key for the given digest. At most 10 problems are reported.
+func describeCosignProblems(keys []crypto.PublicKey, digest string, signatures []oci.CosignSignature) (problems []string) {
+	if len(signatures) == 0 {
+		return []string{fmt.Sprintf("Failed verifying digest %s: no cosign signature found", digest)}
+	}
+
+	for _, signature := range signatures {
+		problem := cosignProblemMessage(keys, digest, signature)
+		if problem == "" {
+			return nil
+		}
+		if len(problems) < 10 {
+			problems = append(problems, problem)
+		}
+	}
+	return problems
+}
+
+// cosignProblemMessage generates a message describing cosign verification issues for a signature of the given digest.
+// When an empty string is returned, it means there is no problem - the validation has passed.
+func cosignProblemMessage(keys []crypto.PublicKey, digest string, signature oci.CosignSignature) string {
+	rawSignature, err := base64.StdEncoding.DecodeString(signature.Signature)
+	if err != nil {
+		return fmt.Sprintf("Failed verifying digest %s: malformed signature encoding: %v", digest, err)
+	}
+
+	signed := false
+	for _, key := range keys {
+		if verifyCosignSignature(key, signature.Payload, rawSignature) {
+			signed = true
+			break
+		}
+	}
+	if !signed {
+		return fmt.Sprintf("Failed verifying digest %s: signature not made by any of the allowed keys", digest)
+	}
+
+	// Only trust the payload once it is known to be signed by a trusted key
+	var payload cosignSimpleSigning
+	if err := json.Unmarshal(signature.Payload, &payload); err != nil {
+		return fmt.Sprintf("Failed verifying digest %s: malformed signature payload: %v", digest, err)
+	}
+	if payload.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Sprintf("Failed verifying digest %s: signature is made for a different digest %s", digest, payload.Critical.Image.DockerManifestDigest)
+	}
+
+	return ""
+}
+
+func verifyCosignSignature(key crypto.PublicKey, payload []byte, signature []byte) bool {
+	hash := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, hash[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, signature)
+	default:
+		return false
+	}
+}
+
+func parseCosignPublicKey(key string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, errors.New("cosign public key is not PEM encoded")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cosign public key: %w", err)
+	}
+	switch pub.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return pub, nil
+	default:
+		return nil, fmt.Errorf("unsupported cosign public key type %T", pub)
+	}
+}
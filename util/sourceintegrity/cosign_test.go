@@ -0,0 +1,265 @@
+package sourceintegrity
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+	ocimocks "github.com/argoproj/argo-cd/v3/util/oci/mocks"
+	utilTest "github.com/argoproj/argo-cd/v3/util/test"
+)
+
+const (
+	cosignRepoURL = "oci://registry.example.com/org/app"
+	cosignDigest  = "sha256:6fd9a5f2a4b1fbb8cd7b4e0f5d39b94c8c9ca9bd79e0ef6bf4e1b5ac0a4e5a4d"
+)
+
+type cosignTestKey struct {
+	pem  string
+	sign func(payload []byte) string
+}
+
+func newECDSATestKey(t *testing.T) cosignTestKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return cosignTestKey{
+		pem: publicKeyPEM(t, key.Public()),
+		sign: func(payload []byte) string {
+			hash := sha256.Sum256(payload)
+			signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+			require.NoError(t, err)
+			return base64.StdEncoding.EncodeToString(signature)
+		},
+	}
+}
+
+func newRSATestKey(t *testing.T) cosignTestKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return cosignTestKey{
+		pem: publicKeyPEM(t, key.Public()),
+		sign: func(payload []byte) string {
+			hash := sha256.Sum256(payload)
+			signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+			require.NoError(t, err)
+			return base64.StdEncoding.EncodeToString(signature)
+		},
+	}
+}
+
+func newEd25519TestKey(t *testing.T) cosignTestKey {
+	t.Helper()
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return cosignTestKey{
+		pem: publicKeyPEM(t, pub),
+		sign: func(payload []byte) string {
+			return base64.StdEncoding.EncodeToString(ed25519.Sign(key, payload))
+		},
+	}
+}
+
+func publicKeyPEM(t *testing.T, pub crypto.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func simpleSigningPayload(digest string) []byte {
+	return fmt.Appendf(nil, `{"critical":{"identity":{"docker-reference":"registry.example.com/org/app"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, digest)
+}
+
+func signedBy(key cosignTestKey, digest string) oci.CosignSignature {
+	payload := simpleSigningPayload(digest)
+	return oci.CosignSignature{Payload: payload, Signature: key.sign(payload)}
+}
+
+func cosignSourceIntegrity(keys ...string) *v1alpha1.SourceIntegrity {
+	return &v1alpha1.SourceIntegrity{OCI: &v1alpha1.SourceIntegrityOCI{Policies: []*v1alpha1.SourceIntegrityOCIPolicy{{
+		Repos:  []v1alpha1.SourceIntegrityOCIPolicyRepo{{URL: "oci://registry.example.com/org/*"}},
+		Cosign: &v1alpha1.SourceIntegrityOCIPolicyCosign{Keys: keys},
+	}}}}
+}
+
+func TestVerifyOCICosign(t *testing.T) {
+	ecdsaKey := newECDSATestKey(t)
+	rsaKey := newRSATestKey(t)
+	ed25519Key := newEd25519TestKey(t)
+	untrustedKey := newECDSATestKey(t)
+
+	tampered := signedBy(ecdsaKey, cosignDigest)
+	tampered.Payload = simpleSigningPayload("sha256:0000000000000000000000000000000000000000000000000000000000000000")
+
+	testCases := []struct {
+		name             string
+		keys             []string
+		signatures       []oci.CosignSignature
+		expectedProblems []string
+	}{
+		{
+			name:       "signed with ECDSA key",
+			keys:       []string{ecdsaKey.pem},
+			signatures: []oci.CosignSignature{signedBy(ecdsaKey, cosignDigest)},
+		},
+		{
+			name:       "signed with RSA key",
+			keys:       []string{rsaKey.pem},
+			signatures: []oci.CosignSignature{signedBy(rsaKey, cosignDigest)},
+		},
+		{
+			name:       "signed with Ed25519 key",
+			keys:       []string{ed25519Key.pem},
+			signatures: []oci.CosignSignature{signedBy(ed25519Key, cosignDigest)},
+		},
+		{
+			name:       "one of the signatures is trusted",
+			keys:       []string{"not a key", ecdsaKey.pem},
+			signatures: []oci.CosignSignature{signedBy(untrustedKey, cosignDigest), signedBy(ecdsaKey, cosignDigest)},
+		},
+		{
+			name:       "unsigned",
+			keys:       []string{ecdsaKey.pem},
+			signatures: []oci.CosignSignature{},
+			expectedProblems: []string{
+				"Failed verifying digest " + cosignDigest + ": no cosign signature found",
+			},
+		},
+		{
+			name:       "signed with untrusted key",
+			keys:       []string{ecdsaKey.pem, rsaKey.pem},
+			signatures: []oci.CosignSignature{signedBy(untrustedKey, cosignDigest)},
+			expectedProblems: []string{
+				"Failed verifying digest " + cosignDigest + ": signature not made by any of the allowed keys",
+			},
+		},
+		{
+			name:       "signature for a different digest",
+			keys:       []string{ecdsaKey.pem},
+			signatures: []oci.CosignSignature{signedBy(ecdsaKey, "sha256:1111111111111111111111111111111111111111111111111111111111111111")},
+			expectedProblems: []string{
+				"Failed verifying digest " + cosignDigest + ": signature is made for a different digest sha256:1111111111111111111111111111111111111111111111111111111111111111",
+			},
+		},
+		{
+			name:       "tampered payload",
+			keys:       []string{ecdsaKey.pem},
+			signatures: []oci.CosignSignature{tampered},
+			expectedProblems: []string{
+				"Failed verifying digest " + cosignDigest + ": signature not made by any of the allowed keys",
+			},
+		},
+		{
+			name:       "malformed signature",
+			keys:       []string{ecdsaKey.pem},
+			signatures: []oci.CosignSignature{{Payload: simpleSigningPayload(cosignDigest), Signature: "%%%"}},
+			expectedProblems: []string{
+				"Failed verifying digest " + cosignDigest + ": malformed signature encoding: illegal base64 data at input byte 0",
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			ociClient := &ocimocks.Client{}
+			ociClient.EXPECT().CosignSignatures(mock.Anything, cosignDigest).Return(tt.signatures, nil)
+
+			result, err := VerifyOCI(t.Context(), cosignSourceIntegrity(tt.keys...), ociClient, cosignRepoURL, cosignDigest)
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, []v1alpha1.SourceIntegrityCheckResultItem{{Name: "OCI/COSIGN", Problems: tt.expectedProblems}}, result.Checks)
+			assert.Equal(t, len(tt.expectedProblems) == 0, result.IsValid())
+		})
+	}
+}
+
+func TestVerifyOCICosignFetchError(t *testing.T) {
+	ociClient := &ocimocks.Client{}
+	ociClient.EXPECT().CosignSignatures(mock.Anything, cosignDigest).Return(nil, errors.New("connection refused"))
+
+	result, err := VerifyOCI(t.Context(), cosignSourceIntegrity(newECDSATestKey(t).pem), ociClient, cosignRepoURL, cosignDigest)
+	require.ErrorContains(t, err, "failed to retrieve cosign signatures for digest "+cosignDigest+": connection refused")
+	assert.Nil(t, result)
+}
+
+func TestOCIPolicyMatching(t *testing.T) {
+	org := &v1alpha1.SourceIntegrityOCIPolicy{
+		Repos: []v1alpha1.SourceIntegrityOCIPolicyRepo{
+			{URL: "oci://registry.example.com/org/*"},
+			{URL: "!oci://registry.example.com/org/unsigned-*"},
+		},
+		Cosign: &v1alpha1.SourceIntegrityOCIPolicyCosign{},
+	}
+	duplicated := &v1alpha1.SourceIntegrityOCIPolicy{
+		Repos:  []v1alpha1.SourceIntegrityOCIPolicyRepo{{URL: "oci://registry.example.com/org/duplicated"}},
+		Cosign: &v1alpha1.SourceIntegrityOCIPolicyCosign{},
+	}
+	si := &v1alpha1.SourceIntegrity{OCI: &v1alpha1.SourceIntegrityOCI{Policies: []*v1alpha1.SourceIntegrityOCIPolicy{org, duplicated}}}
+
+	testCases := []struct {
+		repo           string
+		expectedLogs   []string
+		expectedNoFunc bool
+	}{
+		{
+			repo:         "oci://registry.example.com/org/app",
+			expectedLogs: []string{},
+		},
+		{
+			repo:           "oci://registry.example.com/org/unsigned-app",
+			expectedLogs:   []string{"No oci source integrity policies found for repo URL: oci://registry.example.com/org/unsigned-app"},
+			expectedNoFunc: true,
+		},
+		{
+			repo:         "oci://registry.example.com/org/duplicated",
+			expectedLogs: []string{"multiple (2) oci source integrity policies found for repo URL: oci://registry.example.com/org/duplicated"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.repo, func(t *testing.T) {
+			hook := utilTest.NewLogHook(logrus.InfoLevel)
+			logrus.AddHook(hook)
+			defer hook.CleanupHook()
+
+			forOCIFunc := lookupOCI(si, tt.repo)
+			if tt.expectedNoFunc {
+				assert.Nil(t, forOCIFunc)
+			} else {
+				assert.NotNil(t, forOCIFunc)
+			}
+			assert.Equal(t, tt.expectedLogs, hook.GetEntries())
+		})
+	}
+}
+
+func TestHasCriteriaOCI(t *testing.T) {
+	si := &v1alpha1.SourceIntegrity{OCI: &v1alpha1.SourceIntegrityOCI{Policies: []*v1alpha1.SourceIntegrityOCIPolicy{{
+		Repos:  []v1alpha1.SourceIntegrityOCIPolicyRepo{{URL: "*"}},
+		Cosign: &v1alpha1.SourceIntegrityOCIPolicyCosign{},
+	}}}}
+
+	assert.True(t, HasCriteria(si, v1alpha1.ApplicationSource{RepoURL: "oci://registry.example.com/org/app"}))
+	assert.True(t, HasCriteria(si, v1alpha1.ApplicationSource{RepoURL: "registry.example.com/charts", Chart: "app"}))
+	assert.False(t, HasCriteria(si, v1alpha1.ApplicationSource{RepoURL: "https://charts.example.com", Chart: "app"}))
+	assert.False(t, HasCriteria(si, v1alpha1.ApplicationSource{RepoURL: "https://github.com/org/app.git"}))
+}
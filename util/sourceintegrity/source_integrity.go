@@ -2,6 +2,7 @@ package sourceintegrity
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"os"
@@ -20,12 +21,20 @@ var _gpgDisabledLoggedAlready bool
 
 // HasCriteria determines if any of the sources have some criteria declared
 func HasCriteria(si *v1alpha1.SourceIntegrity, sources ...v1alpha1.ApplicationSource) bool {
-	if si == nil || si.Git == nil {
+	if si == nil {
 		return false
 	}
 
 	for _, source := range sources {
-		if !source.IsZero() && !source.IsOCI() && !source.IsHelm() {
+		if source.IsZero() {
+			continue
+		}
+		switch {
+		case source.IsOCI() || source.IsHelmOci():
+			if lookupOCI(si, source.RepoURL) != nil {
+				return true
+			}
+		case !source.IsHelm():
 			if lookupGit(si, source.RepoURL) != nil {
 				return true
 			}
@@ -35,6 +44,40 @@ func HasCriteria(si *v1alpha1.SourceIntegrity, sources ...v1alpha1.ApplicationSo
 	return false
 }
 
+// Validate returns an error if some SSH keys or X.509 certificates of the git policies cannot be parsed. These are
+// otherwise only logged and ignored when the signatures are verified.
+func Validate(si *v1alpha1.SourceIntegrity) error {
+	if si == nil || si.Git == nil {
+		return nil
+	}
+	var errs []error
+	for i, policy := range si.Git.Policies {
+		if policy == nil {
+			continue
+		}
+		if policy.SSH != nil {
+			for j, key := range policy.SSH.Keys {
+				if _, err := parseSSHPublicKey(key); err != nil {
+					errs = append(errs, fmt.Errorf("invalid ssh key %d of git source integrity policy %d: %w", j, i, err))
+				}
+			}
+		}
+		if policy.X509 != nil {
+			for j, root := range policy.X509.CARoots {
+				if !x509.NewCertPool().AppendCertsFromPEM([]byte(root)) {
+					errs = append(errs, fmt.Errorf("invalid x509 CA certificate %d of git source integrity policy %d: no PEM encoded certificate found", j, i))
+				}
+			}
+			for j, tsa := range policy.X509.TimestampAuthorities {
+				if !x509.NewCertPool().AppendCertsFromPEM([]byte(tsa)) {
+					errs = append(errs, fmt.Errorf("invalid x509 timestamp authority certificate %d of git source integrity policy %d: no PEM encoded certificate found", j, i))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // VerifyGit makes sure the git repository satisfies the criteria declared.
 // It returns nil in case there were no relevant criteria, a check result if there were.
 // The verifiedRevision is expected to be either an annotated tag to a resolved commit sha - the revision, its signature is being verified.
@@ -51,6 +94,10 @@ func VerifyGit(ctx context.Context, si *v1alpha1.SourceIntegrity, gitClient git.
 }
 
 func lookupGit(si *v1alpha1.SourceIntegrity, repoURL string) gitFunc {
+	if si.Git == nil {
+		return nil
+	}
+
 	policies := findMatchingGitPolicies(si.Git, repoURL)
 	nPolicies := len(policies)
 	if nPolicies == 0 {
@@ -68,46 +115,66 @@ func lookupGit(si *v1alpha1.SourceIntegrity, repoURL string) gitFunc {
 	}
 
 	policy := policies[0]
-	if policy.GPG != nil {
-		if policy.GPG.Mode == v1alpha1.SourceIntegrityGitPolicyGPGModeNone {
-			// Declare missing check because there is no verification performed
-			return nil
-		}
+	if policy.GPG == nil && policy.SSH == nil && policy.X509 == nil {
+		log.Warnf("No verification configured for SourceIntegrity policy for %+v", policy.Repos)
+		return nil
+	}
 
-		if !_gpgDisabledLoggedAlready && !IsGPGEnabled() {
+	// Leave out the verifiers that are not performing any verification
+	active := &v1alpha1.SourceIntegrityGitPolicy{Repos: policy.Repos, GPG: policy.GPG, SSH: policy.SSH, X509: policy.X509}
+	if active.GPG != nil && active.GPG.Mode == v1alpha1.SourceIntegrityGitPolicyGPGModeNone {
+		active.GPG = nil
+	}
+	if active.GPG != nil && !IsGPGEnabled() {
+		if !_gpgDisabledLoggedAlready {
 			log.Warnf("SourceIntegrity criteria for git+gpg declared, but it is turned off by ARGOCD_GPG_ENABLED")
 			_gpgDisabledLoggedAlready = true
-			return nil
-		}
-
-		return func(ctx context.Context, gitClient git.Client, verifiedRevision string) (*v1alpha1.SourceIntegrityCheckResult, string, error) {
-			return verify(ctx, policy.GPG, gitClient, verifiedRevision)
 		}
+		active.GPG = nil
+	}
+	if active.SSH != nil && active.SSH.Mode == v1alpha1.SourceIntegrityGitPolicyGPGModeNone {
+		active.SSH = nil
+	}
+	if active.X509 != nil && active.X509.Mode == v1alpha1.SourceIntegrityGitPolicyGPGModeNone {
+		active.X509 = nil
+	}
+	if active.GPG == nil && active.SSH == nil && active.X509 == nil {
+		// Declare missing check because there is no verification performed
+		return nil
 	}
 
-	log.Warnf("No verification configured for SourceIntegrity policy for %+v", policy.Repos)
-	return nil
+	return func(ctx context.Context, gitClient git.Client, verifiedRevision string) (*v1alpha1.SourceIntegrityCheckResult, string, error) {
+		return verify(ctx, active, gitClient, verifiedRevision)
+	}
 }
 
 func findMatchingGitPolicies(si *v1alpha1.SourceIntegrityGit, repoURL string) (policies []*v1alpha1.SourceIntegrityGitPolicy) {
 	for _, p := range si.Policies {
-		include := false
+		urlGlobs := make([]string, 0, len(p.Repos))
 		for _, r := range p.Repos {
-			m := repoMatches(r.URL, repoURL)
-			if m == -1 {
-				include = false
-				break
-			} else if m == 1 {
-				include = true
-			}
+			urlGlobs = append(urlGlobs, r.URL)
 		}
-		if include {
+		if includesRepo(urlGlobs, repoURL) {
 			policies = append(policies, p)
 		}
 	}
 	return policies
 }
 
+// includesRepo reports whether the repoURL is matched by at least one of the urlGlobs, and it is not excluded by any.
+func includesRepo(urlGlobs []string, repoURL string) bool {
+	include := false
+	for _, urlGlob := range urlGlobs {
+		m := repoMatches(urlGlob, repoURL)
+		if m == -1 {
+			return false
+		} else if m == 1 {
+			include = true
+		}
+	}
+	return include
+}
+
 func repoMatches(urlGlob string, repoURL string) int {
 	if strings.HasPrefix(urlGlob, "!") {
 		if glob.Match(urlGlob[1:], repoURL) {
@@ -122,38 +189,65 @@ func repoMatches(urlGlob string, repoURL string) int {
 	return 0
 }
 
-func verify(ctx context.Context, g *v1alpha1.SourceIntegrityGitPolicyGPG, gitClient git.Client, verifiedRevision string) (*v1alpha1.SourceIntegrityCheckResult, string, error) {
-	const checkName = "GIT/GPG"
+// verify checks the signatures using all the verifiers configured in the policy. Each signature is verified by the one
+// matching its format, and the history is verified when at least one of the verifiers is in the strict mode.
+func verify(ctx context.Context, policy *v1alpha1.SourceIntegrityGitPolicy, gitClient git.Client, verifiedRevision string) (*v1alpha1.SourceIntegrityCheckResult, string, error) {
+	var names []string
+	var modes []v1alpha1.SourceIntegrityGitPolicyGPGMode
+	var gpgKeys []string
+	if policy.GPG != nil {
+		names = append(names, "GPG")
+		modes = append(modes, policy.GPG.Mode)
+		gpgKeys = policy.GPG.Keys
+	}
+	if policy.SSH != nil {
+		names = append(names, "SSH")
+		modes = append(modes, policy.SSH.Mode)
+	}
+	if policy.X509 != nil {
+		names = append(names, "X509")
+		modes = append(modes, policy.X509.Mode)
+	}
+	checkName := "GIT/" + strings.Join(names, "+")
 
-	var deep bool
-	switch g.Mode {
-	// verify tag if on tag, latest revision otherwise
-	case v1alpha1.SourceIntegrityGitPolicyGPGModeHead:
-		deep = false
-	// verify history from the current commit
-	case v1alpha1.SourceIntegrityGitPolicyGPGModeStrict:
-		deep = true
-	default:
-		return nil, "", fmt.Errorf("unknown GPG mode %q configured for GIT source integrity", g.Mode)
+	deep := false
+	for i, mode := range modes {
+		switch mode {
+		// verify tag if on tag, latest revision otherwise
+		case v1alpha1.SourceIntegrityGitPolicyGPGModeHead:
+		// verify history from the current commit
+		case v1alpha1.SourceIntegrityGitPolicyGPGModeStrict:
+			deep = true
+		default:
+			return nil, "", fmt.Errorf("unknown %s mode %q configured for GIT source integrity", names[i], mode)
+		}
+	}
+
+	var verifier git.SignatureVerifier
+	if policy.SSH != nil || policy.X509 != nil {
+		verifier = newSignatureVerifier(policy.SSH, policy.X509)
 	}
 
 	// TODO: Remove deprecated https://github.com/argoproj/argo-cd/issues/27695
-	signatures, legacyVerification, err := gitClient.LsSignatures(ctx, verifiedRevision, deep)
+	signatures, legacyVerification, err := gitClient.LsSignatures(ctx, verifiedRevision, deep, verifier)
 	if err != nil {
 		return nil, "", err
 	}
-	legacyDescriptionCondition := VerifyGnuPGSignature(verifiedRevision, g.Keys, legacyVerification)
-	legacyDescription := ""
-	if legacyDescriptionCondition != nil {
-		legacyDescription = legacyDescriptionCondition.Message
-	}
-
 	if len(signatures) == 0 {
 		// Checked for defensiveness, there should be 1+ signatures always
 		return nil, "", errors.New("git LsSignatures found no signatures for " + verifiedRevision)
 	}
 
-	problems, legacyGood := describeProblems(g, signatures)
+	legacyDescription := ""
+	// The legacy verification understands GnuPG signatures only
+	if signatures[0].SignatureFormat != git.SignatureFormatSSH && signatures[0].SignatureFormat != git.SignatureFormatX509 {
+		legacyDescriptionCondition := VerifyGnuPGSignature(verifiedRevision, gpgKeys, legacyVerification)
+		if legacyDescriptionCondition != nil {
+			legacyDescription = legacyDescriptionCondition.Message
+		}
+	}
+
+	problems, legacyGood := describeProblems(policy.GPG, signatures)
 	result := &v1alpha1.SourceIntegrityCheckResult{Checks: []v1alpha1.SourceIntegrityCheckResultItem{{
 		Name:     checkName,
 		Problems: problems,
@@ -164,6 +258,30 @@ func verify(ctx context.Context, g *v1alpha1.SourceIntegrityGitPolicyGPG, gitCli
 	return result, legacyDescription, nil
 }
 
+// newSignatureVerifier creates a verifier of the git signatures made in SSH and X.509 formats. The signatures in
+// formats with no configured verifier are reported as made by an untrusted key.
+func newSignatureVerifier(sshPolicy *v1alpha1.SourceIntegrityGitPolicySSH, x509Policy *v1alpha1.SourceIntegrityGitPolicyX509) git.SignatureVerifier {
+	var sshV *sshVerifier
+	if sshPolicy != nil {
+		sshV = newSSHVerifier(sshPolicy)
+	}
+	var x509V *x509Verifier
+	if x509Policy != nil {
+		x509V = newX509Verifier(x509Policy)
+	}
+
+	return func(format git.SignatureFormat, signature string, payload []byte) (git.GPGVerificationResult, string) {
+		switch {
+		case format == git.SignatureFormatSSH && sshV != nil:
+			return sshV.verify(signature, payload)
+		case format == git.SignatureFormatX509 && x509V != nil:
+			return x509V.verify(signature, payload)
+		default:
+			return git.GPGVerificationResultUntrusted, ""
+		}
+	}
+}
+
 // describeProblems reports 10 most recent problematic signatures or unsigned commits.
 // The number is limited not to flood the UI and logs with too many problems. Problems related to the same signing key are squashed.
 func describeProblems(g *v1alpha1.SourceIntegrityGitPolicyGPG, signatureInfos []git.RevisionSignatureInfo) (problems []string, legacyDescription string) {
@@ -212,6 +330,8 @@ func describeProblems(g *v1alpha1.SourceIntegrityGitPolicyGPG, signatureInfos []
 
 // gpgProblemMessage generates a message describing GPG verification issues for a specific revision signature and the configured policy.
 // When an empty string is returned, it means there is no problem - the validation has passed.
+// The SSH and X.509 signatures are reported good by the signature verifier only when made by the trusted keys, so there
+// is nothing to check on top of the verification result for those.
 func gpgProblemMessage(g *v1alpha1.SourceIntegrityGitPolicyGPG, signatureInfo git.RevisionSignatureInfo) string {
 	if signatureInfo.VerificationResult != git.GPGVerificationResultGood {
 		return fmt.Sprintf(
@@ -220,7 +340,16 @@ func gpgProblemMessage(g *v1alpha1.SourceIntegrityGitPolicyGPG, signatureInfo gi
 		)
 	}
 
-	for _, allowedKey := range g.Keys {
+	if signatureInfo.SignatureFormat == git.SignatureFormatSSH || signatureInfo.SignatureFormat == git.SignatureFormatX509 {
+		return ""
+	}
+
+	// OpenPGP signatures are reported good for every key in the keyring, even when GPG is not configured for the policy
+	var allowedKeys []string
+	if g != nil {
+		allowedKeys = g.Keys
+	}
+	for _, allowedKey := range allowedKeys {
 		allowedKey, err := KeyID(allowedKey)
 		if err != nil {
 			log.Error(err.Error())
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/git"
@@ -65,7 +66,7 @@ func TestGPGUnknownMode(t *testing.T) {
 	gitClient.EXPECT().CommitSHA(mock.Anything).Return("DEADBEEF", nil)
 
 	s := &v1alpha1.SourceIntegrityGitPolicyGPG{Mode: "foobar", Keys: []string{}}
-	result, _, err := verify(t.Context(), s, gitClient, "https://github.com/argoproj/argo-cd.git")
+	result, _, err := verify(t.Context(), &v1alpha1.SourceIntegrityGitPolicy{GPG: s}, gitClient, "https://github.com/argoproj/argo-cd.git")
 	require.ErrorContains(t, err, `unknown GPG mode "foobar" configured for GIT source integrity`)
 	assert.Nil(t, result)
 }
@@ -224,7 +225,7 @@ func TestComparingWithGPGFingerprint(t *testing.T) {
 	require.True(t, IsLongKeyID(fingerprint))
 
 	gitClient := &gitmocks.Client{}
-	gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything).Return(
+	gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
 		[]git.RevisionSignatureInfo{{
 			Revision: "1.0", VerificationResult: git.GPGVerificationResultGood, SignatureKeyID: shortKey, Date: "ignored", AuthorIdentity: "ignored",
 		}},
@@ -236,7 +237,7 @@ gpg: Good signature from "test user <testuser@example.com>" [ultimate]`, fingerp
 
 	gpgWithTag := &v1alpha1.SourceIntegrityGitPolicyGPG{Mode: v1alpha1.SourceIntegrityGitPolicyGPGModeHead, Keys: []string{fingerprint}}
 	// And verifying a given revision
-	result, legacy, err := verify(t.Context(), gpgWithTag, gitClient, "1.0")
+	result, legacy, err := verify(t.Context(), &v1alpha1.SourceIntegrityGitPolicy{GPG: gpgWithTag}, gitClient, "1.0")
 	require.NoError(t, err)
 
 	assert.True(t, result.IsValid())
@@ -255,14 +256,14 @@ func TestGPGHeadValid(t *testing.T) {
 		{
 			revision: sha,
 			check: func(gitClient *gitmocks.Client, logger utilTest.LogHook) {
-				gitClient.AssertCalled(t, "LsSignatures", mock.Anything, sha, false)
+				gitClient.AssertCalled(t, "LsSignatures", mock.Anything, sha, false, mock.Anything)
 				assert.Empty(t, logger.GetEntries())
 			},
 		},
 		{
 			revision: tag,
 			check: func(gitClient *gitmocks.Client, logger utilTest.LogHook) {
-				gitClient.AssertCalled(t, "LsSignatures", mock.Anything, tag, false)
+				gitClient.AssertCalled(t, "LsSignatures", mock.Anything, tag, false, mock.Anything)
 				assert.Empty(t, logger.GetEntries())
 			},
 		},
@@ -272,7 +273,7 @@ func TestGPGHeadValid(t *testing.T) {
 		t.Run("verify "+test.revision, func(t *testing.T) {
 			// Given repo with a tagged commit
 			gitClient := &gitmocks.Client{}
-			gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, revision string, _ bool) ([]git.RevisionSignatureInfo, string, error) {
+			gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, revision string, _ bool, _ git.SignatureVerifier) ([]git.RevisionSignatureInfo, string, error) {
 				signatureInfos := []git.RevisionSignatureInfo{{
 					Revision: revision, VerificationResult: git.GPGVerificationResultGood, SignatureKeyID: keyId, Date: "ignored", AuthorIdentity: "ignored",
 				}}
@@ -292,7 +293,7 @@ gpg: Good signature from "test user <testuser@example.com>" [ultimate]`, keyId)
 				Keys: []string{keyId, "0000000000000000"},
 			}
 			// And verifying a given revision
-			result, legacy, err := verify(t.Context(), gpgWithTag, gitClient, test.revision)
+			result, legacy, err := verify(t.Context(), &v1alpha1.SourceIntegrityGitPolicy{GPG: gpgWithTag}, gitClient, test.revision)
 			require.NoError(t, err)
 			// Then it is checked and valid
 			assert.True(t, result.IsValid())
@@ -535,8 +536,8 @@ GIT/GPG: Failed verifying revision %s by 'ignored': signed with unallowed key (k
 		t.Run("verify "+test.revision, func(t *testing.T) {
 			// Given repo with a tagged commit
 			gitClient := &gitmocks.Client{}
-			gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
-				func(_ context.Context, revision string, deep bool) (info []git.RevisionSignatureInfo, legacy string, err error) {
+			gitClient.EXPECT().LsSignatures(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+				func(_ context.Context, revision string, deep bool, _ git.SignatureVerifier) (info []git.RevisionSignatureInfo, legacy string, err error) {
 					if ret, ok := lsSignatures[deep][revision]; ok {
 						legacy := fmt.Sprintf(`gpg: Signature made %s
 gpg:                using RSA key D6E87BF6B9AE64079FFEDC02%s
@@ -558,7 +559,7 @@ gpg: Good signature from "%s" [ultimate]`, "Wed Feb 26 23:22:34 2020 CET", ret[0
 				Keys: []string{keyOfFirst, keyOfSecond},
 			}
 			// And verifying a given revision
-			result, legacy, err := verify(t.Context(), gpgWithTag, gitClient, test.revision)
+			result, legacy, err := verify(t.Context(), &v1alpha1.SourceIntegrityGitPolicy{GPG: gpgWithTag}, gitClient, test.revision)
 			require.NoError(t, err)
 
 			// Then it is checked and valid
@@ -577,3 +578,61 @@ gpg: Good signature from "%s" [ultimate]`, "Wed Feb 26 23:22:34 2020 CET", ret[0
 		})
 	}
 }
+
+func TestMultipleVerifiers(t *testing.T) {
+	const gpgKey = "4cfe068f80b1681b"
+	fixtureKey, err := parseSSHPublicKey(sshFixtureKey)
+	require.NoError(t, err)
+	sshKeyID := ssh.FingerprintSHA256(fixtureKey.key)
+
+	gitClient := &gitmocks.Client{}
+	gitClient.EXPECT().LsSignatures(mock.Anything, "HEAD", true, mock.Anything).RunAndReturn(
+		func(_ context.Context, _ string, _ bool, verifier git.SignatureVerifier) ([]git.RevisionSignatureInfo, string, error) {
+			require.NotNil(t, verifier)
+			result, keyID := verifier(git.SignatureFormatSSH, sshFixtureSignature, []byte(sshFixturePayload))
+			assert.Equal(t, git.GPGVerificationResultGood, result)
+			assert.Equal(t, sshKeyID, keyID)
+			// No X.509 verifier configured
+			result, _ = verifier(git.SignatureFormatX509, x509FixtureSignature, []byte(x509FixturePayload))
+			assert.Equal(t, git.GPGVerificationResultUntrusted, result)
+
+			return []git.RevisionSignatureInfo{
+				{Revision: "3333333333333333333333333333333333333333", VerificationResult: git.GPGVerificationResultGood, SignatureFormat: git.SignatureFormatSSH, SignatureKeyID: sshKeyID, Date: "ignored", AuthorIdentity: "ssh"},
+				{Revision: "2222222222222222222222222222222222222222", VerificationResult: git.GPGVerificationResultGood, SignatureFormat: git.SignatureFormatOpenPGP, SignatureKeyID: gpgKey, Date: "ignored", AuthorIdentity: "gpg"},
+				{Revision: "1111111111111111111111111111111111111111", VerificationResult: git.GPGVerificationResultGood, SignatureFormat: git.SignatureFormatOpenPGP, SignatureKeyID: "0000000000000000", Date: "ignored", AuthorIdentity: "other"},
+			}, "", nil
+		},
+	)
+
+	policy := &v1alpha1.SourceIntegrityGitPolicy{
+		GPG: &v1alpha1.SourceIntegrityGitPolicyGPG{Mode: v1alpha1.SourceIntegrityGitPolicyGPGModeHead, Keys: []string{gpgKey}},
+		SSH: &v1alpha1.SourceIntegrityGitPolicySSH{Mode: v1alpha1.SourceIntegrityGitPolicyGPGModeStrict, Keys: []string{sshFixtureKey}},
+	}
+	result, legacy, err := verify(t.Context(), policy, gitClient, "HEAD")
+	require.NoError(t, err)
+
+	assert.False(t, result.IsValid())
+	require.Len(t, result.Checks, 1)
+	assert.Equal(t, "GIT/GPG+SSH", result.Checks[0].Name)
+	require.Len(t, result.Checks[0].Problems, 1)
+	assert.Contains(t, result.Checks[0].Problems[0], "1111111111111111111111111111111111111111")
+	assert.Equal(t, "Good signature from ssh key "+sshKeyID, legacy)
+}
+
+func TestValidate(t *testing.T) {
+	ca := newX509TestCA(t)
+	require.NoError(t, Validate(nil))
+	require.NoError(t, Validate(&v1alpha1.SourceIntegrity{Git: &v1alpha1.SourceIntegrityGit{Policies: []*v1alpha1.SourceIntegrityGitPolicy{{
+		SSH:  &v1alpha1.SourceIntegrityGitPolicySSH{Keys: []string{sshFixtureKey, "dev@example.com " + sshFixtureKey}},
+		X509: &v1alpha1.SourceIntegrityGitPolicyX509{CARoots: []string{ca.pem()}, TimestampAuthorities: []string{ca.pem()}, Identities: []string{"*"}},
+	}}}}))
+
+	err := Validate(&v1alpha1.SourceIntegrity{Git: &v1alpha1.SourceIntegrityGit{Policies: []*v1alpha1.SourceIntegrityGitPolicy{
+		{SSH: &v1alpha1.SourceIntegrityGitPolicySSH{Keys: []string{sshFixtureKey, `dev@example.com namespaces="file" ` + sshFixtureKey}}},
+		{X509: &v1alpha1.SourceIntegrityGitPolicyX509{CARoots: []string{"ca"}, TimestampAuthorities: []string{"tsa"}, Identities: []string{"*"}}},
+	}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid ssh key 1 of git source integrity policy 0: failed to parse SSH public key of dev@example.com: the namespaces do not include "git"`)
+	assert.Contains(t, err.Error(), "invalid x509 CA certificate 0 of git source integrity policy 1")
+	assert.Contains(t, err.Error(), "invalid x509 timestamp authority certificate 0 of git source integrity policy 1")
+}
@@ -0,0 +1,221 @@
+package sourceintegrity
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"slices"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+const (
+	// sshSignatureMagic is the preamble of both the SSH signature blob and the signed data
+	sshSignatureMagic = "SSHSIG"
+	// sshSignatureNamespace is the namespace git signs the commits and tags in
+	sshSignatureNamespace = "git"
+)
+
+// sshSignature is the SSH signature blob, as described by
+// https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig
+type sshSignature struct {
+	Magic         [6]byte
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// sshSignedData is the data the SSH signature is made for, following the sshSignatureMagic preamble
+type sshSignedData struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+// sshVerifier verifies SSH signatures of git objects against the trusted keys
+type sshVerifier struct {
+	keys []*sshTrustedKey
+}
+
+// sshTrustedKey is a trusted key, which is restricted to the principals of its allowed_signers line if any
+type sshTrustedKey struct {
+	key        ssh.PublicKey
+	principals []string
+}
+
+func newSSHVerifier(s *v1alpha1.SourceIntegrityGitPolicySSH) *sshVerifier {
+	v := &sshVerifier{}
+	for _, k := range s.Keys {
+		key, err := parseSSHPublicKey(k)
+		if err != nil {
+			log.Error(err.Error())
+			continue
+		}
+		v.keys = append(v.keys, key)
+	}
+	return v
+}
+
+// verify checks the armored SSH signature is made by one of the trusted keys for the payload, and that the key is
+// trusted for the committer or tagger of the payload. It reports the fingerprint of the signing key as its ID, in the
+// same format as `ssh-keygen -l` does.
+func (v *sshVerifier) verify(signature string, payload []byte) (git.GPGVerificationResult, string) {
+	sig, key, err := parseSSHSignature(signature)
+	if err != nil {
+		log.Warnf("Failed parsing SSH signature: %v", err)
+		return git.GPGVerificationResultBad, ""
+	}
+	keyID := ssh.FingerprintSHA256(key)
+
+	if sig.Namespace != sshSignatureNamespace {
+		log.Warnf("SSH signature made by %s is made for namespace %q, expected %q", keyID, sig.Namespace, sshSignatureNamespace)
+		return git.GPGVerificationResultBad, keyID
+	}
+
+	var h hash.Hash
+	switch sig.HashAlgorithm {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		log.Warnf("SSH signature made by %s uses unsupported hash algorithm %q", keyID, sig.HashAlgorithm)
+		return git.GPGVerificationResultBad, keyID
+	}
+	h.Write(payload)
+
+	signed := append([]byte(sshSignatureMagic), ssh.Marshal(sshSignedData{
+		Namespace:     sig.Namespace,
+		Reserved:      sig.Reserved,
+		HashAlgorithm: sig.HashAlgorithm,
+		Hash:          h.Sum(nil),
+	})...)
+	blob := &ssh.Signature{}
+	if err := ssh.Unmarshal(sig.Signature, blob); err != nil {
+		log.Warnf("Failed parsing SSH signature made by %s: %v", keyID, err)
+		return git.GPGVerificationResultBad, keyID
+	}
+	if err := key.Verify(signed, blob); err != nil {
+		return git.GPGVerificationResultBad, keyID
+	}
+
+	email := signerEmail(payload)
+	for _, trusted := range v.keys {
+		if bytes.Equal(trusted.key.Marshal(), key.Marshal()) && trusted.principalAllowed(email) {
+			return git.GPGVerificationResultGood, keyID
+		}
+	}
+	return git.GPGVerificationResultUntrusted, keyID
+}
+
+// principalAllowed reports whether the key is trusted for the given e-mail
+func (k *sshTrustedKey) principalAllowed(email string) bool {
+	if k.principals == nil {
+		return true
+	}
+	if email == "" {
+		return false
+	}
+	for _, principal := range k.principals {
+		if glob.Match(principal, email) {
+			return true
+		}
+	}
+	return false
+}
+
+// signerEmail returns the e-mail of the committer of the commit, or of the tagger of the tag, in the payload
+func signerEmail(payload []byte) string {
+	header, _, _ := bytes.Cut(payload, []byte("\n\n"))
+	for _, line := range strings.Split(string(header), "\n") {
+		ident, ok := strings.CutPrefix(line, "committer ")
+		if !ok {
+			ident, ok = strings.CutPrefix(line, "tagger ")
+		}
+		if !ok {
+			continue
+		}
+		_, email, found := strings.Cut(ident, "<")
+		if !found {
+			return ""
+		}
+		email, _, found = strings.Cut(email, ">")
+		if !found {
+			return ""
+		}
+		return email
+	}
+	return ""
+}
+
+func parseSSHSignature(signature string) (*sshSignature, ssh.PublicKey, error) {
+	block, _ := pem.Decode([]byte(signature))
+	if block == nil || block.Type != "SSH SIGNATURE" {
+		return nil, nil, errors.New("signature is not an armored SSH signature")
+	}
+
+	sig := &sshSignature{}
+	if err := ssh.Unmarshal(block.Bytes, sig); err != nil {
+		return nil, nil, err
+	}
+	if string(sig.Magic[:]) != sshSignatureMagic {
+		return nil, nil, errors.New("invalid SSH signature preamble")
+	}
+	if sig.Version != 1 {
+		return nil, nil, fmt.Errorf("unsupported SSH signature version %d", sig.Version)
+	}
+
+	key, err := ssh.ParsePublicKey(sig.PublicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed parsing the signing key: %w", err)
+	}
+	return sig, key, nil
+}
+
+// parseSSHPublicKey parses a key in the authorized_keys format, with no options, or a line in the allowed_signers
+// format. The key of an allowed_signers line is trusted for its principals only, and for all namespaces unless the line
+// restricts them, in which case they must include "git". The other allowed_signers options are not supported.
+func parseSSHPublicKey(line string) (*sshTrustedKey, error) {
+	line = strings.TrimSpace(line)
+	if pub, _, options, _, err := ssh.ParseAuthorizedKey([]byte(line)); err == nil && len(options) == 0 {
+		return &sshTrustedKey{key: pub}, nil
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) < 2 {
+		return nil, errors.New("failed to parse SSH public key: no key found")
+	}
+	principals := fields[0]
+	pub, _, options, _, err := ssh.ParseAuthorizedKey([]byte(strings.TrimSpace(line[len(principals):])))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SSH public key: %w", err)
+	}
+	restricted := false
+	gitNamespace := false
+	for _, option := range options {
+		name, value, _ := strings.Cut(option, "=")
+		if !strings.EqualFold(name, "namespaces") {
+			return nil, fmt.Errorf("failed to parse SSH public key of %s: unsupported option %q", principals, name)
+		}
+		restricted = true
+		gitNamespace = gitNamespace || slices.Contains(strings.Split(strings.Trim(value, `"`), ","), sshSignatureNamespace)
+	}
+	if restricted && !gitNamespace {
+		return nil, fmt.Errorf("failed to parse SSH public key of %s: the namespaces do not include %q", principals, sshSignatureNamespace)
+	}
+	return &sshTrustedKey{key: pub, principals: strings.Split(principals, ",")}, nil
+}
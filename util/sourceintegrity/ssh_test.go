@@ -0,0 +1,220 @@
+package sourceintegrity
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// Made by `ssh-keygen -Y sign -n git -f key payload`
+const (
+	sshFixtureKey       = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIHLFHqUSGY/udCb2x7zuy/46Kng4YT9dOQlrW9LFrUUb dev@example.com"
+	sshFixturePayload   = "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\nauthor Dev <dev@example.com> 1700000000 +0000\ncommitter Dev <dev@example.com> 1700000000 +0000\n\nsigned\n"
+	sshFixtureSignature = `-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAgcsUepRIZj+50JvbHvO7L/joqeD
+hhP105CWtb0sWtRRsAAAADZ2l0AAAAAAAAAAZzaGE1MTIAAABTAAAAC3NzaC1lZDI1NTE5
+AAAAQG6yitHCKOl8bHdVJNxtgzcBDYmTcSkTTgBK7uar84VMhTadRzkZdXihrqFXEOsWwx
+qmN3eQs0xFQf4f5fpACwc=
+-----END SSH SIGNATURE-----
+`
+)
+
+func newSSHTestSigner(t *testing.T, rsaKey bool) ssh.Signer {
+	t.Helper()
+	var signer ssh.Signer
+	var err error
+	if rsaKey {
+		key, keyErr := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, keyErr)
+		signer, err = ssh.NewSignerFromKey(key)
+	} else {
+		key, keyErr := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, keyErr)
+		signer, err = ssh.NewSignerFromKey(key)
+	}
+	require.NoError(t, err)
+	return signer
+}
+
+func sshSign(t *testing.T, signer ssh.Signer, namespace string, payload []byte) string {
+	t.Helper()
+	hash := sha256.Sum256(payload)
+	signed := append([]byte(sshSignatureMagic), ssh.Marshal(sshSignedData{
+		Namespace:     namespace,
+		HashAlgorithm: "sha256",
+		Hash:          hash[:],
+	})...)
+	signature, err := signer.Sign(rand.Reader, signed)
+	require.NoError(t, err)
+
+	blob := ssh.Marshal(sshSignature{
+		Magic:         [6]byte([]byte(sshSignatureMagic)),
+		Version:       1,
+		PublicKey:     signer.PublicKey().Marshal(),
+		Namespace:     namespace,
+		HashAlgorithm: "sha256",
+		Signature:     ssh.Marshal(signature),
+	})
+	return string(pem.EncodeToMemory(&pem.Block{Type: "SSH SIGNATURE", Bytes: blob}))
+}
+
+func authorizedKey(signer ssh.Signer) string {
+	return string(ssh.MarshalAuthorizedKey(signer.PublicKey()))
+}
+
+func TestVerifySSHSignature(t *testing.T) {
+	payload := []byte("commit payload")
+	ecdsaSigner := newSSHTestSigner(t, false)
+	rsaSigner := newSSHTestSigner(t, true)
+	untrustedSigner := newSSHTestSigner(t, false)
+
+	fixtureKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(sshFixtureKey))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		keys           []string
+		signature      string
+		payload        []byte
+		expectedResult git.GPGVerificationResult
+		expectedKeyID  string
+	}{
+		{
+			name:           "signed by ssh-keygen",
+			keys:           []string{sshFixtureKey},
+			signature:      sshFixtureSignature,
+			payload:        []byte(sshFixturePayload),
+			expectedResult: git.GPGVerificationResultGood,
+			expectedKeyID:  ssh.FingerprintSHA256(fixtureKey),
+		},
+		{
+			name:           "allowed signers format",
+			keys:           []string{`dev@example.com namespaces="git" ` + sshFixtureKey},
+			signature:      sshFixtureSignature,
+			payload:        []byte(sshFixturePayload),
+			expectedResult: git.GPGVerificationResultGood,
+			expectedKeyID:  ssh.FingerprintSHA256(fixtureKey),
+		},
+		{
+			name:           "allowed signers principal glob",
+			keys:           []string{`admin@example.com,*@example.com namespaces="file,git" ` + sshFixtureKey},
+			signature:      sshFixtureSignature,
+			payload:        []byte(sshFixturePayload),
+			expectedResult: git.GPGVerificationResultGood,
+			expectedKeyID:  ssh.FingerprintSHA256(fixtureKey),
+		},
+		{
+			name:           "allowed signers for other committer",
+			keys:           []string{`admin@example.com namespaces="git" ` + sshFixtureKey},
+			signature:      sshFixtureSignature,
+			payload:        []byte(sshFixturePayload),
+			expectedResult: git.GPGVerificationResultUntrusted,
+			expectedKeyID:  ssh.FingerprintSHA256(fixtureKey),
+		},
+		{
+			name:           "allowed signers without namespaces",
+			keys:           []string{"dev@example.com " + sshFixtureKey},
+			signature:      sshFixtureSignature,
+			payload:        []byte(sshFixturePayload),
+			expectedResult: git.GPGVerificationResultGood,
+			expectedKeyID:  ssh.FingerprintSHA256(fixtureKey),
+		},
+		{
+			name:           "allowed signers with several namespaces options",
+			keys:           []string{`dev@example.com namespaces="git",namespaces="file" ` + sshFixtureKey},
+			signature:      sshFixtureSignature,
+			payload:        []byte(sshFixturePayload),
+			expectedResult: git.GPGVerificationResultGood,
+			expectedKeyID:  ssh.FingerprintSHA256(fixtureKey),
+		},
+		{
+			name:           "allowed signers for other namespaces",
+			keys:           []string{`dev@example.com namespaces="file" ` + sshFixtureKey},
+			signature:      sshFixtureSignature,
+			payload:        []byte(sshFixturePayload),
+			expectedResult: git.GPGVerificationResultUntrusted,
+			expectedKeyID:  ssh.FingerprintSHA256(fixtureKey),
+		},
+		{
+			name:           "allowed signers with unsupported option",
+			keys:           []string{`dev@example.com cert-authority,namespaces="git" ` + sshFixtureKey},
+			signature:      sshFixtureSignature,
+			payload:        []byte(sshFixturePayload),
+			expectedResult: git.GPGVerificationResultUntrusted,
+			expectedKeyID:  ssh.FingerprintSHA256(fixtureKey),
+		},
+		{
+			name:           "signed with ECDSA key",
+			keys:           []string{"not a key", authorizedKey(ecdsaSigner)},
+			signature:      sshSign(t, ecdsaSigner, "git", payload),
+			payload:        payload,
+			expectedResult: git.GPGVerificationResultGood,
+			expectedKeyID:  ssh.FingerprintSHA256(ecdsaSigner.PublicKey()),
+		},
+		{
+			name:           "signed with RSA key",
+			keys:           []string{authorizedKey(rsaSigner)},
+			signature:      sshSign(t, rsaSigner, "git", payload),
+			payload:        payload,
+			expectedResult: git.GPGVerificationResultGood,
+			expectedKeyID:  ssh.FingerprintSHA256(rsaSigner.PublicKey()),
+		},
+		{
+			name:           "signed with untrusted key",
+			keys:           []string{authorizedKey(ecdsaSigner), authorizedKey(rsaSigner)},
+			signature:      sshSign(t, untrustedSigner, "git", payload),
+			payload:        payload,
+			expectedResult: git.GPGVerificationResultUntrusted,
+			expectedKeyID:  ssh.FingerprintSHA256(untrustedSigner.PublicKey()),
+		},
+		{
+			name:           "tampered payload",
+			keys:           []string{sshFixtureKey},
+			signature:      sshFixtureSignature,
+			payload:        []byte(sshFixturePayload + "tampered"),
+			expectedResult: git.GPGVerificationResultBad,
+			expectedKeyID:  ssh.FingerprintSHA256(fixtureKey),
+		},
+		{
+			name:           "signed for other namespace",
+			keys:           []string{authorizedKey(ecdsaSigner)},
+			signature:      sshSign(t, ecdsaSigner, "file", payload),
+			payload:        payload,
+			expectedResult: git.GPGVerificationResultBad,
+			expectedKeyID:  ssh.FingerprintSHA256(ecdsaSigner.PublicKey()),
+		},
+		{
+			name:           "malformed signature",
+			keys:           []string{sshFixtureKey},
+			signature:      "-----BEGIN SSH SIGNATURE-----\nU1NIU0lH\n-----END SSH SIGNATURE-----\n",
+			payload:        payload,
+			expectedResult: git.GPGVerificationResultBad,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := newSSHVerifier(&v1alpha1.SourceIntegrityGitPolicySSH{Keys: tt.keys})
+			result, keyID := verifier.verify(tt.signature, tt.payload)
+			assert.Equal(t, tt.expectedResult, result)
+			assert.Equal(t, tt.expectedKeyID, keyID)
+		})
+	}
+}
+
+func TestSignerEmail(t *testing.T) {
+	assert.Equal(t, "dev@example.com", signerEmail([]byte(sshFixturePayload)))
+	assert.Equal(t, "release@example.com", signerEmail([]byte("object 4b825dc642cb6eb9a060e54bf8d69288fbee4904\ntype commit\ntag v1.0.0\ntagger Release <release@example.com> 1700000000 +0000\n\nv1.0.0\n")))
+	assert.Empty(t, signerEmail([]byte("tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\n\ncommitter Dev <dev@example.com> 1700000000 +0000\n")))
+}
@@ -0,0 +1,212 @@
+package sourceintegrity
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	cms "github.com/github/smimesign/ietf-cms"
+	"github.com/github/smimesign/ietf-cms/oid"
+	"github.com/github/smimesign/ietf-cms/protocol"
+	"github.com/github/smimesign/ietf-cms/timestamp"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+// x509Verifier verifies X.509 signatures of git objects against the trusted CAs
+type x509Verifier struct {
+	roots                *x509.CertPool
+	identities           []string
+	timestampAuthorities *x509.CertPool
+}
+
+func newX509Verifier(x *v1alpha1.SourceIntegrityGitPolicyX509) *x509Verifier {
+	v := &x509Verifier{roots: x509.NewCertPool(), identities: x.Identities}
+	for _, root := range x.CARoots {
+		if !v.roots.AppendCertsFromPEM([]byte(root)) {
+			log.Error("failed to parse X.509 CA certificate: no PEM encoded certificate found")
+		}
+	}
+	if len(x.TimestampAuthorities) > 0 {
+		v.timestampAuthorities = x509.NewCertPool()
+		for _, tsa := range x.TimestampAuthorities {
+			if !v.timestampAuthorities.AppendCertsFromPEM([]byte(tsa)) {
+				log.Error("failed to parse X.509 timestamp authority certificate: no PEM encoded certificate found")
+			}
+		}
+	}
+	if len(v.identities) == 0 {
+		log.Error("no identities configured for X.509 signature verification, no signature will be trusted")
+	}
+	return v
+}
+
+// verify checks the armored CMS signature is made for the payload by a certificate issued for code signing to one of
+// the trusted identities by one of the trusted CAs. The certificate must be valid at the time of a timestamp of the
+// signature issued by one of the trusted timestamp authorities, or at the current time when there is none. The signing
+// time declared by the signature itself is not trusted. It reports the serial number of the signing certificate as its
+// ID.
+func (v *x509Verifier) verify(signature string, payload []byte) (git.GPGVerificationResult, string) {
+	signedData, err := parseCMSSignature(signature)
+	if err != nil {
+		log.Warnf("Failed parsing X.509 signature: %v", err)
+		return git.GPGVerificationResultBad, ""
+	}
+	if len(signedData.SignerInfos) != 1 {
+		log.Warnf("X.509 signature has %d signers, expected 1", len(signedData.SignerInfos))
+		return git.GPGVerificationResultBad, ""
+	}
+	signer := &signedData.SignerInfos[0]
+
+	certificates, err := signedData.X509Certificates()
+	if err != nil {
+		log.Warnf("Failed parsing X.509 signature certificates: %v", err)
+		return git.GPGVerificationResultBad, ""
+	}
+	certificate, err := signer.FindCertificate(certificates)
+	if err != nil {
+		log.Warnf("Failed finding X.509 signing certificate: %v", err)
+		return git.GPGVerificationResultBad, ""
+	}
+	keyID := fmt.Sprintf("%X", certificate.SerialNumber)
+
+	verificationTime := time.Now()
+	if v.timestampAuthorities != nil {
+		genTime, ok, err := v.verifyTimestamp(*signer)
+		if err != nil {
+			log.Warnf("Failed verifying the timestamp of X.509 signature made by %s: %v", keyID, err)
+			return git.GPGVerificationResultBad, keyID
+		}
+		if ok {
+			verificationTime = genTime
+		}
+	}
+	// The timestamp is verified above against the trusted timestamp authorities, rather than by the CMS library against
+	// the trusted CAs, or ignored when there are none
+	signer.UnsignedAttrs = nil
+	der, err := signedData.ContentInfoDER()
+	if err != nil {
+		log.Warnf("Failed encoding X.509 signature: %v", err)
+		return git.GPGVerificationResultBad, keyID
+	}
+	sd, err := cms.ParseSignedData(der)
+	if err != nil {
+		log.Warnf("Failed parsing X.509 signature: %v", err)
+		return git.GPGVerificationResultBad, keyID
+	}
+
+	_, err = sd.VerifyDetached(payload, x509.VerifyOptions{
+		Roots:       v.roots,
+		CurrentTime: verificationTime,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	var invalidErr x509.CertificateInvalidError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	switch {
+	case errors.As(err, &invalidErr) && invalidErr.Reason == x509.Expired:
+		return git.GPGVerificationResultExpiredKey, keyID
+	case errors.As(err, &invalidErr), errors.As(err, &unknownAuthorityErr):
+		return git.GPGVerificationResultUntrusted, keyID
+	case err != nil:
+		log.Warnf("Failed verifying X.509 signature made by %s: %v", keyID, err)
+		return git.GPGVerificationResultBad, keyID
+	}
+
+	if !v.identityAllowed(certificate) {
+		return git.GPGVerificationResultUntrusted, keyID
+	}
+	return git.GPGVerificationResultGood, keyID
+}
+
+// verifyTimestamp checks the RFC 3161 timestamp token in the unsigned attributes of the signer is issued for its
+// signature by one of the trusted timestamp authorities, and returns the time of the timestamp. It returns false when
+// the signer has no timestamp token.
+func (v *x509Verifier) verifyTimestamp(signer protocol.SignerInfo) (time.Time, bool, error) {
+	if !signer.UnsignedAttrs.HasAttribute(oid.AttributeTimeStampToken) {
+		return time.Time{}, false, nil
+	}
+	token, err := signer.UnsignedAttrs.GetOnlyAttributeValueBytes(oid.AttributeTimeStampToken)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("malformed timestamp token: %w", err)
+	}
+	contentInfo, err := protocol.ParseContentInfo(token.FullBytes)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("malformed timestamp token: %w", err)
+	}
+	signedData, err := contentInfo.SignedDataContent()
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("malformed timestamp token: %w", err)
+	}
+	info, err := timestamp.ParseInfo(signedData.EncapContentInfo)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("malformed timestamp token info: %w", err)
+	}
+
+	imprintHash, err := info.MessageImprint.Hash()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	imprint, err := timestamp.NewMessageImprint(imprintHash, bytes.NewReader(signer.Signature))
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if !imprint.Equal(info.MessageImprint) {
+		return time.Time{}, false, errors.New("timestamp is issued for a different signature")
+	}
+
+	tokenSignedData, err := cms.ParseSignedData(token.FullBytes)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("malformed timestamp token: %w", err)
+	}
+	if _, err := tokenSignedData.Verify(x509.VerifyOptions{
+		Roots:       v.timestampAuthorities,
+		CurrentTime: info.GenTime,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	}); err != nil {
+		return time.Time{}, false, fmt.Errorf("untrusted timestamp token: %w", err)
+	}
+	return info.GenTime, true, nil
+}
+
+// identityAllowed reports whether some of the e-mail or URI subject alternative names of the certificate are matched
+// by the allowed identities
+func (v *x509Verifier) identityAllowed(certificate *x509.Certificate) bool {
+	identities := append([]string{}, certificate.EmailAddresses...)
+	for _, uri := range certificate.URIs {
+		identities = append(identities, uri.String())
+	}
+	for _, identity := range identities {
+		for _, allowed := range v.identities {
+			if glob.Match(allowed, identity) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseCMSSignature parses the armored detached CMS signature
+func parseCMSSignature(signature string) (*protocol.SignedData, error) {
+	block, _ := pem.Decode([]byte(signature))
+	if block == nil {
+		return nil, errors.New("signature is not PEM encoded")
+	}
+	contentInfo, err := protocol.ParseContentInfo(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signedData, err := contentInfo.SignedDataContent()
+	if err != nil {
+		return nil, err
+	}
+	if signedData.EncapContentInfo.EContent.Bytes != nil {
+		return nil, errors.New("signature is not detached")
+	}
+	return signedData, nil
+}
@@ -0,0 +1,361 @@
+package sourceintegrity
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/github/smimesign/ietf-cms/oid"
+	"github.com/github/smimesign/ietf-cms/protocol"
+	"github.com/github/smimesign/ietf-cms/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+// Made by `openssl cms -sign -binary -md sha256 -in payload -signer leaf.pem -inkey leaf.key -outform PEM`
+const (
+	x509FixtureCA = `-----BEGIN CERTIFICATE-----
+MIIBeDCCAR+gAwIBAgIUbEYhJB+FeTkjLFJf/Hy8d3owR1cwCgYIKoZIzj0EAwIw
+EjEQMA4GA1UEAwwHVGVzdCBDQTAeFw0yNjEwMTcwMTUwMDRaFw0zNjEwMTQwMTUw
+MDRaMBIxEDAOBgNVBAMMB1Rlc3QgQ0EwWTATBgcqhkjOPQIBBggqhkjOPQMBBwNC
+AASkR4IojgFGqGmDNvmj+op9+zP8r16SakFgWV0xysxTIpfo9s2nbn5wp+M7TqfK
+24+0bMHEmjKisR2/laY1qM5Ko1MwUTAdBgNVHQ4EFgQUsicGOVpZH2QYDACDYTBH
+o+2gWIIwHwYDVR0jBBgwFoAUsicGOVpZH2QYDACDYTBHo+2gWIIwDwYDVR0TAQH/
+BAUwAwEB/zAKBggqhkjOPQQDAgNHADBEAiAdE19lqtf6xTy5JLlMvDy4QRqHssQt
+VX56ldgeelwSawIgTzeZe07/SkHpvGq7bBENomQJkSbEKWAUqAaIXsaD1SY=
+-----END CERTIFICATE-----
+`
+	x509FixtureOtherCA = `-----BEGIN CERTIFICATE-----
+MIIBfDCCASGgAwIBAgIUFxPLFTNTn37rTt6eKkGnG8OKapYwCgYIKoZIzj0EAwIw
+EzERMA8GA1UEAwwIT3RoZXIgQ0EwHhcNMjYxMDE3MDE1MDA4WhcNMzYxMDE0MDE1
+MDA4WjATMREwDwYDVQQDDAhPdGhlciBDQTBZMBMGByqGSM49AgEGCCqGSM49AwEH
+A0IABBNHJKRbxWBSB2KZsaovQlGJINYam44WQjm0VF8c9KWqiQlmYKdQb1zMZMwk
+pdsH5zetKB/t4lBLESnrTc7K+vqjUzBRMB0GA1UdDgQWBBTS+vOL7ZFpjutmOY9D
+JoxmP1FcKzAfBgNVHSMEGDAWgBTS+vOL7ZFpjutmOY9DJoxmP1FcKzAPBgNVHRMB
+Af8EBTADAQH/MAoGCCqGSM49BAMCA0kAMEYCIQCQs9vXV7/hSKO/S9a8j9roYMr4
+O4O71gsZeRCNrxXPmgIhAOfw92+l97+FwP+qZy5FqaLrSGyN26ip297eCEQur0NQ
+-----END CERTIFICATE-----
+`
+	x509FixturePayload   = "tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904\nauthor Dev <dev@example.com> 1700000000 +0000\ncommitter Dev <dev@example.com> 1700000000 +0000\n\nsigned\n"
+	x509FixtureSignature = `-----BEGIN CMS-----
+MIIDTwYJKoZIhvcNAQcCoIIDQDCCAzwCAQExDTALBglghkgBZQMEAgEwCwYJKoZI
+hvcNAQcBoIIBmTCCAZUwggE7oAMCAQICFCq8jUHxVOmTO0FhtPljdg8XfxIcMAoG
+CCqGSM49BAMCMBIxEDAOBgNVBAMMB1Rlc3QgQ0EwHhcNMjYxMDE3MDE1MDA0WhcN
+MzYxMDE0MDE1MDA0WjAOMQwwCgYDVQQDDANkZXYwWTATBgcqhkjOPQIBBggqhkjO
+PQMBBwNCAAT/vU79zMl9jWX5OVXwq0bRox+juBiLlgVjBNoCqlVt9x/9Y1d51zvW
+u3x33HJuASqyCnidZz23DYRozWytI8jvo3MwcTAaBgNVHREEEzARgQ9kZXZAZXhh
+bXBsZS5jb20wEwYDVR0lBAwwCgYIKwYBBQUHAwMwHQYDVR0OBBYEFEI8L7kx0gxI
++wr12F3pmcykjda/MB8GA1UdIwQYMBaAFLInBjlaWR9kGAwAg2EwR6PtoFiCMAoG
+CCqGSM49BAMCA0gAMEUCIQDNdeAvzmdB7S/d82NJS1k4Yh14YOQIYZw6UQrA7MtG
+1gIgYfCgiigHLj9c521ZNERoFvas4hmyR6UEKtjXtL0jNjcxggF8MIIBeAIBATAq
+MBIxEDAOBgNVBAMMB1Rlc3QgQ0ECFCq8jUHxVOmTO0FhtPljdg8XfxIcMAsGCWCG
+SAFlAwQCAaCB5DAYBgkqhkiG9w0BCQMxCwYJKoZIhvcNAQcBMBwGCSqGSIb3DQEJ
+BTEPFw0yNjEwMTcwMTUwMDRaMC8GCSqGSIb3DQEJBDEiBCBSmTKnCGH67XuFsyCr
+PkNr2m9UuVjSnPl0qPjWssCloDB5BgkqhkiG9w0BCQ8xbDBqMAsGCWCGSAFlAwQB
+KjALBglghkgBZQMEARYwCwYJYIZIAWUDBAECMAoGCCqGSIb3DQMHMA4GCCqGSIb3
+DQMCAgIAgDANBggqhkiG9w0DAgIBQDAHBgUrDgMCBzANBggqhkiG9w0DAgIBKDAK
+BggqhkjOPQQDAgRHMEUCIDVwht47TmyBI3IrJZsK0QH0pitmfsReRCd7+N1JRSuW
+AiEAt4ePMk5mZpk8uL27+K3+mSxoquRgRM9kMlNH6hpIpKc=
+-----END CMS-----
+`
+	x509FixtureSerial = "2ABC8D41F154E9933B4161B4F963760F177F121C"
+)
+
+type x509TestCA struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+func newX509TestCA(t *testing.T) *x509TestCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &x509TestCA{certificate: certificate, key: key}
+}
+
+func (ca *x509TestCA) pem() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.certificate.Raw}))
+}
+
+// issue issues a certificate for a new key
+func (ca *x509TestCA) issue(t *testing.T, template *x509.Certificate) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return certificate, key
+}
+
+// sign makes a detached CMS signature of the payload with a short-lived certificate issued for code signing, declaring
+// the signing time, the way gitsign does. The signature is timestamped at the signing time by the timestamp authority
+// issued by tsa, if not nil.
+func (ca *x509TestCA) sign(t *testing.T, payload []byte, notAfter time.Time, signingTime time.Time, tsa *x509TestCA) string {
+	t.Helper()
+	return ca.signWithCertificate(t, payload, &x509.Certificate{
+		SerialNumber:   big.NewInt(42),
+		EmailAddresses: []string{"dev@example.com"},
+		NotBefore:      notAfter.Add(-10 * time.Minute),
+		NotAfter:       notAfter,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}, signingTime, tsa)
+}
+
+// signWithCertificate makes a detached CMS signature of the payload with a certificate issued from the template
+func (ca *x509TestCA) signWithCertificate(t *testing.T, payload []byte, template *x509.Certificate, signingTime time.Time, tsa *x509TestCA) string {
+	t.Helper()
+	certificate, key := ca.issue(t, template)
+	content, err := protocol.NewDataEncapsulatedContentInfo(payload)
+	require.NoError(t, err)
+	signedData, err := protocol.NewSignedData(content)
+	require.NoError(t, err)
+	require.NoError(t, signedData.AddSignerInfo([]*x509.Certificate{certificate}, key))
+	signer := &signedData.SignerInfos[0]
+	declareSigningTime(t, signer, key, signingTime)
+	if tsa != nil {
+		attribute, err := protocol.NewAttribute(oid.AttributeTimeStampToken, asn1.RawValue{FullBytes: tsa.timestamp(t, signer.Signature, signingTime)})
+		require.NoError(t, err)
+		signer.UnsignedAttrs = append(signer.UnsignedAttrs, attribute)
+	}
+	signedData.EncapContentInfo.EContent = asn1.RawValue{}
+	der, err := signedData.ContentInfoDER()
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "SIGNED MESSAGE", Bytes: der}))
+}
+
+// timestamp makes an RFC 3161 timestamp token of the signature at the given time, signed by a timestamp authority
+// certificate issued by the CA
+func (ca *x509TestCA) timestamp(t *testing.T, signature []byte, genTime time.Time) []byte {
+	t.Helper()
+	certificate, key := ca.issue(t, &x509.Certificate{
+		SerialNumber: big.NewInt(7),
+		Subject:      pkix.Name{CommonName: "Test TSA"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	})
+	imprint, err := timestamp.NewMessageImprint(crypto.SHA256, bytes.NewReader(signature))
+	require.NoError(t, err)
+	info, err := asn1.Marshal(timestamp.Info{
+		Version:        1,
+		Policy:         asn1.ObjectIdentifier{1, 2, 3},
+		MessageImprint: imprint,
+		SerialNumber:   big.NewInt(1),
+		GenTime:        genTime.UTC().Truncate(time.Second),
+	})
+	require.NoError(t, err)
+	content, err := protocol.NewEncapsulatedContentInfo(oid.ContentTypeTSTInfo, info)
+	require.NoError(t, err)
+	signedData, err := protocol.NewSignedData(content)
+	require.NoError(t, err)
+	require.NoError(t, signedData.AddSignerInfo([]*x509.Certificate{certificate}, key))
+	der, err := signedData.ContentInfoDER()
+	require.NoError(t, err)
+	return der
+}
+
+// declareSigningTime replaces the signing time declared by the signed attributes of the signer, and signs them again
+func declareSigningTime(t *testing.T, signer *protocol.SignerInfo, key *ecdsa.PrivateKey, signingTime time.Time) {
+	t.Helper()
+	attribute, err := protocol.NewAttribute(oid.AttributeSigningTime, signingTime.UTC())
+	require.NoError(t, err)
+	for i := range signer.SignedAttrs {
+		if signer.SignedAttrs[i].Type.Equal(oid.AttributeSigningTime) {
+			signer.SignedAttrs[i] = attribute
+		}
+	}
+	signedAttrs, err := signer.SignedAttrs.MarshaledForSigning()
+	require.NoError(t, err)
+	digest := sha256.Sum256(signedAttrs)
+	signer.Signature, err = ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+}
+
+func TestVerifyX509Signature(t *testing.T) {
+	payload := []byte("commit payload")
+	ca := newX509TestCA(t)
+	tsa := newX509TestCA(t)
+	expired := time.Now().Add(-time.Minute)
+
+	testCases := []struct {
+		name                 string
+		caRoots              []string
+		identities           []string
+		timestampAuthorities []string
+		signature            string
+		payload              []byte
+		expectedResult       git.GPGVerificationResult
+		expectedKeyID        string
+	}{
+		{
+			name:           "signed by openssl",
+			caRoots:        []string{x509FixtureCA},
+			identities:     []string{"dev@example.com"},
+			signature:      x509FixtureSignature,
+			payload:        []byte(x509FixturePayload),
+			expectedResult: git.GPGVerificationResultGood,
+			expectedKeyID:  x509FixtureSerial,
+		},
+		{
+			name:           "signed by allowed identity",
+			caRoots:        []string{"not a certificate", x509FixtureOtherCA, x509FixtureCA},
+			identities:     []string{"admin@example.com", "*@example.com"},
+			signature:      x509FixtureSignature,
+			payload:        []byte(x509FixturePayload),
+			expectedResult: git.GPGVerificationResultGood,
+			expectedKeyID:  x509FixtureSerial,
+		},
+		{
+			name:           "signed by other identity",
+			caRoots:        []string{x509FixtureCA},
+			identities:     []string{"admin@example.com"},
+			signature:      x509FixtureSignature,
+			payload:        []byte(x509FixturePayload),
+			expectedResult: git.GPGVerificationResultUntrusted,
+			expectedKeyID:  x509FixtureSerial,
+		},
+		{
+			name:           "no identities configured",
+			caRoots:        []string{x509FixtureCA},
+			signature:      x509FixtureSignature,
+			payload:        []byte(x509FixturePayload),
+			expectedResult: git.GPGVerificationResultUntrusted,
+			expectedKeyID:  x509FixtureSerial,
+		},
+		{
+			name:           "issued by untrusted CA",
+			caRoots:        []string{x509FixtureOtherCA},
+			identities:     []string{"*"},
+			signature:      x509FixtureSignature,
+			payload:        []byte(x509FixturePayload),
+			expectedResult: git.GPGVerificationResultUntrusted,
+			expectedKeyID:  x509FixtureSerial,
+		},
+		{
+			name:       "certificate not issued for code signing",
+			caRoots:    []string{ca.pem()},
+			identities: []string{"dev@example.com"},
+			signature: ca.signWithCertificate(t, payload, &x509.Certificate{
+				SerialNumber:   big.NewInt(43),
+				EmailAddresses: []string{"dev@example.com"},
+				NotBefore:      time.Now().Add(-time.Minute),
+				NotAfter:       time.Now().Add(time.Minute),
+				ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			}, time.Now(), nil),
+			payload:        payload,
+			expectedResult: git.GPGVerificationResultUntrusted,
+			expectedKeyID:  "2B",
+		},
+		{
+			name:           "tampered payload",
+			caRoots:        []string{x509FixtureCA},
+			identities:     []string{"*"},
+			signature:      x509FixtureSignature,
+			payload:        []byte(x509FixturePayload + "tampered"),
+			expectedResult: git.GPGVerificationResultBad,
+			expectedKeyID:  x509FixtureSerial,
+		},
+		{
+			name:           "short-lived certificate valid now",
+			caRoots:        []string{ca.pem()},
+			identities:     []string{"dev@example.com"},
+			signature:      ca.sign(t, payload, time.Now().Add(time.Minute), time.Now(), nil),
+			payload:        payload,
+			expectedResult: git.GPGVerificationResultGood,
+			expectedKeyID:  "2A",
+		},
+		{
+			name:           "short-lived certificate expired after declared signing time",
+			caRoots:        []string{ca.pem()},
+			identities:     []string{"dev@example.com"},
+			signature:      ca.sign(t, payload, expired, expired.Add(-5*time.Minute), nil),
+			payload:        payload,
+			expectedResult: git.GPGVerificationResultExpiredKey,
+			expectedKeyID:  "2A",
+		},
+		{
+			name:                 "short-lived certificate expired after timestamp",
+			caRoots:              []string{ca.pem()},
+			identities:           []string{"dev@example.com"},
+			timestampAuthorities: []string{tsa.pem()},
+			signature:            ca.sign(t, payload, expired, expired.Add(-5*time.Minute), tsa),
+			payload:              payload,
+			expectedResult:       git.GPGVerificationResultGood,
+			expectedKeyID:        "2A",
+		},
+		{
+			name:                 "timestamped after certificate expiry",
+			caRoots:              []string{ca.pem()},
+			identities:           []string{"dev@example.com"},
+			timestampAuthorities: []string{tsa.pem()},
+			signature:            ca.sign(t, payload, expired, time.Now(), tsa),
+			payload:              payload,
+			expectedResult:       git.GPGVerificationResultExpiredKey,
+			expectedKeyID:        "2A",
+		},
+		{
+			name:                 "timestamped by untrusted timestamp authority",
+			caRoots:              []string{ca.pem()},
+			identities:           []string{"dev@example.com"},
+			timestampAuthorities: []string{tsa.pem()},
+			signature:            ca.sign(t, payload, expired, expired.Add(-5*time.Minute), ca),
+			payload:              payload,
+			expectedResult:       git.GPGVerificationResultBad,
+			expectedKeyID:        "2A",
+		},
+		{
+			name:           "timestamp ignored without trusted timestamp authorities",
+			caRoots:        []string{ca.pem()},
+			identities:     []string{"dev@example.com"},
+			signature:      ca.sign(t, payload, expired, expired.Add(-5*time.Minute), tsa),
+			payload:        payload,
+			expectedResult: git.GPGVerificationResultExpiredKey,
+			expectedKeyID:  "2A",
+		},
+		{
+			name:           "malformed signature",
+			caRoots:        []string{x509FixtureCA},
+			identities:     []string{"*"},
+			signature:      "-----BEGIN SIGNED MESSAGE-----\nMAA=\n-----END SIGNED MESSAGE-----\n",
+			payload:        payload,
+			expectedResult: git.GPGVerificationResultBad,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := newX509Verifier(&v1alpha1.SourceIntegrityGitPolicyX509{CARoots: tt.caRoots, Identities: tt.identities, TimestampAuthorities: tt.timestampAuthorities})
+			result, keyID := verifier.verify(tt.signature, tt.payload)
+			assert.Equal(t, tt.expectedResult, result)
+			assert.Equal(t, tt.expectedKeyID, keyID)
+		})
+	}
+}